@@ -27,6 +27,7 @@ var services = mapOf(
         "dns" to "DNS",
         "datafactory" to "Data Factory",
         "datalake" to "Data Lake",
+        "dashboard" to "Dashboard",
         "datashare" to "Data Share",
         "databricks" to "DataBricks",
         "dataprotection" to "DataProtection",
@@ -38,6 +39,7 @@ var services = mapOf(
         "domainservices" to "DomainServices",
         "eventgrid" to "EventGrid",
         "eventhub" to "EventHub",
+        "fabric" to "Fabric",
         "firewall" to "Firewall",
         "frontdoor" to "FrontDoor",
         "hdinsight" to "HDInsight",
@@ -73,6 +75,7 @@ var services = mapOf(
         "postgres" to "PostgreSQL",
         "powerbi" to "PowerBI",
         "privatedns" to "Private DNS",
+        "privatednsresolver" to "Private DNS Resolver",
         "purview" to "Purview",
         "recoveryservices" to "Recovery Services",
         "redis" to "Redis",
@@ -89,6 +92,7 @@ var services = mapOf(
         "signalr" to "SignalR",
         "springcloud" to "Spring Cloud",
         "storage" to "Storage",
+        "storagemover" to "Storage Mover",
         "streamanalytics" to "Stream Analytics",
         "subscription" to "Subscription",
         "synapse" to "Synapse",
@@ -0,0 +1,306 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// The Container Service SDK we're using (2021-08-01) doesn't expose a number of properties for Managed Clusters
+// and Agent Pools which are available in newer API versions - since upgrading the SDK requires migrating the whole
+// of the Kubernetes Cluster resources, these methods send the request using a newer API version instead, merging
+// the additional properties into the `properties` block of the request.
+//
+// NOTE: whilst only the additional properties which are non-nil are merged into the request, the request is still
+// processed using the semantics of the newer API version - meaning properties which aren't available in the SDK
+// model are reset to their defaults, and properties which have since been deprecated (e.g. `enablePodSecurityPolicy`)
+// are still sent. As such these methods should only be used when the additional properties are being changed.
+
+const containerServiceExtendedAPIVersion = "2023-10-01"
+
+// ManagedClusterExtendedProperties contains the subset of the Managed Cluster properties which aren't available in the SDK
+type ManagedClusterExtendedProperties struct {
+	IngressProfile            *ManagedClusterIngressProfile            `json:"ingressProfile,omitempty"`
+	OidcIssuerProfile         *ManagedClusterOidcIssuerProfile         `json:"oidcIssuerProfile,omitempty"`
+	SecurityProfile           *ManagedClusterSecurityProfile           `json:"securityProfile,omitempty"`
+	WorkloadAutoScalerProfile *ManagedClusterWorkloadAutoScalerProfile `json:"workloadAutoScalerProfile,omitempty"`
+}
+
+type ManagedClusterIngressProfile struct {
+	WebAppRouting *ManagedClusterIngressProfileWebAppRouting `json:"webAppRouting,omitempty"`
+}
+
+type ManagedClusterIngressProfileWebAppRouting struct {
+	DnsZoneResourceIds *[]string `json:"dnsZoneResourceIds,omitempty"`
+	Enabled            *bool     `json:"enabled,omitempty"`
+}
+
+type ManagedClusterOidcIssuerProfile struct {
+	Enabled   *bool   `json:"enabled,omitempty"`
+	IssuerURL *string `json:"issuerURL,omitempty"`
+}
+
+type ManagedClusterSecurityProfile struct {
+	AzureKeyVaultKms *ManagedClusterAzureKeyVaultKms                `json:"azureKeyVaultKms,omitempty"`
+	Defender         *ManagedClusterSecurityProfileDefender         `json:"defender,omitempty"`
+	ImageCleaner     *ManagedClusterSecurityProfileImageCleaner     `json:"imageCleaner,omitempty"`
+	WorkloadIdentity *ManagedClusterSecurityProfileWorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+type ManagedClusterAzureKeyVaultKms struct {
+	Enabled               *bool   `json:"enabled,omitempty"`
+	KeyId                 *string `json:"keyId,omitempty"`
+	KeyVaultNetworkAccess *string `json:"keyVaultNetworkAccess,omitempty"`
+}
+
+type ManagedClusterSecurityProfileDefender struct {
+	LogAnalyticsWorkspaceResourceId *string                                          `json:"logAnalyticsWorkspaceResourceId,omitempty"`
+	SecurityMonitoring              *ManagedClusterSecurityProfileDefenderMonitoring `json:"securityMonitoring,omitempty"`
+}
+
+type ManagedClusterSecurityProfileDefenderMonitoring struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type ManagedClusterSecurityProfileImageCleaner struct {
+	Enabled       *bool  `json:"enabled,omitempty"`
+	IntervalHours *int64 `json:"intervalHours,omitempty"`
+}
+
+type ManagedClusterSecurityProfileWorkloadIdentity struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type ManagedClusterWorkloadAutoScalerProfile struct {
+	Keda                  *ManagedClusterWorkloadAutoScalerProfileToggle `json:"keda,omitempty"`
+	VerticalPodAutoscaler *ManagedClusterWorkloadAutoScalerProfileToggle `json:"verticalPodAutoscaler,omitempty"`
+}
+
+type ManagedClusterWorkloadAutoScalerProfileToggle struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// CreateOrUpdateManagedClusterWithExtendedProperties sends the Managed Cluster to the API using a newer API version,
+// including the extended properties which aren't available in the SDK
+func CreateOrUpdateManagedClusterWithExtendedProperties(ctx context.Context, client *containerservice.ManagedClustersClient, resourceGroupName string, resourceName string, parameters containerservice.ManagedCluster, extended ManagedClusterExtendedProperties) (result containerservice.ManagedClustersCreateOrUpdateFuture, err error) {
+	payload, err := mergeExtendedProperties(parameters, extended)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "CreateOrUpdate", nil, "Failure building payload")
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"resourceName":      autorest.Encode("path", resourceName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": containerServiceExtendedAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ContainerService/managedClusters/{resourceName}", pathParameters),
+		autorest.WithJSON(payload),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetManagedClusterExtendedProperties retrieves the extended properties of the Managed Cluster which aren't available in the SDK
+func GetManagedClusterExtendedProperties(ctx context.Context, client *containerservice.ManagedClustersClient, resourceGroupName string, resourceName string) (*ManagedClusterExtendedProperties, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"resourceName":      autorest.Encode("path", resourceName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": containerServiceExtendedAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ContainerService/managedClusters/{resourceName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "Get", resp, "Failure sending request")
+	}
+
+	var result struct {
+		Properties *ManagedClusterExtendedProperties `json:"properties,omitempty"`
+	}
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result.Properties, nil
+}
+
+// AgentPoolExtendedProperties contains the subset of the Agent Pool properties which aren't available in the SDK
+type AgentPoolExtendedProperties struct {
+	HostGroupID *string `json:"hostGroupID,omitempty"`
+}
+
+// CreateOrUpdateAgentPoolWithExtendedProperties sends the Agent Pool to the API using a newer API version,
+// including the extended properties which aren't available in the SDK
+func CreateOrUpdateAgentPoolWithExtendedProperties(ctx context.Context, client *containerservice.AgentPoolsClient, resourceGroupName string, resourceName string, agentPoolName string, parameters containerservice.AgentPool, extended AgentPoolExtendedProperties) (result containerservice.AgentPoolsCreateOrUpdateFuture, err error) {
+	payload, err := mergeExtendedProperties(parameters, extended)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.AgentPoolsClient", "CreateOrUpdate", nil, "Failure building payload")
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"agentPoolName":     autorest.Encode("path", agentPoolName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"resourceName":      autorest.Encode("path", resourceName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": containerServiceExtendedAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ContainerService/managedClusters/{resourceName}/agentPools/{agentPoolName}", pathParameters),
+		autorest.WithJSON(payload),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.AgentPoolsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.AgentPoolsClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetAgentPoolExtendedProperties retrieves the extended properties of the Agent Pool which aren't available in the SDK
+func GetAgentPoolExtendedProperties(ctx context.Context, client *containerservice.AgentPoolsClient, resourceGroupName string, resourceName string, agentPoolName string) (*AgentPoolExtendedProperties, error) {
+	pathParameters := map[string]interface{}{
+		"agentPoolName":     autorest.Encode("path", agentPoolName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"resourceName":      autorest.Encode("path", resourceName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": containerServiceExtendedAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ContainerService/managedClusters/{resourceName}/agentPools/{agentPoolName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "containerservice.AgentPoolsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "containerservice.AgentPoolsClient", "Get", resp, "Failure sending request")
+	}
+
+	var result struct {
+		Properties *AgentPoolExtendedProperties `json:"properties,omitempty"`
+	}
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "containerservice.AgentPoolsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result.Properties, nil
+}
+
+// mergeExtendedProperties serializes the SDK model and merges the extended properties into its `properties` block
+func mergeExtendedProperties(parameters interface{}, extended interface{}) (map[string]interface{}, error) {
+	payload, err := convertToJsonMap(parameters)
+	if err != nil {
+		return nil, fmt.Errorf("serializing the parameters: %+v", err)
+	}
+
+	extendedProperties, err := convertToJsonMap(extended)
+	if err != nil {
+		return nil, fmt.Errorf("serializing the extended properties: %+v", err)
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	payload["properties"] = mergeJsonMaps(properties, extendedProperties)
+
+	return payload, nil
+}
+
+func convertToJsonMap(input interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(b, &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// mergeJsonMaps recursively merges the values from `overrides` into `input`
+func mergeJsonMaps(input map[string]interface{}, overrides map[string]interface{}) map[string]interface{} {
+	for k, v := range overrides {
+		existing, existingIsMap := input[k].(map[string]interface{})
+		override, overrideIsMap := v.(map[string]interface{})
+		if existingIsMap && overrideIsMap {
+			input[k] = mergeJsonMaps(existing, override)
+			continue
+		}
+
+		input[k] = v
+	}
+
+	return input
+}
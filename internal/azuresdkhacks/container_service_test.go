@@ -0,0 +1,173 @@
+package azuresdkhacks
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestMergeJsonMaps(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Input     map[string]interface{}
+		Overrides map[string]interface{}
+		Expected  map[string]interface{}
+	}{
+		{
+			Name:      "no overrides",
+			Input:     map[string]interface{}{"a": "b"},
+			Overrides: map[string]interface{}{},
+			Expected:  map[string]interface{}{"a": "b"},
+		},
+		{
+			Name:      "new key",
+			Input:     map[string]interface{}{"a": "b"},
+			Overrides: map[string]interface{}{"c": "d"},
+			Expected:  map[string]interface{}{"a": "b", "c": "d"},
+		},
+		{
+			Name:      "overridden value",
+			Input:     map[string]interface{}{"a": "b"},
+			Overrides: map[string]interface{}{"a": "c"},
+			Expected:  map[string]interface{}{"a": "c"},
+		},
+		{
+			Name: "nested maps are merged",
+			Input: map[string]interface{}{
+				"profile": map[string]interface{}{
+					"existing": true,
+				},
+			},
+			Overrides: map[string]interface{}{
+				"profile": map[string]interface{}{
+					"additional": true,
+				},
+			},
+			Expected: map[string]interface{}{
+				"profile": map[string]interface{}{
+					"existing":   true,
+					"additional": true,
+				},
+			},
+		},
+		{
+			Name: "map replaces a non-map value",
+			Input: map[string]interface{}{
+				"profile": "value",
+			},
+			Overrides: map[string]interface{}{
+				"profile": map[string]interface{}{
+					"additional": true,
+				},
+			},
+			Expected: map[string]interface{}{
+				"profile": map[string]interface{}{
+					"additional": true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := mergeJsonMaps(tc.Input, tc.Overrides)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestMergeExtendedPropertiesAgentPool(t *testing.T) {
+	parameters := containerservice.AgentPool{
+		Name: utils.String("pool1"),
+		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+			Count:  utils.Int32(1),
+			VMSize: utils.String("Standard_D2s_v3"),
+		},
+	}
+
+	t.Run("no extended properties", func(t *testing.T) {
+		actual, err := mergeExtendedProperties(parameters, AgentPoolExtendedProperties{})
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		// the `name` is read-only, so isn't sent by the SDK
+		expected := map[string]interface{}{
+			"properties": map[string]interface{}{
+				"count":  float64(1),
+				"vmSize": "Standard_D2s_v3",
+			},
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	})
+
+	t.Run("host group", func(t *testing.T) {
+		hostGroupId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/hostGroups/hostGroup1"
+		actual, err := mergeExtendedProperties(parameters, AgentPoolExtendedProperties{
+			HostGroupID: utils.String(hostGroupId),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		// the `name` is read-only, so isn't sent by the SDK
+		expected := map[string]interface{}{
+			"properties": map[string]interface{}{
+				"count":       float64(1),
+				"vmSize":      "Standard_D2s_v3",
+				"hostGroupID": hostGroupId,
+			},
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	})
+}
+
+func TestMergeExtendedPropertiesManagedCluster(t *testing.T) {
+	parameters := containerservice.ManagedCluster{
+		Location: utils.String("westeurope"),
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			DNSPrefix: utils.String("example"),
+		},
+	}
+
+	actual, err := mergeExtendedProperties(parameters, ManagedClusterExtendedProperties{
+		OidcIssuerProfile: &ManagedClusterOidcIssuerProfile{
+			Enabled: utils.Bool(true),
+		},
+		SecurityProfile: &ManagedClusterSecurityProfile{
+			WorkloadIdentity: &ManagedClusterSecurityProfileWorkloadIdentity{
+				Enabled: utils.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// only the extended properties which have been specified are sent
+	expected := map[string]interface{}{
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"dnsPrefix": "example",
+			"oidcIssuerProfile": map[string]interface{}{
+				"enabled": true,
+			},
+			"securityProfile": map[string]interface{}{
+				"workloadIdentity": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,71 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// The Storage SDK we're using (2021-04-01) doesn't expose the account-level version immutability, which can only
+// be enabled when the Storage Account is created - as such this method sends the create request using a newer API
+// version, merging the additional properties into the `properties` block of the request.
+
+const storageAccountExtendedAPIVersion = "2021-09-01"
+
+// StorageAccountExtendedProperties contains the subset of the Storage Account properties which aren't available in the SDK
+type StorageAccountExtendedProperties struct {
+	ImmutableStorageWithVersioning *StorageAccountImmutableStorageWithVersioning `json:"immutableStorageWithVersioning,omitempty"`
+}
+
+type StorageAccountImmutableStorageWithVersioning struct {
+	Enabled            *bool                             `json:"enabled,omitempty"`
+	ImmutabilityPolicy *StorageAccountImmutabilityPolicy `json:"immutabilityPolicy,omitempty"`
+}
+
+type StorageAccountImmutabilityPolicy struct {
+	AllowProtectedAppendWrites            *bool   `json:"allowProtectedAppendWrites,omitempty"`
+	ImmutabilityPeriodSinceCreationInDays *int64  `json:"immutabilityPeriodSinceCreationInDays,omitempty"`
+	State                                 *string `json:"state,omitempty"`
+}
+
+// CreateStorageAccountWithExtendedProperties creates the Storage Account using a newer API version, including the
+// extended properties which aren't available in the SDK
+func CreateStorageAccountWithExtendedProperties(ctx context.Context, client *storage.AccountsClient, resourceGroupName string, accountName string, parameters storage.AccountCreateParameters, extended StorageAccountExtendedProperties) (result storage.AccountsCreateFuture, err error) {
+	payload, err := mergeExtendedProperties(parameters, extended)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "Create", nil, "Failure building payload")
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"accountName":       autorest.Encode("path", accountName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": storageAccountExtendedAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}", pathParameters),
+		autorest.WithJSON(payload),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "Create", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
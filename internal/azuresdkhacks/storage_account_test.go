@@ -0,0 +1,52 @@
+package azuresdkhacks
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestMergeExtendedPropertiesStorageAccount(t *testing.T) {
+	parameters := storage.AccountCreateParameters{
+		Kind:     storage.KindStorageV2,
+		Location: utils.String("westeurope"),
+		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
+			EnableHTTPSTrafficOnly: utils.Bool(true),
+		},
+	}
+
+	actual, err := mergeExtendedProperties(parameters, StorageAccountExtendedProperties{
+		ImmutableStorageWithVersioning: &StorageAccountImmutableStorageWithVersioning{
+			Enabled: utils.Bool(true),
+			ImmutabilityPolicy: &StorageAccountImmutabilityPolicy{
+				AllowProtectedAppendWrites:            utils.Bool(false),
+				ImmutabilityPeriodSinceCreationInDays: utils.Int64(3),
+				State:                                 utils.String("Unlocked"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"kind":     "StorageV2",
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"supportsHttpsTrafficOnly": true,
+			"immutableStorageWithVersioning": map[string]interface{}{
+				"enabled": true,
+				"immutabilityPolicy": map[string]interface{}{
+					"allowProtectedAppendWrites":            false,
+					"immutabilityPeriodSinceCreationInDays": float64(3),
+					"state":                                 "Unlocked",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -1,6 +1,7 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/api"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -44,6 +46,20 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			"api_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(api.ApiTypeGraphql),
+					string(api.ApiTypeHttp),
+					string(api.ApiTypeSoap),
+					string(api.ApiTypeWebsocket),
+				}, false),
+				ConflictsWith: []string{"soap_pass_through"},
+			},
+
 			"display_name": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -67,6 +83,8 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 					ValidateFunc: validation.StringInSlice([]string{
 						string(apimanagement.ProtocolHTTP),
 						string(apimanagement.ProtocolHTTPS),
+						string(api.ProtocolWs),
+						string(api.ProtocolWss),
 					}, false),
 				},
 			},
@@ -116,6 +134,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 								string(apimanagement.WadlXML),
 								string(apimanagement.Wsdl),
 								string(apimanagement.WsdlLink),
+								string(api.ContentFormatGraphqlNegativelink),
 							}, false),
 						},
 
@@ -177,9 +196,10 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 			},
 
 			"soap_pass_through": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"api_type"},
 			},
 
 			"source_api_id": {
@@ -305,15 +325,18 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
-	var apiType apimanagement.APIType
-	var soapApiType apimanagement.SoapAPIType
-
-	soapPassThrough := d.Get("soap_pass_through").(bool)
-	if soapPassThrough {
+	apiType := apimanagement.APIType(d.Get("api_type").(string))
+	if d.Get("soap_pass_through").(bool) {
 		apiType = apimanagement.Soap
-		soapApiType = apimanagement.SoapPassThrough
-	} else {
+	} else if apiType == "" || (apiType == apimanagement.Soap && d.HasChange("soap_pass_through")) {
 		apiType = apimanagement.HTTP
+	}
+
+	// the `apiType` field uses `http` to indicate a SOAP API exposed as REST, otherwise it matches the API Type
+	soapApiType := apimanagement.SoapAPIType(apiType)
+	if apiType == apimanagement.Soap {
+		soapApiType = apimanagement.SoapPassThrough
+	} else if apiType == apimanagement.HTTP {
 		soapApiType = apimanagement.SoapToRest
 	}
 
@@ -358,13 +381,8 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 			apiParams.APICreateOrUpdateProperties.APIVersionSetID = utils.String(versionSetId)
 		}
 
-		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, apiParams, "")
-		if err != nil {
-			return fmt.Errorf("creating/updating %s: %+v", id, err)
-		}
-
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
+		if err := createOrUpdateApiManagementApi(ctx, meta.(*clients.Client), id, apiId, apiParams); err != nil {
+			return err
 		}
 	}
 
@@ -414,13 +432,8 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		params.APICreateOrUpdateProperties.APIVersionSetID = utils.String(versionSetId)
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, params, "")
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
+	if err := createOrUpdateApiManagementApi(ctx, meta.(*clients.Client), id, apiId, params); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID())
@@ -468,6 +481,12 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("service_url", props.ServiceURL)
 		d.Set("revision", props.APIRevision)
 		d.Set("soap_pass_through", string(props.APIType) == string(apimanagement.SoapPassThrough))
+
+		apiType := string(props.APIType)
+		if apiType == "" {
+			apiType = string(apimanagement.HTTP)
+		}
+		d.Set("api_type", apiType)
 		d.Set("subscription_required", props.SubscriptionRequired)
 		d.Set("version", props.APIVersion)
 		d.Set("version_set_id", props.APIVersionSetID)
@@ -521,6 +540,94 @@ func resourceApiManagementApiDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+// createOrUpdateApiManagementApi creates/updates the specified API - GraphQL and WebSocket APIs are only
+// supported by newer versions of the API Management API, so these are provisioned using a newer API Version
+func createOrUpdateApiManagementApi(ctx context.Context, client *clients.Client, id parse.ApiId, apiId string, input apimanagement.APICreateOrUpdateParameter) error {
+	props := input.APICreateOrUpdateProperties
+	if props == nil || (props.APIType != apimanagement.APIType(api.ApiTypeGraphql) && props.APIType != apimanagement.APIType(api.ApiTypeWebsocket)) {
+		future, err := client.ApiManagement.ApiClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, input, "")
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.ApiManagement.ApiClient.Client); err != nil {
+			return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
+		}
+
+		return nil
+	}
+
+	apiType := api.ApiType(props.APIType)
+	soapApiType := api.SoapApiType(props.SoapAPIType)
+	parameters := api.ApiCreateOrUpdateParameter{
+		Properties: &api.ApiCreateOrUpdateProperties{
+			ApiRevisionDescription: props.APIRevisionDescription,
+			ApiType:                &soapApiType,
+			ApiVersion:             props.APIVersion,
+			ApiVersionDescription:  props.APIVersionDescription,
+			ApiVersionSetId:        props.APIVersionSetID,
+			Description:            props.Description,
+			DisplayName:            props.DisplayName,
+			Path:                   utils.NormalizeNilableString(props.Path),
+			ServiceUrl:             props.ServiceURL,
+			SourceApiId:            props.SourceAPIID,
+			SubscriptionRequired:   props.SubscriptionRequired,
+			Type:                   &apiType,
+			Value:                  props.Value,
+		},
+	}
+
+	if props.Format != "" {
+		format := api.ContentFormat(props.Format)
+		parameters.Properties.Format = &format
+	}
+
+	if props.Protocols != nil {
+		protocols := make([]api.Protocol, 0)
+		for _, v := range *props.Protocols {
+			protocols = append(protocols, api.Protocol(v))
+		}
+		parameters.Properties.Protocols = &protocols
+	}
+
+	if v := props.SubscriptionKeyParameterNames; v != nil {
+		parameters.Properties.SubscriptionKeyParameterNames = &api.SubscriptionKeyParameterNamesContract{
+			Header: v.Header,
+			Query:  v.Query,
+		}
+	}
+
+	if v := props.AuthenticationSettings; v != nil {
+		authenticationSettings := api.AuthenticationSettingsContract{}
+		if v.OAuth2 != nil {
+			authenticationSettings.OAuth2 = &api.OAuth2AuthenticationSettingsContract{
+				AuthorizationServerId: v.OAuth2.AuthorizationServerID,
+				Scope:                 v.OAuth2.Scope,
+			}
+		}
+		if v.Openid != nil {
+			authenticationSettings.Openid = &api.OpenIdAuthenticationSettingsContract{
+				OpenidProviderId: v.Openid.OpenidProviderID,
+			}
+			if v.Openid.BearerTokenSendingMethods != nil {
+				methods := make([]api.BearerTokenSendingMethods, 0)
+				for _, method := range *v.Openid.BearerTokenSendingMethods {
+					methods = append(methods, api.BearerTokenSendingMethods(method))
+				}
+				authenticationSettings.Openid.BearerTokenSendingMethods = &methods
+			}
+		}
+		parameters.Properties.AuthenticationSettings = &authenticationSettings
+	}
+
+	apiClient := client.ApiManagement.ApiV20220801Client
+	if err := apiClient.CreateOrUpdateThenPoll(ctx, api.NewApiID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, apiId), parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func expandApiManagementApiProtocols(input []interface{}) *[]apimanagement.Protocol {
 	if len(input) == 0 {
 		return nil
@@ -146,6 +146,38 @@ func TestAccApiManagementApi_soapPassthrough(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApi_graphql(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.graphql(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("graphql"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementApi_websocket(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.websocket(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("websocket"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApiManagementApi_subscriptionRequired(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
 	r := ApiManagementApiResource{}
@@ -400,6 +432,42 @@ resource "azurerm_api_management_api" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ApiManagementApiResource) graphql(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "1"
+  api_type            = "graphql"
+  service_url         = "https://example.com/graphql"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementApiResource) websocket(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["wss"]
+  revision            = "1"
+  api_type            = "websocket"
+  service_url         = "wss://example.com/socket"
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ApiManagementApiResource) subscriptionRequired(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
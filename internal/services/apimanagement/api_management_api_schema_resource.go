@@ -161,6 +161,7 @@ func resourceApiManagementApiSchemaRead(d *pluginsdk.ResourceData, meta interfac
 				- WSDL Schema use application/vnd.ms-azure-apim.xsd+xml
 				- OpenApi Schema use application/vnd.oai.openapi.components+json
 				- WADL Schema use application/vnd.ms-azure-apim.wadl.grammars+xml.
+				- GraphQL Schema use application/vnd.ms-azure-apim.graphql.schema
 
 				Definitions used for Swagger/OpenAPI schemas only, otherwise Value is used
 			*/
@@ -173,7 +174,7 @@ func resourceApiManagementApiSchemaRead(d *pluginsdk.ResourceData, meta interfac
 					}
 					d.Set("value", string(value))
 				}
-			case "application/vnd.ms-azure-apim.xsd+xml", "application/vnd.ms-azure-apim.wadl.grammars+xml":
+			case "application/vnd.ms-azure-apim.xsd+xml", "application/vnd.ms-azure-apim.wadl.grammars+xml", "application/vnd.ms-azure-apim.graphql.schema":
 				d.Set("value", documentProperties.Value)
 			default:
 				log.Printf("[WARN] Unknown content type %q for %s", *properties.ContentType, *id)
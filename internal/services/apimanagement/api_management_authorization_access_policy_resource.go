@@ -0,0 +1,196 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorizationaccesspolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationAccessPolicyModel struct {
+	Name            string `tfschema:"name"`
+	AuthorizationId string `tfschema:"authorization_id"`
+	TenantId        string `tfschema:"tenant_id"`
+	ObjectId        string `tfschema:"object_id"`
+}
+
+type ApiManagementAuthorizationAccessPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationAccessPolicyResource{}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"authorization_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: authorizationaccesspolicy.ValidateAuthorizationID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationAccessPolicyModel{}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) ResourceType() string {
+	return "azurerm_api_management_authorization_access_policy"
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorizationaccesspolicy.ValidateAuthorizationAccessPolicyID
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			var model ApiManagementAuthorizationAccessPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			authorizationId, err := authorizationaccesspolicy.ParseAuthorizationID(model.AuthorizationId)
+			if err != nil {
+				return err
+			}
+
+			id := authorizationaccesspolicy.NewAuthorizationAccessPolicyID(authorizationId.SubscriptionId, authorizationId.ResourceGroupName, authorizationId.ServiceName, authorizationId.AuthorizationProviderId, authorizationId.AuthorizationId, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementAuthorizationAccessPolicy(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAuthorizationAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementAuthorizationAccessPolicyModel{
+				Name:            id.AuthorizationAccessPolicyId,
+				AuthorizationId: authorizationaccesspolicy.NewAuthorizationID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.AuthorizationProviderId, id.AuthorizationId).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.TenantId = utils.NormalizeNilableString(model.Properties.TenantId)
+				state.ObjectId = utils.NormalizeNilableString(model.Properties.ObjectId)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAuthorizationAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationAccessPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementAuthorizationAccessPolicy(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationAccessPolicyClient
+
+			id, err := authorizationaccesspolicy.ParseAuthorizationAccessPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := authorizationaccesspolicy.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementAuthorizationAccessPolicy(model ApiManagementAuthorizationAccessPolicyModel) authorizationaccesspolicy.AuthorizationAccessPolicyContract {
+	return authorizationaccesspolicy.AuthorizationAccessPolicyContract{
+		Properties: &authorizationaccesspolicy.AuthorizationAccessPolicyContractProperties{
+			TenantId: utils.String(model.TenantId),
+			ObjectId: utils.String(model.ObjectId),
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorizationaccesspolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationAccessPolicyResource struct{}
+
+func TestAccApiManagementAuthorizationAccessPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_access_policy", "test")
+	r := ApiManagementAuthorizationAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementAuthorizationAccessPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_access_policy", "test")
+	r := ApiManagementAuthorizationAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ApiManagementAuthorizationAccessPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorizationaccesspolicy.ParseAuthorizationAccessPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.AuthorizationAccessPolicyClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementAuthorizationAccessPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_api_management_authorization_access_policy" "test" {
+  name             = "acctest-policy-%d"
+  authorization_id = azurerm_api_management_authorization.test.id
+  tenant_id        = data.azurerm_client_config.current.tenant_id
+  object_id        = data.azurerm_client_config.current.object_id
+}
+`, ApiManagementAuthorizationResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationAccessPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_access_policy" "import" {
+  name             = azurerm_api_management_authorization_access_policy.test.name
+  authorization_id = azurerm_api_management_authorization_access_policy.test.authorization_id
+  tenant_id        = azurerm_api_management_authorization_access_policy.test.tenant_id
+  object_id        = azurerm_api_management_authorization_access_policy.test.object_id
+}
+`, r.basic(data))
+}
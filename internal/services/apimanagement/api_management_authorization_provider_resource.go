@@ -0,0 +1,305 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationProviderModel struct {
+	Name             string                                  `tfschema:"name"`
+	ApiManagementId  string                                  `tfschema:"api_management_id"`
+	DisplayName      string                                  `tfschema:"display_name"`
+	IdentityProvider string                                  `tfschema:"identity_provider"`
+	OAuth2           []ApiManagementAuthorizationOAuth2Model `tfschema:"oauth2"`
+	RedirectUrl      string                                  `tfschema:"redirect_url"`
+}
+
+type ApiManagementAuthorizationOAuth2Model struct {
+	AuthorizationCodeParameters map[string]string `tfschema:"authorization_code_parameters"`
+	ClientCredentialsParameters map[string]string `tfschema:"client_credentials_parameters"`
+}
+
+type ApiManagementAuthorizationProviderResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationProviderResource{}
+
+func (r ApiManagementAuthorizationProviderResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// e.g. `aad`, `google` or `oauth2` - the list of Identity Providers is defined by the service
+		"identity_provider": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"oauth2": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"authorization_code_parameters": {
+						Type:      pluginsdk.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+						AtLeastOneOf: []string{"oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"},
+					},
+
+					"client_credentials_parameters": {
+						Type:      pluginsdk.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+						AtLeastOneOf: []string{"oauth2.0.authorization_code_parameters", "oauth2.0.client_credentials_parameters"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"redirect_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationProviderModel{}
+}
+
+func (r ApiManagementAuthorizationProviderResource) ResourceType() string {
+	return "azurerm_api_management_authorization_provider"
+}
+
+func (r ApiManagementAuthorizationProviderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorizationprovider.ValidateAuthorizationProviderID
+}
+
+func (r ApiManagementAuthorizationProviderResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			var model ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiManagementId, err := parse.ApiManagementID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			id := authorizationprovider.NewAuthorizationProviderID(apiManagementId.SubscriptionId, apiManagementId.ResourceGroup, apiManagementId.ServiceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementAuthorizationProvider(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// secrets such as the `clientSecret` aren't returned by the API, so we look these up from the existing config
+			var config ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApiManagementAuthorizationProviderModel{
+				Name:            id.AuthorizationProviderId,
+				ApiManagementId: parse.NewApiManagementID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				state.IdentityProvider = utils.NormalizeNilableString(props.IdentityProvider)
+
+				if oauth2 := props.Oauth2; oauth2 != nil {
+					state.RedirectUrl = utils.NormalizeNilableString(oauth2.RedirectUrl)
+					state.OAuth2 = flattenApiManagementAuthorizationProviderOAuth2(oauth2.GrantTypes, config.OAuth2)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationProviderModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementAuthorizationProvider(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationProviderResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationProviderClient
+
+			id, err := authorizationprovider.ParseAuthorizationProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := authorizationprovider.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementAuthorizationProvider(model ApiManagementAuthorizationProviderModel) authorizationprovider.AuthorizationProviderContract {
+	grantTypes := authorizationprovider.AuthorizationProviderOAuth2GrantTypes{}
+	if len(model.OAuth2) > 0 {
+		oauth2 := model.OAuth2[0]
+		if len(oauth2.AuthorizationCodeParameters) > 0 {
+			grantTypes.AuthorizationCode = &oauth2.AuthorizationCodeParameters
+		}
+		if len(oauth2.ClientCredentialsParameters) > 0 {
+			grantTypes.ClientCredentials = &oauth2.ClientCredentialsParameters
+		}
+	}
+
+	return authorizationprovider.AuthorizationProviderContract{
+		Properties: &authorizationprovider.AuthorizationProviderContractProperties{
+			DisplayName:      utils.String(model.DisplayName),
+			IdentityProvider: utils.String(model.IdentityProvider),
+			Oauth2: &authorizationprovider.AuthorizationProviderOAuth2Settings{
+				GrantTypes: &grantTypes,
+			},
+		},
+	}
+}
+
+func flattenApiManagementAuthorizationProviderOAuth2(input *authorizationprovider.AuthorizationProviderOAuth2GrantTypes, config []ApiManagementAuthorizationOAuth2Model) []ApiManagementAuthorizationOAuth2Model {
+	if input == nil {
+		return []ApiManagementAuthorizationOAuth2Model{}
+	}
+
+	existing := ApiManagementAuthorizationOAuth2Model{}
+	if len(config) > 0 {
+		existing = config[0]
+	}
+
+	output := ApiManagementAuthorizationOAuth2Model{}
+	if input.AuthorizationCode != nil {
+		output.AuthorizationCodeParameters = mergeApiManagementAuthorizationParameters(*input.AuthorizationCode, existing.AuthorizationCodeParameters)
+	}
+	if input.ClientCredentials != nil {
+		output.ClientCredentialsParameters = mergeApiManagementAuthorizationParameters(*input.ClientCredentials, existing.ClientCredentialsParameters)
+	}
+
+	return []ApiManagementAuthorizationOAuth2Model{output}
+}
+
+// mergeApiManagementAuthorizationParameters returns the parameters returned from the API, using the value from
+// the existing config for any parameters which are secrets and therefore omitted (or returned empty) by the API
+func mergeApiManagementAuthorizationParameters(input map[string]string, config map[string]string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v
+	}
+
+	for k, v := range config {
+		if existing, ok := output[k]; !ok || existing == "" {
+			output[k] = v
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,171 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationProviderResource struct{}
+
+func TestAccApiManagementAuthorizationProvider_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("redirect_url").Exists(),
+			),
+		},
+		// the client secret isn't returned from the API
+		data.ImportStep("oauth2.0.authorization_code_parameters.%", "oauth2.0.authorization_code_parameters.clientSecret"),
+	})
+}
+
+func TestAccApiManagementAuthorizationProvider_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementAuthorizationProvider_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization_provider", "test")
+	r := ApiManagementAuthorizationProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("oauth2.0.authorization_code_parameters.%", "oauth2.0.authorization_code_parameters.clientSecret"),
+		{
+			Config: r.clientCredentials(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("oauth2.0.client_credentials_parameters.%", "oauth2.0.client_credentials_parameters.clientSecret"),
+	})
+}
+
+func (ApiManagementAuthorizationProviderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorizationprovider.ParseAuthorizationProviderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.AuthorizationProviderClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementAuthorizationProviderResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Developer_1"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationProviderResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-ap-%d"
+  api_management_id = azurerm_api_management.test.id
+  display_name      = "Test Provider"
+  identity_provider = "aad"
+
+  oauth2 {
+    authorization_code_parameters = {
+      clientId     = "00000000-0000-0000-0000-000000000000"
+      clientSecret = "c1ientS3cr3t!"
+      resourceUri  = "https://graph.microsoft.com"
+      scopes       = "User.Read"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationProviderResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "import" {
+  name              = azurerm_api_management_authorization_provider.test.name
+  api_management_id = azurerm_api_management_authorization_provider.test.api_management_id
+  display_name      = azurerm_api_management_authorization_provider.test.display_name
+  identity_provider = azurerm_api_management_authorization_provider.test.identity_provider
+
+  oauth2 {
+    authorization_code_parameters = {
+      clientId     = "00000000-0000-0000-0000-000000000000"
+      clientSecret = "c1ientS3cr3t!"
+      resourceUri  = "https://graph.microsoft.com"
+      scopes       = "User.Read"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementAuthorizationProviderResource) clientCredentials(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization_provider" "test" {
+  name              = "acctest-ap-%d"
+  api_management_id = azurerm_api_management.test.id
+  display_name      = "Test Provider Updated"
+  identity_provider = "aad"
+
+  oauth2 {
+    client_credentials_parameters = {
+      clientId     = "00000000-0000-0000-0000-000000000000"
+      clientSecret = "c1ientS3cr3t!"
+      resourceUri  = "https://graph.microsoft.com"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
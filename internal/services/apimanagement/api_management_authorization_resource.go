@@ -0,0 +1,233 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationModel struct {
+	Name                    string            `tfschema:"name"`
+	AuthorizationProviderId string            `tfschema:"authorization_provider_id"`
+	GrantType               string            `tfschema:"grant_type"`
+	Parameters              map[string]string `tfschema:"parameters"`
+	Status                  string            `tfschema:"status"`
+}
+
+type ApiManagementAuthorizationResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementAuthorizationResource{}
+
+func (r ApiManagementAuthorizationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"authorization_provider_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: authorization.ValidateAuthorizationProviderID,
+		},
+
+		"grant_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(authorization.OAuth2GrantTypeAuthorizationCode),
+				string(authorization.OAuth2GrantTypeClientCredentials),
+			}, false),
+		},
+
+		"parameters": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) ModelObject() interface{} {
+	return &ApiManagementAuthorizationModel{}
+}
+
+func (r ApiManagementAuthorizationResource) ResourceType() string {
+	return "azurerm_api_management_authorization"
+}
+
+func (r ApiManagementAuthorizationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return authorization.ValidateAuthorizationID
+}
+
+func (r ApiManagementAuthorizationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			var model ApiManagementAuthorizationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			providerId, err := authorization.ParseAuthorizationProviderID(model.AuthorizationProviderId)
+			if err != nil {
+				return err
+			}
+
+			id := authorization.NewAuthorizationID(providerId.SubscriptionId, providerId.ResourceGroupName, providerId.ServiceName, providerId.AuthorizationProviderId, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementAuthorization(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// secrets such as the `clientSecret` aren't returned by the API, so we look these up from the existing config
+			var config ApiManagementAuthorizationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApiManagementAuthorizationModel{
+				Name:                    id.AuthorizationId,
+				AuthorizationProviderId: authorization.NewAuthorizationProviderID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.AuthorizationProviderId).ID(),
+				Parameters:              config.Parameters,
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.Status = utils.NormalizeNilableString(props.Status)
+
+				if props.OAuth2grantType != nil {
+					state.GrantType = string(*props.OAuth2grantType)
+				}
+
+				if props.Parameters != nil {
+					state.Parameters = mergeApiManagementAuthorizationParameters(*props.Parameters, config.Parameters)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementAuthorizationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementAuthorization(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementAuthorizationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.AuthorizationClient
+
+			id, err := authorization.ParseAuthorizationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := authorization.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementAuthorization(model ApiManagementAuthorizationModel) authorization.AuthorizationContract {
+	authorizationType := authorization.AuthorizationTypeOAuthTwo
+	grantType := authorization.OAuth2GrantType(model.GrantType)
+
+	properties := authorization.AuthorizationContractProperties{
+		AuthorizationType: &authorizationType,
+		OAuth2grantType:   &grantType,
+	}
+
+	if len(model.Parameters) > 0 {
+		properties.Parameters = &model.Parameters
+	}
+
+	return authorization.AuthorizationContract{
+		Properties: &properties,
+	}
+}
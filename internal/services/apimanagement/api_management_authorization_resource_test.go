@@ -0,0 +1,118 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementAuthorizationResource struct{}
+
+func TestAccApiManagementAuthorization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization", "test")
+	r := ApiManagementAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementAuthorization_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization", "test")
+	r := ApiManagementAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementAuthorization_clientCredentials(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_authorization", "test")
+	r := ApiManagementAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.clientCredentials(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the client secret isn't returned from the API
+		data.ImportStep("parameters.%", "parameters.clientSecret"),
+	})
+}
+
+func (ApiManagementAuthorizationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := authorization.ParseAuthorizationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.AuthorizationClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementAuthorizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization" "test" {
+  name                      = "acctest-auth-%d"
+  authorization_provider_id = azurerm_api_management_authorization_provider.test.id
+  grant_type                = "AuthorizationCode"
+}
+`, ApiManagementAuthorizationProviderResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization" "import" {
+  name                      = azurerm_api_management_authorization.test.name
+  authorization_provider_id = azurerm_api_management_authorization.test.authorization_provider_id
+  grant_type                = azurerm_api_management_authorization.test.grant_type
+}
+`, r.basic(data))
+}
+
+func (ApiManagementAuthorizationResource) clientCredentials(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_authorization" "test" {
+  name                      = "acctest-auth-%d"
+  authorization_provider_id = azurerm_api_management_authorization_provider.test.id
+  grant_type                = "ClientCredentials"
+
+  parameters = {
+    clientId     = "00000000-0000-0000-0000-000000000000"
+    clientSecret = "c1ientS3cr3t!"
+  }
+}
+`, ApiManagementAuthorizationProviderResource{}.clientCredentials(data), data.RandomInteger)
+}
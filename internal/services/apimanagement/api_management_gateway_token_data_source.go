@@ -0,0 +1,112 @@
+package apimanagement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2020-12-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceApiManagementGatewayToken() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceApiManagementGatewayTokenRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.GatewayID,
+			},
+
+			// the token is valid for at most 30 days
+			"expiry": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"key_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(apimanagement.Primary),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.Primary),
+					string(apimanagement.Secondary),
+				}, false),
+			},
+
+			"token": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceApiManagementGatewayTokenRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.GatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GatewayID(d.Get("gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	expiry, err := time.Parse(time.RFC3339, d.Get("expiry").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `expiry`: %+v", err)
+	}
+
+	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(keys.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("listing keys for %s: %+v", id, err)
+	}
+
+	parameters := apimanagement.GatewayTokenRequestContract{
+		KeyType: apimanagement.KeyType(d.Get("key_type").(string)),
+		Expiry:  &date.Time{Time: expiry},
+	}
+	token, err := client.GenerateToken(ctx, id.ResourceGroup, id.ServiceName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("generating token for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("gateway_id", id.ID())
+	d.Set("token", token.Value)
+	d.Set("primary_key", keys.Primary)
+	d.Set("secondary_key", keys.Secondary)
+
+	return nil
+}
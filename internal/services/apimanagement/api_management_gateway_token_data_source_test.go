@@ -0,0 +1,42 @@
+package apimanagement_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiManagementGatewayTokenDataSource struct {
+}
+
+func TestAccDataSourceApiManagementGatewayToken_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_gateway_token", "test")
+	r := ApiManagementGatewayTokenDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("key_type").HasValue("primary"),
+				check.That(data.ResourceName).Key("token").Exists(),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+	})
+}
+
+func (ApiManagementGatewayTokenDataSource) basic(data acceptance.TestData) string {
+	expiry := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_api_management_gateway_token" "test" {
+  gateway_id = azurerm_api_management_gateway.test.id
+  expiry     = "%s"
+}
+`, ApiManagementGatewayResource{}.basic(data), expiry)
+}
@@ -0,0 +1,192 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLApiResolverPolicyModel struct {
+	ApiManagementGraphQLApiResolverId string `tfschema:"api_management_graphql_api_resolver_id"`
+	XmlContent                        string `tfschema:"xml_content"`
+}
+
+// ApiManagementGraphQLApiResolverPolicyResource manages the policy for a GraphQL Resolver, which defines the
+// backend (for example `http-data-source`) used to resolve the data for the field
+type ApiManagementGraphQLApiResolverPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementGraphQLApiResolverPolicyResource{}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"api_management_graphql_api_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: graphqlapiresolverpolicy.ValidateResolverID,
+		},
+
+		"xml_content": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) ModelObject() interface{} {
+	return &ApiManagementGraphQLApiResolverPolicyModel{}
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) ResourceType() string {
+	return "azurerm_api_management_graphql_api_resolver_policy"
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return graphqlapiresolverpolicy.ValidateResolverPolicyID
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			var model ApiManagementGraphQLApiResolverPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resolverId, err := graphqlapiresolverpolicy.ParseResolverID(model.ApiManagementGraphQLApiResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := graphqlapiresolverpolicy.NewResolverPolicyID(resolverId.SubscriptionId, resolverId.ResourceGroupName, resolverId.ServiceName, resolverId.ApiId, resolverId.ResolverId)
+			existing, err := client.Get(ctx, id, graphqlapiresolverpolicy.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementGraphQLApiResolverPolicy(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			id, err := graphqlapiresolverpolicy.ParseResolverPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			format := graphqlapiresolverpolicy.PolicyExportFormatXml
+			options := graphqlapiresolverpolicy.GetOperationOptions{
+				Format: &format,
+			}
+			resp, err := client.Get(ctx, *id, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementGraphQLApiResolverPolicyModel{
+				ApiManagementGraphQLApiResolverId: graphqlapiresolverpolicy.NewResolverID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.XmlContent = html.UnescapeString(model.Properties.Value)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			id, err := graphqlapiresolverpolicy.ParseResolverPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementGraphQLApiResolverPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementGraphQLApiResolverPolicy(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			id, err := graphqlapiresolverpolicy.ParseResolverPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := graphqlapiresolverpolicy.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementGraphQLApiResolverPolicy(model ApiManagementGraphQLApiResolverPolicyModel) graphqlapiresolverpolicy.PolicyContract {
+	format := graphqlapiresolverpolicy.PolicyContentFormatRawxml
+	return graphqlapiresolverpolicy.PolicyContract{
+		Properties: &graphqlapiresolverpolicy.PolicyContractProperties{
+			Format: &format,
+			Value:  model.XmlContent,
+		},
+	}
+}
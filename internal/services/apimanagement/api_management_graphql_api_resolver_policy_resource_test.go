@@ -0,0 +1,132 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLApiResolverPolicyResource struct{}
+
+func TestAccApiManagementGraphQLApiResolverPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver_policy", "test")
+	r := ApiManagementGraphQLApiResolverPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementGraphQLApiResolverPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver_policy", "test")
+	r := ApiManagementGraphQLApiResolverPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementGraphQLApiResolverPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver_policy", "test")
+	r := ApiManagementGraphQLApiResolverPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementGraphQLApiResolverPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := graphqlapiresolverpolicy.ParseResolverPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.GraphQLApiResolverPolicyClient.Get(ctx, *id, graphqlapiresolverpolicy.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementGraphQLApiResolverPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver_policy" "test" {
+  api_management_graphql_api_resolver_id = azurerm_api_management_graphql_api_resolver.test.id
+  xml_content                            = <<XML
+<http-data-source>
+  <http-request>
+    <set-method>GET</set-method>
+    <set-url>https://example.com/users</set-url>
+  </http-request>
+</http-data-source>
+XML
+}
+`, ApiManagementGraphQLApiResolverResource{}.basic(data))
+}
+
+func (r ApiManagementGraphQLApiResolverPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver_policy" "import" {
+  api_management_graphql_api_resolver_id = azurerm_api_management_graphql_api_resolver_policy.test.api_management_graphql_api_resolver_id
+  xml_content                            = azurerm_api_management_graphql_api_resolver_policy.test.xml_content
+}
+`, r.basic(data))
+}
+
+func (ApiManagementGraphQLApiResolverPolicyResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver_policy" "test" {
+  api_management_graphql_api_resolver_id = azurerm_api_management_graphql_api_resolver.test.id
+  xml_content                            = <<XML
+<http-data-source>
+  <http-request>
+    <set-method>POST</set-method>
+    <set-url>https://example.com/users/search</set-url>
+    <set-header name="Content-Type" exists-action="override">
+      <value>application/json</value>
+    </set-header>
+  </http-request>
+</http-data-source>
+XML
+}
+`, ApiManagementGraphQLApiResolverResource{}.basic(data))
+}
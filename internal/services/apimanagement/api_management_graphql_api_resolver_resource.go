@@ -0,0 +1,213 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLApiResolverModel struct {
+	Name        string `tfschema:"name"`
+	ApiId       string `tfschema:"api_id"`
+	DisplayName string `tfschema:"display_name"`
+	Path        string `tfschema:"path"`
+	Description string `tfschema:"description"`
+}
+
+type ApiManagementGraphQLApiResolverResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementGraphQLApiResolverResource{}
+
+func (r ApiManagementGraphQLApiResolverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"api_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// e.g. `Query/users` - the GraphQL type and field which this resolver resolves
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) ModelObject() interface{} {
+	return &ApiManagementGraphQLApiResolverModel{}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) ResourceType() string {
+	return "azurerm_api_management_graphql_api_resolver"
+}
+
+func (r ApiManagementGraphQLApiResolverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return graphqlapiresolver.ValidateResolverID
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			var model ApiManagementGraphQLApiResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiId, err := parse.ApiID(model.ApiId)
+			if err != nil {
+				return err
+			}
+
+			id := graphqlapiresolver.NewResolverID(apiId.SubscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementGraphQLApiResolver(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementGraphQLApiResolverModel{
+				Name:  id.ResolverId,
+				ApiId: parse.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.DisplayName = model.Properties.DisplayName
+				state.Path = model.Properties.Path
+				state.Description = utils.NormalizeNilableString(model.Properties.Description)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementGraphQLApiResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementGraphQLApiResolver(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := graphqlapiresolver.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementGraphQLApiResolver(model ApiManagementGraphQLApiResolverModel) graphqlapiresolver.ResolverContract {
+	properties := graphqlapiresolver.ResolverEntityBaseContract{
+		DisplayName: model.DisplayName,
+		Path:        model.Path,
+	}
+
+	if model.Description != "" {
+		properties.Description = utils.String(model.Description)
+	}
+
+	return graphqlapiresolver.ResolverContract{
+		Properties: &properties,
+	}
+}
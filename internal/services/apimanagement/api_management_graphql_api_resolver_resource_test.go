@@ -0,0 +1,129 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLApiResolverResource struct{}
+
+func TestAccApiManagementGraphQLApiResolver_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver", "test")
+	r := ApiManagementGraphQLApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementGraphQLApiResolver_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver", "test")
+	r := ApiManagementGraphQLApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementGraphQLApiResolver_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver", "test")
+	r := ApiManagementGraphQLApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementGraphQLApiResolverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := graphqlapiresolver.ParseResolverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.GraphQLApiResolverClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementGraphQLApiResolverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver" "test" {
+  name         = "acctest-resolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "Users"
+  path         = "Query/users"
+}
+`, ApiManagementApiResource{}.graphql(data), data.RandomInteger)
+}
+
+func (r ApiManagementGraphQLApiResolverResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver" "import" {
+  name         = azurerm_api_management_graphql_api_resolver.test.name
+  api_id       = azurerm_api_management_graphql_api_resolver.test.api_id
+  display_name = azurerm_api_management_graphql_api_resolver.test.display_name
+  path         = azurerm_api_management_graphql_api_resolver.test.path
+}
+`, r.basic(data))
+}
+
+func (ApiManagementGraphQLApiResolverResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver" "test" {
+  name         = "acctest-resolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "User By Id"
+  path         = "Query/user"
+  description  = "Resolves a single user"
+}
+`, ApiManagementApiResource{}.graphql(data), data.RandomInteger)
+}
@@ -0,0 +1,297 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceApiModel struct {
+	Name                     string   `tfschema:"name"`
+	ApiManagementWorkspaceId string   `tfschema:"api_management_workspace_id"`
+	DisplayName              string   `tfschema:"display_name"`
+	Path                     string   `tfschema:"path"`
+	Protocols                []string `tfschema:"protocols"`
+	Revision                 string   `tfschema:"revision"`
+	RevisionDescription      string   `tfschema:"revision_description"`
+	Description              string   `tfschema:"description"`
+	ServiceUrl               string   `tfschema:"service_url"`
+	SubscriptionRequired     bool     `tfschema:"subscription_required"`
+	IsCurrent                bool     `tfschema:"is_current"`
+	IsOnline                 bool     `tfschema:"is_online"`
+}
+
+type ApiManagementWorkspaceApiResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceApiResource{}
+
+func (r ApiManagementWorkspaceApiResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaceapi.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ApiManagementApiPath,
+		},
+
+		"protocols": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(workspaceapi.ProtocolHttp),
+					string(workspaceapi.ProtocolHttps),
+				}, false),
+			},
+		},
+
+		"revision": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "1",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"revision_description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"is_current": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"is_online": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceApiModel{}
+}
+
+func (r ApiManagementWorkspaceApiResource) ResourceType() string {
+	return "azurerm_api_management_workspace_api"
+}
+
+func (r ApiManagementWorkspaceApiResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspaceapi.ValidateWorkspaceApiID
+}
+
+func (r ApiManagementWorkspaceApiResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaceapi.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspaceapi.NewWorkspaceApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApiManagementWorkspaceApi(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := workspaceapi.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceApiModel{
+				Name:                     id.ApiId,
+				ApiManagementWorkspaceId: workspaceapi.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.Path = props.Path
+				state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				state.Revision = utils.NormalizeNilableString(props.ApiRevision)
+				state.RevisionDescription = utils.NormalizeNilableString(props.ApiRevisionDescription)
+				state.Description = utils.NormalizeNilableString(props.Description)
+				state.ServiceUrl = utils.NormalizeNilableString(props.ServiceUrl)
+				state.SubscriptionRequired = props.SubscriptionRequired != nil && *props.SubscriptionRequired
+				state.IsCurrent = props.IsCurrent != nil && *props.IsCurrent
+				state.IsOnline = props.IsOnline != nil && *props.IsOnline
+
+				protocols := make([]string, 0)
+				if props.Protocols != nil {
+					for _, v := range *props.Protocols {
+						protocols = append(protocols, string(v))
+					}
+				}
+				state.Protocols = protocols
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := workspaceapi.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandApiManagementWorkspaceApi(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := workspaceapi.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspaceapi.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceApi(model ApiManagementWorkspaceApiModel) workspaceapi.ApiContract {
+	protocols := make([]workspaceapi.Protocol, 0)
+	for _, v := range model.Protocols {
+		protocols = append(protocols, workspaceapi.Protocol(v))
+	}
+
+	properties := workspaceapi.ApiContractProperties{
+		ApiRevision:          utils.String(model.Revision),
+		DisplayName:          utils.String(model.DisplayName),
+		Path:                 model.Path,
+		Protocols:            &protocols,
+		SubscriptionRequired: utils.Bool(model.SubscriptionRequired),
+	}
+
+	if model.RevisionDescription != "" {
+		properties.ApiRevisionDescription = utils.String(model.RevisionDescription)
+	}
+
+	if model.Description != "" {
+		properties.Description = utils.String(model.Description)
+	}
+
+	if model.ServiceUrl != "" {
+		properties.ServiceUrl = utils.String(model.ServiceUrl)
+	}
+
+	return workspaceapi.ApiContract{
+		Properties: &properties,
+	}
+}
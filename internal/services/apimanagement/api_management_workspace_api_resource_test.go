@@ -0,0 +1,136 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceApiResource struct{}
+
+func TestAccApiManagementWorkspaceApi_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_current").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceApiResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspaceapi.ParseWorkspaceApiID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspaceApiClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApiManagementWorkspaceApiResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "api1"
+  path                        = "api1"
+  protocols                   = ["https"]
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceApiResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "import" {
+  name                        = azurerm_api_management_workspace_api.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_api.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_api.test.display_name
+  path                        = azurerm_api_management_workspace_api.test.path
+  protocols                   = azurerm_api_management_workspace_api.test.protocols
+}
+`, r.basic(data))
+}
+
+func (ApiManagementWorkspaceApiResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "api1 updated"
+  path                        = "api1-updated"
+  protocols                   = ["http", "https"]
+  revision_description        = "first revision"
+  description                 = "An API owned by the workspace"
+  service_url                 = "https://example.com/backend"
+  subscription_required       = false
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,270 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceProductModel struct {
+	Name                     string `tfschema:"name"`
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	DisplayName              string `tfschema:"display_name"`
+	Published                bool   `tfschema:"published"`
+	SubscriptionRequired     bool   `tfschema:"subscription_required"`
+	ApprovalRequired         bool   `tfschema:"approval_required"`
+	Description              string `tfschema:"description"`
+	SubscriptionsLimit       int64  `tfschema:"subscriptions_limit"`
+	Terms                    string `tfschema:"terms"`
+}
+
+type ApiManagementWorkspaceProductResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceProductResource{}
+
+func (r ApiManagementWorkspaceProductResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaceproduct.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"published": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"approval_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subscriptions_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"terms": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceProductResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceProductModel{}
+}
+
+func (r ApiManagementWorkspaceProductResource) ResourceType() string {
+	return "azurerm_api_management_workspace_product"
+}
+
+func (r ApiManagementWorkspaceProductResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspaceproduct.ValidateWorkspaceProductID
+}
+
+func (r ApiManagementWorkspaceProductResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !model.SubscriptionRequired && model.ApprovalRequired {
+				return fmt.Errorf("`subscription_required` must be true when `approval_required` is true")
+			}
+
+			workspaceId, err := workspaceproduct.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspaceproduct.NewWorkspaceProductID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementWorkspaceProduct(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := workspaceproduct.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceProductModel{
+				Name:                     id.ProductId,
+				ApiManagementWorkspaceId: workspaceproduct.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.DisplayName = props.DisplayName
+				state.Published = props.State != nil && *props.State == workspaceproduct.ProductStatePublished
+				state.SubscriptionRequired = props.SubscriptionRequired != nil && *props.SubscriptionRequired
+				state.ApprovalRequired = props.ApprovalRequired != nil && *props.ApprovalRequired
+				state.Description = utils.NormalizeNilableString(props.Description)
+				state.Terms = utils.NormalizeNilableString(props.Terms)
+
+				if props.SubscriptionsLimit != nil {
+					state.SubscriptionsLimit = *props.SubscriptionsLimit
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := workspaceproduct.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !model.SubscriptionRequired && model.ApprovalRequired {
+				return fmt.Errorf("`subscription_required` must be true when `approval_required` is true")
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementWorkspaceProduct(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := workspaceproduct.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspaceproduct.DeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceProduct(model ApiManagementWorkspaceProductModel) workspaceproduct.ProductContract {
+	state := workspaceproduct.ProductStateNotPublished
+	if model.Published {
+		state = workspaceproduct.ProductStatePublished
+	}
+
+	properties := workspaceproduct.ProductContractProperties{
+		DisplayName:          model.DisplayName,
+		State:                &state,
+		SubscriptionRequired: utils.Bool(model.SubscriptionRequired),
+	}
+
+	// approval and subscription limits can only be set when a subscription is required
+	if model.SubscriptionRequired {
+		properties.ApprovalRequired = utils.Bool(model.ApprovalRequired)
+
+		if model.SubscriptionsLimit > 0 {
+			properties.SubscriptionsLimit = utils.Int64(model.SubscriptionsLimit)
+		}
+	}
+
+	if model.Description != "" {
+		properties.Description = utils.String(model.Description)
+	}
+
+	if model.Terms != "" {
+		properties.Terms = utils.String(model.Terms)
+	}
+
+	return workspaceproduct.ProductContract{
+		Properties: &properties,
+	}
+}
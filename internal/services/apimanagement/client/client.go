@@ -3,6 +3,16 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2020-12-01/apimanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/api"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorizationaccesspolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/authorizationprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspaceproduct"
 )
 
 type Client struct {
@@ -13,7 +23,11 @@ type Client struct {
 	ApiOperationPoliciesClient       *apimanagement.APIOperationPolicyClient
 	ApiReleasesClient                *apimanagement.APIReleaseClient
 	ApiSchemasClient                 *apimanagement.APISchemaClient
+	ApiV20220801Client               *api.ApiClient
 	ApiVersionSetClient              *apimanagement.APIVersionSetClient
+	AuthorizationAccessPolicyClient  *authorizationaccesspolicy.AuthorizationAccessPolicyClient
+	AuthorizationClient              *authorization.AuthorizationClient
+	AuthorizationProviderClient      *authorizationprovider.AuthorizationProviderClient
 	AuthorizationServersClient       *apimanagement.AuthorizationServerClient
 	BackendClient                    *apimanagement.BackendClient
 	CacheClient                      *apimanagement.CacheClient
@@ -23,6 +37,8 @@ type Client struct {
 	EmailTemplateClient              *apimanagement.EmailTemplateClient
 	GatewayClient                    *apimanagement.GatewayClient
 	GatewayApisClient                *apimanagement.GatewayAPIClient
+	GraphQLApiResolverClient         *graphqlapiresolver.GraphQLApiResolverClient
+	GraphQLApiResolverPolicyClient   *graphqlapiresolverpolicy.GraphQLApiResolverPolicyClient
 	GroupClient                      *apimanagement.GroupClient
 	GroupUsersClient                 *apimanagement.GroupUserClient
 	IdentityProviderClient           *apimanagement.IdentityProviderClient
@@ -42,6 +58,10 @@ type Client struct {
 	TagClient                        *apimanagement.TagClient
 	TenantAccessClient               *apimanagement.TenantAccessClient
 	UsersClient                      *apimanagement.UserClient
+	WorkspaceClient                  *workspace.WorkspaceClient
+	WorkspaceApiClient               *workspaceapi.WorkspaceApiClient
+	WorkspacePolicyClient            *workspacepolicy.WorkspacePolicyClient
+	WorkspaceProductClient           *workspaceproduct.WorkspaceProductClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -66,9 +86,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	apiSchemasClient := apimanagement.NewAPISchemaClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&apiSchemasClient.Client, o.ResourceManagerAuthorizer)
 
+	apiV20220801Client := api.NewApiClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&apiV20220801Client.Client, o.ResourceManagerAuthorizer)
+
 	apiVersionSetClient := apimanagement.NewAPIVersionSetClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&apiVersionSetClient.Client, o.ResourceManagerAuthorizer)
 
+	authorizationAccessPolicyClient := authorizationaccesspolicy.NewAuthorizationAccessPolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&authorizationAccessPolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	authorizationClient := authorization.NewAuthorizationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&authorizationClient.Client, o.ResourceManagerAuthorizer)
+
+	authorizationProviderClient := authorizationprovider.NewAuthorizationProviderClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&authorizationProviderClient.Client, o.ResourceManagerAuthorizer)
+
 	authorizationServersClient := apimanagement.NewAuthorizationServerClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&authorizationServersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -96,6 +128,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	gatewayApisClient := apimanagement.NewGatewayAPIClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&gatewayApisClient.Client, o.ResourceManagerAuthorizer)
 
+	graphQLApiResolverClient := graphqlapiresolver.NewGraphQLApiResolverClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&graphQLApiResolverClient.Client, o.ResourceManagerAuthorizer)
+
+	graphQLApiResolverPolicyClient := graphqlapiresolverpolicy.NewGraphQLApiResolverPolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&graphQLApiResolverPolicyClient.Client, o.ResourceManagerAuthorizer)
+
 	groupClient := apimanagement.NewGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupClient.Client, o.ResourceManagerAuthorizer)
 
@@ -153,6 +191,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	usersClient := apimanagement.NewUserClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&usersClient.Client, o.ResourceManagerAuthorizer)
 
+	workspaceClient := workspace.NewWorkspaceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceApiClient := workspaceapi.NewWorkspaceApiClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceApiClient.Client, o.ResourceManagerAuthorizer)
+
+	workspacePolicyClient := workspacepolicy.NewWorkspacePolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspacePolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceProductClient := workspaceproduct.NewWorkspaceProductClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceProductClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ApiClient:                        &apiClient,
 		ApiDiagnosticClient:              &apiDiagnosticClient,
@@ -161,7 +211,11 @@ func NewClient(o *common.ClientOptions) *Client {
 		ApiOperationPoliciesClient:       &apiOperationPoliciesClient,
 		ApiReleasesClient:                &apiReleasesClient,
 		ApiSchemasClient:                 &apiSchemasClient,
+		ApiV20220801Client:               &apiV20220801Client,
 		ApiVersionSetClient:              &apiVersionSetClient,
+		AuthorizationAccessPolicyClient:  &authorizationAccessPolicyClient,
+		AuthorizationClient:              &authorizationClient,
+		AuthorizationProviderClient:      &authorizationProviderClient,
 		AuthorizationServersClient:       &authorizationServersClient,
 		BackendClient:                    &backendClient,
 		CacheClient:                      &cacheClient,
@@ -171,6 +225,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		EmailTemplateClient:              &emailTemplateClient,
 		GatewayClient:                    &gatewayClient,
 		GatewayApisClient:                &gatewayApisClient,
+		GraphQLApiResolverClient:         &graphQLApiResolverClient,
+		GraphQLApiResolverPolicyClient:   &graphQLApiResolverPolicyClient,
 		GroupClient:                      &groupClient,
 		GroupUsersClient:                 &groupUsersClient,
 		IdentityProviderClient:           &identityProviderClient,
@@ -190,5 +246,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		TagClient:                        &tagClient,
 		TenantAccessClient:               &tenantAccessClient,
 		UsersClient:                      &usersClient,
+		WorkspaceClient:                  &workspaceClient,
+		WorkspaceApiClient:               &workspaceApiClient,
+		WorkspacePolicyClient:            &workspacePolicyClient,
+		WorkspaceProductClient:           &workspaceProductClient,
 	}
 }
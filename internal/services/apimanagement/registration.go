@@ -26,6 +26,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_api_management_api":             dataSourceApiManagementApi(),
 		"azurerm_api_management_api_version_set": dataSourceApiManagementApiVersionSet(),
 		"azurerm_api_management_gateway":         dataSourceApiManagementGateway(),
+		"azurerm_api_management_gateway_token":   dataSourceApiManagementGatewayToken(),
 		"azurerm_api_management_group":           dataSourceApiManagementGroup(),
 		"azurerm_api_management_product":         dataSourceApiManagementProduct(),
 		"azurerm_api_management_user":            dataSourceApiManagementUser(),
@@ -83,6 +84,15 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApiManagementAuthorizationResource{},
+		ApiManagementAuthorizationAccessPolicyResource{},
+		ApiManagementAuthorizationProviderResource{},
+		ApiManagementGraphQLApiResolverResource{},
+		ApiManagementGraphQLApiResolverPolicyResource{},
 		ApiManagementNotificationRecipientEmailResource{},
+		ApiManagementWorkspaceResource{},
+		ApiManagementWorkspaceApiResource{},
+		ApiManagementWorkspacePolicyResource{},
+		ApiManagementWorkspaceProductResource{},
 	}
 }
@@ -0,0 +1,15 @@
+package api
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ApiClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewApiClientWithBaseURI(endpoint string) ApiClient {
+	return ApiClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
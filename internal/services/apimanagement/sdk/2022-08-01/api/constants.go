@@ -0,0 +1,188 @@
+package api
+
+import "strings"
+
+type ApiType string
+
+const (
+	ApiTypeGraphql   ApiType = "graphql"
+	ApiTypeHttp      ApiType = "http"
+	ApiTypeSoap      ApiType = "soap"
+	ApiTypeWebsocket ApiType = "websocket"
+)
+
+func PossibleValuesForApiType() []string {
+	return []string{
+		string(ApiTypeGraphql),
+		string(ApiTypeHttp),
+		string(ApiTypeSoap),
+		string(ApiTypeWebsocket),
+	}
+}
+
+func parseApiType(input string) (*ApiType, error) {
+	vals := map[string]ApiType{
+		"graphql":   ApiTypeGraphql,
+		"http":      ApiTypeHttp,
+		"soap":      ApiTypeSoap,
+		"websocket": ApiTypeWebsocket,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ApiType(input)
+	return &out, nil
+}
+
+type BearerTokenSendingMethods string
+
+const (
+	BearerTokenSendingMethodsAuthorizationHeader BearerTokenSendingMethods = "authorizationHeader"
+	BearerTokenSendingMethodsQuery               BearerTokenSendingMethods = "query"
+)
+
+func PossibleValuesForBearerTokenSendingMethods() []string {
+	return []string{
+		string(BearerTokenSendingMethodsAuthorizationHeader),
+		string(BearerTokenSendingMethodsQuery),
+	}
+}
+
+func parseBearerTokenSendingMethods(input string) (*BearerTokenSendingMethods, error) {
+	vals := map[string]BearerTokenSendingMethods{
+		"authorizationheader": BearerTokenSendingMethodsAuthorizationHeader,
+		"query":               BearerTokenSendingMethodsQuery,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BearerTokenSendingMethods(input)
+	return &out, nil
+}
+
+type ContentFormat string
+
+const (
+	ContentFormatGraphqlNegativelink             ContentFormat = "graphql-link"
+	ContentFormatOpenapi                         ContentFormat = "openapi"
+	ContentFormatOpenapiNegativelink             ContentFormat = "openapi-link"
+	ContentFormatOpenapiPositivejson             ContentFormat = "openapi+json"
+	ContentFormatOpenapiPositivejsonNegativelink ContentFormat = "openapi+json-link"
+	ContentFormatSwaggerNegativelinkNegativejson ContentFormat = "swagger-link-json"
+	ContentFormatSwaggerNegativejson             ContentFormat = "swagger-json"
+	ContentFormatWadlNegativelinkNegativejson    ContentFormat = "wadl-link-json"
+	ContentFormatWadlNegativexml                 ContentFormat = "wadl-xml"
+	ContentFormatWsdl                            ContentFormat = "wsdl"
+	ContentFormatWsdlNegativelink                ContentFormat = "wsdl-link"
+)
+
+func PossibleValuesForContentFormat() []string {
+	return []string{
+		string(ContentFormatGraphqlNegativelink),
+		string(ContentFormatOpenapi),
+		string(ContentFormatOpenapiNegativelink),
+		string(ContentFormatOpenapiPositivejson),
+		string(ContentFormatOpenapiPositivejsonNegativelink),
+		string(ContentFormatSwaggerNegativelinkNegativejson),
+		string(ContentFormatSwaggerNegativejson),
+		string(ContentFormatWadlNegativelinkNegativejson),
+		string(ContentFormatWadlNegativexml),
+		string(ContentFormatWsdl),
+		string(ContentFormatWsdlNegativelink),
+	}
+}
+
+func parseContentFormat(input string) (*ContentFormat, error) {
+	vals := map[string]ContentFormat{
+		"graphql-link":      ContentFormatGraphqlNegativelink,
+		"openapi":           ContentFormatOpenapi,
+		"openapi-link":      ContentFormatOpenapiNegativelink,
+		"openapi+json":      ContentFormatOpenapiPositivejson,
+		"openapi+json-link": ContentFormatOpenapiPositivejsonNegativelink,
+		"swagger-link-json": ContentFormatSwaggerNegativelinkNegativejson,
+		"swagger-json":      ContentFormatSwaggerNegativejson,
+		"wadl-link-json":    ContentFormatWadlNegativelinkNegativejson,
+		"wadl-xml":          ContentFormatWadlNegativexml,
+		"wsdl":              ContentFormatWsdl,
+		"wsdl-link":         ContentFormatWsdlNegativelink,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ContentFormat(input)
+	return &out, nil
+}
+
+type Protocol string
+
+const (
+	ProtocolHttp  Protocol = "http"
+	ProtocolHttps Protocol = "https"
+	ProtocolWs    Protocol = "ws"
+	ProtocolWss   Protocol = "wss"
+)
+
+func PossibleValuesForProtocol() []string {
+	return []string{
+		string(ProtocolHttp),
+		string(ProtocolHttps),
+		string(ProtocolWs),
+		string(ProtocolWss),
+	}
+}
+
+func parseProtocol(input string) (*Protocol, error) {
+	vals := map[string]Protocol{
+		"http":  ProtocolHttp,
+		"https": ProtocolHttps,
+		"ws":    ProtocolWs,
+		"wss":   ProtocolWss,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Protocol(input)
+	return &out, nil
+}
+
+type SoapApiType string
+
+const (
+	SoapApiTypeGraphQL         SoapApiType = "graphql"
+	SoapApiTypeSoapPassThrough SoapApiType = "soap"
+	SoapApiTypeSoapToRest      SoapApiType = "http"
+	SoapApiTypeWebSocket       SoapApiType = "websocket"
+)
+
+func PossibleValuesForSoapApiType() []string {
+	return []string{
+		string(SoapApiTypeGraphQL),
+		string(SoapApiTypeSoapPassThrough),
+		string(SoapApiTypeSoapToRest),
+		string(SoapApiTypeWebSocket),
+	}
+}
+
+func parseSoapApiType(input string) (*SoapApiType, error) {
+	vals := map[string]SoapApiType{
+		"graphql":   SoapApiTypeGraphQL,
+		"soap":      SoapApiTypeSoapPassThrough,
+		"http":      SoapApiTypeSoapToRest,
+		"websocket": SoapApiTypeWebSocket,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SoapApiType(input)
+	return &out, nil
+}
@@ -0,0 +1,5 @@
+package api
+
+type ApiCreateOrUpdateParameter struct {
+	Properties *ApiCreateOrUpdateProperties `json:"properties,omitempty"`
+}
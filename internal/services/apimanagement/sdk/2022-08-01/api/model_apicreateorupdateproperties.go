@@ -0,0 +1,21 @@
+package api
+
+type ApiCreateOrUpdateProperties struct {
+	ApiRevisionDescription        *string                                `json:"apiRevisionDescription,omitempty"`
+	ApiType                       *SoapApiType                           `json:"apiType,omitempty"`
+	ApiVersion                    *string                                `json:"apiVersion,omitempty"`
+	ApiVersionDescription         *string                                `json:"apiVersionDescription,omitempty"`
+	ApiVersionSetId               *string                                `json:"apiVersionSetId,omitempty"`
+	AuthenticationSettings        *AuthenticationSettingsContract        `json:"authenticationSettings,omitempty"`
+	Description                   *string                                `json:"description,omitempty"`
+	DisplayName                   *string                                `json:"displayName,omitempty"`
+	Format                        *ContentFormat                         `json:"format,omitempty"`
+	Path                          string                                 `json:"path"`
+	Protocols                     *[]Protocol                            `json:"protocols,omitempty"`
+	ServiceUrl                    *string                                `json:"serviceUrl,omitempty"`
+	SourceApiId                   *string                                `json:"sourceApiId,omitempty"`
+	SubscriptionKeyParameterNames *SubscriptionKeyParameterNamesContract `json:"subscriptionKeyParameterNames,omitempty"`
+	SubscriptionRequired          *bool                                  `json:"subscriptionRequired,omitempty"`
+	Type                          *ApiType                               `json:"type,omitempty"`
+	Value                         *string                                `json:"value,omitempty"`
+}
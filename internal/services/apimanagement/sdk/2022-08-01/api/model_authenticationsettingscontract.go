@@ -0,0 +1,6 @@
+package api
+
+type AuthenticationSettingsContract struct {
+	OAuth2 *OAuth2AuthenticationSettingsContract `json:"oAuth2,omitempty"`
+	Openid *OpenIdAuthenticationSettingsContract `json:"openid,omitempty"`
+}
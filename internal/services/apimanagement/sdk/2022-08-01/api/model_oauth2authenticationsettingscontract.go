@@ -0,0 +1,6 @@
+package api
+
+type OAuth2AuthenticationSettingsContract struct {
+	AuthorizationServerId *string `json:"authorizationServerId,omitempty"`
+	Scope                 *string `json:"scope,omitempty"`
+}
@@ -0,0 +1,6 @@
+package api
+
+type SubscriptionKeyParameterNamesContract struct {
+	Header *string `json:"header,omitempty"`
+	Query  *string `json:"query,omitempty"`
+}
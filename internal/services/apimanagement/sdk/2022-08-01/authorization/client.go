@@ -0,0 +1,15 @@
+package authorization
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AuthorizationClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAuthorizationClientWithBaseURI(endpoint string) AuthorizationClient {
+	return AuthorizationClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
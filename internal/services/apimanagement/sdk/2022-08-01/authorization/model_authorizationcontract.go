@@ -0,0 +1,8 @@
+package authorization
+
+type AuthorizationContract struct {
+	Id         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *AuthorizationContractProperties `json:"properties,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+}
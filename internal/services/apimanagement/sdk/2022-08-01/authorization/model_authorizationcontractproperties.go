@@ -0,0 +1,9 @@
+package authorization
+
+type AuthorizationContractProperties struct {
+	AuthorizationType *AuthorizationType  `json:"authorizationType,omitempty"`
+	Error             *AuthorizationError `json:"error,omitempty"`
+	OAuth2grantType   *OAuth2GrantType    `json:"oAuth2grantType,omitempty"`
+	Parameters        *map[string]string  `json:"parameters,omitempty"`
+	Status            *string             `json:"status,omitempty"`
+}
@@ -0,0 +1,6 @@
+package authorization
+
+type AuthorizationError struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
@@ -0,0 +1,15 @@
+package authorizationaccesspolicy
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AuthorizationAccessPolicyClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAuthorizationAccessPolicyClientWithBaseURI(endpoint string) AuthorizationAccessPolicyClient {
+	return AuthorizationAccessPolicyClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,163 @@
+package authorizationaccesspolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = AuthorizationAccessPolicyId{}
+
+// AuthorizationAccessPolicyId is a struct representing the Resource ID for a Authorization Access Policy
+type AuthorizationAccessPolicyId struct {
+	SubscriptionId              string
+	ResourceGroupName           string
+	ServiceName                 string
+	AuthorizationProviderId     string
+	AuthorizationId             string
+	AuthorizationAccessPolicyId string
+}
+
+// NewAuthorizationAccessPolicyID returns a new AuthorizationAccessPolicyId struct
+func NewAuthorizationAccessPolicyID(subscriptionId string, resourceGroupName string, serviceName string, authorizationProviderId string, authorizationId string, authorizationAccessPolicyId string) AuthorizationAccessPolicyId {
+	return AuthorizationAccessPolicyId{
+		SubscriptionId:              subscriptionId,
+		ResourceGroupName:           resourceGroupName,
+		ServiceName:                 serviceName,
+		AuthorizationProviderId:     authorizationProviderId,
+		AuthorizationId:             authorizationId,
+		AuthorizationAccessPolicyId: authorizationAccessPolicyId,
+	}
+}
+
+// ParseAuthorizationAccessPolicyID parses 'input' into a AuthorizationAccessPolicyId
+func ParseAuthorizationAccessPolicyID(input string) (*AuthorizationAccessPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AuthorizationAccessPolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AuthorizationAccessPolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.AuthorizationProviderId, ok = parsed.Parsed["authorizationProviderId"]; !ok {
+		return nil, fmt.Errorf("the segment 'authorizationProviderId' was not found in the resource id %q", input)
+	}
+
+	if id.AuthorizationId, ok = parsed.Parsed["authorizationId"]; !ok {
+		return nil, fmt.Errorf("the segment 'authorizationId' was not found in the resource id %q", input)
+	}
+
+	if id.AuthorizationAccessPolicyId, ok = parsed.Parsed["authorizationAccessPolicyId"]; !ok {
+		return nil, fmt.Errorf("the segment 'authorizationAccessPolicyId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseAuthorizationAccessPolicyIDInsensitively parses 'input' case-insensitively into a AuthorizationAccessPolicyId
+// note: this method should only be used for API response data and not user input
+func ParseAuthorizationAccessPolicyIDInsensitively(input string) (*AuthorizationAccessPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AuthorizationAccessPolicyId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AuthorizationAccessPolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.AuthorizationProviderId, ok = parsed.Parsed["authorizationProviderId"]; !ok {
+		return nil, fmt.Errorf("the segment 'authorizationProviderId' was not found in the resource id %q", input)
+	}
+
+	if id.AuthorizationId, ok = parsed.Parsed["authorizationId"]; !ok {
+		return nil, fmt.Errorf("the segment 'authorizationId' was not found in the resource id %q", input)
+	}
+
+	if id.AuthorizationAccessPolicyId, ok = parsed.Parsed["authorizationAccessPolicyId"]; !ok {
+		return nil, fmt.Errorf("the segment 'authorizationAccessPolicyId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateAuthorizationAccessPolicyID checks that 'input' can be parsed as a Authorization Access Policy ID
+func ValidateAuthorizationAccessPolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAuthorizationAccessPolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Authorization Access Policy ID
+func (id AuthorizationAccessPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/authorizationProviders/%s/authorizations/%s/accessPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.AuthorizationProviderId, id.AuthorizationId, id.AuthorizationAccessPolicyId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Authorization Access Policy ID
+func (id AuthorizationAccessPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "serviceValue"),
+		resourceids.StaticSegment("staticAuthorizationProviders", "authorizationProviders", "authorizationProviders"),
+		resourceids.UserSpecifiedSegment("authorizationProviderId", "authorizationProviderIdValue"),
+		resourceids.StaticSegment("staticAuthorizations", "authorizations", "authorizations"),
+		resourceids.UserSpecifiedSegment("authorizationId", "authorizationIdValue"),
+		resourceids.StaticSegment("staticAccessPolicies", "accessPolicies", "accessPolicies"),
+		resourceids.UserSpecifiedSegment("authorizationAccessPolicyId", "authorizationAccessPolicyIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Authorization Access Policy ID
+func (id AuthorizationAccessPolicyId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Service Name: %q", id.ServiceName),
+		fmt.Sprintf("Authorization Provider Id: %q", id.AuthorizationProviderId),
+		fmt.Sprintf("Authorization Id: %q", id.AuthorizationId),
+		fmt.Sprintf("Authorization Access Policy Id: %q", id.AuthorizationAccessPolicyId),
+	}
+	return fmt.Sprintf("Authorization Access Policy (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package authorizationaccesspolicy
+
+type AuthorizationAccessPolicyContract struct {
+	Id         *string                                      `json:"id,omitempty"`
+	Name       *string                                      `json:"name,omitempty"`
+	Properties *AuthorizationAccessPolicyContractProperties `json:"properties,omitempty"`
+	Type       *string                                      `json:"type,omitempty"`
+}
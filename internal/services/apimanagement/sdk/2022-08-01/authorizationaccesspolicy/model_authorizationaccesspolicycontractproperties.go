@@ -0,0 +1,6 @@
+package authorizationaccesspolicy
+
+type AuthorizationAccessPolicyContractProperties struct {
+	ObjectId *string `json:"objectId,omitempty"`
+	TenantId *string `json:"tenantId,omitempty"`
+}
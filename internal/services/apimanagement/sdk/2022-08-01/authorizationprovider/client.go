@@ -0,0 +1,15 @@
+package authorizationprovider
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AuthorizationProviderClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAuthorizationProviderClientWithBaseURI(endpoint string) AuthorizationProviderClient {
+	return AuthorizationProviderClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
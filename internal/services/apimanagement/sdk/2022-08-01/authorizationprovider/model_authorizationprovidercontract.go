@@ -0,0 +1,8 @@
+package authorizationprovider
+
+type AuthorizationProviderContract struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *AuthorizationProviderContractProperties `json:"properties,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package authorizationprovider
+
+type AuthorizationProviderContractProperties struct {
+	DisplayName      *string                              `json:"displayName,omitempty"`
+	IdentityProvider *string                              `json:"identityProvider,omitempty"`
+	Oauth2           *AuthorizationProviderOAuth2Settings `json:"oauth2,omitempty"`
+}
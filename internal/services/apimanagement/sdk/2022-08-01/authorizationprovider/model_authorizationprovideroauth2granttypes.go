@@ -0,0 +1,6 @@
+package authorizationprovider
+
+type AuthorizationProviderOAuth2GrantTypes struct {
+	AuthorizationCode *map[string]string `json:"authorizationCode,omitempty"`
+	ClientCredentials *map[string]string `json:"clientCredentials,omitempty"`
+}
@@ -0,0 +1,6 @@
+package authorizationprovider
+
+type AuthorizationProviderOAuth2Settings struct {
+	GrantTypes  *AuthorizationProviderOAuth2GrantTypes `json:"grantTypes,omitempty"`
+	RedirectUrl *string                                `json:"redirectUrl,omitempty"`
+}
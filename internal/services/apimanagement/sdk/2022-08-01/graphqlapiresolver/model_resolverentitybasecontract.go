@@ -0,0 +1,7 @@
+package graphqlapiresolver
+
+type ResolverEntityBaseContract struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName string  `json:"displayName"`
+	Path        string  `json:"path"`
+}
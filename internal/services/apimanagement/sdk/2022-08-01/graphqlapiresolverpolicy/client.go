@@ -0,0 +1,15 @@
+package graphqlapiresolverpolicy
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GraphQLApiResolverPolicyClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGraphQLApiResolverPolicyClientWithBaseURI(endpoint string) GraphQLApiResolverPolicyClient {
+	return GraphQLApiResolverPolicyClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,152 @@
+package graphqlapiresolverpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ResolverPolicyId{}
+
+// ResolverPolicyId is a struct representing the Resource ID for a Resolver Policy
+type ResolverPolicyId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ServiceName       string
+	ApiId             string
+	ResolverId        string
+}
+
+// NewResolverPolicyID returns a new ResolverPolicyId struct
+func NewResolverPolicyID(subscriptionId string, resourceGroupName string, serviceName string, apiId string, resolverId string) ResolverPolicyId {
+	return ResolverPolicyId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ServiceName:       serviceName,
+		ApiId:             apiId,
+		ResolverId:        resolverId,
+	}
+}
+
+// ParseResolverPolicyID parses 'input' into a ResolverPolicyId
+func ParseResolverPolicyID(input string) (*ResolverPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ResolverPolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ResolverPolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.ApiId, ok = parsed.Parsed["apiId"]; !ok {
+		return nil, fmt.Errorf("the segment 'apiId' was not found in the resource id %q", input)
+	}
+
+	if id.ResolverId, ok = parsed.Parsed["resolverId"]; !ok {
+		return nil, fmt.Errorf("the segment 'resolverId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseResolverPolicyIDInsensitively parses 'input' case-insensitively into a ResolverPolicyId
+// note: this method should only be used for API response data and not user input
+func ParseResolverPolicyIDInsensitively(input string) (*ResolverPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ResolverPolicyId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ResolverPolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.ApiId, ok = parsed.Parsed["apiId"]; !ok {
+		return nil, fmt.Errorf("the segment 'apiId' was not found in the resource id %q", input)
+	}
+
+	if id.ResolverId, ok = parsed.Parsed["resolverId"]; !ok {
+		return nil, fmt.Errorf("the segment 'resolverId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateResolverPolicyID checks that 'input' can be parsed as a Resolver Policy ID
+func ValidateResolverPolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseResolverPolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Resolver Policy ID
+func (id ResolverPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/resolvers/%s/policies/policy"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Resolver Policy ID
+func (id ResolverPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "serviceValue"),
+		resourceids.StaticSegment("staticApis", "apis", "apis"),
+		resourceids.UserSpecifiedSegment("apiId", "apiIdValue"),
+		resourceids.StaticSegment("staticResolvers", "resolvers", "resolvers"),
+		resourceids.UserSpecifiedSegment("resolverId", "resolverIdValue"),
+		resourceids.StaticSegment("staticPolicies", "policies", "policies"),
+		resourceids.StaticSegment("staticPolicy", "policy", "policy"),
+	}
+}
+
+// String returns a human-readable description of this Resolver Policy ID
+func (id ResolverPolicyId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Service Name: %q", id.ServiceName),
+		fmt.Sprintf("Api Id: %q", id.ApiId),
+		fmt.Sprintf("Resolver Id: %q", id.ResolverId),
+	}
+	return fmt.Sprintf("Resolver Policy (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,6 @@
+package graphqlapiresolverpolicy
+
+type PolicyContractProperties struct {
+	Format *PolicyContentFormat `json:"format,omitempty"`
+	Value  string               `json:"value"`
+}
@@ -0,0 +1,15 @@
+package workspace
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspaceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceClientWithBaseURI(endpoint string) WorkspaceClient {
+	return WorkspaceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
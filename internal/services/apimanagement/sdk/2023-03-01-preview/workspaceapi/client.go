@@ -0,0 +1,15 @@
+package workspaceapi
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspaceApiClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceApiClientWithBaseURI(endpoint string) WorkspaceApiClient {
+	return WorkspaceApiClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,77 @@
+package workspaceapi
+
+import "strings"
+
+type ApiType string
+
+const (
+	ApiTypeGraphql   ApiType = "graphql"
+	ApiTypeGrpc      ApiType = "grpc"
+	ApiTypeHttp      ApiType = "http"
+	ApiTypeOdata     ApiType = "odata"
+	ApiTypeSoap      ApiType = "soap"
+	ApiTypeWebsocket ApiType = "websocket"
+)
+
+func PossibleValuesForApiType() []string {
+	return []string{
+		string(ApiTypeGraphql),
+		string(ApiTypeGrpc),
+		string(ApiTypeHttp),
+		string(ApiTypeOdata),
+		string(ApiTypeSoap),
+		string(ApiTypeWebsocket),
+	}
+}
+
+func parseApiType(input string) (*ApiType, error) {
+	vals := map[string]ApiType{
+		"graphql":   ApiTypeGraphql,
+		"grpc":      ApiTypeGrpc,
+		"http":      ApiTypeHttp,
+		"odata":     ApiTypeOdata,
+		"soap":      ApiTypeSoap,
+		"websocket": ApiTypeWebsocket,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ApiType(input)
+	return &out, nil
+}
+
+type Protocol string
+
+const (
+	ProtocolHttp  Protocol = "http"
+	ProtocolHttps Protocol = "https"
+	ProtocolWs    Protocol = "ws"
+	ProtocolWss   Protocol = "wss"
+)
+
+func PossibleValuesForProtocol() []string {
+	return []string{
+		string(ProtocolHttp),
+		string(ProtocolHttps),
+		string(ProtocolWs),
+		string(ProtocolWss),
+	}
+}
+
+func parseProtocol(input string) (*Protocol, error) {
+	vals := map[string]Protocol{
+		"http":  ProtocolHttp,
+		"https": ProtocolHttps,
+		"ws":    ProtocolWs,
+		"wss":   ProtocolWss,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Protocol(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package workspaceapi
+
+type ApiContract struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ApiContractProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package workspaceapi
+
+import "fmt"
+
+const defaultApiVersion = "2023-03-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspaceapi/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package workspaceproduct
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspaceProductClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceProductClientWithBaseURI(endpoint string) WorkspaceProductClient {
+	return WorkspaceProductClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package workspaceproduct
+
+import "fmt"
+
+const defaultApiVersion = "2023-03-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspaceproduct/%s", defaultApiVersion)
+}
@@ -1,6 +1,7 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -44,6 +45,13 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// a workspace-based component can't be migrated back to a classic one
+			pluginsdk.ForceNewIfChange("workspace_id", func(ctx context.Context, old, new, _ interface{}) bool {
+				return old.(string) != "" && new.(string) == ""
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -214,7 +222,9 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	if workspaceRaw, hasWorkspaceId := d.GetOk("workspace_id"); hasWorkspaceId {
+		// setting the workspace migrates a classic component into a workspace-based one
 		applicationInsightsComponentProperties.WorkspaceResourceID = utils.String(workspaceRaw.(string))
+		applicationInsightsComponentProperties.IngestionMode = insights.IngestionModeLogAnalytics
 	}
 
 	if v, ok := d.GetOk("retention_in_days"); ok {
@@ -0,0 +1,570 @@
+package applicationinsights
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/sdk/2022-06-15/webtestsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationInsightsStandardWebTest() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationInsightsStandardWebTestCreateUpdate,
+		Read:   resourceApplicationInsightsStandardWebTestRead,
+		Update: resourceApplicationInsightsStandardWebTestCreateUpdate,
+		Delete: resourceApplicationInsightsStandardWebTestDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := webtestsapis.ParseWebTestID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"application_insights_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"geo_locations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:             pluginsdk.TypeString,
+					ValidateFunc:     validation.StringIsNotEmpty,
+					StateFunc:        location.StateFunc,
+					DiffSuppressFunc: location.DiffSuppressFunc,
+				},
+			},
+
+			"request": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"http_verb": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  http.MethodGet,
+							ValidateFunc: validation.StringInSlice([]string{
+								http.MethodGet,
+								http.MethodPost,
+								http.MethodPut,
+								http.MethodPatch,
+								http.MethodDelete,
+							}, false),
+						},
+
+						"body": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"follow_redirects_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"parse_dependent_requests_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"header": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"value": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"validation_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expected_status_code": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      200,
+							ValidateFunc: validation.IntBetween(0, 599),
+						},
+
+						"ssl_cert_remaining_lifetime": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 365),
+						},
+
+						"ssl_check_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
+						"content": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"content_match": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"ignore_case": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+
+									"pass_if_text_found": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"frequency": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				Default:  300,
+				ValidateFunc: validation.IntInSlice([]int{
+					300,
+					600,
+					900,
+				}),
+			},
+
+			"retry_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"timeout": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				Default:  30,
+				ValidateFunc: validation.IntInSlice([]int{
+					30,
+					60,
+					90,
+					120,
+				}),
+			},
+
+			"tags": tags.Schema(),
+
+			"synthetic_monitor_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationInsightsStandardWebTestCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppInsights.StandardWebTestsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	appInsightsId, err := parse.ComponentID(d.Get("application_insights_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := webtestsapis.NewWebTestID(appInsightsId.SubscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.WebTestsGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_application_insights_standard_web_test", id.ID())
+		}
+	}
+
+	request := expandApplicationInsightsStandardWebTestRequest(d.Get("request").([]interface{}))
+	validationRules := expandApplicationInsightsStandardWebTestValidationRules(d.Get("validation_rules").([]interface{}))
+	if validationRules.SSLCheck != nil && *validationRules.SSLCheck && request.RequestUrl != nil && !strings.HasPrefix(strings.ToLower(*request.RequestUrl), "https://") {
+		return fmt.Errorf("`validation_rules.0.ssl_check_enabled` can only be enabled when `request.0.url` uses the `https` scheme")
+	}
+
+	// the hidden-link tag is how the Web Test is associated with the Application Insights component
+	t := d.Get("tags").(map[string]interface{})
+	t[fmt.Sprintf("hidden-link:%s", appInsightsId.ID())] = "Resource"
+
+	kind := webtestsapis.WebTestKindStandard
+	payload := webtestsapis.WebTest{
+		Kind:     &kind,
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &webtestsapis.WebTestProperties{
+			Description:        utils.String(d.Get("description").(string)),
+			Enabled:            utils.Bool(d.Get("enabled").(bool)),
+			Frequency:          utils.Int64(int64(d.Get("frequency").(int))),
+			Kind:               webtestsapis.WebTestKindStandard,
+			Locations:          expandApplicationInsightsStandardWebTestGeoLocations(d.Get("geo_locations").([]interface{})),
+			Name:               id.WebTestName,
+			Request:            request,
+			RetryEnabled:       utils.Bool(d.Get("retry_enabled").(bool)),
+			SyntheticMonitorId: id.WebTestName,
+			Timeout:            utils.Int64(int64(d.Get("timeout").(int))),
+			ValidationRules:    validationRules,
+		},
+		Tags: expandApplicationInsightsStandardWebTestTags(t),
+	}
+
+	if _, err := client.WebTestsCreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationInsightsStandardWebTestRead(d, meta)
+}
+
+func resourceApplicationInsightsStandardWebTestRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppInsights.StandardWebTestsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webtestsapis.ParseWebTestID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.WebTestsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.WebTestName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		appInsightsId := ""
+		t := make(map[string]string)
+		if model.Tags != nil {
+			for k, v := range *model.Tags {
+				if strings.HasPrefix(k, "hidden-link:") {
+					appInsightsId = strings.TrimPrefix(k, "hidden-link:")
+					continue
+				}
+				t[k] = v
+			}
+		}
+		d.Set("application_insights_id", appInsightsId)
+
+		if props := model.Properties; props != nil {
+			d.Set("synthetic_monitor_id", props.SyntheticMonitorId)
+			d.Set("description", props.Description)
+			d.Set("enabled", props.Enabled)
+			d.Set("frequency", props.Frequency)
+			d.Set("retry_enabled", props.RetryEnabled)
+			d.Set("timeout", props.Timeout)
+
+			if err := d.Set("geo_locations", flattenApplicationInsightsStandardWebTestGeoLocations(props.Locations)); err != nil {
+				return fmt.Errorf("setting `geo_locations`: %+v", err)
+			}
+
+			if err := d.Set("request", flattenApplicationInsightsStandardWebTestRequest(props.Request)); err != nil {
+				return fmt.Errorf("setting `request`: %+v", err)
+			}
+
+			if err := d.Set("validation_rules", flattenApplicationInsightsStandardWebTestValidationRules(props.ValidationRules)); err != nil {
+				return fmt.Errorf("setting `validation_rules`: %+v", err)
+			}
+		}
+
+		return tags.FlattenAndSet(d, tags.FromTypedObject(t))
+	}
+
+	return nil
+}
+
+func resourceApplicationInsightsStandardWebTestDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppInsights.StandardWebTestsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webtestsapis.ParseWebTestID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.WebTestsDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandApplicationInsightsStandardWebTestGeoLocations(input []interface{}) []webtestsapis.WebTestGeolocation {
+	locations := make([]webtestsapis.WebTestGeolocation, 0)
+
+	for _, v := range input {
+		locations = append(locations, webtestsapis.WebTestGeolocation{
+			Id: utils.String(v.(string)),
+		})
+	}
+
+	return locations
+}
+
+func flattenApplicationInsightsStandardWebTestGeoLocations(input []webtestsapis.WebTestGeolocation) []string {
+	results := make([]string, 0)
+
+	for _, v := range input {
+		if v.Id != nil {
+			results = append(results, azure.NormalizeLocation(*v.Id))
+		}
+	}
+
+	return results
+}
+
+func expandApplicationInsightsStandardWebTestRequest(input []interface{}) *webtestsapis.WebTestPropertiesRequest {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	headers := make([]webtestsapis.HeaderField, 0)
+	for _, raw := range v["header"].([]interface{}) {
+		header := raw.(map[string]interface{})
+		headers = append(headers, webtestsapis.HeaderField{
+			Key:   utils.String(header["name"].(string)),
+			Value: utils.String(header["value"].(string)),
+		})
+	}
+
+	request := &webtestsapis.WebTestPropertiesRequest{
+		FollowRedirects:        utils.Bool(v["follow_redirects_enabled"].(bool)),
+		HTTPVerb:               utils.String(v["http_verb"].(string)),
+		Headers:                &headers,
+		ParseDependentRequests: utils.Bool(v["parse_dependent_requests_enabled"].(bool)),
+		RequestUrl:             utils.String(v["url"].(string)),
+	}
+
+	if body := v["body"].(string); body != "" {
+		request.RequestBody = utils.String(body)
+	}
+
+	return request
+}
+
+func flattenApplicationInsightsStandardWebTestRequest(input *webtestsapis.WebTestPropertiesRequest) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	headers := make([]interface{}, 0)
+	if input.Headers != nil {
+		for _, v := range *input.Headers {
+			name := ""
+			if v.Key != nil {
+				name = *v.Key
+			}
+			value := ""
+			if v.Value != nil {
+				value = *v.Value
+			}
+			headers = append(headers, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
+		}
+	}
+
+	body := ""
+	if input.RequestBody != nil {
+		body = *input.RequestBody
+	}
+
+	httpVerb := ""
+	if input.HTTPVerb != nil {
+		httpVerb = *input.HTTPVerb
+	}
+
+	url := ""
+	if input.RequestUrl != nil {
+		url = *input.RequestUrl
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"body":                             body,
+			"follow_redirects_enabled":         input.FollowRedirects != nil && *input.FollowRedirects,
+			"header":                           headers,
+			"http_verb":                        httpVerb,
+			"parse_dependent_requests_enabled": input.ParseDependentRequests != nil && *input.ParseDependentRequests,
+			"url":                              url,
+		},
+	}
+}
+
+func expandApplicationInsightsStandardWebTestValidationRules(input []interface{}) *webtestsapis.WebTestPropertiesValidationRules {
+	// the expected status code defaults to 200 when no validation rules are specified
+	rules := &webtestsapis.WebTestPropertiesValidationRules{
+		ExpectedHTTPStatusCode: utils.Int64(200),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return rules
+	}
+
+	v := input[0].(map[string]interface{})
+
+	rules.ExpectedHTTPStatusCode = utils.Int64(int64(v["expected_status_code"].(int)))
+	rules.SSLCheck = utils.Bool(v["ssl_check_enabled"].(bool))
+
+	if lifetime := v["ssl_cert_remaining_lifetime"].(int); lifetime != 0 {
+		rules.SSLCertRemainingLifetimeCheck = utils.Int64(int64(lifetime))
+	}
+
+	if content := v["content"].([]interface{}); len(content) > 0 && content[0] != nil {
+		c := content[0].(map[string]interface{})
+		rules.ContentValidation = &webtestsapis.WebTestPropertiesValidationRulesContentValidation{
+			ContentMatch:    utils.String(c["content_match"].(string)),
+			IgnoreCase:      utils.Bool(c["ignore_case"].(bool)),
+			PassIfTextFound: utils.Bool(c["pass_if_text_found"].(bool)),
+		}
+	}
+
+	return rules
+}
+
+func flattenApplicationInsightsStandardWebTestValidationRules(input *webtestsapis.WebTestPropertiesValidationRules) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the API returns the default validation rules when none are specified, which is treated as the block being omitted
+	isDefault := input.ContentValidation == nil && input.SSLCertRemainingLifetimeCheck == nil && (input.SSLCheck == nil || !*input.SSLCheck)
+	if isDefault && (input.ExpectedHTTPStatusCode == nil || *input.ExpectedHTTPStatusCode == 200) {
+		return []interface{}{}
+	}
+
+	content := make([]interface{}, 0)
+	if v := input.ContentValidation; v != nil {
+		contentMatch := ""
+		if v.ContentMatch != nil {
+			contentMatch = *v.ContentMatch
+		}
+		content = append(content, map[string]interface{}{
+			"content_match":      contentMatch,
+			"ignore_case":        v.IgnoreCase != nil && *v.IgnoreCase,
+			"pass_if_text_found": v.PassIfTextFound != nil && *v.PassIfTextFound,
+		})
+	}
+
+	expectedStatusCode := 0
+	if input.ExpectedHTTPStatusCode != nil {
+		expectedStatusCode = int(*input.ExpectedHTTPStatusCode)
+	}
+
+	sslCertRemainingLifetime := 0
+	if input.SSLCertRemainingLifetimeCheck != nil {
+		sslCertRemainingLifetime = int(*input.SSLCertRemainingLifetimeCheck)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"content":                     content,
+			"expected_status_code":        expectedStatusCode,
+			"ssl_cert_remaining_lifetime": sslCertRemainingLifetime,
+			"ssl_check_enabled":           input.SSLCheck != nil && *input.SSLCheck,
+		},
+	}
+}
+
+func expandApplicationInsightsStandardWebTestTags(input map[string]interface{}) *map[string]string {
+	t := tags.ToTypedObject(tags.Expand(input))
+	return &t
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/sdk/2022-06-15/webtestsapis"
 )
 
 type Client struct {
@@ -12,6 +13,7 @@ type Client struct {
 	WebTestsClient           *insights.WebTestsClient
 	BillingClient            *insights.ComponentCurrentBillingFeaturesClient
 	SmartDetectionRuleClient *insights.ProactiveDetectionConfigurationsClient
+	StandardWebTestsClient   *webtestsapis.WebTestsAPIsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -33,6 +35,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	smartDetectionRuleClient := insights.NewProactiveDetectionConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&smartDetectionRuleClient.Client, o.ResourceManagerAuthorizer)
 
+	standardWebTestsClient := webtestsapis.NewWebTestsAPIsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&standardWebTestsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AnalyticsItemsClient:     &analyticsItemsClient,
 		APIKeysClient:            &apiKeysClient,
@@ -40,5 +45,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		WebTestsClient:           &webTestsClient,
 		BillingClient:            &billingClient,
 		SmartDetectionRuleClient: &smartDetectionRuleClient,
+		StandardWebTestsClient:   &standardWebTestsClient,
 	}
 }
@@ -32,6 +32,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_application_insights":                      resourceApplicationInsights(),
 		"azurerm_application_insights_analytics_item":       resourceApplicationInsightsAnalyticsItem(),
 		"azurerm_application_insights_smart_detection_rule": resourceApplicationInsightsSmartDetectionRule(),
+		"azurerm_application_insights_standard_web_test":    resourceApplicationInsightsStandardWebTest(),
 		"azurerm_application_insights_web_test":             resourceApplicationInsightsWebTests(),
 	}
 }
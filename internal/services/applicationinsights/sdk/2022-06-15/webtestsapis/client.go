@@ -0,0 +1,15 @@
+package webtestsapis
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WebTestsAPIsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWebTestsAPIsClientWithBaseURI(endpoint string) WebTestsAPIsClient {
+	return WebTestsAPIsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,34 @@
+package webtestsapis
+
+import "strings"
+
+type WebTestKind string
+
+const (
+	WebTestKindMultistep WebTestKind = "multistep"
+	WebTestKindPing      WebTestKind = "ping"
+	WebTestKindStandard  WebTestKind = "standard"
+)
+
+func PossibleValuesForWebTestKind() []string {
+	return []string{
+		string(WebTestKindMultistep),
+		string(WebTestKindPing),
+		string(WebTestKindStandard),
+	}
+}
+
+func parseWebTestKind(input string) (*WebTestKind, error) {
+	vals := map[string]WebTestKind{
+		"multistep": WebTestKindMultistep,
+		"ping":      WebTestKindPing,
+		"standard":  WebTestKindStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebTestKind(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package webtestsapis
+
+type HeaderField struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webtestsapis
+
+type WebTestGeolocation struct {
+	Id *string `json:"Id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webtestsapis
+
+type WebTestPropertiesConfiguration struct {
+	WebTest *string `json:"WebTest,omitempty"`
+}
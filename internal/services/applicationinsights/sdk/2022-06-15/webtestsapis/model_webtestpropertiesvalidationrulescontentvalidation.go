@@ -0,0 +1,7 @@
+package webtestsapis
+
+type WebTestPropertiesValidationRulesContentValidation struct {
+	ContentMatch    *string `json:"ContentMatch,omitempty"`
+	IgnoreCase      *bool   `json:"IgnoreCase,omitempty"`
+	PassIfTextFound *bool   `json:"PassIfTextFound,omitempty"`
+}
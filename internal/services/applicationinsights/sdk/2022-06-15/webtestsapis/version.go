@@ -0,0 +1,9 @@
+package webtestsapis
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/webtestsapis/%s", defaultApiVersion)
+}
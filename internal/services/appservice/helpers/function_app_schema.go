@@ -92,11 +92,11 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 				},
 
 				"app_scale_limit": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true,
-					Description: "The number of workers this function app can scale out to. Only applicable to apps on the Consumption and Premium plan.",
-					// TODO Validation?
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of workers this function app can scale out to. Only applicable to apps on the Consumption and Premium plan.",
 				},
 
 				"application_insights_key": {
@@ -150,10 +150,11 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 				},
 
 				"elastic_instance_minimum": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true,
-					Description: "The number of minimum instances for this Linux Function App. Only affects apps on Elastic Premium plans.",
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 20),
+					Description:  "The number of minimum instances for this Linux Function App. Only affects apps on Elastic Premium plans.",
 				},
 
 				"http2_enabled": {
@@ -201,10 +202,11 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 				},
 
 				"pre_warmed_instance_count": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true, // Variable defaults depending on plan etc
-					Description: "The number of pre-warmed instances for this function app. Only affects apps on an Elastic Premium plan.",
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true, // Variable defaults depending on plan etc
+					ValidateFunc: validation.IntBetween(0, 20),
+					Description:  "The number of pre-warmed instances for this function app. Only affects apps on an Elastic Premium plan.",
 				},
 
 				"remote_debugging": {
@@ -336,12 +338,14 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 
 type ApplicationStackLinuxFunctionApp struct {
 	// Note - Function Apps differ to Web Apps here. They do not use the named properties in the SiteConfig block and exclusively use the app_settings map
-	DotNetVersion string                   `tfschema:"dotnet_version"`     // Supported values `3.1`. Version 6 is in preview on Windows Only
-	NodeVersion   string                   `tfschema:"node_version"`       // Supported values `12LTS`, `14LTS`
-	PythonVersion string                   `tfschema:"python_version"`     // Supported values `3.9`, `3.8`, `3.7`
-	JavaVersion   string                   `tfschema:"java_version"`       // Supported values `8`, `11`
-	CustomHandler bool                     `tfschema:"use_custom_runtime"` // Supported values `true`
-	Docker        []ApplicationStackDocker `tfschema:"docker"`             // Needs ElasticPremium or Basic (B1) Standard (S 1-3) or Premium(PxV2 or PxV3) LINUX Service Plan
+	DotNetVersion         string                   `tfschema:"dotnet_version"`              // Supported values `3.1`. Version 6 is in preview on Windows Only
+	DotNetIsolated        bool                     `tfschema:"use_dotnet_isolated_runtime"` // Supported values `true` for `dotnet-isolated`, `false` otherwise
+	NodeVersion           string                   `tfschema:"node_version"`                // Supported values `12LTS`, `14LTS`
+	PythonVersion         string                   `tfschema:"python_version"`              // Supported values `3.9`, `3.8`, `3.7`
+	PowerShellCoreVersion string                   `tfschema:"powershell_core_version"`     // Supported values `7`, `7.2`
+	JavaVersion           string                   `tfschema:"java_version"`                // Supported values `8`, `11`
+	CustomHandler         bool                     `tfschema:"use_custom_runtime"`          // Supported values `true`
+	Docker                []ApplicationStackDocker `tfschema:"docker"`                      // Needs ElasticPremium or Basic (B1) Standard (S 1-3) or Premium(PxV2 or PxV3) LINUX Service Plan
 }
 
 type ApplicationStackDocker struct {
@@ -371,12 +375,23 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.python_version",
 						"site_config.0.application_stack.0.java_version",
 						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
 					Description: "The version of .Net. Possible values are `3.1` and `6`",
 				},
 
+				"use_dotnet_isolated_runtime": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.dotnet_version",
+					},
+					Description: "Should the DotNet process use an isolated runtime. Defaults to `false`.",
+				},
+
 				"python_version": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
@@ -390,6 +405,7 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.python_version",
 						"site_config.0.application_stack.0.java_version",
 						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
@@ -408,12 +424,32 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.python_version",
 						"site_config.0.application_stack.0.java_version",
 						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
 					Description: "The version of Node to use. Possible values include `12`, and `14`",
 				},
 
+				"powershell_core_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"7",
+						"7.2",
+					}, false),
+					ExactlyOneOf: []string{
+						"site_config.0.application_stack.0.dotnet_version",
+						"site_config.0.application_stack.0.python_version",
+						"site_config.0.application_stack.0.java_version",
+						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
+						"site_config.0.application_stack.0.docker",
+						"site_config.0.application_stack.0.use_custom_runtime",
+					},
+					Description: "The version of PowerShell Core to use. Possibles values are `7`, and `7.2`",
+				},
+
 				"java_version": {
 					Type:     pluginsdk.TypeString,
 					Optional: true,
@@ -426,6 +462,7 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.python_version",
 						"site_config.0.application_stack.0.java_version",
 						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
@@ -479,6 +516,7 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.python_version",
 						"site_config.0.application_stack.0.java_version",
 						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
@@ -493,6 +531,7 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.python_version",
 						"site_config.0.application_stack.0.java_version",
 						"site_config.0.application_stack.0.node_version",
+						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
@@ -612,11 +651,19 @@ func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, e
 		if len(linuxSiteConfig.ApplicationStack) > 0 {
 			linuxAppStack := linuxSiteConfig.ApplicationStack[0]
 			if linuxAppStack.DotNetVersion != "" {
-				appSettings = append(appSettings, web.NameValuePair{
-					Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
-					Value: utils.String("dotnet"),
-				})
-				linuxSiteConfig.LinuxFxVersion = fmt.Sprintf("DOTNET|%s", linuxAppStack.DotNetVersion)
+				if linuxAppStack.DotNetIsolated {
+					appSettings = append(appSettings, web.NameValuePair{
+						Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+						Value: utils.String("dotnet-isolated"),
+					})
+					linuxSiteConfig.LinuxFxVersion = fmt.Sprintf("DOTNET-ISOLATED|%s", linuxAppStack.DotNetVersion)
+				} else {
+					appSettings = append(appSettings, web.NameValuePair{
+						Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+						Value: utils.String("dotnet"),
+					})
+					linuxSiteConfig.LinuxFxVersion = fmt.Sprintf("DOTNET|%s", linuxAppStack.DotNetVersion)
+				}
 			}
 
 			if linuxAppStack.NodeVersion != "" {
@@ -647,6 +694,14 @@ func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, e
 				linuxSiteConfig.LinuxFxVersion = fmt.Sprintf("Java|%s", linuxAppStack.JavaVersion)
 			}
 
+			if linuxAppStack.PowerShellCoreVersion != "" {
+				appSettings = append(appSettings, web.NameValuePair{
+					Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
+					Value: utils.String("powershell"),
+				})
+				linuxSiteConfig.LinuxFxVersion = fmt.Sprintf("PowerShell|%s", linuxAppStack.PowerShellCoreVersion)
+			}
+
 			if linuxAppStack.CustomHandler {
 				appSettings = append(appSettings, web.NameValuePair{
 					Name:  utils.String("FUNCTIONS_WORKER_RUNTIME"),
@@ -773,6 +828,14 @@ func ExpandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, e
 		expanded.PreWarmedInstanceCount = utils.Int32(int32(linuxSiteConfig.PreWarmedInstanceCount))
 	}
 
+	if metadata.ResourceData.HasChange("site_config.0.elastic_instance_minimum") {
+		expanded.MinimumElasticInstanceCount = utils.Int32(int32(linuxSiteConfig.ElasticInstanceMinimum))
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.runtime_scale_monitoring_enabled") {
+		expanded.FunctionsRuntimeScaleMonitoringEnabled = utils.Bool(linuxSiteConfig.RuntimeScaleMonitoring)
+	}
+
 	if metadata.ResourceData.HasChange("site_config.0.vnet_route_all_enabled") {
 		expanded.VnetRouteAllEnabled = utils.Bool(linuxSiteConfig.VnetRouteAllEnabled)
 	}
@@ -63,6 +63,9 @@ func EncodeFunctionAppLinuxFxVersion(input []ApplicationStackLinuxFunctionApp) *
 	case appStack.NodeVersion != "":
 		appType = "Node"
 		appString = appStack.NodeVersion
+	case appStack.DotNetVersion != "" && appStack.DotNetIsolated:
+		appType = "DotNet-Isolated"
+		appString = appStack.DotNetVersion
 	case appStack.DotNetVersion != "":
 		appType = "DotNet"
 		appString = appStack.DotNetVersion
@@ -72,6 +75,12 @@ func EncodeFunctionAppLinuxFxVersion(input []ApplicationStackLinuxFunctionApp) *
 	case appStack.JavaVersion != "":
 		appType = "Java"
 		appString = appStack.JavaVersion
+	case appStack.PowerShellCoreVersion != "":
+		appType = "PowerShell"
+		appString = appStack.PowerShellCoreVersion
+	case appStack.CustomHandler:
+		// Custom Handlers need an explicit empty string here
+		return utils.String("")
 	case len(appStack.Docker) > 0 && appStack.Docker[0].ImageName != "":
 		appType = "Docker"
 		dockerCfg := appStack.Docker[0]
@@ -103,6 +112,10 @@ func DecodeFunctionAppLinuxFxVersion(input string) ([]ApplicationStackLinuxFunct
 		appStack := ApplicationStackLinuxFunctionApp{DotNetVersion: parts[1]}
 		result = append(result, appStack)
 
+	case "dotnet-isolated":
+		appStack := ApplicationStackLinuxFunctionApp{DotNetVersion: parts[1], DotNetIsolated: true}
+		result = append(result, appStack)
+
 	case "node":
 		appStack := ApplicationStackLinuxFunctionApp{NodeVersion: parts[1]}
 		result = append(result, appStack)
@@ -115,6 +128,10 @@ func DecodeFunctionAppLinuxFxVersion(input string) ([]ApplicationStackLinuxFunct
 		appStack := ApplicationStackLinuxFunctionApp{JavaVersion: parts[1]}
 		result = append(result, appStack)
 
+	case "powershell":
+		appStack := ApplicationStackLinuxFunctionApp{PowerShellCoreVersion: parts[1]}
+		result = append(result, appStack)
+
 	case "docker":
 		// This is handled as part of unpacking the app_settings using DecodeFunctionAppDockerFxString but included here for signposting as this is not intuitive.
 	}
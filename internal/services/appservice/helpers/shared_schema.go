@@ -378,6 +378,74 @@ func CorsSettingsSchemaComputed() *pluginsdk.Schema {
 	}
 }
 
+type StickySettings struct {
+	AppSettingNames       []string `tfschema:"app_setting_names"`
+	ConnectionStringNames []string `tfschema:"connection_string_names"`
+}
+
+func StickySettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_setting_names": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					AtLeastOneOf: []string{
+						"sticky_settings.0.app_setting_names",
+						"sticky_settings.0.connection_string_names",
+					},
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"connection_string_names": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					AtLeastOneOf: []string{
+						"sticky_settings.0.app_setting_names",
+						"sticky_settings.0.connection_string_names",
+					},
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func StickySettingsSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_setting_names": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"connection_string_names": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
 type SourceControl struct {
 	RepoURL           string `tfschema:"repo_url"`
 	Branch            string `tfschema:"branch"`
@@ -1507,7 +1575,6 @@ func FlattenIpRestrictions(ipRestrictionsList *[]web.IPSecurityRestriction) []Ip
 			if *v.IPAddress == "Any" {
 				continue
 			}
-			ipRestriction.IpAddress = *v.IPAddress
 			if v.Tag == web.IPFilterTagServiceTag {
 				ipRestriction.ServiceTag = *v.IPAddress
 			} else {
@@ -1559,6 +1626,45 @@ func flattenIpRestrictionHeaders(headers map[string][]string) []IpRestrictionHea
 	return []IpRestrictionHeaders{ipRestrictionHeader}
 }
 
+// ExpandStickySettings returns the Slot Config Names for the Web App - an empty set of names is returned when
+// the block has been removed, since the API is update only
+func ExpandStickySettings(input []StickySettings) *web.SlotConfigNamesResource {
+	appSettingNames := make([]string, 0)
+	connectionStringNames := make([]string, 0)
+	if len(input) > 0 {
+		appSettingNames = append(appSettingNames, input[0].AppSettingNames...)
+		connectionStringNames = append(connectionStringNames, input[0].ConnectionStringNames...)
+	}
+
+	return &web.SlotConfigNamesResource{
+		SlotConfigNames: &web.SlotConfigNames{
+			AppSettingNames:       &appSettingNames,
+			ConnectionStringNames: &connectionStringNames,
+		},
+	}
+}
+
+func FlattenStickySettings(input *web.SlotConfigNames) []StickySettings {
+	if input == nil {
+		return []StickySettings{}
+	}
+
+	result := StickySettings{}
+	if input.AppSettingNames != nil {
+		result.AppSettingNames = *input.AppSettingNames
+	}
+
+	if input.ConnectionStringNames != nil {
+		result.ConnectionStringNames = *input.ConnectionStringNames
+	}
+
+	if len(result.AppSettingNames) == 0 && len(result.ConnectionStringNames) == 0 {
+		return []StickySettings{}
+	}
+
+	return []StickySettings{result}
+}
+
 func FlattenWebStringDictionary(input web.StringDictionary) map[string]string {
 	result := make(map[string]string)
 	for k, v := range input.Properties {
@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -907,6 +908,7 @@ func windowsApplicationStackSchema() *pluginsdk.Schema {
 						"v3.0",
 						"v4.0",
 						"v5.0",
+						"v6.0",
 					}, false),
 				},
 
@@ -940,6 +942,7 @@ func windowsApplicationStackSchema() *pluginsdk.Schema {
 						"10-LTS", // Linux Only?
 						"12-LTS",
 						"14-LTS",
+						"16-LTS",
 					}, false),
 					ConflictsWith: []string{
 						"site_config.0.application_stack.0.java_version",
@@ -1118,6 +1121,7 @@ func linuxApplicationStackSchema() *pluginsdk.Schema {
 						"2.1",
 						"3.1",
 						"5.0",
+						"6.0",
 					}, false),
 					ConflictsWith: []string{
 						"site_config.0.application_stack.0.php_version",
@@ -1136,6 +1140,7 @@ func linuxApplicationStackSchema() *pluginsdk.Schema {
 						"7.2", // TODO - Remove? 7.2 is available, but deprecated in the service
 						"7.3",
 						"7.4",
+						"8.0",
 					}, false),
 					ConflictsWith: []string{
 						"site_config.0.application_stack.0.dotnet_version",
@@ -1154,6 +1159,7 @@ func linuxApplicationStackSchema() *pluginsdk.Schema {
 						"3.6",
 						"3.7",
 						"3.8",
+						"3.9",
 					}, false),
 					ConflictsWith: []string{
 						"site_config.0.application_stack.0.dotnet_version",
@@ -1174,6 +1180,7 @@ func linuxApplicationStackSchema() *pluginsdk.Schema {
 						"10-lts", // TODO - Remove?  Deprecated
 						"12-lts",
 						"14-lts",
+						"16-lts",
 					}, false),
 					ConflictsWith: []string{
 						"site_config.0.application_stack.0.dotnet_version",
@@ -1474,10 +1481,10 @@ func autoHealActionSchemaWindows() *pluginsdk.Schema {
 				},
 
 				"minimum_process_execution_time": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					Computed: true,
-					//ValidateFunc: // TODO - Time in hh:mm:ss, because why not...
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validate.TimeInterval,
 				},
 			},
 		},
@@ -1538,10 +1545,10 @@ func autoHealActionSchemaLinux() *pluginsdk.Schema {
 				},
 
 				"minimum_process_execution_time": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					Computed: true,
-					//ValidateFunc: // TODO - Time in hh:mm:ss, because why not...
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validate.TimeInterval,
 				},
 			},
 		},
@@ -1589,9 +1596,9 @@ func autoHealTriggerSchemaWindows() *pluginsdk.Schema {
 							},
 
 							"interval": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time, // TODO should be hh:mm:ss - This is too loose, need to improve
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 						},
 					},
@@ -1611,7 +1618,7 @@ func autoHealTriggerSchemaWindows() *pluginsdk.Schema {
 							"status_code_range": {
 								Type:         pluginsdk.TypeString,
 								Required:     true,
-								ValidateFunc: nil, // TODO - status code range validation
+								ValidateFunc: validate.StatusCodeRange,
 							},
 
 							"count": {
@@ -1621,9 +1628,9 @@ func autoHealTriggerSchemaWindows() *pluginsdk.Schema {
 							},
 
 							"interval": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 
 							"sub_status": {
@@ -1653,15 +1660,15 @@ func autoHealTriggerSchemaWindows() *pluginsdk.Schema {
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"time_taken": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 
 							"interval": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 
 							"count": {
@@ -1803,9 +1810,9 @@ func autoHealTriggerSchemaLinux() *pluginsdk.Schema {
 							},
 
 							"interval": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time, // TODO should be hh:mm:ss - This is too loose, need to improve?
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 						},
 					},
@@ -1819,7 +1826,7 @@ func autoHealTriggerSchemaLinux() *pluginsdk.Schema {
 							"status_code_range": {
 								Type:         pluginsdk.TypeString,
 								Required:     true,
-								ValidateFunc: nil, // TODO - status code range validation
+								ValidateFunc: validate.StatusCodeRange,
 							},
 
 							"count": {
@@ -1829,9 +1836,9 @@ func autoHealTriggerSchemaLinux() *pluginsdk.Schema {
 							},
 
 							"interval": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 
 							"sub_status": {
@@ -1861,15 +1868,15 @@ func autoHealTriggerSchemaLinux() *pluginsdk.Schema {
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"time_taken": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 
 							"interval": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-								//ValidateFunc: validation.IsRFC3339Time,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.TimeInterval,
 							},
 
 							"count": {
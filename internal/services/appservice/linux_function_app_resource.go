@@ -836,6 +836,9 @@ func (m *LinuxFunctionAppModel) unpackLinuxFunctionAppSettings(input web.StringD
 		case "FUNCTIONS_WORKER_RUNTIME":
 			if m.SiteConfig[0].ApplicationStack != nil {
 				m.SiteConfig[0].ApplicationStack[0].CustomHandler = strings.EqualFold(*v, "custom")
+			} else if strings.EqualFold(utils.NormalizeNilableString(v), "custom") {
+				// Custom Handlers have an empty LinuxFxVersion, so the stack can only be determined from the worker runtime
+				m.SiteConfig[0].ApplicationStack = []helpers.ApplicationStackLinuxFunctionApp{{CustomHandler: true}}
 			}
 
 		case "DOCKER_REGISTRY_SERVER_URL":
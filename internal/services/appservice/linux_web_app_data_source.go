@@ -38,6 +38,7 @@ type LinuxWebAppDataSourceModel struct {
 	SiteConfig                    []helpers.SiteConfigLinux  `tfschema:"site_config"`
 	StorageAccounts               []helpers.StorageAccount   `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString `tfschema:"connection_string"`
+	StickySettings                []helpers.StickySettings   `tfschema:"sticky_settings"`
 	Tags                          map[string]string          `tfschema:"tags"`
 	CustomDomainVerificationId    string                     `tfschema:"custom_domain_verification_id"`
 	DefaultHostname               string                     `tfschema:"default_hostname"`
@@ -177,6 +178,8 @@ func (r LinuxWebAppDataSource) Attributes() map[string]*pluginsdk.Schema {
 
 		"site_config": helpers.SiteConfigSchemaLinuxComputed(),
 
+		"sticky_settings": helpers.StickySettingsSchemaComputed(),
+
 		"storage_account": helpers.StorageAccountSchemaComputed(),
 
 		"tags": tags.SchemaDataSource(),
@@ -242,6 +245,11 @@ func (r LinuxWebAppDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
 			}
 
+			stickySettings, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
+			}
+
 			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
@@ -297,6 +305,8 @@ func (r LinuxWebAppDataSource) Read() sdk.ResourceFunc {
 
 			webApp.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
+			webApp.StickySettings = helpers.FlattenStickySettings(stickySettings.SlotConfigNames)
+
 			webApp.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
 			metadata.SetID(id)
@@ -40,6 +40,7 @@ type LinuxWebAppModel struct {
 	MetaData                      map[string]string          `tfschema:"app_metadata"`
 	SiteConfig                    []helpers.SiteConfigLinux  `tfschema:"site_config"`
 	StorageAccounts               []helpers.StorageAccount   `tfschema:"storage_account"`
+	StickySettings                []helpers.StickySettings   `tfschema:"sticky_settings"`
 	ConnectionStrings             []helpers.ConnectionString `tfschema:"connection_string"`
 	Tags                          map[string]string          `tfschema:"tags"`
 	CustomDomainVerificationId    string                     `tfschema:"custom_domain_verification_id"`
@@ -131,6 +132,8 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"site_config": helpers.SiteConfigSchemaLinux(),
 
+		"sticky_settings": helpers.StickySettingsSchema(),
+
 		"storage_account": helpers.StorageAccountSchema(),
 
 		"tags": tags.Schema(),
@@ -356,6 +359,13 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(webApp.StickySettings) > 0 {
+				stickySettings := helpers.ExpandStickySettings(webApp.StickySettings)
+				if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, *stickySettings); err != nil {
+					return fmt.Errorf("setting Sticky Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -420,6 +430,11 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
 			}
 
+			stickySettings, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
+			}
+
 			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
@@ -506,6 +521,8 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
+			state.StickySettings = helpers.FlattenStickySettings(stickySettings.SlotConfigNames)
+
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 			return metadata.Encode(&state)
 		},
@@ -655,6 +672,13 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("sticky_settings") {
+				stickySettingsUpdate := helpers.ExpandStickySettings(state.StickySettings)
+				if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, *stickySettingsUpdate); err != nil {
+					return fmt.Errorf("updating Sticky Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("storage_account") {
 				storageAccountUpdate := helpers.ExpandStorageConfig(state.StorageAccounts)
 				if _, err := client.UpdateAzureStorageAccounts(ctx, id.ResourceGroup, id.SiteName, *storageAccountUpdate); err != nil {
@@ -41,6 +41,7 @@ func (r Registration) Resources() []sdk.Resource {
 			LinuxFunctionAppResource{},
 			LinuxWebAppResource{},
 			ServicePlanResource{},
+			WebAppActiveSlotResource{},
 			WindowsWebAppResource{},
 		}
 	}
@@ -0,0 +1,39 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StatusCodeRange validates that the value is either a single HTTP Status Code (e.g. `500`) or a
+// range of HTTP Status Codes separated by a hyphen (e.g. `500-599`)
+func StatusCodeRange(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	parts := strings.Split(value, "-")
+	if len(parts) > 2 {
+		errors = append(errors, fmt.Errorf("%q must be a single status code or a range in the format `101-599`, got %q", k, value))
+		return
+	}
+
+	codes := make([]int, 0)
+	for _, part := range parts {
+		code, err := strconv.Atoi(part)
+		if err != nil || code < 101 || code > 599 {
+			errors = append(errors, fmt.Errorf("%q must contain status codes between 101 and 599, got %q", k, value))
+			return
+		}
+		codes = append(codes, code)
+	}
+
+	if len(codes) == 2 && codes[0] > codes[1] {
+		errors = append(errors, fmt.Errorf("the start of the range in %q must not be greater than the end, got %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,61 @@
+package validate
+
+import "testing"
+
+func TestStatusCodeRange(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "abc",
+			Valid: false,
+		},
+		{
+			Input: "100",
+			Valid: false,
+		},
+		{
+			Input: "600",
+			Valid: false,
+		},
+		{
+			Input: "500-",
+			Valid: false,
+		},
+		{
+			Input: "599-500",
+			Valid: false,
+		},
+		{
+			Input: "400-450-500",
+			Valid: false,
+		},
+		{
+			Input: "500",
+			Valid: true,
+		},
+		{
+			Input: "500-599",
+			Valid: true,
+		},
+		{
+			Input: "404-404",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := StatusCodeRange(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var timeIntervalRegex = regexp.MustCompile(`^([0-9]+):([0-5][0-9]):([0-5][0-9])$`)
+
+// TimeInterval validates that the value is a time span in the format `hh:mm:ss`, as used by the Auto Heal triggers and actions
+func TimeInterval(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if matched := timeIntervalRegex.MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q must be a time interval in the format `hh:mm:ss`, got %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,57 @@
+package validate
+
+import "testing"
+
+func TestTimeInterval(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: ":00:00",
+			Valid: false,
+		},
+		{
+			Input: "1:00:00",
+			Valid: true,
+		},
+		{
+			Input: "00:60:00",
+			Valid: false,
+		},
+		{
+			Input: "00:00:60",
+			Valid: false,
+		},
+		{
+			Input: "PT1M",
+			Valid: false,
+		},
+		{
+			Input: "00:01:00",
+			Valid: true,
+		},
+		{
+			Input: "23:59:59",
+			Valid: true,
+		},
+		{
+			Input: "100:00:00",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := TimeInterval(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
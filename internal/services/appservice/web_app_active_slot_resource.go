@@ -0,0 +1,197 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppActiveSlotResource struct{}
+
+type WebAppActiveSlotModel struct {
+	SlotID             string `tfschema:"slot_id"`
+	OverwriteNetwork   bool   `tfschema:"overwrite_network_config"`
+	LastSuccessfulSwap string `tfschema:"last_successful_swap"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppActiveSlotResource{}
+
+func (r WebAppActiveSlotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"slot_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.WebAppSlotID,
+		},
+
+		"overwrite_network_config": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_successful_swap": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) ModelObject() interface{} {
+	return &WebAppActiveSlotModel{}
+}
+
+func (r WebAppActiveSlotResource) ResourceType() string {
+	return "azurerm_web_app_active_slot"
+}
+
+func (r WebAppActiveSlotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var activeSlot WebAppActiveSlotModel
+
+			if err := metadata.Decode(&activeSlot); err != nil {
+				return err
+			}
+
+			client := metadata.Client.AppService.WebAppsClient
+
+			slotId, err := parse.WebAppSlotID(activeSlot.SlotID)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWebAppID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName)
+
+			if err := swapWebAppSlotWithProduction(ctx, client, *slotId, activeSlot.OverwriteNetwork); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			app, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(app.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading active slot for %s: %+v", id, err)
+			}
+
+			if app.SiteProperties == nil {
+				return fmt.Errorf("reading Slot Swap Status for %s: properties was nil", id)
+			}
+
+			state := WebAppActiveSlotModel{
+				// The existing value is retained as this isn't returned by the API
+				OverwriteNetwork: metadata.ResourceData.Get("overwrite_network_config").(bool),
+			}
+
+			// the Slot Swap Status is nil when the Web App has never been swapped, meaning the production slot is active
+			swapStatus := web.SlotSwapStatus{}
+			if app.SiteProperties.SlotSwapStatus != nil {
+				swapStatus = *app.SiteProperties.SlotSwapStatus
+			}
+
+			if swapStatus.SourceSlotName != nil {
+				state.SlotID = parse.NewWebAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, *swapStatus.SourceSlotName).ID()
+			}
+
+			if swapStatus.TimestampUtc != nil {
+				state.LastSuccessfulSwap = swapStatus.TimestampUtc.String()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var activeSlot WebAppActiveSlotModel
+			if err := metadata.Decode(&activeSlot); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("slot_id") {
+				slotId, err := parse.WebAppSlotID(activeSlot.SlotID)
+				if err != nil {
+					return err
+				}
+
+				if err := swapWebAppSlotWithProduction(ctx, client, *slotId, activeSlot.OverwriteNetwork); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// A slot swap can't be undone, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func (r WebAppActiveSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	// This is a meta resource with a 1:1 relationship with the Web App it's pointed at so we use the same ID
+	return validate.WebAppID
+}
+
+func swapWebAppSlotWithProduction(ctx context.Context, client *web.AppsClient, slotId parse.WebAppSlotId, overwriteNetwork bool) error {
+	if _, err := client.GetSlot(ctx, slotId.ResourceGroup, slotId.SiteName, slotId.SlotName); err != nil {
+		return fmt.Errorf("reading %s: %+v", slotId, err)
+	}
+
+	swapEntity := web.CsmSlotEntity{
+		TargetSlot:   utils.String(slotId.SlotName),
+		PreserveVnet: utils.Bool(!overwriteNetwork),
+	}
+
+	future, err := client.SwapSlotWithProduction(ctx, slotId.ResourceGroup, slotId.SiteName, swapEntity)
+	if err != nil {
+		return fmt.Errorf("swapping %s with production: %+v", slotId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to swap with production: %+v", slotId, err)
+	}
+
+	return nil
+}
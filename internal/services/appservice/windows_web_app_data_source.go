@@ -37,6 +37,7 @@ type WindowsWebAppDataSourceModel struct {
 	SiteConfig                    []helpers.SiteConfigWindows `tfschema:"site_config"`
 	StorageAccounts               []helpers.StorageAccount    `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString  `tfschema:"connection_string"`
+	StickySettings                []helpers.StickySettings    `tfschema:"sticky_settings"`
 	CustomDomainVerificationId    string                      `tfschema:"custom_domain_verification_id"`
 	DefaultHostname               string                      `tfschema:"default_hostname"`
 	Kind                          string                      `tfschema:"kind"`
@@ -168,6 +169,8 @@ func (d WindowsWebAppDataSource) Attributes() map[string]*pluginsdk.Schema {
 
 		"site_config": helpers.SiteConfigSchemaWindowsComputed(),
 
+		"sticky_settings": helpers.StickySettingsSchemaComputed(),
+
 		"storage_account": helpers.StorageAccountSchemaComputed(),
 
 		"tags": tags.SchemaDataSource(),
@@ -233,6 +236,11 @@ func (d WindowsWebAppDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Windows %s: %+v", id, err)
 			}
 
+			stickySettings, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Sticky Settings for Windows %s: %+v", id, err)
+			}
+
 			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("listing Site Publishing Credential information for Windows %s: %+v", id, err)
@@ -299,6 +307,8 @@ func (d WindowsWebAppDataSource) Read() sdk.ResourceFunc {
 
 			webApp.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
+			webApp.StickySettings = helpers.FlattenStickySettings(stickySettings.SlotConfigNames)
+
 			webApp.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
 			metadata.SetID(id)
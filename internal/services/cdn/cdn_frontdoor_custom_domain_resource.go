@@ -0,0 +1,327 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	dnsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	dnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorCustomDomainCreate,
+		Read:   resourceCdnFrontDoorCustomDomainRead,
+		Update: resourceCdnFrontDoorCustomDomainUpdate,
+		Delete: resourceCdnFrontDoorCustomDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(12 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(12 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorCustomDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: dnsValidate.DnsZoneID,
+			},
+
+			"tls": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"certificate_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdCertificateTypeManagedCertificate),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdCertificateTypeCustomerCertificate),
+								string(cdn.AfdCertificateTypeManagedCertificate),
+							}, false),
+						},
+
+						"minimum_tls_version": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdMinimumTLSVersionTLS12),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdMinimumTLSVersionTLS10),
+								string(cdn.AfdMinimumTLSVersionTLS12),
+							}, false),
+						},
+
+						"cdn_frontdoor_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.FrontDoorSecretID,
+						},
+					},
+				},
+			},
+
+			"expiration_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"validation_token": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorCustomDomainID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_custom_domain", id.ID())
+	}
+
+	tlsSettings, err := expandFrontDoorCustomDomainTLSSettings(d.Get("tls").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	props := cdn.AFDDomain{
+		AFDDomainProperties: &cdn.AFDDomainProperties{
+			AzureDNSZone: expandFrontDoorResourceReference(d.Get("dns_zone_id").(string)),
+			HostName:     utils.String(d.Get("host_name").(string)),
+			TLSSettings:  tlsSettings,
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.AFDDomainProperties; props != nil {
+		d.Set("host_name", props.HostName)
+
+		dnsZoneId := ""
+		if v := flattenFrontDoorResourceReference(props.AzureDNSZone); v != "" {
+			parsed, err := dnsParse.DnsZoneID(v)
+			if err != nil {
+				return err
+			}
+			dnsZoneId = parsed.ID()
+		}
+		d.Set("dns_zone_id", dnsZoneId)
+
+		tls, err := flattenFrontDoorCustomDomainTLSSettings(props.TLSSettings)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("tls", tls); err != nil {
+			return fmt.Errorf("setting `tls`: %+v", err)
+		}
+
+		expirationDate := ""
+		validationToken := ""
+		if validationProps := props.ValidationProperties; validationProps != nil {
+			if validationProps.ExpirationDate != nil {
+				expirationDate = *validationProps.ExpirationDate
+			}
+			if validationProps.ValidationToken != nil {
+				validationToken = *validationProps.ValidationToken
+			}
+		}
+		d.Set("expiration_date", expirationDate)
+		d.Set("validation_token", validationToken)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorCustomDomainUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tlsSettings, err := expandFrontDoorCustomDomainTLSSettings(d.Get("tls").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	props := cdn.AFDDomainUpdateParameters{
+		AFDDomainUpdatePropertiesParameters: &cdn.AFDDomainUpdatePropertiesParameters{
+			AzureDNSZone: expandFrontDoorResourceReference(d.Get("dns_zone_id").(string)),
+			TLSSettings:  tlsSettings,
+		},
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorCustomDomainTLSSettings(input []interface{}) (*cdn.AFDDomainHTTPSParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	certificateType := cdn.AfdCertificateType(v["certificate_type"].(string))
+	secretId := v["cdn_frontdoor_secret_id"].(string)
+
+	if certificateType == cdn.AfdCertificateTypeCustomerCertificate && secretId == "" {
+		return nil, fmt.Errorf("`cdn_frontdoor_secret_id` must be specified when `certificate_type` is `%s`", cdn.AfdCertificateTypeCustomerCertificate)
+	}
+
+	if certificateType == cdn.AfdCertificateTypeManagedCertificate && secretId != "" {
+		return nil, fmt.Errorf("`cdn_frontdoor_secret_id` cannot be specified when `certificate_type` is `%s`", cdn.AfdCertificateTypeManagedCertificate)
+	}
+
+	return &cdn.AFDDomainHTTPSParameters{
+		CertificateType:   certificateType,
+		MinimumTLSVersion: cdn.AfdMinimumTLSVersion(v["minimum_tls_version"].(string)),
+		Secret:            expandFrontDoorResourceReference(secretId),
+	}, nil
+}
+
+func flattenFrontDoorCustomDomainTLSSettings(input *cdn.AFDDomainHTTPSParameters) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	secretId := ""
+	if v := flattenFrontDoorResourceReference(input.Secret); v != "" {
+		parsed, err := parse.FrontDoorSecretID(v)
+		if err != nil {
+			return nil, err
+		}
+		secretId = parsed.ID()
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_type":        string(input.CertificateType),
+			"minimum_tls_version":     string(input.MinimumTLSVersion),
+			"cdn_frontdoor_secret_id": secretId,
+		},
+	}, nil
+}
@@ -0,0 +1,265 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorOrigin() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorOriginCreate,
+		Read:   resourceCdnFrontDoorOriginRead,
+		Update: resourceCdnFrontDoorOriginUpdate,
+		Delete: resourceCdnFrontDoorOriginDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorOriginID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"http_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      80,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"https_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      443,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"origin_host_header": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+
+			"weight": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      500,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorOriginCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	originGroupId, err := parse.FrontDoorOriginGroupID(d.Get("cdn_frontdoor_origin_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorOriginID(originGroupId.SubscriptionId, originGroupId.ResourceGroup, originGroupId.ProfileName, originGroupId.OriginGroupName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_origin", id.ID())
+	}
+
+	props := cdn.AFDOrigin{
+		AFDOriginProperties: &cdn.AFDOriginProperties{
+			EnabledState: expandFrontDoorEnabledState(d.Get("enabled").(bool)),
+			HostName:     utils.String(d.Get("host_name").(string)),
+			HTTPPort:     utils.Int32(int32(d.Get("http_port").(int))),
+			HTTPSPort:    utils.Int32(int32(d.Get("https_port").(int))),
+			Priority:     utils.Int32(int32(d.Get("priority").(int))),
+			Weight:       utils.Int32(int32(d.Get("weight").(int))),
+		},
+	}
+
+	if v := d.Get("origin_host_header").(string); v != "" {
+		props.AFDOriginProperties.OriginHostHeader = utils.String(v)
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorOriginRead(d, meta)
+}
+
+func resourceCdnFrontDoorOriginRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorOriginID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.OriginName)
+	d.Set("cdn_frontdoor_origin_group_id", parse.NewFrontDoorOriginGroupID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.OriginGroupName).ID())
+
+	if props := resp.AFDOriginProperties; props != nil {
+		d.Set("enabled", flattenFrontDoorEnabledState(props.EnabledState))
+		d.Set("host_name", props.HostName)
+		d.Set("origin_host_header", props.OriginHostHeader)
+
+		httpPort := 0
+		if props.HTTPPort != nil {
+			httpPort = int(*props.HTTPPort)
+		}
+		d.Set("http_port", httpPort)
+
+		httpsPort := 0
+		if props.HTTPSPort != nil {
+			httpsPort = int(*props.HTTPSPort)
+		}
+		d.Set("https_port", httpsPort)
+
+		priority := 0
+		if props.Priority != nil {
+			priority = int(*props.Priority)
+		}
+		d.Set("priority", priority)
+
+		weight := 0
+		if props.Weight != nil {
+			weight = int(*props.Weight)
+		}
+		d.Set("weight", weight)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorOriginUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorOriginID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := cdn.AFDOriginUpdateParameters{
+		AFDOriginUpdatePropertiesParameters: &cdn.AFDOriginUpdatePropertiesParameters{
+			EnabledState: expandFrontDoorEnabledState(d.Get("enabled").(bool)),
+			HostName:     utils.String(d.Get("host_name").(string)),
+			HTTPPort:     utils.Int32(int32(d.Get("http_port").(int))),
+			HTTPSPort:    utils.Int32(int32(d.Get("https_port").(int))),
+			Priority:     utils.Int32(int32(d.Get("priority").(int))),
+			Weight:       utils.Int32(int32(d.Get("weight").(int))),
+		},
+	}
+
+	if v := d.Get("origin_host_header").(string); v != "" {
+		props.AFDOriginUpdatePropertiesParameters.OriginHostHeader = utils.String(v)
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorOriginRead(d, meta)
+}
+
+func resourceCdnFrontDoorOriginDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorOriginsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorOriginID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,486 @@
+package cdn
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorRoute() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRouteCreate,
+		Read:   resourceCdnFrontDoorRouteRead,
+		Update: resourceCdnFrontDoorRouteUpdate,
+		Delete: resourceCdnFrontDoorRouteDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRouteID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorEndpointID,
+			},
+
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+
+			"patterns_to_match": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 25,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"supported_protocols": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MaxItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(cdn.AFDEndpointProtocolsHTTP),
+						string(cdn.AFDEndpointProtocolsHTTPS),
+					}, false),
+				},
+			},
+
+			"cache": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query_string_caching_behavior": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+								string(cdn.AfdQueryStringCachingBehaviorUseQueryString),
+							}, false),
+						},
+
+						"compression_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"content_types_to_compress": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"cdn_frontdoor_custom_domain_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorCustomDomainID,
+				},
+			},
+
+			"cdn_frontdoor_origin_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cdn_frontdoor_rule_set_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorRuleSetID,
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"forwarding_protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.ForwardingProtocolMatchRequest),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.ForwardingProtocolHTTPOnly),
+					string(cdn.ForwardingProtocolHTTPSOnly),
+					string(cdn.ForwardingProtocolMatchRequest),
+				}, false),
+			},
+
+			"https_redirect_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"link_to_default_domain": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := parse.FrontDoorEndpointID(d.Get("cdn_frontdoor_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRouteID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_route", id.ID())
+	}
+
+	if err := validateFrontDoorRouteProtocols(d); err != nil {
+		return err
+	}
+
+	queryStringCachingBehavior, compressionSettings := expandFrontDoorRouteCache(d.Get("cache").([]interface{}))
+
+	props := cdn.Route{
+		RouteProperties: &cdn.RouteProperties{
+			CompressionSettings:        compressionSettings,
+			CustomDomains:              expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{})),
+			EnabledState:               expandFrontDoorEnabledState(d.Get("enabled").(bool)),
+			ForwardingProtocol:         cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string)),
+			HTTPSRedirect:              expandFrontDoorRouteHttpsRedirect(d.Get("https_redirect_enabled").(bool)),
+			LinkToDefaultDomain:        expandFrontDoorRouteLinkToDefaultDomain(d.Get("link_to_default_domain").(bool)),
+			OriginGroup:                expandFrontDoorResourceReference(d.Get("cdn_frontdoor_origin_group_id").(string)),
+			PatternsToMatch:            utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{})),
+			QueryStringCachingBehavior: queryStringCachingBehavior,
+			RuleSets:                   expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_rule_set_ids").(*pluginsdk.Set).List()),
+			SupportedProtocols:         expandFrontDoorRouteSupportedProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if v := d.Get("cdn_frontdoor_origin_path").(string); v != "" {
+		props.RouteProperties.OriginPath = utils.String(v)
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RouteName)
+	d.Set("cdn_frontdoor_endpoint_id", parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName).ID())
+
+	if props := resp.RouteProperties; props != nil {
+		originGroupId := ""
+		if v := flattenFrontDoorResourceReference(props.OriginGroup); v != "" {
+			parsed, err := parse.FrontDoorOriginGroupID(v)
+			if err != nil {
+				return err
+			}
+			originGroupId = parsed.ID()
+		}
+		d.Set("cdn_frontdoor_origin_group_id", originGroupId)
+
+		customDomainIds := make([]interface{}, 0)
+		for _, v := range flattenFrontDoorResourceReferences(props.CustomDomains) {
+			parsed, err := parse.FrontDoorCustomDomainID(v.(string))
+			if err != nil {
+				return err
+			}
+			customDomainIds = append(customDomainIds, parsed.ID())
+		}
+		if err := d.Set("cdn_frontdoor_custom_domain_ids", customDomainIds); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_custom_domain_ids`: %+v", err)
+		}
+
+		ruleSetIds := make([]interface{}, 0)
+		for _, v := range flattenFrontDoorResourceReferences(props.RuleSets) {
+			parsed, err := parse.FrontDoorRuleSetID(v.(string))
+			if err != nil {
+				return err
+			}
+			ruleSetIds = append(ruleSetIds, parsed.ID())
+		}
+		if err := d.Set("cdn_frontdoor_rule_set_ids", ruleSetIds); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_rule_set_ids`: %+v", err)
+		}
+
+		cache, err := flattenFrontDoorRouteCache(props.QueryStringCachingBehavior, props.CompressionSettings)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("cache", cache); err != nil {
+			return fmt.Errorf("setting `cache`: %+v", err)
+		}
+
+		d.Set("cdn_frontdoor_origin_path", props.OriginPath)
+		d.Set("enabled", flattenFrontDoorEnabledState(props.EnabledState))
+		d.Set("forwarding_protocol", string(props.ForwardingProtocol))
+		d.Set("https_redirect_enabled", props.HTTPSRedirect == cdn.HTTPSRedirectEnabled)
+		d.Set("link_to_default_domain", props.LinkToDefaultDomain == cdn.LinkToDefaultDomainEnabled)
+
+		if err := d.Set("patterns_to_match", utils.FlattenStringSlice(props.PatternsToMatch)); err != nil {
+			return fmt.Errorf("setting `patterns_to_match`: %+v", err)
+		}
+
+		if err := d.Set("supported_protocols", flattenFrontDoorRouteSupportedProtocols(props.SupportedProtocols)); err != nil {
+			return fmt.Errorf("setting `supported_protocols`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRouteUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := validateFrontDoorRouteProtocols(d); err != nil {
+		return err
+	}
+
+	queryStringCachingBehavior, compressionSettings := expandFrontDoorRouteCache(d.Get("cache").([]interface{}))
+
+	props := cdn.RouteUpdateParameters{
+		RouteUpdatePropertiesParameters: &cdn.RouteUpdatePropertiesParameters{
+			CompressionSettings:        compressionSettings,
+			CustomDomains:              expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{})),
+			EnabledState:               expandFrontDoorEnabledState(d.Get("enabled").(bool)),
+			ForwardingProtocol:         cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string)),
+			HTTPSRedirect:              expandFrontDoorRouteHttpsRedirect(d.Get("https_redirect_enabled").(bool)),
+			LinkToDefaultDomain:        expandFrontDoorRouteLinkToDefaultDomain(d.Get("link_to_default_domain").(bool)),
+			OriginGroup:                expandFrontDoorResourceReference(d.Get("cdn_frontdoor_origin_group_id").(string)),
+			PatternsToMatch:            utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{})),
+			QueryStringCachingBehavior: queryStringCachingBehavior,
+			RuleSets:                   expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_rule_set_ids").(*pluginsdk.Set).List()),
+			SupportedProtocols:         expandFrontDoorRouteSupportedProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if v := d.Get("cdn_frontdoor_origin_path").(string); v != "" {
+		props.RouteUpdatePropertiesParameters.OriginPath = utils.String(v)
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func validateFrontDoorRouteProtocols(d *pluginsdk.ResourceData) error {
+	if !d.Get("https_redirect_enabled").(bool) {
+		return nil
+	}
+
+	// redirecting HTTP traffic to HTTPS requires the route to accept both protocols
+	protocols := d.Get("supported_protocols").(*pluginsdk.Set)
+	if !protocols.Contains(string(cdn.AFDEndpointProtocolsHTTP)) || !protocols.Contains(string(cdn.AFDEndpointProtocolsHTTPS)) {
+		return fmt.Errorf("`supported_protocols` must contain both `Http` and `Https` when `https_redirect_enabled` is `true`")
+	}
+
+	return nil
+}
+
+func expandFrontDoorRouteHttpsRedirect(input bool) cdn.HTTPSRedirect {
+	if input {
+		return cdn.HTTPSRedirectEnabled
+	}
+
+	return cdn.HTTPSRedirectDisabled
+}
+
+func expandFrontDoorRouteLinkToDefaultDomain(input bool) cdn.LinkToDefaultDomain {
+	if input {
+		return cdn.LinkToDefaultDomainEnabled
+	}
+
+	return cdn.LinkToDefaultDomainDisabled
+}
+
+func expandFrontDoorRouteSupportedProtocols(input []interface{}) *[]cdn.AFDEndpointProtocols {
+	results := make([]cdn.AFDEndpointProtocols, 0)
+
+	for _, item := range input {
+		results = append(results, cdn.AFDEndpointProtocols(item.(string)))
+	}
+
+	return &results
+}
+
+func flattenFrontDoorRouteSupportedProtocols(input *[]cdn.AFDEndpointProtocols) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, string(item))
+	}
+
+	return results
+}
+
+func expandFrontDoorRouteCache(input []interface{}) (cdn.AfdQueryStringCachingBehavior, interface{}) {
+	if len(input) == 0 || input[0] == nil {
+		return cdn.AfdQueryStringCachingBehaviorNotSet, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	compressionSettings := cdn.CompressionSettings{
+		ContentTypesToCompress: utils.ExpandStringSlice(v["content_types_to_compress"].([]interface{})),
+		IsCompressionEnabled:   utils.Bool(v["compression_enabled"].(bool)),
+	}
+
+	return cdn.AfdQueryStringCachingBehavior(v["query_string_caching_behavior"].(string)), compressionSettings
+}
+
+func flattenFrontDoorRouteCache(queryStringCachingBehavior cdn.AfdQueryStringCachingBehavior, input interface{}) ([]interface{}, error) {
+	if queryStringCachingBehavior == "" || queryStringCachingBehavior == cdn.AfdQueryStringCachingBehaviorNotSet {
+		return []interface{}{}, nil
+	}
+
+	// the compression settings are returned as an untyped object, so round-trip them through JSON
+	compressionSettings := cdn.CompressionSettings{}
+	if input != nil {
+		raw, err := json.Marshal(input)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling `compressionSettings`: %+v", err)
+		}
+
+		if err := json.Unmarshal(raw, &compressionSettings); err != nil {
+			return nil, fmt.Errorf("unmarshaling `compressionSettings`: %+v", err)
+		}
+	}
+
+	compressionEnabled := false
+	if compressionSettings.IsCompressionEnabled != nil {
+		compressionEnabled = *compressionSettings.IsCompressionEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"compression_enabled":           compressionEnabled,
+			"content_types_to_compress":     utils.FlattenStringSlice(compressionSettings.ContentTypesToCompress),
+			"query_string_caching_behavior": string(queryStringCachingBehavior),
+		},
+	}, nil
+}
@@ -0,0 +1,259 @@
+package cdn
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorSecret() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorSecretCreate,
+		Read:   resourceCdnFrontDoorSecretRead,
+		Delete: resourceCdnFrontDoorSecretDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorSecretID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"secret": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"customer_certificate": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"key_vault_certificate_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+									},
+
+									"subject_alternative_names": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorSecretCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorSecretID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_secret", id.ID())
+	}
+
+	secrets := d.Get("secret").([]interface{})
+	customerCertificates := secrets[0].(map[string]interface{})["customer_certificate"].([]interface{})
+	certificateId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(customerCertificates[0].(map[string]interface{})["key_vault_certificate_id"].(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, meta.(*clients.Client).Resource, certificateId.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID for the Key Vault at URL %q: %+v", certificateId.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		return fmt.Errorf("unable to find the Resource ID for the Key Vault at URL %q", certificateId.KeyVaultBaseUrl)
+	}
+
+	keyVaultId, err := keyVaultParse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	// the Front Door API references the certificate through the ARM ID of the Key Vault Secret backing it
+	params := cdn.CustomerCertificateParameters{
+		SecretSource: &cdn.ResourceReference{
+			ID: utils.String(fmt.Sprintf("%s/secrets/%s", keyVaultId.ID(), certificateId.Name)),
+		},
+		UseLatestVersion: utils.Bool(certificateId.Version == ""),
+		Type:             cdn.TypeBasicSecretParametersTypeCustomerCertificate,
+	}
+	if certificateId.Version != "" {
+		params.SecretVersion = utils.String(certificateId.Version)
+	}
+
+	props := cdn.Secret{
+		SecretProperties: &cdn.SecretProperties{
+			Parameters: params,
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecretName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorSecretRead(d, meta)
+}
+
+func resourceCdnFrontDoorSecretRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SecretName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	secret := make([]interface{}, 0)
+	if props := resp.SecretProperties; props != nil && props.Parameters != nil {
+		if params, ok := props.Parameters.AsCustomerCertificateParameters(); ok && params != nil {
+			certificateId := ""
+			if v := flattenFrontDoorResourceReference(params.SecretSource); v != "" {
+				parts := strings.Split(v, "/secrets/")
+				if len(parts) != 2 {
+					return fmt.Errorf("parsing the Secret Source %q for %s: expected a Key Vault ID followed by `/secrets/{name}`", v, *id)
+				}
+
+				keyVaultId, err := keyVaultParse.VaultID(parts[0])
+				if err != nil {
+					return err
+				}
+
+				keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+				if err != nil {
+					return fmt.Errorf("retrieving the Base URI for %s: %+v", *keyVaultId, err)
+				}
+
+				version := ""
+				if params.UseLatestVersion == nil || !*params.UseLatestVersion {
+					version = utils.NormalizeNilableString(params.SecretVersion)
+				}
+
+				nestedItemId, err := keyVaultParse.NewNestedItemID(*keyVaultBaseUri, "certificates", parts[1], version)
+				if err != nil {
+					return err
+				}
+				certificateId = nestedItemId.ID()
+			}
+
+			secret = append(secret, map[string]interface{}{
+				"customer_certificate": []interface{}{
+					map[string]interface{}{
+						"key_vault_certificate_id":  certificateId,
+						"subject_alternative_names": utils.FlattenStringSlice(params.SubjectAlternativeNames),
+					},
+				},
+			})
+		}
+	}
+
+	if err := d.Set("secret", secret); err != nil {
+		return fmt.Errorf("setting `secret`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	frontDoorParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
+	frontDoorValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorSecurityPolicyCreate,
+		Read:   resourceCdnFrontDoorSecurityPolicyRead,
+		Delete: resourceCdnFrontDoorSecurityPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorSecurityPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"security_policies": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"firewall": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"cdn_frontdoor_firewall_policy_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: frontDoorValidate.WebApplicationFirewallPolicyID,
+									},
+
+									"association": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"domain": {
+													Type:     pluginsdk.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 500,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															// this can be either a Front Door Endpoint or a Front Door Custom Domain
+															"cdn_frontdoor_domain_id": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: azure.ValidateResourceID,
+															},
+														},
+													},
+												},
+
+												"patterns_to_match": {
+													Type:     pluginsdk.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 25,
+													Elem: &pluginsdk.Schema{
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validation.StringIsNotEmpty,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorSecurityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorSecurityPolicyID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_security_policy", id.ID())
+	}
+
+	props := cdn.SecurityPolicy{
+		SecurityPolicyProperties: &cdn.SecurityPolicyProperties{
+			Parameters: expandFrontDoorSecurityPolicyParameters(d.Get("security_policies").([]interface{})),
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorSecurityPolicyRead(d, meta)
+}
+
+func resourceCdnFrontDoorSecurityPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SecurityPolicyName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.SecurityPolicyProperties; props != nil && props.Parameters != nil {
+		securityPolicies, err := flattenFrontDoorSecurityPolicyParameters(props.Parameters)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("security_policies", securityPolicies); err != nil {
+			return fmt.Errorf("setting `security_policies`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorSecurityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorSecurityPolicyParameters(input []interface{}) cdn.BasicSecurityPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	firewalls := input[0].(map[string]interface{})["firewall"].([]interface{})
+	if len(firewalls) == 0 || firewalls[0] == nil {
+		return nil
+	}
+
+	firewall := firewalls[0].(map[string]interface{})
+
+	associations := make([]cdn.SecurityPolicyWebApplicationFirewallAssociation, 0)
+	for _, item := range firewall["association"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		domains := make([]cdn.ResourceReference, 0)
+		for _, domain := range v["domain"].([]interface{}) {
+			if domain == nil {
+				continue
+			}
+			domains = append(domains, cdn.ResourceReference{
+				ID: utils.String(domain.(map[string]interface{})["cdn_frontdoor_domain_id"].(string)),
+			})
+		}
+
+		associations = append(associations, cdn.SecurityPolicyWebApplicationFirewallAssociation{
+			Domains:         &domains,
+			PatternsToMatch: utils.ExpandStringSlice(v["patterns_to_match"].([]interface{})),
+		})
+	}
+
+	return cdn.SecurityPolicyWebApplicationFirewallParameters{
+		WafPolicy:    expandFrontDoorResourceReference(firewall["cdn_frontdoor_firewall_policy_id"].(string)),
+		Associations: &associations,
+		Type:         cdn.TypeWebApplicationFirewall,
+	}
+}
+
+func flattenFrontDoorSecurityPolicyParameters(input cdn.BasicSecurityPolicyParameters) ([]interface{}, error) {
+	params, ok := input.AsSecurityPolicyWebApplicationFirewallParameters()
+	if !ok || params == nil {
+		return []interface{}{}, nil
+	}
+
+	wafPolicyId := ""
+	if v := flattenFrontDoorResourceReference(params.WafPolicy); v != "" {
+		parsed, err := frontDoorParse.WebApplicationFirewallPolicyID(v)
+		if err != nil {
+			return nil, err
+		}
+		wafPolicyId = parsed.ID()
+	}
+
+	associations := make([]interface{}, 0)
+	if params.Associations != nil {
+		for _, item := range *params.Associations {
+			domains := make([]interface{}, 0)
+			for _, domainId := range flattenFrontDoorResourceReferences(item.Domains) {
+				domains = append(domains, map[string]interface{}{
+					"cdn_frontdoor_domain_id": domainId,
+				})
+			}
+
+			associations = append(associations, map[string]interface{}{
+				"domain":            domains,
+				"patterns_to_match": utils.FlattenStringSlice(item.PatternsToMatch),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"firewall": []interface{}{
+				map[string]interface{}{
+					"association":                      associations,
+					"cdn_frontdoor_firewall_policy_id": wafPolicyId,
+				},
+			},
+		},
+	}, nil
+}
@@ -9,6 +9,16 @@ type Client struct {
 	CustomDomainsClient *cdn.CustomDomainsClient
 	EndpointsClient     *cdn.EndpointsClient
 	ProfilesClient      *cdn.ProfilesClient
+
+	FrontDoorCustomDomainsClient    *cdn.AFDCustomDomainsClient
+	FrontDoorEndpointsClient        *cdn.AFDEndpointsClient
+	FrontDoorOriginGroupsClient     *cdn.AFDOriginGroupsClient
+	FrontDoorOriginsClient          *cdn.AFDOriginsClient
+	FrontDoorProfilesClient         *cdn.ProfilesClient
+	FrontDoorRoutesClient           *cdn.RoutesClient
+	FrontDoorRuleSetsClient         *cdn.RuleSetsClient
+	FrontDoorSecretsClient          *cdn.SecretsClient
+	FrontDoorSecurityPoliciesClient *cdn.SecurityPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +31,46 @@ func NewClient(o *common.ClientOptions) *Client {
 	profilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorCustomDomainsClient := cdn.NewAFDCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorCustomDomainsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorEndpointsClient := cdn.NewAFDEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginGroupsClient := cdn.NewAFDOriginGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginsClient := cdn.NewAFDOriginsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorProfilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRoutesClient := cdn.NewRoutesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRoutesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRuleSetsClient := cdn.NewRuleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRuleSetsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorSecretsClient := cdn.NewSecretsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorSecretsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorSecurityPoliciesClient := cdn.NewSecurityPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorSecurityPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		CustomDomainsClient: &customDomainsClient,
 		EndpointsClient:     &endpointsClient,
 		ProfilesClient:      &profilesClient,
+
+		FrontDoorCustomDomainsClient:    &frontDoorCustomDomainsClient,
+		FrontDoorEndpointsClient:        &frontDoorEndpointsClient,
+		FrontDoorOriginGroupsClient:     &frontDoorOriginGroupsClient,
+		FrontDoorOriginsClient:          &frontDoorOriginsClient,
+		FrontDoorProfilesClient:         &frontDoorProfilesClient,
+		FrontDoorRoutesClient:           &frontDoorRoutesClient,
+		FrontDoorRuleSetsClient:         &frontDoorRuleSetsClient,
+		FrontDoorSecretsClient:          &frontDoorSecretsClient,
+		FrontDoorSecurityPoliciesClient: &frontDoorSecurityPoliciesClient,
 	}
 }
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// FrontDoorName validates the name of the CDN FrontDoor Profile and its child resources which
+// may only contain letters, numbers and hyphens and must begin and end with a letter or number
+func FrontDoorName(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if m, _ := regexp.MatchString(`^[\da-zA-Z]([-\da-zA-Z]{0,88}[\da-zA-Z])?$`, v); !m {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 90 characters in length, begin and end with a letter or number and may only contain letters, numbers and hyphens, got %q", k, v))
+	}
+
+	return nil, errors
+}
+
+// FrontDoorRuleSetName validates the name of the CDN FrontDoor Rule Set which must begin with
+// a letter and may only contain letters and numbers
+func FrontDoorRuleSetName(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if m, _ := regexp.MatchString(`^[a-zA-Z][\da-zA-Z]{0,59}$`, v); !m {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 60 characters in length, begin with a letter and may only contain letters and numbers, got %q", k, v))
+	}
+
+	return nil, errors
+}
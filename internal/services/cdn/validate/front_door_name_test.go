@@ -0,0 +1,102 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFrontDoorName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "1",
+			Valid: true,
+		},
+		{
+			Input: "front-door-1",
+			Valid: true,
+		},
+		{
+			Input: "-front-door",
+			Valid: false,
+		},
+		{
+			Input: "front-door-",
+			Valid: false,
+		},
+		{
+			Input: "front_door",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 90),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 91),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := FrontDoorName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
+
+func TestFrontDoorRuleSetName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "ruleSet1",
+			Valid: true,
+		},
+		{
+			Input: "1ruleSet",
+			Valid: false,
+		},
+		{
+			Input: "rule-set",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 60),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 61),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := FrontDoorRuleSetName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
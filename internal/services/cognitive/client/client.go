@@ -3,17 +3,23 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2021-04-30/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2023-05-01/deployments"
 )
 
 type Client struct {
-	AccountsClient *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	AccountsClient    *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	DeploymentsClient *deployments.DeploymentsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := cognitiveservicesaccounts.NewCognitiveServicesAccountsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	deploymentsClient := deployments.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AccountsClient: &accountsClient,
+		AccountsClient:    &accountsClient,
+		DeploymentsClient: &deploymentsClient,
 	}
 }
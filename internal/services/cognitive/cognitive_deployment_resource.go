@@ -0,0 +1,312 @@
+package cognitive
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2021-04-30/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2023-05-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCognitiveDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCognitiveDeploymentCreate,
+		Read:   resourceCognitiveDeploymentRead,
+		Update: resourceCognitiveDeploymentUpdate,
+		Delete: resourceCognitiveDeploymentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := deployments.ParseDeploymentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cognitive_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"model": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"format": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"OpenAI",
+							}, false),
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"scale": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Standard",
+								"GlobalStandard",
+								"ProvisionedManaged",
+							}, false),
+						},
+
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"rai_policy_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceCognitiveDeploymentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := cognitiveservicesaccounts.ParseAccountID(d.Get("cognitive_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := deployments.NewDeploymentID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+
+	// the API only allows a single operation on the Deployments within an Account at a time
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cognitive_deployment", id.ID())
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, expandCognitiveDeployment(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCognitiveDeploymentRead(d, meta)
+}
+
+func resourceCognitiveDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deployments.ParseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DeploymentName)
+	d.Set("cognitive_account_id", cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if err := d.Set("scale", flattenCognitiveDeploymentScale(model.Sku)); err != nil {
+			return fmt.Errorf("setting `scale`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("model", flattenCognitiveDeploymentModel(props.Model)); err != nil {
+				return fmt.Errorf("setting `model`: %+v", err)
+			}
+
+			d.Set("rai_policy_name", props.RaiPolicyName)
+		}
+	}
+
+	return nil
+}
+
+func resourceCognitiveDeploymentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deployments.ParseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, expandCognitiveDeployment(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCognitiveDeploymentRead(d, meta)
+}
+
+func resourceCognitiveDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deployments.ParseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCognitiveDeployment(d *pluginsdk.ResourceData) deployments.Deployment {
+	parameters := deployments.Deployment{
+		Properties: &deployments.DeploymentProperties{},
+	}
+
+	if v := d.Get("model").([]interface{}); len(v) > 0 && v[0] != nil {
+		model := v[0].(map[string]interface{})
+		parameters.Properties.Model = &deployments.DeploymentModel{
+			Format:  utils.String(model["format"].(string)),
+			Name:    utils.String(model["name"].(string)),
+			Version: utils.String(model["version"].(string)),
+		}
+	}
+
+	if v := d.Get("scale").([]interface{}); len(v) > 0 && v[0] != nil {
+		scale := v[0].(map[string]interface{})
+		parameters.Sku = &deployments.Sku{
+			Name:     scale["type"].(string),
+			Capacity: utils.Int64(int64(scale["capacity"].(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("rai_policy_name"); ok {
+		parameters.Properties.RaiPolicyName = utils.String(v.(string))
+	}
+
+	return parameters
+}
+
+func flattenCognitiveDeploymentModel(input *deployments.DeploymentModel) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	format := ""
+	if input.Format != nil {
+		format = *input.Format
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"format":  format,
+			"name":    name,
+			"version": version,
+		},
+	}
+}
+
+func flattenCognitiveDeploymentScale(input *deployments.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":     input.Name,
+			"capacity": capacity,
+		},
+	}
+}
@@ -30,5 +30,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_cognitive_account":                      resourceCognitiveAccount(),
 		"azurerm_cognitive_account_customer_managed_key": resourceCognitiveAccountCustomerManagedKey(),
+		"azurerm_cognitive_deployment":                   resourceCognitiveDeployment(),
 	}
 }
@@ -0,0 +1,7 @@
+package deployments
+
+type DeploymentModel struct {
+	Format  *string `json:"format,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
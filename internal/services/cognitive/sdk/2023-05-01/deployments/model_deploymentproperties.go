@@ -0,0 +1,9 @@
+package deployments
+
+type DeploymentProperties struct {
+	Model                *DeploymentModel                     `json:"model,omitempty"`
+	ProvisioningState    *DeploymentProvisioningState         `json:"provisioningState,omitempty"`
+	RaiPolicyName        *string                              `json:"raiPolicyName,omitempty"`
+	ScaleSettings        *DeploymentScaleSettings             `json:"scaleSettings,omitempty"`
+	VersionUpgradeOption *DeploymentModelVersionUpgradeOption `json:"versionUpgradeOption,omitempty"`
+}
@@ -0,0 +1,7 @@
+package deployments
+
+type DeploymentScaleSettings struct {
+	ActiveCapacity *int64               `json:"activeCapacity,omitempty"`
+	Capacity       *int64               `json:"capacity,omitempty"`
+	ScaleType      *DeploymentScaleType `json:"scaleType,omitempty"`
+}
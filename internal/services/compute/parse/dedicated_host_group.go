@@ -67,3 +67,47 @@ func DedicatedHostGroupID(input string) (*DedicatedHostGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// DedicatedHostGroupIDInsensitively parses an DedicatedHostGroup ID into an DedicatedHostGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DedicatedHostGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DedicatedHostGroupIDInsensitively(input string) (*DedicatedHostGroupId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DedicatedHostGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'hostGroups' segment
+	hostGroupsKey := "hostGroups"
+	for key := range id.Path {
+		if strings.EqualFold(key, hostGroupsKey) {
+			hostGroupsKey = key
+			break
+		}
+	}
+	if resourceId.HostGroupName, err = id.PopSegment(hostGroupsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
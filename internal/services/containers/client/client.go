@@ -7,19 +7,59 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/containergroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironmentsstorages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/cacherules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/credentialsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-11-01-preview/connectedregistries"
 )
 
 type Client struct {
-	AgentPoolsClient                *containerservice.AgentPoolsClient
-	GroupsClient                    *containerinstance.ContainerGroupsClient
+	AgentPoolsClient                           *containerservice.AgentPoolsClient
+	ContainerAppsClient                        *containerapps.ContainerAppsClient
+	ContainerRegistryAgentPoolsClient          *containerregistry.AgentPoolsClient
+	ContainerRegistryCacheRulesClient          *cacherules.CacheRulesClient
+	ContainerRegistryConnectedRegistriesClient *connectedregistries.ConnectedRegistriesClient
+	ContainerRegistryCredentialSetsClient      *credentialsets.CredentialSetsClient
+	ContainerRegistryTasksClient               *containerregistry.TasksClient
+	DaprComponentsClient                       *daprcomponents.DaprComponentsClient
+	ExtensionsClient                           *extensions.ExtensionsClient
+	FleetMembersClient                         *fleetmembers.FleetMembersClient
+	FleetsClient                               *fleets.FleetsClient
+	FleetUpdateRunsClient                      *updateruns.UpdateRunsClient
+	FleetUpdateStrategiesClient                *fleetupdatestrategies.FleetUpdateStrategiesClient
+	FluxConfigurationClient                    *fluxconfiguration.FluxConfigurationClient
+	GroupsClient                               *containerinstance.ContainerGroupsClient
+	// GroupsV2Client uses a newer API Version which supports spot priority, confidential SKUs and subnet IDs
+	GroupsV2Client                  *containergroups.ContainerGroupsClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
-	RegistriesClient                *containerregistry.RegistriesClient
-	ReplicationsClient              *containerregistry.ReplicationsClient
-	ServicesClient                  *legacy.ContainerServicesClient
-	WebhooksClient                  *containerregistry.WebhooksClient
-	TokensClient                    *containerregistry.TokensClient
-	ScopeMapsClient                 *containerregistry.ScopeMapsClient
+	// MaintenanceConfigurationsV2Client uses a newer API Version which supports the auto-upgrade and node OS schedules
+	MaintenanceConfigurationsV2Client    *maintenanceconfigurations.MaintenanceConfigurationsClient
+	ManagedEnvironmentCertificatesClient *certificates.CertificatesClient
+	ManagedEnvironmentsClient            *managedenvironments.ManagedEnvironmentsClient
+	ManagedEnvironmentStoragesClient     *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
+	RegistriesClient                     *containerregistry.RegistriesClient
+	// RegistriesV2Client uses a newer API Version which supports the export policy
+	RegistriesV2Client *registries.RegistriesClient
+	ReplicationsClient *containerregistry.ReplicationsClient
+	ServicesClient     *legacy.ContainerServicesClient
+	SnapshotsClient    *containerservice.SnapshotsClient
+	WebhooksClient     *containerregistry.WebhooksClient
+	TokensClient       *containerregistry.TokensClient
+	ScopeMapsClient    *containerregistry.ScopeMapsClient
 
 	Environment azure.Environment
 }
@@ -28,6 +68,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	registriesClient := containerregistry.NewRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&registriesClient.Client, o.ResourceManagerAuthorizer)
 
+	registriesV2Client := registries.NewRegistriesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&registriesV2Client.Client, o.ResourceManagerAuthorizer)
+
+	registryAgentPoolsClient := containerregistry.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryAgentPoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	registryCacheRulesClient := cacherules.NewCacheRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&registryCacheRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	registryConnectedRegistriesClient := connectedregistries.NewConnectedRegistriesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&registryConnectedRegistriesClient.Client, o.ResourceManagerAuthorizer)
+
+	registryCredentialSetsClient := credentialsets.NewCredentialSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&registryCredentialSetsClient.Client, o.ResourceManagerAuthorizer)
+
+	registryTasksClient := containerregistry.NewTasksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryTasksClient.Client, o.ResourceManagerAuthorizer)
+
 	webhooksClient := containerregistry.NewWebhooksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&webhooksClient.Client, o.ResourceManagerAuthorizer)
 
@@ -43,6 +101,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
+	groupsV2Client := containergroups.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&groupsV2Client.Client, o.ResourceManagerAuthorizer)
+
 	// AKS
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&kubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
@@ -53,20 +114,80 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	maintenanceConfigurationsV2Client := maintenanceconfigurations.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&maintenanceConfigurationsV2Client.Client, o.ResourceManagerAuthorizer)
+
+	extensionsClient := extensions.NewExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&extensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	fluxConfigurationClient := fluxconfiguration.NewFluxConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fluxConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetsClient := fleets.NewFleetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetsClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetMembersClient := fleetmembers.NewFleetMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetMembersClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetUpdateRunsClient := updateruns.NewUpdateRunsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetUpdateRunsClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetUpdateStrategiesClient := fleetupdatestrategies.NewFleetUpdateStrategiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetUpdateStrategiesClient.Client, o.ResourceManagerAuthorizer)
+
+	// Container Apps
+	containerAppsClient := containerapps.NewContainerAppsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&containerAppsClient.Client, o.ResourceManagerAuthorizer)
+
+	daprComponentsClient := daprcomponents.NewDaprComponentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&daprComponentsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedEnvironmentsClient := managedenvironments.NewManagedEnvironmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedEnvironmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedEnvironmentCertificatesClient := certificates.NewCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedEnvironmentCertificatesClient.Client, o.ResourceManagerAuthorizer)
+
+	managedEnvironmentStoragesClient := managedenvironmentsstorages.NewManagedEnvironmentsStoragesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedEnvironmentStoragesClient.Client, o.ResourceManagerAuthorizer)
+
+	snapshotsClient := containerservice.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AgentPoolsClient:                &agentPoolsClient,
-		KubernetesClustersClient:        &kubernetesClustersClient,
-		GroupsClient:                    &groupsClient,
-		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
-		RegistriesClient:                &registriesClient,
-		WebhooksClient:                  &webhooksClient,
-		ReplicationsClient:              &replicationsClient,
-		ServicesClient:                  &servicesClient,
-		Environment:                     o.Environment,
-		TokensClient:                    &tokensClient,
-		ScopeMapsClient:                 &scopeMapsClient,
+		AgentPoolsClient:                           &agentPoolsClient,
+		ContainerAppsClient:                        &containerAppsClient,
+		ContainerRegistryAgentPoolsClient:          &registryAgentPoolsClient,
+		ContainerRegistryCacheRulesClient:          &registryCacheRulesClient,
+		ContainerRegistryConnectedRegistriesClient: &registryConnectedRegistriesClient,
+		ContainerRegistryCredentialSetsClient:      &registryCredentialSetsClient,
+		ContainerRegistryTasksClient:               &registryTasksClient,
+		DaprComponentsClient:                       &daprComponentsClient,
+		KubernetesClustersClient:                   &kubernetesClustersClient,
+		ExtensionsClient:                           &extensionsClient,
+		FleetMembersClient:                         &fleetMembersClient,
+		FleetsClient:                               &fleetsClient,
+		FleetUpdateRunsClient:                      &fleetUpdateRunsClient,
+		FleetUpdateStrategiesClient:                &fleetUpdateStrategiesClient,
+		FluxConfigurationClient:                    &fluxConfigurationClient,
+		GroupsClient:                               &groupsClient,
+		GroupsV2Client:                             &groupsV2Client,
+		MaintenanceConfigurationsClient:            &maintenanceConfigurationsClient,
+		MaintenanceConfigurationsV2Client:          &maintenanceConfigurationsV2Client,
+		ManagedEnvironmentCertificatesClient:       &managedEnvironmentCertificatesClient,
+		ManagedEnvironmentsClient:                  &managedEnvironmentsClient,
+		ManagedEnvironmentStoragesClient:           &managedEnvironmentStoragesClient,
+		RegistriesClient:                           &registriesClient,
+		RegistriesV2Client:                         &registriesV2Client,
+		WebhooksClient:                             &webhooksClient,
+		ReplicationsClient:                         &replicationsClient,
+		SnapshotsClient:                            &snapshotsClient,
+		ServicesClient:                             &servicesClient,
+		Environment:                                o.Environment,
+		TokensClient:                               &tokensClient,
+		ScopeMapsClient:                            &scopeMapsClient,
 	}
 }
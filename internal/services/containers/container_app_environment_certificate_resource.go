@@ -0,0 +1,261 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCertificateResource{}
+
+type ContainerAppEnvironmentCertificateResource struct{}
+
+type ContainerAppEnvironmentCertificateModel struct {
+	Name                      string            `tfschema:"name"`
+	ContainerAppEnvironmentId string            `tfschema:"container_app_environment_id"`
+	CertificateBlobBase64     string            `tfschema:"certificate_blob_base64"`
+	CertificatePassword       string            `tfschema:"certificate_password"`
+	Tags                      map[string]string `tfschema:"tags"`
+
+	ExpirationDate string `tfschema:"expiration_date"`
+	IssueDate      string `tfschema:"issue_date"`
+	Issuer         string `tfschema:"issuer"`
+	SubjectName    string `tfschema:"subject_name"`
+	Thumbprint     string `tfschema:"thumbprint"`
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+		},
+
+		"certificate_blob_base64": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsBase64,
+		},
+
+		"certificate_password": {
+			Type:      pluginsdk.TypeString,
+			Required:  true,
+			ForceNew:  true,
+			Sensitive: true,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiration_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issue_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issuer": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"subject_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentCertificateModel{}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) ResourceType() string {
+	return "azurerm_container_app_environment_certificate"
+}
+
+func (r ContainerAppEnvironmentCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return certificates.ValidateCertificateID
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentCertificatesClient
+			environmentsClient := metadata.Client.Containers.ManagedEnvironmentsClient
+
+			var model ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := certificates.NewCertificateID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the Certificate must be in the same location as the Container App Environment
+			environment, err := environmentsClient.Get(ctx, *environmentId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *environmentId, err)
+			}
+			if environment.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *environmentId)
+			}
+
+			parameters := certificates.Certificate{
+				Location: location.Normalize(environment.Model.Location),
+				Properties: &certificates.CertificateProperties{
+					Password: utils.String(model.CertificatePassword),
+					Value:    utils.String(model.CertificateBlobBase64),
+				},
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentCertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentCertificateModel{
+				Name:                      id.CertificateName,
+				ContainerAppEnvironmentId: managedenvironments.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+				// the certificate and its password aren't returned from the API, so these are retained from the config
+				CertificateBlobBase64: metadata.ResourceData.Get("certificate_blob_base64").(string),
+				CertificatePassword:   metadata.ResourceData.Get("certificate_password").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.ExpirationDate = utils.NormalizeNilableString(props.ExpirationDate)
+					state.IssueDate = utils.NormalizeNilableString(props.IssueDate)
+					state.Issuer = utils.NormalizeNilableString(props.Issuer)
+					state.SubjectName = utils.NormalizeNilableString(props.SubjectName)
+					state.Thumbprint = utils.NormalizeNilableString(props.Thumbprint)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentCertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := certificates.CertificatePatch{}
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentCertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,341 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentDaprComponentResource{}
+
+type ContainerAppEnvironmentDaprComponentResource struct{}
+
+type ContainerAppEnvironmentDaprComponentModel struct {
+	Name                      string                                              `tfschema:"name"`
+	ContainerAppEnvironmentId string                                              `tfschema:"container_app_environment_id"`
+	ComponentType             string                                              `tfschema:"component_type"`
+	Version                   string                                              `tfschema:"version"`
+	IgnoreErrors              bool                                                `tfschema:"ignore_errors"`
+	InitTimeout               string                                              `tfschema:"init_timeout"`
+	Scopes                    []string                                            `tfschema:"scopes"`
+	Metadata                  []ContainerAppEnvironmentDaprComponentMetadataModel `tfschema:"metadata"`
+	Secret                    []ContainerAppSecretModel                           `tfschema:"secret"`
+}
+
+type ContainerAppEnvironmentDaprComponentMetadataModel struct {
+	Name       string `tfschema:"name"`
+	Value      string `tfschema:"value"`
+	SecretName string `tfschema:"secret_name"`
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+		},
+
+		"component_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ignore_errors": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"init_timeout": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "5s",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentDaprComponentModel{}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_dapr_component"
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return daprcomponents.ValidateDaprComponentID
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.DaprComponentsClient
+
+			var model ContainerAppEnvironmentDaprComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := daprcomponents.NewDaprComponentID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandContainerAppEnvironmentDaprComponent(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentDaprComponentModel{
+				Name:                      id.DaprComponentName,
+				ContainerAppEnvironmentId: managedenvironments.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ComponentType = utils.NormalizeNilableString(props.ComponentType)
+					state.Version = utils.NormalizeNilableString(props.Version)
+					state.IgnoreErrors = props.IgnoreErrors != nil && *props.IgnoreErrors
+					state.InitTimeout = utils.NormalizeNilableString(props.InitTimeout)
+
+					if props.Scopes != nil {
+						state.Scopes = *props.Scopes
+					}
+
+					if props.Metadata != nil {
+						for _, v := range *props.Metadata {
+							state.Metadata = append(state.Metadata, ContainerAppEnvironmentDaprComponentMetadataModel{
+								Name:       utils.NormalizeNilableString(v.Name),
+								Value:      utils.NormalizeNilableString(v.Value),
+								SecretName: utils.NormalizeNilableString(v.SecretRef),
+							})
+						}
+					}
+				}
+			}
+
+			// the values of the Secrets aren't returned from the Get request, so we need to look these up separately
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing Secrets for %s: %+v", *id, err)
+			}
+			if secrets := secretsResp.Model; secrets != nil {
+				for _, v := range secrets.Value {
+					state.Secret = append(state.Secret, ContainerAppSecretModel{
+						Name:  utils.NormalizeNilableString(v.Name),
+						Value: utils.NormalizeNilableString(v.Value),
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentDaprComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Secrets aren't returned from the Get request, so we send the entire payload to ensure these are retained
+			if _, err := client.CreateOrUpdate(ctx, *id, expandContainerAppEnvironmentDaprComponent(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppEnvironmentDaprComponent(input ContainerAppEnvironmentDaprComponentModel) daprcomponents.DaprComponent {
+	scopes := input.Scopes
+	secrets := make([]daprcomponents.Secret, 0)
+	for _, v := range input.Secret {
+		secrets = append(secrets, daprcomponents.Secret{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return daprcomponents.DaprComponent{
+		Properties: &daprcomponents.DaprComponentProperties{
+			ComponentType: utils.String(input.ComponentType),
+			IgnoreErrors:  utils.Bool(input.IgnoreErrors),
+			InitTimeout:   utils.String(input.InitTimeout),
+			Metadata:      expandContainerAppEnvironmentDaprComponentMetadata(input.Metadata),
+			Scopes:        &scopes,
+			Secrets:       &secrets,
+			Version:       utils.String(input.Version),
+		},
+	}
+}
+
+func expandContainerAppEnvironmentDaprComponentMetadata(input []ContainerAppEnvironmentDaprComponentMetadataModel) *[]daprcomponents.DaprMetadata {
+	output := make([]daprcomponents.DaprMetadata, 0)
+	for _, v := range input {
+		item := daprcomponents.DaprMetadata{
+			Name: utils.String(v.Name),
+		}
+		if v.SecretName != "" {
+			item.SecretRef = utils.String(v.SecretName)
+		} else {
+			item.Value = utils.String(v.Value)
+		}
+		output = append(output, item)
+	}
+
+	return &output
+}
@@ -0,0 +1,447 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironments"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	logAnalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
+
+type ContainerAppEnvironmentResource struct{}
+
+type ContainerAppEnvironmentModel struct {
+	Name                        string                                        `tfschema:"name"`
+	ResourceGroupName           string                                        `tfschema:"resource_group_name"`
+	Location                    string                                        `tfschema:"location"`
+	LogAnalyticsWorkspaceId     string                                        `tfschema:"log_analytics_workspace_id"`
+	InfrastructureSubnetId      string                                        `tfschema:"infrastructure_subnet_id"`
+	InternalLoadBalancerEnabled bool                                          `tfschema:"internal_load_balancer_enabled"`
+	ZoneRedundancyEnabled       bool                                          `tfschema:"zone_redundancy_enabled"`
+	WorkloadProfile             []ContainerAppEnvironmentWorkloadProfileModel `tfschema:"workload_profile"`
+	Tags                        map[string]string                             `tfschema:"tags"`
+
+	DefaultDomain                string `tfschema:"default_domain"`
+	DockerBridgeCidr             string `tfschema:"docker_bridge_cidr"`
+	PlatformReservedCidr         string `tfschema:"platform_reserved_cidr"`
+	PlatformReservedDnsIPAddress string `tfschema:"platform_reserved_dns_ip_address"`
+	StaticIPAddress              string `tfschema:"static_ip_address"`
+}
+
+type ContainerAppEnvironmentWorkloadProfileModel struct {
+	Name                string `tfschema:"name"`
+	WorkloadProfileType string `tfschema:"workload_profile_type"`
+	MinimumCount        int64  `tfschema:"minimum_count"`
+	MaximumCount        int64  `tfschema:"maximum_count"`
+}
+
+func (r ContainerAppEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ContainerAppEnvironmentName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+		},
+
+		"infrastructure_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"internal_load_balancer_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      false,
+			RequiredWith: []string{"infrastructure_subnet_id"},
+		},
+
+		"zone_redundancy_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      false,
+			RequiredWith: []string{"infrastructure_subnet_id"},
+		},
+
+		"workload_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"workload_profile_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Consumption",
+							"D4",
+							"D8",
+							"D16",
+							"D32",
+							"E4",
+							"E8",
+							"E16",
+							"E32",
+						}, false),
+					},
+
+					"minimum_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"maximum_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"docker_bridge_cidr": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"platform_reserved_cidr": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"platform_reserved_dns_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"static_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentModel{}
+}
+
+func (r ContainerAppEnvironmentResource) ResourceType() string {
+	return "azurerm_container_app_environment"
+}
+
+func (r ContainerAppEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedenvironments.ValidateManagedEnvironmentID
+}
+
+func (r ContainerAppEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppEnvironmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := managedenvironments.NewManagedEnvironmentID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := managedenvironments.ManagedEnvironment{
+				Location: location.Normalize(model.Location),
+				Properties: &managedenvironments.ManagedEnvironmentProperties{
+					WorkloadProfiles: expandContainerAppEnvironmentWorkloadProfiles(model.WorkloadProfile),
+					ZoneRedundant:    utils.Bool(model.ZoneRedundancyEnabled),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.LogAnalyticsWorkspaceId != "" {
+				logsConfiguration, err := expandContainerAppEnvironmentAppLogsConfiguration(ctx, metadata, model.LogAnalyticsWorkspaceId)
+				if err != nil {
+					return err
+				}
+				parameters.Properties.AppLogsConfiguration = logsConfiguration
+			}
+
+			if model.InfrastructureSubnetId != "" {
+				parameters.Properties.VnetConfiguration = &managedenvironments.VnetConfiguration{
+					InfrastructureSubnetId: utils.String(model.InfrastructureSubnetId),
+					Internal:               utils.Bool(model.InternalLoadBalancerEnabled),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentModel{
+				Name:              id.ManagedEnvironmentName,
+				ResourceGroupName: id.ResourceGroupName,
+				// the API only returns the Customer ID of the Log Analytics Workspace, so this is retained from the config
+				LogAnalyticsWorkspaceId: metadata.ResourceData.Get("log_analytics_workspace_id").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if vnet := props.VnetConfiguration; vnet != nil {
+						state.InfrastructureSubnetId = utils.NormalizeNilableString(vnet.InfrastructureSubnetId)
+						state.InternalLoadBalancerEnabled = vnet.Internal != nil && *vnet.Internal
+						state.DockerBridgeCidr = utils.NormalizeNilableString(vnet.DockerBridgeCidr)
+						state.PlatformReservedCidr = utils.NormalizeNilableString(vnet.PlatformReservedCidr)
+						state.PlatformReservedDnsIPAddress = utils.NormalizeNilableString(vnet.PlatformReservedDnsIP)
+					}
+
+					state.ZoneRedundancyEnabled = props.ZoneRedundant != nil && *props.ZoneRedundant
+					state.DefaultDomain = utils.NormalizeNilableString(props.DefaultDomain)
+					state.StaticIPAddress = utils.NormalizeNilableString(props.StaticIP)
+					state.WorkloadProfile = flattenContainerAppEnvironmentWorkloadProfiles(props.WorkloadProfiles)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := managedenvironments.ManagedEnvironment{
+				Location:   location.Normalize(model.Location),
+				Properties: &managedenvironments.ManagedEnvironmentProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("log_analytics_workspace_id") {
+				// the Shared Key isn't returned from the API, so the configuration is only sent when it's changed
+				logsConfiguration := &managedenvironments.AppLogsConfiguration{}
+				if model.LogAnalyticsWorkspaceId != "" {
+					logsConfiguration, err = expandContainerAppEnvironmentAppLogsConfiguration(ctx, metadata, model.LogAnalyticsWorkspaceId)
+					if err != nil {
+						return err
+					}
+				}
+				parameters.Properties.AppLogsConfiguration = logsConfiguration
+			}
+
+			if metadata.ResourceData.HasChange("workload_profile") {
+				workloadProfiles := expandContainerAppEnvironmentWorkloadProfiles(model.WorkloadProfile)
+				if workloadProfiles == nil {
+					workloadProfiles = &[]managedenvironments.WorkloadProfile{}
+				}
+				parameters.Properties.WorkloadProfiles = workloadProfiles
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppEnvironmentAppLogsConfiguration(ctx context.Context, metadata sdk.ResourceMetaData, input string) (*managedenvironments.AppLogsConfiguration, error) {
+	workspacesClient := metadata.Client.LogAnalytics.WorkspacesClient
+	sharedKeysClient := metadata.Client.LogAnalytics.SharedKeysClient
+
+	workspaceId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := workspacesClient.Get(ctx, workspaceId.ResourceGroup, workspaceId.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+	}
+	if workspace.WorkspaceProperties == nil || workspace.WorkspaceProperties.CustomerID == nil {
+		return nil, fmt.Errorf("retrieving %s: `customerId` was nil", *workspaceId)
+	}
+
+	sharedKeys, err := sharedKeysClient.GetSharedKeys(ctx, workspaceId.ResourceGroup, workspaceId.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Shared Keys for %s: %+v", *workspaceId, err)
+	}
+	if sharedKeys.PrimarySharedKey == nil {
+		return nil, fmt.Errorf("retrieving the Shared Keys for %s: `primarySharedKey` was nil", *workspaceId)
+	}
+
+	return &managedenvironments.AppLogsConfiguration{
+		Destination: utils.String("log-analytics"),
+		LogAnalyticsConfiguration: &managedenvironments.LogAnalyticsConfiguration{
+			CustomerId: workspace.WorkspaceProperties.CustomerID,
+			SharedKey:  sharedKeys.PrimarySharedKey,
+		},
+	}, nil
+}
+
+func expandContainerAppEnvironmentWorkloadProfiles(input []ContainerAppEnvironmentWorkloadProfileModel) *[]managedenvironments.WorkloadProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]managedenvironments.WorkloadProfile, 0)
+	for _, v := range input {
+		profile := managedenvironments.WorkloadProfile{
+			Name:                v.Name,
+			WorkloadProfileType: v.WorkloadProfileType,
+		}
+
+		// the Consumption profile doesn't support scaling settings
+		if v.WorkloadProfileType != "Consumption" {
+			profile.MinimumCount = utils.Int64(v.MinimumCount)
+			profile.MaximumCount = utils.Int64(v.MaximumCount)
+		}
+
+		output = append(output, profile)
+	}
+
+	return &output
+}
+
+func flattenContainerAppEnvironmentWorkloadProfiles(input *[]managedenvironments.WorkloadProfile) []ContainerAppEnvironmentWorkloadProfileModel {
+	output := make([]ContainerAppEnvironmentWorkloadProfileModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		profile := ContainerAppEnvironmentWorkloadProfileModel{
+			Name:                v.Name,
+			WorkloadProfileType: v.WorkloadProfileType,
+		}
+
+		if v.MinimumCount != nil {
+			profile.MinimumCount = *v.MinimumCount
+		}
+
+		if v.MaximumCount != nil {
+			profile.MaximumCount = *v.MaximumCount
+		}
+
+		output = append(output, profile)
+	}
+
+	return output
+}
@@ -0,0 +1,229 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironmentsstorages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentStorageResource{}
+
+type ContainerAppEnvironmentStorageResource struct{}
+
+type ContainerAppEnvironmentStorageModel struct {
+	Name                      string `tfschema:"name"`
+	ContainerAppEnvironmentId string `tfschema:"container_app_environment_id"`
+	AccountName               string `tfschema:"account_name"`
+	ShareName                 string `tfschema:"share_name"`
+	AccessKey                 string `tfschema:"access_key"`
+	AccessMode                string `tfschema:"access_mode"`
+}
+
+func (r ContainerAppEnvironmentStorageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+		},
+
+		"account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"share_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"access_key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"access_mode": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(managedenvironmentsstorages.AccessModeReadOnly),
+				string(managedenvironmentsstorages.AccessModeReadWrite),
+			}, false),
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentStorageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppEnvironmentStorageResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentStorageModel{}
+}
+
+func (r ContainerAppEnvironmentStorageResource) ResourceType() string {
+	return "azurerm_container_app_environment_storage"
+}
+
+func (r ContainerAppEnvironmentStorageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedenvironmentsstorages.ValidateStorageID
+}
+
+func (r ContainerAppEnvironmentStorageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentStoragesClient
+
+			var model ContainerAppEnvironmentStorageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := managedenvironmentsstorages.NewStorageID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandContainerAppEnvironmentStorage(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentStorageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentStoragesClient
+
+			id, err := managedenvironmentsstorages.ParseStorageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentStorageModel{
+				Name:                      id.StorageName,
+				ContainerAppEnvironmentId: managedenvironments.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+				// the Access Key isn't returned from the API, so this is retained from the config
+				AccessKey: metadata.ResourceData.Get("access_key").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil && props.AzureFile != nil {
+					state.AccountName = utils.NormalizeNilableString(props.AzureFile.AccountName)
+					state.ShareName = utils.NormalizeNilableString(props.AzureFile.ShareName)
+					if props.AzureFile.AccessMode != nil {
+						state.AccessMode = string(*props.AzureFile.AccessMode)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentStorageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentStoragesClient
+
+			id, err := managedenvironmentsstorages.ParseStorageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentStorageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Access Key must be sent in every request, so we send the entire payload
+			if _, err := client.CreateOrUpdate(ctx, *id, expandContainerAppEnvironmentStorage(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentStorageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ManagedEnvironmentStoragesClient
+
+			id, err := managedenvironmentsstorages.ParseStorageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppEnvironmentStorage(input ContainerAppEnvironmentStorageModel) managedenvironmentsstorages.ManagedEnvironmentStorage {
+	accessMode := managedenvironmentsstorages.AccessMode(input.AccessMode)
+	return managedenvironmentsstorages.ManagedEnvironmentStorage{
+		Properties: &managedenvironmentsstorages.ManagedEnvironmentStorageProperties{
+			AzureFile: &managedenvironmentsstorages.AzureFileProperties{
+				AccessMode:  &accessMode,
+				AccountKey:  utils.String(input.AccessKey),
+				AccountName: utils.String(input.AccountName),
+				ShareName:   utils.String(input.ShareName),
+			},
+		},
+	}
+}
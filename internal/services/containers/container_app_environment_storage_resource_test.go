@@ -0,0 +1,137 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironmentsstorages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppEnvironmentStorageResource struct{}
+
+func TestAccContainerAppEnvironmentStorage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_storage", "test")
+	r := ContainerAppEnvironmentStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "ReadOnly"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("access_key"),
+	})
+}
+
+func TestAccContainerAppEnvironmentStorage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_storage", "test")
+	r := ContainerAppEnvironmentStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "ReadOnly"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppEnvironmentStorage_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_storage", "test")
+	r := ContainerAppEnvironmentStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "ReadOnly"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("access_key"),
+		{
+			Config: r.basic(data, "ReadWrite"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_mode").HasValue("ReadWrite"),
+			),
+		},
+		data.ImportStep("access_key"),
+	})
+}
+
+func (r ContainerAppEnvironmentStorageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managedenvironmentsstorages.ParseStorageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ManagedEnvironmentStoragesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentStorageResource) basic(data acceptance.TestData, accessMode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_storage" "test" {
+  name                         = "acctest-storage%d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  account_name                 = azurerm_storage_account.test.name
+  share_name                   = azurerm_storage_share.test.name
+  access_key                   = azurerm_storage_account.test.primary_access_key
+  access_mode                  = "%s"
+}
+`, r.template(data), data.RandomInteger, accessMode)
+}
+
+func (r ContainerAppEnvironmentStorageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_storage" "import" {
+  name                         = azurerm_container_app_environment_storage.test.name
+  container_app_environment_id = azurerm_container_app_environment_storage.test.container_app_environment_id
+  account_name                 = azurerm_container_app_environment_storage.test.account_name
+  share_name                   = azurerm_container_app_environment_storage.test.share_name
+  access_key                   = azurerm_container_app_environment_storage.test.access_key
+  access_mode                  = azurerm_container_app_environment_storage.test.access_mode
+}
+`, r.basic(data, "ReadOnly"))
+}
+
+func (r ContainerAppEnvironmentStorageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare%s"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 5
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomString, data.RandomString)
+}
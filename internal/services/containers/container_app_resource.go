@@ -0,0 +1,1225 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/managedenvironments"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerAppResource{}
+
+type ContainerAppResource struct{}
+
+type ContainerAppModel struct {
+	Name                      string                      `tfschema:"name"`
+	ResourceGroupName         string                      `tfschema:"resource_group_name"`
+	ContainerAppEnvironmentId string                      `tfschema:"container_app_environment_id"`
+	RevisionMode              string                      `tfschema:"revision_mode"`
+	WorkloadProfileName       string                      `tfschema:"workload_profile_name"`
+	Template                  []ContainerAppTemplateModel `tfschema:"template"`
+	Dapr                      []ContainerAppDaprModel     `tfschema:"dapr"`
+	Ingress                   []ContainerAppIngressModel  `tfschema:"ingress"`
+	Registry                  []ContainerAppRegistryModel `tfschema:"registry"`
+	Secret                    []ContainerAppSecretModel   `tfschema:"secret"`
+	Tags                      map[string]string           `tfschema:"tags"`
+
+	Location                   string   `tfschema:"location"`
+	CustomDomainVerificationId string   `tfschema:"custom_domain_verification_id"`
+	LatestRevisionFqdn         string   `tfschema:"latest_revision_fqdn"`
+	LatestRevisionName         string   `tfschema:"latest_revision_name"`
+	OutboundIPAddresses        []string `tfschema:"outbound_ip_addresses"`
+}
+
+type ContainerAppTemplateModel struct {
+	Container       []ContainerAppContainerModel       `tfschema:"container"`
+	MinReplicas     int64                              `tfschema:"min_replicas"`
+	MaxReplicas     int64                              `tfschema:"max_replicas"`
+	RevisionSuffix  string                             `tfschema:"revision_suffix"`
+	HttpScaleRule   []ContainerAppHttpScaleRuleModel   `tfschema:"http_scale_rule"`
+	CustomScaleRule []ContainerAppCustomScaleRuleModel `tfschema:"custom_scale_rule"`
+	Volume          []ContainerAppVolumeModel          `tfschema:"volume"`
+}
+
+type ContainerAppContainerModel struct {
+	Name             string                                 `tfschema:"name"`
+	Image            string                                 `tfschema:"image"`
+	Cpu              float64                                `tfschema:"cpu"`
+	Memory           string                                 `tfschema:"memory"`
+	EphemeralStorage string                                 `tfschema:"ephemeral_storage"`
+	Args             []string                               `tfschema:"args"`
+	Command          []string                               `tfschema:"command"`
+	Env              []ContainerAppEnvironmentVariableModel `tfschema:"env"`
+	VolumeMounts     []ContainerAppVolumeMountModel         `tfschema:"volume_mounts"`
+}
+
+type ContainerAppVolumeMountModel struct {
+	Name string `tfschema:"name"`
+	Path string `tfschema:"path"`
+}
+
+type ContainerAppEnvironmentVariableModel struct {
+	Name       string `tfschema:"name"`
+	Value      string `tfschema:"value"`
+	SecretName string `tfschema:"secret_name"`
+}
+
+type ContainerAppVolumeModel struct {
+	Name        string `tfschema:"name"`
+	StorageName string `tfschema:"storage_name"`
+	StorageType string `tfschema:"storage_type"`
+}
+
+type ContainerAppDaprModel struct {
+	AppId       string `tfschema:"app_id"`
+	AppPort     int64  `tfschema:"app_port"`
+	AppProtocol string `tfschema:"app_protocol"`
+}
+
+type ContainerAppHttpScaleRuleModel struct {
+	Name               string                                     `tfschema:"name"`
+	ConcurrentRequests string                                     `tfschema:"concurrent_requests"`
+	Authentication     []ContainerAppScaleRuleAuthenticationModel `tfschema:"authentication"`
+}
+
+type ContainerAppCustomScaleRuleModel struct {
+	Name           string                                     `tfschema:"name"`
+	CustomRuleType string                                     `tfschema:"custom_rule_type"`
+	Metadata       map[string]string                          `tfschema:"metadata"`
+	Authentication []ContainerAppScaleRuleAuthenticationModel `tfschema:"authentication"`
+}
+
+type ContainerAppScaleRuleAuthenticationModel struct {
+	SecretName       string `tfschema:"secret_name"`
+	TriggerParameter string `tfschema:"trigger_parameter"`
+}
+
+type ContainerAppIngressModel struct {
+	AllowInsecureConnections bool                             `tfschema:"allow_insecure_connections"`
+	ExternalEnabled          bool                             `tfschema:"external_enabled"`
+	Fqdn                     string                           `tfschema:"fqdn"`
+	TargetPort               int64                            `tfschema:"target_port"`
+	Transport                string                           `tfschema:"transport"`
+	TrafficWeight            []ContainerAppTrafficWeightModel `tfschema:"traffic_weight"`
+}
+
+type ContainerAppTrafficWeightModel struct {
+	Label          string `tfschema:"label"`
+	LatestRevision bool   `tfschema:"latest_revision"`
+	RevisionSuffix string `tfschema:"revision_suffix"`
+	Percentage     int64  `tfschema:"percentage"`
+}
+
+type ContainerAppRegistryModel struct {
+	Server             string `tfschema:"server"`
+	Username           string `tfschema:"username"`
+	PasswordSecretName string `tfschema:"password_secret_name"`
+}
+
+type ContainerAppSecretModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ContainerAppName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+		},
+
+		"revision_mode": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(containerapps.ActiveRevisionsModeMultiple),
+				string(containerapps.ActiveRevisionsModeSingle),
+			}, false),
+		},
+
+		"workload_profile_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"template": containerAppTemplateSchema(),
+
+		"ingress": containerAppIngressSchema(),
+
+		"dapr": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"app_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"app_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+
+					"app_protocol": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(containerapps.AppProtocolHTTP),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerapps.AppProtocolGrpc),
+							string(containerapps.AppProtocolHTTP),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"registry": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"server": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password_secret_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerAppResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": location.SchemaComputed(),
+
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"latest_revision_fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_revision_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ContainerAppResource) ModelObject() interface{} {
+	return &ContainerAppModel{}
+}
+
+func (r ContainerAppResource) ResourceType() string {
+	return "azurerm_container_app"
+}
+
+func (r ContainerAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerapps.ValidateContainerAppID
+}
+
+func (r ContainerAppResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerAppsClient
+			environmentsClient := metadata.Client.Containers.ManagedEnvironmentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := containerapps.NewContainerAppID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// a Container App must be in the same location as the Container App Environment
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+			environment, err := environmentsClient.Get(ctx, *environmentId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *environmentId, err)
+			}
+			if environment.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *environmentId)
+			}
+
+			parameters := expandContainerApp(id, model, location.Normalize(environment.Model.Location))
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerAppsClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppModel{
+				Name:              id.ContainerAppName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.ManagedEnvironmentId != nil {
+						environmentId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(*props.ManagedEnvironmentId)
+						if err != nil {
+							return err
+						}
+						state.ContainerAppEnvironmentId = environmentId.ID()
+					}
+
+					state.WorkloadProfileName = utils.NormalizeNilableString(props.WorkloadProfileName)
+					state.Template = flattenContainerAppTemplate(props.Template)
+					state.CustomDomainVerificationId = utils.NormalizeNilableString(props.CustomDomainVerificationId)
+					state.LatestRevisionFqdn = utils.NormalizeNilableString(props.LatestRevisionFqdn)
+					state.LatestRevisionName = utils.NormalizeNilableString(props.LatestRevisionName)
+
+					if props.OutboundIPAddresses != nil {
+						state.OutboundIPAddresses = *props.OutboundIPAddresses
+					}
+
+					if config := props.Configuration; config != nil {
+						if config.ActiveRevisionsMode != nil {
+							state.RevisionMode = string(*config.ActiveRevisionsMode)
+						}
+						state.Dapr = flattenContainerAppDapr(config.Dapr)
+						state.Ingress = flattenContainerAppIngress(config.Ingress, id.ContainerAppName)
+						state.Registry = flattenContainerAppRegistries(config.Registries)
+					}
+				}
+			}
+
+			// the values of the Secrets aren't returned from the Get request, so we need to look these up separately
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing Secrets for %s: %+v", *id, err)
+			}
+			state.Secret = flattenContainerAppSecrets(secretsResp.Model)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerAppsClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the Secrets aren't returned from the Get request, so we send the entire payload to ensure these are retained
+			parameters := expandContainerApp(*id, model, location.Normalize(existing.Model.Location))
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerAppsClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func containerAppTemplateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"image": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"cpu": {
+								Type:         pluginsdk.TypeFloat,
+								Required:     true,
+								ValidateFunc: validation.FloatAtLeast(0.25),
+							},
+
+							"memory": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"ephemeral_storage": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"args": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"command": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"volume_mounts": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"name": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"path": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+
+							"env": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"name": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"value": {
+											Type:         pluginsdk.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"secret_name": {
+											Type:         pluginsdk.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"min_replicas": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 300),
+				},
+
+				"max_replicas": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntBetween(1, 300),
+				},
+
+				"revision_suffix": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"http_scale_rule": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"concurrent_requests": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"authentication": containerAppScaleRuleAuthenticationSchema(),
+						},
+					},
+				},
+
+				"custom_scale_rule": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"custom_rule_type": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"metadata": {
+								Type:     pluginsdk.TypeMap,
+								Required: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+
+							"authentication": containerAppScaleRuleAuthenticationSchema(),
+						},
+					},
+				},
+
+				"volume": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"storage_type": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								Default:  string(containerapps.StorageTypeEmptyDir),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(containerapps.StorageTypeAzureFile),
+									string(containerapps.StorageTypeEmptyDir),
+								}, false),
+							},
+
+							"storage_name": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func containerAppScaleRuleAuthenticationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"secret_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"trigger_parameter": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func containerAppIngressSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"allow_insecure_connections": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"external_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"fqdn": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"target_port": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+
+				"transport": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(containerapps.IngressTransportMethodAuto),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(containerapps.IngressTransportMethodAuto),
+						string(containerapps.IngressTransportMethodHTTP),
+						string(containerapps.IngressTransportMethodHTTPTwo),
+						string(containerapps.IngressTransportMethodTcp),
+					}, false),
+				},
+
+				"traffic_weight": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"label": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"latest_revision": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"revision_suffix": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"percentage": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandContainerApp(id containerapps.ContainerAppId, input ContainerAppModel, location string) containerapps.ContainerApp {
+	revisionMode := containerapps.ActiveRevisionsMode(input.RevisionMode)
+	output := containerapps.ContainerApp{
+		Location: location,
+		Properties: &containerapps.ContainerAppProperties{
+			Configuration: &containerapps.Configuration{
+				ActiveRevisionsMode: &revisionMode,
+				Dapr:                expandContainerAppDapr(input.Dapr),
+				Ingress:             expandContainerAppIngress(input.Ingress, id.ContainerAppName),
+				Registries:          expandContainerAppRegistries(input.Registry),
+				Secrets:             expandContainerAppSecrets(input.Secret),
+			},
+			ManagedEnvironmentId: utils.String(input.ContainerAppEnvironmentId),
+			Template:             expandContainerAppTemplate(input.Template),
+		},
+		Tags: &input.Tags,
+	}
+
+	if input.WorkloadProfileName != "" {
+		output.Properties.WorkloadProfileName = utils.String(input.WorkloadProfileName)
+	}
+
+	return output
+}
+
+func expandContainerAppTemplate(input []ContainerAppTemplateModel) *containerapps.Template {
+	if len(input) == 0 {
+		return nil
+	}
+
+	template := input[0]
+	containers := make([]containerapps.Container, 0)
+	for _, v := range template.Container {
+		args := v.Args
+		command := v.Command
+		container := containerapps.Container{
+			Args:    &args,
+			Command: &command,
+			Image:   utils.String(v.Image),
+			Name:    utils.String(v.Name),
+			Resources: &containerapps.ContainerResources{
+				Cpu:    utils.Float(v.Cpu),
+				Memory: utils.String(v.Memory),
+			},
+		}
+
+		env := make([]containerapps.EnvironmentVar, 0)
+		for _, e := range v.Env {
+			envVar := containerapps.EnvironmentVar{
+				Name: utils.String(e.Name),
+			}
+			if e.SecretName != "" {
+				envVar.SecretRef = utils.String(e.SecretName)
+			} else {
+				envVar.Value = utils.String(e.Value)
+			}
+			env = append(env, envVar)
+		}
+		container.Env = &env
+
+		volumeMounts := make([]containerapps.VolumeMount, 0)
+		for _, m := range v.VolumeMounts {
+			volumeMounts = append(volumeMounts, containerapps.VolumeMount{
+				MountPath:  utils.String(m.Path),
+				VolumeName: utils.String(m.Name),
+			})
+		}
+		container.VolumeMounts = &volumeMounts
+
+		containers = append(containers, container)
+	}
+
+	rules := make([]containerapps.ScaleRule, 0)
+	for _, v := range template.HttpScaleRule {
+		rules = append(rules, containerapps.ScaleRule{
+			Name: utils.String(v.Name),
+			HTTP: &containerapps.HTTPScaleRule{
+				Auth: expandContainerAppScaleRuleAuthentication(v.Authentication),
+				Metadata: &map[string]string{
+					"concurrentRequests": v.ConcurrentRequests,
+				},
+			},
+		})
+	}
+	for _, v := range template.CustomScaleRule {
+		metadata := v.Metadata
+		rules = append(rules, containerapps.ScaleRule{
+			Name: utils.String(v.Name),
+			Custom: &containerapps.CustomScaleRule{
+				Auth:     expandContainerAppScaleRuleAuthentication(v.Authentication),
+				Metadata: &metadata,
+				Type:     utils.String(v.CustomRuleType),
+			},
+		})
+	}
+
+	volumes := make([]containerapps.Volume, 0)
+	for _, v := range template.Volume {
+		storageType := containerapps.StorageType(v.StorageType)
+		volume := containerapps.Volume{
+			Name:        utils.String(v.Name),
+			StorageType: &storageType,
+		}
+		if v.StorageName != "" {
+			volume.StorageName = utils.String(v.StorageName)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	output := &containerapps.Template{
+		Containers: &containers,
+		Volumes:    &volumes,
+		Scale: &containerapps.Scale{
+			MaxReplicas: utils.Int64(template.MaxReplicas),
+			MinReplicas: utils.Int64(template.MinReplicas),
+			Rules:       &rules,
+		},
+	}
+
+	if template.RevisionSuffix != "" {
+		output.RevisionSuffix = utils.String(template.RevisionSuffix)
+	}
+
+	return output
+}
+
+func flattenContainerAppTemplate(input *containerapps.Template) []ContainerAppTemplateModel {
+	if input == nil {
+		return []ContainerAppTemplateModel{}
+	}
+
+	template := ContainerAppTemplateModel{
+		RevisionSuffix: utils.NormalizeNilableString(input.RevisionSuffix),
+	}
+
+	if input.Containers != nil {
+		for _, v := range *input.Containers {
+			container := ContainerAppContainerModel{
+				Name:  utils.NormalizeNilableString(v.Name),
+				Image: utils.NormalizeNilableString(v.Image),
+			}
+
+			if v.Args != nil {
+				container.Args = *v.Args
+			}
+
+			if v.Command != nil {
+				container.Command = *v.Command
+			}
+
+			if resources := v.Resources; resources != nil {
+				if resources.Cpu != nil {
+					container.Cpu = *resources.Cpu
+				}
+				container.Memory = utils.NormalizeNilableString(resources.Memory)
+				container.EphemeralStorage = utils.NormalizeNilableString(resources.EphemeralStorage)
+			}
+
+			if v.Env != nil {
+				for _, e := range *v.Env {
+					container.Env = append(container.Env, ContainerAppEnvironmentVariableModel{
+						Name:       utils.NormalizeNilableString(e.Name),
+						Value:      utils.NormalizeNilableString(e.Value),
+						SecretName: utils.NormalizeNilableString(e.SecretRef),
+					})
+				}
+			}
+
+			if v.VolumeMounts != nil {
+				for _, m := range *v.VolumeMounts {
+					container.VolumeMounts = append(container.VolumeMounts, ContainerAppVolumeMountModel{
+						Name: utils.NormalizeNilableString(m.VolumeName),
+						Path: utils.NormalizeNilableString(m.MountPath),
+					})
+				}
+			}
+
+			template.Container = append(template.Container, container)
+		}
+	}
+
+	if input.Volumes != nil {
+		for _, v := range *input.Volumes {
+			volume := ContainerAppVolumeModel{
+				Name:        utils.NormalizeNilableString(v.Name),
+				StorageName: utils.NormalizeNilableString(v.StorageName),
+			}
+			if v.StorageType != nil {
+				volume.StorageType = string(*v.StorageType)
+			}
+			template.Volume = append(template.Volume, volume)
+		}
+	}
+
+	if scale := input.Scale; scale != nil {
+		if scale.MinReplicas != nil {
+			template.MinReplicas = *scale.MinReplicas
+		}
+
+		if scale.MaxReplicas != nil {
+			template.MaxReplicas = *scale.MaxReplicas
+		}
+
+		if scale.Rules != nil {
+			for _, v := range *scale.Rules {
+				if v.HTTP != nil {
+					rule := ContainerAppHttpScaleRuleModel{
+						Name:           utils.NormalizeNilableString(v.Name),
+						Authentication: flattenContainerAppScaleRuleAuthentication(v.HTTP.Auth),
+					}
+					if v.HTTP.Metadata != nil {
+						rule.ConcurrentRequests = (*v.HTTP.Metadata)["concurrentRequests"]
+					}
+					template.HttpScaleRule = append(template.HttpScaleRule, rule)
+				}
+
+				if v.Custom != nil {
+					rule := ContainerAppCustomScaleRuleModel{
+						Name:           utils.NormalizeNilableString(v.Name),
+						CustomRuleType: utils.NormalizeNilableString(v.Custom.Type),
+						Authentication: flattenContainerAppScaleRuleAuthentication(v.Custom.Auth),
+					}
+					if v.Custom.Metadata != nil {
+						rule.Metadata = *v.Custom.Metadata
+					}
+					template.CustomScaleRule = append(template.CustomScaleRule, rule)
+				}
+			}
+		}
+	}
+
+	return []ContainerAppTemplateModel{template}
+}
+
+func expandContainerAppScaleRuleAuthentication(input []ContainerAppScaleRuleAuthenticationModel) *[]containerapps.ScaleRuleAuth {
+	output := make([]containerapps.ScaleRuleAuth, 0)
+	for _, v := range input {
+		output = append(output, containerapps.ScaleRuleAuth{
+			SecretRef:        utils.String(v.SecretName),
+			TriggerParameter: utils.String(v.TriggerParameter),
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerAppScaleRuleAuthentication(input *[]containerapps.ScaleRuleAuth) []ContainerAppScaleRuleAuthenticationModel {
+	output := make([]ContainerAppScaleRuleAuthenticationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ContainerAppScaleRuleAuthenticationModel{
+			SecretName:       utils.NormalizeNilableString(v.SecretRef),
+			TriggerParameter: utils.NormalizeNilableString(v.TriggerParameter),
+		})
+	}
+
+	return output
+}
+
+func expandContainerAppDapr(input []ContainerAppDaprModel) *containerapps.Dapr {
+	if len(input) == 0 {
+		return &containerapps.Dapr{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	dapr := input[0]
+	appProtocol := containerapps.AppProtocol(dapr.AppProtocol)
+	output := &containerapps.Dapr{
+		AppId:       utils.String(dapr.AppId),
+		AppProtocol: &appProtocol,
+		Enabled:     utils.Bool(true),
+	}
+
+	if dapr.AppPort != 0 {
+		output.AppPort = utils.Int64(dapr.AppPort)
+	}
+
+	return output
+}
+
+func flattenContainerAppDapr(input *containerapps.Dapr) []ContainerAppDaprModel {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []ContainerAppDaprModel{}
+	}
+
+	output := ContainerAppDaprModel{
+		AppId: utils.NormalizeNilableString(input.AppId),
+	}
+
+	if input.AppPort != nil {
+		output.AppPort = *input.AppPort
+	}
+
+	if input.AppProtocol != nil {
+		output.AppProtocol = string(*input.AppProtocol)
+	}
+
+	return []ContainerAppDaprModel{output}
+}
+
+func expandContainerAppIngress(input []ContainerAppIngressModel, appName string) *containerapps.Ingress {
+	if len(input) == 0 {
+		return nil
+	}
+
+	ingress := input[0]
+	transport := containerapps.IngressTransportMethod(ingress.Transport)
+	traffic := make([]containerapps.TrafficWeight, 0)
+	for _, v := range ingress.TrafficWeight {
+		weight := containerapps.TrafficWeight{
+			LatestRevision: utils.Bool(v.LatestRevision),
+			Weight:         utils.Int64(v.Percentage),
+		}
+
+		if v.Label != "" {
+			weight.Label = utils.String(v.Label)
+		}
+
+		// the API expects the full name of the Revision, which is made up of the App Name and the Revision Suffix
+		if !v.LatestRevision && v.RevisionSuffix != "" {
+			weight.RevisionName = utils.String(fmt.Sprintf("%s--%s", appName, v.RevisionSuffix))
+		}
+
+		traffic = append(traffic, weight)
+	}
+
+	return &containerapps.Ingress{
+		AllowInsecure: utils.Bool(ingress.AllowInsecureConnections),
+		External:      utils.Bool(ingress.ExternalEnabled),
+		TargetPort:    utils.Int64(ingress.TargetPort),
+		Traffic:       &traffic,
+		Transport:     &transport,
+	}
+}
+
+func flattenContainerAppIngress(input *containerapps.Ingress, appName string) []ContainerAppIngressModel {
+	if input == nil {
+		return []ContainerAppIngressModel{}
+	}
+
+	ingress := ContainerAppIngressModel{
+		AllowInsecureConnections: input.AllowInsecure != nil && *input.AllowInsecure,
+		ExternalEnabled:          input.External != nil && *input.External,
+		Fqdn:                     utils.NormalizeNilableString(input.Fqdn),
+	}
+
+	if input.TargetPort != nil {
+		ingress.TargetPort = *input.TargetPort
+	}
+
+	if input.Transport != nil {
+		ingress.Transport = strings.ToLower(string(*input.Transport))
+	}
+
+	if input.Traffic != nil {
+		for _, v := range *input.Traffic {
+			weight := ContainerAppTrafficWeightModel{
+				Label:          utils.NormalizeNilableString(v.Label),
+				LatestRevision: v.LatestRevision != nil && *v.LatestRevision,
+			}
+
+			if v.Weight != nil {
+				weight.Percentage = *v.Weight
+			}
+
+			if !weight.LatestRevision && v.RevisionName != nil {
+				weight.RevisionSuffix = strings.TrimPrefix(*v.RevisionName, fmt.Sprintf("%s--", appName))
+			}
+
+			ingress.TrafficWeight = append(ingress.TrafficWeight, weight)
+		}
+	}
+
+	return []ContainerAppIngressModel{ingress}
+}
+
+func expandContainerAppRegistries(input []ContainerAppRegistryModel) *[]containerapps.RegistryCredentials {
+	output := make([]containerapps.RegistryCredentials, 0)
+	for _, v := range input {
+		output = append(output, containerapps.RegistryCredentials{
+			PasswordSecretRef: utils.String(v.PasswordSecretName),
+			Server:            utils.String(v.Server),
+			Username:          utils.String(v.Username),
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerAppRegistries(input *[]containerapps.RegistryCredentials) []ContainerAppRegistryModel {
+	output := make([]ContainerAppRegistryModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ContainerAppRegistryModel{
+			PasswordSecretName: utils.NormalizeNilableString(v.PasswordSecretRef),
+			Server:             utils.NormalizeNilableString(v.Server),
+			Username:           utils.NormalizeNilableString(v.Username),
+		})
+	}
+
+	return output
+}
+
+func expandContainerAppSecrets(input []ContainerAppSecretModel) *[]containerapps.Secret {
+	output := make([]containerapps.Secret, 0)
+	for _, v := range input {
+		output = append(output, containerapps.Secret{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerAppSecrets(input *containerapps.SecretsCollection) []ContainerAppSecretModel {
+	output := make([]ContainerAppSecretModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range input.Value {
+		output = append(output, ContainerAppSecretModel{
+			Name:  utils.NormalizeNilableString(v.Name),
+			Value: utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return output
+}
@@ -3,6 +3,7 @@ package containers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -15,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/containergroups"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -62,6 +65,7 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
+					"None",
 					string(containerinstance.Public),
 					string(containerinstance.Private),
 				}, true),
@@ -80,6 +84,57 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				ConflictsWith: []string{"dns_name_label", "identity"},
 			},
 
+			"subnet_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: networkValidate.SubnetID,
+				},
+				ConflictsWith: []string{"dns_name_label", "network_profile_id"},
+			},
+
+			"priority": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containergroups.ContainerGroupPriorityRegular),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containergroups.ContainerGroupPriorityRegular),
+					string(containergroups.ContainerGroupPrioritySpot),
+				}, false),
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containergroups.ContainerGroupSkuStandard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containergroups.ContainerGroupSkuConfidential),
+					string(containergroups.ContainerGroupSkuDedicated),
+					string(containergroups.ContainerGroupSkuStandard),
+				}, false),
+			},
+
+			"confidential_compute": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cce_policy": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+					},
+				},
+			},
+
 			"os_type": {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
@@ -330,106 +385,65 @@ func resourceContainerGroup() *pluginsdk.Resource {
 							},
 						},
 
-						"volume": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							ForceNew: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-
-									"mount_path": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-
-									"read_only": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										ForceNew: true,
-										Default:  false,
-									},
+						"volume": containerVolumeSchema(),
 
-									"share_name": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+						"liveness_probe": SchemaContainerGroupProbe(),
 
-									"storage_account_name": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+						"readiness_probe": SchemaContainerGroupProbe(),
+					},
+				},
+			},
 
-									"storage_account_key": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										Sensitive:    true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+			"init_container": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
 
-									"empty_dir": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-										ForceNew: true,
-										Default:  false,
-									},
+						"image": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
 
-									"git_repo": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										ForceNew: true,
-										MaxItems: 1,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"url": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ForceNew: true,
-												},
-
-												"directory": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-													ForceNew: true,
-												},
-
-												"revision": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-													ForceNew: true,
-												},
-											},
-										},
-									},
+						"environment_variables": {
+							Type:     pluginsdk.TypeMap,
+							ForceNew: true,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
 
-									"secret": {
-										Type:      pluginsdk.TypeMap,
-										ForceNew:  true,
-										Optional:  true,
-										Sensitive: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
-								},
+						"secure_environment_variables": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
 							},
 						},
 
-						"liveness_probe": SchemaContainerGroupProbe(),
+						"commands": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
 
-						"readiness_probe": SchemaContainerGroupProbe(),
+						"volume": containerVolumeSchema(),
 					},
 				},
 			},
@@ -538,6 +552,105 @@ func resourceContainerGroup() *pluginsdk.Resource {
 	}
 }
 
+func containerVolumeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"mount_path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"read_only": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  false,
+				},
+
+				"share_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"storage_account_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"storage_account_key": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"empty_dir": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  false,
+				},
+
+				"git_repo": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"url": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+
+							"directory": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+
+							"revision": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+
+				"secret": {
+					Type:      pluginsdk.TypeMap,
+					ForceNew:  true,
+					Optional:  true,
+					Sensitive: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.GroupsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -571,15 +684,32 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
+	initContainers, initContainerVolumes, err := expandContainerGroupInitContainers(d)
+	if err != nil {
+		return err
+	}
+	for _, initVolume := range initContainerVolumes {
+		exists := false
+		for _, v := range *containerGroupVolumes {
+			if v.Name != nil && initVolume.Name != nil && *v.Name == *initVolume.Name {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			*containerGroupVolumes = append(*containerGroupVolumes, initVolume)
+		}
+	}
 	containerGroup := containerinstance.ContainerGroup{
 		Name:     &name,
 		Location: &location,
 		Tags:     tags.Expand(t),
 		Identity: expandContainerGroupIdentity(d),
 		ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
-			Containers:    containers,
-			Diagnostics:   diagnostics,
-			RestartPolicy: containerinstance.ContainerGroupRestartPolicy(restartPolicy),
+			Containers:     containers,
+			InitContainers: initContainers,
+			Diagnostics:    diagnostics,
+			RestartPolicy:  containerinstance.ContainerGroupRestartPolicy(restartPolicy),
 			IPAddress: &containerinstance.IPAddress{
 				Type:  containerinstance.ContainerGroupIPAddressType(IPAddressType),
 				Ports: containerGroupPorts,
@@ -595,6 +725,14 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		containerGroup.ContainerGroupProperties.IPAddress.DNSNameLabel = &dnsNameLabel
 	}
 
+	// Container Groups without an IP Address (such as Spot Container Groups) omit the `ipAddress` block entirely
+	if strings.EqualFold(IPAddressType, "None") {
+		if len(d.Get("exposed_port").(*pluginsdk.Set).List()) > 0 || containerGroup.ContainerGroupProperties.IPAddress.DNSNameLabel != nil {
+			return fmt.Errorf("`exposed_port` and `dns_name_label` cannot be specified when `ip_address_type` is `None`")
+		}
+		containerGroup.ContainerGroupProperties.IPAddress = nil
+	}
+
 	// https://docs.microsoft.com/en-us/azure/container-instances/container-instances-vnet#virtual-network-deployment-limitations
 	// https://docs.microsoft.com/en-us/azure/container-instances/container-instances-vnet#preview-limitations
 	if networkProfileID := d.Get("network_profile_id").(string); networkProfileID != "" {
@@ -606,13 +744,31 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, containerGroup)
-	if err != nil {
-		return fmt.Errorf("creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
-	}
+	if containerGroupRequiresV2Api(d) {
+		// spot priority, confidential SKUs and subnet IDs are only available in a newer API version, which no
+		// longer supports Network Profiles - so the payload is built from the existing models and extended
+		if d.Get("network_profile_id").(string) != "" {
+			return fmt.Errorf("`network_profile_id` cannot be used together with `priority`, `sku`, `confidential_compute` or `subnet_ids` - use `subnet_ids` instead")
+		}
+
+		parameters, err := expandContainerGroupV2(d, containerGroup)
+		if err != nil {
+			return err
+		}
+
+		id := containergroups.NewContainerGroupID(meta.(*clients.Client).Account.SubscriptionId, resGroup, name)
+		if err := meta.(*clients.Client).Containers.GroupsV2Client.CreateOrUpdateThenPoll(ctx, id, *parameters); err != nil {
+			return fmt.Errorf("creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, containerGroup)
+		if err != nil {
+			return fmt.Errorf("creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of container group %q (Resource Group %q): %+v", name, resGroup, err)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for completion of container group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -692,6 +848,10 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `container`: %+v", err)
 		}
 
+		if err := d.Set("init_container", flattenContainerGroupInitContainers(d, props.InitContainers, props.Volumes)); err != nil {
+			return fmt.Errorf("setting `init_container`: %+v", err)
+		}
+
 		if err := d.Set("image_registry_credential", flattenContainerImageRegistryCredentials(d, props.ImageRegistryCredentials)); err != nil {
 			return fmt.Errorf("setting `image_registry_credential`: %+v", err)
 		}
@@ -707,6 +867,8 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("dns_name_label", address.DNSNameLabel)
 			d.Set("fqdn", address.Fqdn)
 		}
+		// when `ip_address_type` is `None` the API omits the `ipAddress` block entirely - since `ip_address_type` is
+		// ForceNew the value from the State is kept rather than being inferred, to avoid recreating the Container Group
 
 		d.Set("restart_policy", string(props.RestartPolicy))
 		d.Set("os_type", string(props.OsType))
@@ -717,6 +879,48 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	v2Id := containergroups.NewContainerGroupID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	v2Resp, err := meta.(*clients.Client).Containers.GroupsV2Client.Get(ctx, v2Id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", v2Id, err)
+	}
+
+	priority := string(containergroups.ContainerGroupPriorityRegular)
+	sku := string(containergroups.ContainerGroupSkuStandard)
+	subnetIds := make([]interface{}, 0)
+	confidentialCompute := make([]interface{}, 0)
+	if model := v2Resp.Model; model != nil {
+		props := model.Properties
+		if props.Priority != nil {
+			priority = string(*props.Priority)
+		}
+		if props.Sku != nil {
+			sku = string(*props.Sku)
+		}
+		if props.SubnetIds != nil {
+			for _, v := range *props.SubnetIds {
+				subnetIds = append(subnetIds, v.Id)
+			}
+		}
+		if v := props.ConfidentialComputeProperties; v != nil {
+			confidentialCompute = append(confidentialCompute, map[string]interface{}{
+				"cce_policy": utils.NormalizeNilableString(v.CcePolicy),
+			})
+		}
+	}
+	d.Set("priority", priority)
+	d.Set("sku", sku)
+	// Container Groups deployed using a Network Profile may also return the `subnetIds` - since `subnet_ids` is
+	// ForceNew these are only set when a Network Profile isn't used, to avoid recreating these Container Groups
+	if d.Get("network_profile_id").(string) == "" {
+		if err := d.Set("subnet_ids", subnetIds); err != nil {
+			return fmt.Errorf("setting `subnet_ids`: %+v", err)
+		}
+	}
+	if err := d.Set("confidential_compute", confidentialCompute); err != nil {
+		return fmt.Errorf("setting `confidential_compute`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -1022,6 +1226,93 @@ func expandContainerGroupContainers(d *pluginsdk.ResourceData) (*[]containerinst
 	return &containers, &containerGroupPorts, &containerGroupVolumes, nil
 }
 
+func expandContainerGroupInitContainers(d *pluginsdk.ResourceData) (*[]containerinstance.InitContainerDefinition, []containerinstance.Volume, error) {
+	initContainersConfig := d.Get("init_container").([]interface{})
+	initContainers := make([]containerinstance.InitContainerDefinition, 0)
+	initContainerVolumes := make([]containerinstance.Volume, 0)
+
+	for _, initContainerConfig := range initContainersConfig {
+		data := initContainerConfig.(map[string]interface{})
+
+		initContainer := containerinstance.InitContainerDefinition{
+			Name: utils.String(data["name"].(string)),
+			InitContainerPropertiesDefinition: &containerinstance.InitContainerPropertiesDefinition{
+				Image: utils.String(data["image"].(string)),
+			},
+		}
+
+		envVars := expandContainerEnvironmentVariables(data["environment_variables"], false)
+		secEnvVars := expandContainerEnvironmentVariables(data["secure_environment_variables"], true)
+		*envVars = append(*envVars, *secEnvVars...)
+		initContainer.EnvironmentVariables = envVars
+
+		command := make([]string, 0)
+		for _, v := range data["commands"].([]interface{}) {
+			command = append(command, v.(string))
+		}
+		initContainer.Command = &command
+
+		volumeMounts, volumes, err := expandContainerVolumes(data["volume"])
+		if err != nil {
+			return nil, nil, err
+		}
+		initContainer.VolumeMounts = volumeMounts
+		if volumes != nil {
+			initContainerVolumes = append(initContainerVolumes, *volumes...)
+		}
+
+		initContainers = append(initContainers, initContainer)
+	}
+
+	return &initContainers, initContainerVolumes, nil
+}
+
+func containerGroupRequiresV2Api(d *pluginsdk.ResourceData) bool {
+	return d.Get("priority").(string) != string(containergroups.ContainerGroupPriorityRegular) ||
+		d.Get("sku").(string) != string(containergroups.ContainerGroupSkuStandard) ||
+		len(d.Get("confidential_compute").([]interface{})) > 0 ||
+		len(d.Get("subnet_ids").(*pluginsdk.Set).List()) > 0
+}
+
+// expandContainerGroupV2 converts the Container Group built using the existing models into the newer API Version,
+// which share the same wire format, and then sets the properties which are only available in the newer API Version
+func expandContainerGroupV2(d *pluginsdk.ResourceData, input containerinstance.ContainerGroup) (*containergroups.ContainerGroup, error) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling container group: %+v", err)
+	}
+
+	var output containergroups.ContainerGroup
+	if err := json.Unmarshal(raw, &output); err != nil {
+		return nil, fmt.Errorf("unmarshaling container group: %+v", err)
+	}
+
+	priority := containergroups.ContainerGroupPriority(d.Get("priority").(string))
+	output.Properties.Priority = &priority
+	sku := containergroups.ContainerGroupSku(d.Get("sku").(string))
+	output.Properties.Sku = &sku
+
+	if v := d.Get("subnet_ids").(*pluginsdk.Set).List(); len(v) > 0 {
+		subnetIds := make([]containergroups.ContainerGroupSubnetId, 0)
+		for _, subnetId := range v {
+			subnetIds = append(subnetIds, containergroups.ContainerGroupSubnetId{
+				Id: subnetId.(string),
+			})
+		}
+		output.Properties.SubnetIds = &subnetIds
+	}
+
+	if v := d.Get("confidential_compute").([]interface{}); len(v) > 0 {
+		confidentialCompute := containergroups.ConfidentialComputeProperties{}
+		if raw, ok := v[0].(map[string]interface{}); ok && raw["cce_policy"].(string) != "" {
+			confidentialCompute.CcePolicy = utils.String(raw["cce_policy"].(string))
+		}
+		output.Properties.ConfidentialComputeProperties = &confidentialCompute
+	}
+
+	return &output, nil
+}
+
 func expandContainerEnvironmentVariables(input interface{}, secure bool) *[]containerinstance.EnvironmentVariable {
 	envVars := input.(map[string]interface{})
 	output := make([]containerinstance.EnvironmentVariable, 0, len(envVars))
@@ -1380,13 +1671,13 @@ func flattenContainerGroupContainers(d *pluginsdk.ResourceData, containers *[]co
 
 		if container.EnvironmentVariables != nil {
 			if len(*container.EnvironmentVariables) > 0 {
-				containerConfig["environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, false, d, index)
+				containerConfig["environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, false, d, "container", index)
 			}
 		}
 
 		if container.EnvironmentVariables != nil {
 			if len(*container.EnvironmentVariables) > 0 {
-				containerConfig["secure_environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, true, d, index)
+				containerConfig["secure_environment_variables"] = flattenContainerEnvironmentVariables(container.EnvironmentVariables, true, d, "container", index)
 			}
 		}
 
@@ -1424,7 +1715,65 @@ func flattenContainerGroupContainers(d *pluginsdk.ResourceData, containers *[]co
 	return containerCfg
 }
 
-func flattenContainerEnvironmentVariables(input *[]containerinstance.EnvironmentVariable, isSecure bool, d *pluginsdk.ResourceData, oldContainerIndex int) map[string]interface{} {
+func flattenContainerGroupInitContainers(d *pluginsdk.ResourceData, initContainers *[]containerinstance.InitContainerDefinition, containerGroupVolumes *[]containerinstance.Volume) []interface{} {
+	if initContainers == nil {
+		return []interface{}{}
+	}
+
+	// map old init container names to index so we can look things up
+	nameIndexMap := map[string]int{}
+	initContainersConfig := d.Get("init_container").([]interface{})
+	for i, c := range initContainersConfig {
+		cfg := c.(map[string]interface{})
+		nameIndexMap[cfg["name"].(string)] = i
+	}
+
+	output := make([]interface{}, 0, len(*initContainers))
+	for _, initContainer := range *initContainers {
+		if initContainer.Name == nil {
+			continue
+		}
+		name := *initContainer.Name
+		index, hasConfig := nameIndexMap[name]
+
+		initContainerConfig := map[string]interface{}{
+			"name": name,
+		}
+
+		if props := initContainer.InitContainerPropertiesDefinition; props != nil {
+			initContainerConfig["image"] = utils.NormalizeNilableString(props.Image)
+			initContainerConfig["environment_variables"] = flattenContainerEnvironmentVariables(props.EnvironmentVariables, false, d, "init_container", index)
+
+			// the values of secure environment variables aren't returned by the API, so are looked up from the
+			// matching init container in the config - an init container which isn't in the config has none available
+			secureEnvironmentVariables := make(map[string]interface{})
+			if hasConfig {
+				secureEnvironmentVariables = flattenContainerEnvironmentVariables(props.EnvironmentVariables, true, d, "init_container", index)
+			}
+			initContainerConfig["secure_environment_variables"] = secureEnvironmentVariables
+
+			commands := make([]string, 0)
+			if props.Command != nil {
+				commands = *props.Command
+			}
+			initContainerConfig["commands"] = commands
+
+			var volumesConfig *[]interface{}
+			if hasConfig {
+				if v, ok := initContainersConfig[index].(map[string]interface{})["volume"].([]interface{}); ok {
+					volumesConfig = &v
+				}
+			}
+			initContainerConfig["volume"] = flattenContainerVolumes(props.VolumeMounts, containerGroupVolumes, volumesConfig)
+		}
+
+		output = append(output, initContainerConfig)
+	}
+
+	return output
+}
+
+func flattenContainerEnvironmentVariables(input *[]containerinstance.EnvironmentVariable, isSecure bool, d *pluginsdk.ResourceData, containerKey string, oldContainerIndex int) map[string]interface{} {
 	output := make(map[string]interface{})
 
 	if input == nil {
@@ -1434,7 +1783,7 @@ func flattenContainerEnvironmentVariables(input *[]containerinstance.Environment
 	if isSecure {
 		for _, envVar := range *input {
 			if envVar.Name != nil && envVar.Value == nil {
-				envVarValue := d.Get(fmt.Sprintf("container.%d.secure_environment_variables.%s", oldContainerIndex, *envVar.Name))
+				envVarValue := d.Get(fmt.Sprintf("%s.%d.secure_environment_variables.%s", containerKey, oldContainerIndex, *envVar.Name))
 				output[*envVar.Name] = envVarValue
 			}
 		}
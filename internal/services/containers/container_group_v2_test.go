@@ -0,0 +1,219 @@
+package containers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2019-12-01/containerinstance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/containergroups"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpandContainerGroupV2(t *testing.T) {
+	subnetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	d := schema.TestResourceDataRaw(t, resourceContainerGroup().Schema, map[string]interface{}{
+		"priority":   "Spot",
+		"subnet_ids": []interface{}{subnetId},
+	})
+
+	input := containerinstance.ContainerGroup{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"environment": utils.String("test"),
+		},
+		ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
+			OsType:        containerinstance.Linux,
+			RestartPolicy: containerinstance.Never,
+			Containers: &[]containerinstance.Container{
+				{
+					Name: utils.String("app"),
+					ContainerProperties: &containerinstance.ContainerProperties{
+						Image: utils.String("mcr.microsoft.com/azuredocs/aci-helloworld:latest"),
+						Resources: &containerinstance.ResourceRequirements{
+							Requests: &containerinstance.ResourceRequests{
+								CPU:        utils.Float(0.5),
+								MemoryInGB: utils.Float(1.5),
+							},
+						},
+						VolumeMounts: &[]containerinstance.VolumeMount{
+							{
+								Name:      utils.String("scratch"),
+								MountPath: utils.String("/scratch"),
+							},
+						},
+					},
+				},
+			},
+			InitContainers: &[]containerinstance.InitContainerDefinition{
+				{
+					Name: utils.String("init"),
+					InitContainerPropertiesDefinition: &containerinstance.InitContainerPropertiesDefinition{
+						Image:   utils.String("busybox"),
+						Command: &[]string{"echo", "hello"},
+						EnvironmentVariables: &[]containerinstance.EnvironmentVariable{
+							{
+								Name:        utils.String("SECRET"),
+								SecureValue: utils.String("value"),
+							},
+						},
+					},
+				},
+			},
+			Volumes: &[]containerinstance.Volume{
+				{
+					Name:     utils.String("scratch"),
+					EmptyDir: map[string]string{},
+				},
+			},
+		},
+	}
+
+	actual, err := expandContainerGroupV2(d, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if actual.Location == nil || *actual.Location != "westeurope" {
+		t.Fatalf("Expected the `location` to be `westeurope` but got %+v", actual.Location)
+	}
+	if actual.Tags == nil || (*actual.Tags)["environment"] != "test" {
+		t.Fatalf("Expected the `tags` to be retained but got %+v", actual.Tags)
+	}
+
+	props := actual.Properties
+	if props.OsType != containergroups.OperatingSystemTypesLinux {
+		t.Fatalf("Expected the `osType` to be %q but got %q", containergroups.OperatingSystemTypesLinux, props.OsType)
+	}
+	if props.RestartPolicy == nil || *props.RestartPolicy != containergroups.ContainerGroupRestartPolicyNever {
+		t.Fatalf("Expected the `restartPolicy` to be %q but got %+v", containergroups.ContainerGroupRestartPolicyNever, props.RestartPolicy)
+	}
+	if props.Priority == nil || *props.Priority != containergroups.ContainerGroupPrioritySpot {
+		t.Fatalf("Expected the `priority` to be %q but got %+v", containergroups.ContainerGroupPrioritySpot, props.Priority)
+	}
+	if props.Sku == nil || *props.Sku != containergroups.ContainerGroupSkuStandard {
+		t.Fatalf("Expected the `sku` to be %q but got %+v", containergroups.ContainerGroupSkuStandard, props.Sku)
+	}
+
+	expectedSubnetIds := []containergroups.ContainerGroupSubnetId{{Id: subnetId}}
+	if props.SubnetIds == nil || !reflect.DeepEqual(*props.SubnetIds, expectedSubnetIds) {
+		t.Fatalf("Expected the `subnetIds` to be %+v but got %+v", expectedSubnetIds, props.SubnetIds)
+	}
+
+	expectedContainers := []containergroups.Container{
+		{
+			Name: "app",
+			Properties: containergroups.ContainerProperties{
+				Image: "mcr.microsoft.com/azuredocs/aci-helloworld:latest",
+				Resources: containergroups.ResourceRequirements{
+					Requests: containergroups.ResourceRequests{
+						Cpu:        0.5,
+						MemoryInGB: 1.5,
+					},
+				},
+				VolumeMounts: &[]containergroups.VolumeMount{
+					{
+						Name:      "scratch",
+						MountPath: "/scratch",
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(props.Containers, expectedContainers) {
+		t.Fatalf("Expected the `containers` to be %+v but got %+v", expectedContainers, props.Containers)
+	}
+
+	expectedInitContainers := []containergroups.InitContainerDefinition{
+		{
+			Name: "init",
+			Properties: containergroups.InitContainerPropertiesDefinition{
+				Image:   utils.String("busybox"),
+				Command: &[]string{"echo", "hello"},
+				EnvironmentVariables: &[]containergroups.EnvironmentVariable{
+					{
+						Name:        "SECRET",
+						SecureValue: utils.String("value"),
+					},
+				},
+			},
+		},
+	}
+	if props.InitContainers == nil || !reflect.DeepEqual(*props.InitContainers, expectedInitContainers) {
+		t.Fatalf("Expected the `initContainers` to be %+v but got %+v", expectedInitContainers, props.InitContainers)
+	}
+
+	if props.Volumes == nil || len(*props.Volumes) != 1 || (*props.Volumes)[0].EmptyDir == nil {
+		t.Fatalf("Expected the `emptyDir` volume to be retained but got %+v", props.Volumes)
+	}
+}
+
+func TestFlattenContainerGroupInitContainers(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceContainerGroup().Schema, map[string]interface{}{
+		"init_container": []interface{}{
+			map[string]interface{}{
+				"name":  "configured",
+				"image": "busybox",
+				"secure_environment_variables": map[string]interface{}{
+					"SECRET": "configured-value",
+				},
+			},
+		},
+	})
+
+	secureEnvironmentVariables := &[]containerinstance.EnvironmentVariable{
+		{
+			Name:        utils.String("SECRET"),
+			SecureValue: nil,
+		},
+	}
+	input := &[]containerinstance.InitContainerDefinition{
+		{
+			Name: utils.String("unknown"),
+			InitContainerPropertiesDefinition: &containerinstance.InitContainerPropertiesDefinition{
+				Image:                utils.String("busybox"),
+				EnvironmentVariables: secureEnvironmentVariables,
+			},
+		},
+		{
+			Name: utils.String("configured"),
+			InitContainerPropertiesDefinition: &containerinstance.InitContainerPropertiesDefinition{
+				Image:                utils.String("busybox"),
+				EnvironmentVariables: secureEnvironmentVariables,
+			},
+		},
+	}
+
+	actual := flattenContainerGroupInitContainers(d, input, nil)
+	if len(actual) != 2 {
+		t.Fatalf("Expected 2 init containers but got %d", len(actual))
+	}
+
+	cases := []struct {
+		Name     string
+		Index    int
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "init container not in the config",
+			Index:    0,
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name:  "init container in the config",
+			Index: 1,
+			Expected: map[string]interface{}{
+				"SECRET": "configured-value",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			secure := actual[tc.Index].(map[string]interface{})["secure_environment_variables"]
+			if !reflect.DeepEqual(secure, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, secure)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryAgentPoolResource{}
+
+type ContainerRegistryAgentPoolResource struct{}
+
+type ContainerRegistryAgentPoolModel struct {
+	Name                   string                 `tfschema:"name"`
+	ResourceGroupName      string                 `tfschema:"resource_group_name"`
+	ContainerRegistryName  string                 `tfschema:"container_registry_name"`
+	Location               string                 `tfschema:"location"`
+	InstanceCount          int                    `tfschema:"instance_count"`
+	Tier                   string                 `tfschema:"tier"`
+	VirtualNetworkSubnetId string                 `tfschema:"virtual_network_subnet_id"`
+	Tags                   map[string]interface{} `tfschema:"tags"`
+}
+
+func (r ContainerRegistryAgentPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ContainerRegistryAgentPoolName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"container_registry_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ContainerRegistryName,
+		},
+
+		"location": location.Schema(),
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"tier": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "S1",
+			ValidateFunc: validation.StringInSlice([]string{
+				"S1",
+				"S2",
+				"S3",
+				"I6",
+			}, false),
+		},
+
+		"virtual_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryAgentPoolResource) ModelObject() interface{} {
+	return &ContainerRegistryAgentPoolModel{}
+}
+
+func (r ContainerRegistryAgentPoolResource) ResourceType() string {
+	return "azurerm_container_registry_agent_pool"
+}
+
+func (r ContainerRegistryAgentPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerValidate.ContainerRegistryAgentPoolID
+}
+
+func (r ContainerRegistryAgentPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryAgentPoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerRegistryAgentPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewContainerRegistryAgentPoolID(subscriptionId, model.ResourceGroupName, model.ContainerRegistryName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := containerregistry.AgentPool{
+				Location: utils.String(location.Normalize(model.Location)),
+				AgentPoolProperties: &containerregistry.AgentPoolProperties{
+					Count: utils.Int32(int32(model.InstanceCount)),
+					Tier:  utils.String(model.Tier),
+					Os:    containerregistry.Linux,
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+			if model.VirtualNetworkSubnetId != "" {
+				parameters.AgentPoolProperties.VirtualNetworkSubnetResourceID = utils.String(model.VirtualNetworkSubnetId)
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName, parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryAgentPoolsClient
+
+			id, err := parse.ContainerRegistryAgentPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryAgentPoolModel{
+				Name:                  id.AgentPoolName,
+				ResourceGroupName:     id.ResourceGroup,
+				ContainerRegistryName: id.RegistryName,
+				Location:              location.NormalizeNilable(resp.Location),
+				Tags:                  tags.Flatten(resp.Tags),
+			}
+
+			if props := resp.AgentPoolProperties; props != nil {
+				if props.Count != nil {
+					state.InstanceCount = int(*props.Count)
+				}
+				state.Tier = utils.NormalizeNilableString(props.Tier)
+				state.VirtualNetworkSubnetId = utils.NormalizeNilableString(props.VirtualNetworkSubnetResourceID)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryAgentPoolsClient
+
+			id, err := parse.ContainerRegistryAgentPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryAgentPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := containerregistry.AgentPoolUpdateParameters{}
+			if metadata.ResourceData.HasChange("instance_count") {
+				parameters.AgentPoolPropertiesUpdateParameters = &containerregistry.AgentPoolPropertiesUpdateParameters{
+					Count: utils.Int32(int32(model.InstanceCount)),
+				}
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = tags.Expand(model.Tags)
+			}
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName, parameters)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryAgentPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryAgentPoolsClient
+
+			id, err := parse.ContainerRegistryAgentPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,347 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-11-01-preview/connectedregistries"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryConnectedRegistryResource{}
+
+type ContainerRegistryConnectedRegistryResource struct{}
+
+type ContainerRegistryConnectedRegistryModel struct {
+	Name                string   `tfschema:"name"`
+	ContainerRegistryId string   `tfschema:"container_registry_id"`
+	ParentRegistryId    string   `tfschema:"parent_registry_id"`
+	SyncTokenId         string   `tfschema:"sync_token_id"`
+	SyncSchedule        string   `tfschema:"sync_schedule"`
+	SyncMessageTtl      string   `tfschema:"sync_message_ttl"`
+	SyncWindow          string   `tfschema:"sync_window"`
+	Mode                string   `tfschema:"mode"`
+	ClientTokenIds      []string `tfschema:"client_token_ids"`
+	LogLevel            string   `tfschema:"log_level"`
+	AuditLogEnabled     bool     `tfschema:"audit_log_enabled"`
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]{5,50}$`), "`name` must be between 5 and 50 characters and may only contain alpha numeric characters"),
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"sync_token_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ContainerRegistryTokenID,
+		},
+
+		"parent_registry_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				registries.ValidateRegistryID,
+				connectedregistries.ValidateConnectedRegistryID,
+			),
+		},
+
+		"mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(connectedregistries.ConnectedRegistryModeReadWrite),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(connectedregistries.ConnectedRegistryModeMirror),
+				string(connectedregistries.ConnectedRegistryModeReadOnly),
+				string(connectedregistries.ConnectedRegistryModeReadWrite),
+				string(connectedregistries.ConnectedRegistryModeRegistry),
+			}, false),
+		},
+
+		"sync_schedule": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "* * * * *",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sync_message_ttl": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "P1D",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sync_window": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"client_token_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: containerValidate.ContainerRegistryTokenID,
+			},
+		},
+
+		"log_level": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(connectedregistries.LogLevelNone),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(connectedregistries.LogLevelDebug),
+				string(connectedregistries.LogLevelError),
+				string(connectedregistries.LogLevelInformation),
+				string(connectedregistries.LogLevelNone),
+				string(connectedregistries.LogLevelWarning),
+			}, false),
+		},
+
+		"audit_log_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) ModelObject() interface{} {
+	return &ContainerRegistryConnectedRegistryModel{}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) ResourceType() string {
+	return "azurerm_container_registry_connected_registry"
+}
+
+func (r ContainerRegistryConnectedRegistryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectedregistries.ValidateConnectedRegistryID
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryConnectedRegistriesClient
+
+			var model ContainerRegistryConnectedRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := connectedregistries.NewConnectedRegistryID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			auditLogStatus := connectedregistries.AuditLogStatusDisabled
+			if model.AuditLogEnabled {
+				auditLogStatus = connectedregistries.AuditLogStatusEnabled
+			}
+			logLevel := connectedregistries.LogLevel(model.LogLevel)
+
+			parameters := connectedregistries.ConnectedRegistry{
+				Properties: &connectedregistries.ConnectedRegistryProperties{
+					ClientTokenIds: &model.ClientTokenIds,
+					Logging: &connectedregistries.LoggingProperties{
+						AuditLogStatus: &auditLogStatus,
+						LogLevel:       &logLevel,
+					},
+					Mode: connectedregistries.ConnectedRegistryMode(model.Mode),
+					Parent: connectedregistries.ParentProperties{
+						SyncProperties: connectedregistries.SyncProperties{
+							MessageTtl: model.SyncMessageTtl,
+							Schedule:   utils.String(model.SyncSchedule),
+							TokenId:    model.SyncTokenId,
+						},
+					},
+				},
+			}
+
+			// when the parent is the Container Registry itself, the parent ID is omitted
+			if model.ParentRegistryId != "" && model.ParentRegistryId != registryId.ID() {
+				parameters.Properties.Parent.Id = utils.String(model.ParentRegistryId)
+			}
+			if model.SyncWindow != "" {
+				parameters.Properties.Parent.SyncProperties.SyncWindow = utils.String(model.SyncWindow)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryConnectedRegistriesClient
+
+			id, err := connectedregistries.ParseConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryConnectedRegistryModel{
+				Name:                id.ConnectedRegistryName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.Mode = string(props.Mode)
+
+				if props.ClientTokenIds != nil {
+					state.ClientTokenIds = *props.ClientTokenIds
+				}
+
+				if logging := props.Logging; logging != nil {
+					state.AuditLogEnabled = logging.AuditLogStatus != nil && *logging.AuditLogStatus == connectedregistries.AuditLogStatusEnabled
+					if logging.LogLevel != nil {
+						state.LogLevel = string(*logging.LogLevel)
+					}
+				}
+
+				parent := props.Parent
+				if parent.Id != nil {
+					state.ParentRegistryId = *parent.Id
+				} else {
+					// the parent ID is omitted when the parent is the Container Registry itself, so this is retained from the config
+					state.ParentRegistryId = metadata.ResourceData.Get("parent_registry_id").(string)
+				}
+
+				state.SyncTokenId = parent.SyncProperties.TokenId
+				state.SyncMessageTtl = parent.SyncProperties.MessageTtl
+				state.SyncSchedule = utils.NormalizeNilableString(parent.SyncProperties.Schedule)
+				state.SyncWindow = utils.NormalizeNilableString(parent.SyncProperties.SyncWindow)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryConnectedRegistriesClient
+
+			id, err := connectedregistries.ParseConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryConnectedRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := connectedregistries.ConnectedRegistryUpdateProperties{}
+
+			if metadata.ResourceData.HasChange("client_token_ids") {
+				props.ClientTokenIds = &model.ClientTokenIds
+			}
+
+			if metadata.ResourceData.HasChanges("audit_log_enabled", "log_level") {
+				auditLogStatus := connectedregistries.AuditLogStatusDisabled
+				if model.AuditLogEnabled {
+					auditLogStatus = connectedregistries.AuditLogStatusEnabled
+				}
+				logLevel := connectedregistries.LogLevel(model.LogLevel)
+				props.Logging = &connectedregistries.LoggingProperties{
+					AuditLogStatus: &auditLogStatus,
+					LogLevel:       &logLevel,
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("sync_message_ttl", "sync_schedule", "sync_window") {
+				props.SyncProperties = &connectedregistries.SyncUpdateProperties{
+					MessageTtl: utils.String(model.SyncMessageTtl),
+					Schedule:   utils.String(model.SyncSchedule),
+					SyncWindow: utils.String(model.SyncWindow),
+				}
+			}
+
+			parameters := connectedregistries.ConnectedRegistryUpdateParameters{
+				Properties: &props,
+			}
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryConnectedRegistriesClient
+
+			id, err := connectedregistries.ParseConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
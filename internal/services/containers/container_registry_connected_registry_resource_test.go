@@ -0,0 +1,212 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-11-01-preview/connectedregistries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryConnectedRegistryResource struct{}
+
+func TestAccContainerRegistryConnectedRegistry_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryConnectedRegistry_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryConnectedRegistry_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryConnectedRegistry_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryConnectedRegistryResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectedregistries.ParseConnectedRegistryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryConnectedRegistriesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerRegistryConnectedRegistryResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_connected_registry" "test" {
+  name                  = "testacccr%d"
+  container_registry_id = azurerm_container_registry.test.id
+  sync_token_id         = azurerm_container_registry_token.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryConnectedRegistryResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_connected_registry" "import" {
+  name                  = azurerm_container_registry_connected_registry.test.name
+  container_registry_id = azurerm_container_registry_connected_registry.test.container_registry_id
+  sync_token_id         = azurerm_container_registry_connected_registry.test.sync_token_id
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryConnectedRegistryResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_scope_map" "client" {
+  name                    = "testacccrclient%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  actions = [
+    "repositories/hello-world/content/read",
+    "repositories/hello-world/metadata/read",
+  ]
+}
+
+resource "azurerm_container_registry_token" "client" {
+  name                    = "testacccrclient%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  scope_map_id            = azurerm_container_registry_scope_map.client.id
+}
+
+resource "azurerm_container_registry_connected_registry" "test" {
+  name                  = "testacccr%d"
+  container_registry_id = azurerm_container_registry.test.id
+  sync_token_id         = azurerm_container_registry_token.test.id
+  sync_schedule         = "0 12 * * *"
+  sync_window           = "PT3H"
+  sync_message_ttl      = "P2D"
+  client_token_ids      = [azurerm_container_registry_token.client.id]
+  log_level             = "Debug"
+  audit_log_enabled     = true
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (ContainerRegistryConnectedRegistryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                  = "testacccr%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  sku                   = "Premium"
+  data_endpoint_enabled = true
+}
+
+resource "azurerm_container_registry_scope_map" "test" {
+  name                    = "testacccrsync%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  actions = [
+    "repositories/hello-world/content/delete",
+    "repositories/hello-world/content/read",
+    "repositories/hello-world/content/write",
+    "repositories/hello-world/metadata/read",
+    "repositories/hello-world/metadata/write",
+    "gateway/testacccr%d/config/read",
+    "gateway/testacccr%d/config/write",
+    "gateway/testacccr%d/message/read",
+    "gateway/testacccr%d/message/write",
+  ]
+}
+
+resource "azurerm_container_registry_token" "test" {
+  name                    = "testacccrsync%d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  scope_map_id            = azurerm_container_registry_scope_map.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,322 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/credentialsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/registries"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryCredentialSetResource{}
+
+type ContainerRegistryCredentialSetResource struct{}
+
+type ContainerRegistryCredentialSetModel struct {
+	Name                     string                                                   `tfschema:"name"`
+	ContainerRegistryId      string                                                   `tfschema:"container_registry_id"`
+	LoginServer              string                                                   `tfschema:"login_server"`
+	AuthenticationCredential []ContainerRegistryCredentialSetAuthenticationCredential `tfschema:"authentication_credentials"`
+	Identity                 []ContainerRegistryCredentialSetIdentityModel            `tfschema:"identity"`
+}
+
+type ContainerRegistryCredentialSetAuthenticationCredential struct {
+	UsernameSecretId string `tfschema:"username_secret_id"`
+	PasswordSecretId string `tfschema:"password_secret_id"`
+}
+
+type ContainerRegistryCredentialSetIdentityModel struct {
+	Type        string `tfschema:"type"`
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+}
+
+func (r ContainerRegistryCredentialSetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{5,50}$`), "`name` must be between 5 and 50 characters and may only contain alpha numeric characters and hyphens"),
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"login_server": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authentication_credentials": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"username_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+
+					"password_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+				},
+			},
+		},
+
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(credentialsets.ResourceIdentityTypeSystemAssigned),
+						}, false),
+					},
+
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryCredentialSetResource) ModelObject() interface{} {
+	return &ContainerRegistryCredentialSetModel{}
+}
+
+func (r ContainerRegistryCredentialSetResource) ResourceType() string {
+	return "azurerm_container_registry_credential_set"
+}
+
+func (r ContainerRegistryCredentialSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return credentialsets.ValidateCredentialSetID
+}
+
+func (r ContainerRegistryCredentialSetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryCredentialSetsClient
+
+			var model ContainerRegistryCredentialSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := credentialsets.NewCredentialSetID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := credentialsets.CredentialSet{
+				Identity: expandContainerRegistryCredentialSetIdentity(model.Identity),
+				Properties: &credentialsets.CredentialSetProperties{
+					AuthCredentials: expandContainerRegistryCredentialSetAuthCredentials(model.AuthenticationCredential),
+					LoginServer:     utils.String(model.LoginServer),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryCredentialSetsClient
+
+			id, err := credentialsets.ParseCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryCredentialSetModel{
+				Name:                id.CredentialSetName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Identity = flattenContainerRegistryCredentialSetIdentity(model.Identity)
+
+				if props := model.Properties; props != nil {
+					state.LoginServer = utils.NormalizeNilableString(props.LoginServer)
+					state.AuthenticationCredential = flattenContainerRegistryCredentialSetAuthCredentials(props.AuthCredentials)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryCredentialSetsClient
+
+			id, err := credentialsets.ParseCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryCredentialSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := credentialsets.CredentialSetUpdateParameters{
+				Properties: &credentialsets.CredentialSetUpdateProperties{},
+			}
+			if metadata.ResourceData.HasChange("authentication_credentials") {
+				parameters.Properties.AuthCredentials = expandContainerRegistryCredentialSetAuthCredentials(model.AuthenticationCredential)
+			}
+			if metadata.ResourceData.HasChange("identity") {
+				parameters.Identity = expandContainerRegistryCredentialSetIdentity(model.Identity)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryCredentialSetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryCredentialSetsClient
+
+			id, err := credentialsets.ParseCredentialSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryCredentialSetAuthCredentials(input []ContainerRegistryCredentialSetAuthenticationCredential) *[]credentialsets.AuthCredential {
+	output := make([]credentialsets.AuthCredential, 0)
+	for _, v := range input {
+		// the API only supports a single set of credentials, which must be named `Credential1`
+		name := credentialsets.CredentialNameCredentialOne
+		output = append(output, credentialsets.AuthCredential{
+			Name:                     &name,
+			UsernameSecretIdentifier: utils.String(v.UsernameSecretId),
+			PasswordSecretIdentifier: utils.String(v.PasswordSecretId),
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerRegistryCredentialSetAuthCredentials(input *[]credentialsets.AuthCredential) []ContainerRegistryCredentialSetAuthenticationCredential {
+	output := make([]ContainerRegistryCredentialSetAuthenticationCredential, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ContainerRegistryCredentialSetAuthenticationCredential{
+			UsernameSecretId: utils.NormalizeNilableString(v.UsernameSecretIdentifier),
+			PasswordSecretId: utils.NormalizeNilableString(v.PasswordSecretIdentifier),
+		})
+	}
+
+	return output
+}
+
+func expandContainerRegistryCredentialSetIdentity(input []ContainerRegistryCredentialSetIdentityModel) *credentialsets.IdentityProperties {
+	identityType := credentialsets.ResourceIdentityTypeNone
+	if len(input) > 0 {
+		identityType = credentialsets.ResourceIdentityType(input[0].Type)
+	}
+
+	return &credentialsets.IdentityProperties{
+		Type: &identityType,
+	}
+}
+
+func flattenContainerRegistryCredentialSetIdentity(input *credentialsets.IdentityProperties) []ContainerRegistryCredentialSetIdentityModel {
+	if input == nil || input.Type == nil || *input.Type == credentialsets.ResourceIdentityTypeNone {
+		return []ContainerRegistryCredentialSetIdentityModel{}
+	}
+
+	return []ContainerRegistryCredentialSetIdentityModel{
+		{
+			Type:        string(*input.Type),
+			PrincipalId: utils.NormalizeNilableString(input.PrincipalId),
+			TenantId:    utils.NormalizeNilableString(input.TenantId),
+		},
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-07-01/registries"
 	validate2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	identityParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
@@ -347,6 +348,12 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"export_policy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"network_rule_bypass_option": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -413,6 +420,16 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				return fmt.Errorf("`data_endpoint_enabled` can only be applied when using the Premium Sku")
 			}
 
+			// disabling the export policy is only available for Premium Sku, and requires public network access to be disabled.
+			if !d.Get("export_policy_enabled").(bool) {
+				if !strings.EqualFold(sku, string(containerregistry.Premium)) {
+					return fmt.Errorf("`export_policy_enabled` can only be disabled when using the Premium Sku")
+				}
+				if d.Get("public_network_access_enabled").(bool) {
+					return fmt.Errorf("`public_network_access_enabled` must be set to `false` when `export_policy_enabled` is disabled")
+				}
+			}
+
 			return nil
 		}),
 	}
@@ -539,6 +556,13 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	// the export policy is enabled by default, so this only needs to be applied when it's been disabled
+	if !d.Get("export_policy_enabled").(bool) {
+		if err := applyContainerRegistryExportPolicy(d, meta, resourceGroup, name, false); err != nil {
+			return fmt.Errorf("applying export policy for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -654,6 +678,12 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("waiting for update of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if d.HasChange("export_policy_enabled") {
+		if err := applyContainerRegistryExportPolicy(d, meta, resourceGroup, name, d.Get("export_policy_enabled").(bool)); err != nil {
+			return fmt.Errorf("applying export policy for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	// downgrade to Basic or Standard SKU
 	if skuChange && (isBasicSku || isStandardSku) {
 		if err := applyContainerRegistrySku(d, meta, sku, resourceGroup, name); err != nil {
@@ -699,6 +729,46 @@ func applyContainerRegistrySku(d *pluginsdk.ResourceData, meta interface{}, sku
 	return nil
 }
 
+// applyContainerRegistryExportPolicy sets the export policy using a newer API version, since this isn't
+// available in the API version used for the rest of the Container Registry
+func applyContainerRegistryExportPolicy(d *pluginsdk.ResourceData, meta interface{}, resourceGroup string, name string, enabled bool) error {
+	client := meta.(*clients.Client).Containers.RegistriesV2Client
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := registries.NewRegistryID(subscriptionId, resourceGroup, name)
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// the other policies are retrieved so that these are retained when the policies are updated
+	policies := registries.Policies{}
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Policies != nil {
+		policies = *model.Properties.Policies
+	}
+
+	status := registries.ExportPolicyStatusEnabled
+	if !enabled {
+		status = registries.ExportPolicyStatusDisabled
+	}
+	policies.ExportPolicy = &registries.ExportPolicy{
+		Status: &status,
+	}
+
+	parameters := registries.RegistryUpdateParameters{
+		Properties: &registries.RegistryPropertiesUpdateParameters{
+			Policies: &policies,
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("updating the export policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func applyGeoReplicationLocations(d *pluginsdk.ResourceData, meta interface{}, resourceGroup string, name string, oldGeoReplications []containerregistry.Replication, newGeoReplications []containerregistry.Replication) error {
 	replicationClient := meta.(*clients.Client).Containers.ReplicationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -802,6 +872,19 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("network_rule_bypass_option", string(properties.NetworkRuleBypassOptions))
 	}
 
+	// the export policy is only available in a newer API version, so needs to be retrieved separately
+	exportPolicyEnabled := true
+	registry, err := meta.(*clients.Client).Containers.RegistriesV2Client.Get(ctx, registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving export policy for Container Registry %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	if model := registry.Model; model != nil && model.Properties != nil && model.Properties.Policies != nil {
+		if policy := model.Properties.Policies.ExportPolicy; policy != nil && policy.Status != nil {
+			exportPolicyEnabled = *policy.Status == registries.ExportPolicyStatusEnabled
+		}
+	}
+	d.Set("export_policy_enabled", exportPolicyEnabled)
+
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku", string(sku.Tier))
 	}
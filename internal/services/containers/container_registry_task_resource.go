@@ -0,0 +1,1438 @@
+package containers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	identityValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryTaskResource{}
+
+type ContainerRegistryTaskResource struct{}
+
+type ContainerRegistryTaskModel struct {
+	Name                string                                    `tfschema:"name"`
+	ContainerRegistryId string                                    `tfschema:"container_registry_id"`
+	AgentPoolName       string                                    `tfschema:"agent_pool_name"`
+	AgentSetting        []ContainerRegistryTaskAgentSetting       `tfschema:"agent_setting"`
+	Enabled             bool                                      `tfschema:"enabled"`
+	Platform            []ContainerRegistryTaskPlatform           `tfschema:"platform"`
+	DockerStep          []ContainerRegistryTaskDockerStep         `tfschema:"docker_step"`
+	FileStep            []ContainerRegistryTaskFileStep           `tfschema:"file_step"`
+	EncodedStep         []ContainerRegistryTaskEncodedStep        `tfschema:"encoded_step"`
+	BaseImageTrigger    []ContainerRegistryTaskBaseImageTrigger   `tfschema:"base_image_trigger"`
+	SourceTrigger       []ContainerRegistryTaskSourceTrigger      `tfschema:"source_trigger"`
+	TimerTrigger        []ContainerRegistryTaskTimerTrigger       `tfschema:"timer_trigger"`
+	RegistryCredential  []ContainerRegistryTaskRegistryCredential `tfschema:"registry_credential"`
+	LogTemplate         string                                    `tfschema:"log_template"`
+	IsSystemTask        bool                                      `tfschema:"is_system_task"`
+	TimeoutInSeconds    int                                       `tfschema:"timeout_in_seconds"`
+	Tags                map[string]interface{}                    `tfschema:"tags"`
+}
+
+type ContainerRegistryTaskAgentSetting struct {
+	CPU int `tfschema:"cpu"`
+}
+
+type ContainerRegistryTaskPlatform struct {
+	OS           string `tfschema:"os"`
+	Architecture string `tfschema:"architecture"`
+	Variant      string `tfschema:"variant"`
+}
+
+type ContainerRegistryTaskDockerStep struct {
+	DockerfilePath     string            `tfschema:"dockerfile_path"`
+	ContextPath        string            `tfschema:"context_path"`
+	ContextAccessToken string            `tfschema:"context_access_token"`
+	ImageNames         []string          `tfschema:"image_names"`
+	CacheEnabled       bool              `tfschema:"cache_enabled"`
+	PushEnabled        bool              `tfschema:"push_enabled"`
+	Target             string            `tfschema:"target"`
+	Arguments          map[string]string `tfschema:"arguments"`
+	SecretArguments    map[string]string `tfschema:"secret_arguments"`
+}
+
+type ContainerRegistryTaskFileStep struct {
+	TaskFilePath       string            `tfschema:"task_file_path"`
+	ValueFilePath      string            `tfschema:"value_file_path"`
+	ContextPath        string            `tfschema:"context_path"`
+	ContextAccessToken string            `tfschema:"context_access_token"`
+	Values             map[string]string `tfschema:"values"`
+	SecretValues       map[string]string `tfschema:"secret_values"`
+}
+
+type ContainerRegistryTaskEncodedStep struct {
+	TaskContent        string            `tfschema:"task_content"`
+	ValueContent       string            `tfschema:"value_content"`
+	ContextPath        string            `tfschema:"context_path"`
+	ContextAccessToken string            `tfschema:"context_access_token"`
+	Values             map[string]string `tfschema:"values"`
+	SecretValues       map[string]string `tfschema:"secret_values"`
+}
+
+type ContainerRegistryTaskBaseImageTrigger struct {
+	Name                     string `tfschema:"name"`
+	Type                     string `tfschema:"type"`
+	Enabled                  bool   `tfschema:"enabled"`
+	UpdateTriggerEndpoint    string `tfschema:"update_trigger_endpoint"`
+	UpdateTriggerPayloadType string `tfschema:"update_trigger_payload_type"`
+}
+
+type ContainerRegistryTaskSourceTrigger struct {
+	Name           string                                       `tfschema:"name"`
+	Events         []string                                     `tfschema:"events"`
+	RepositoryURL  string                                       `tfschema:"repository_url"`
+	SourceType     string                                       `tfschema:"source_type"`
+	Branch         string                                       `tfschema:"branch"`
+	Authentication []ContainerRegistryTaskSourceTriggerAuthInfo `tfschema:"authentication"`
+	Enabled        bool                                         `tfschema:"enabled"`
+}
+
+type ContainerRegistryTaskSourceTriggerAuthInfo struct {
+	Token           string `tfschema:"token"`
+	TokenType       string `tfschema:"token_type"`
+	RefreshToken    string `tfschema:"refresh_token"`
+	Scope           string `tfschema:"scope"`
+	ExpireInSeconds int    `tfschema:"expire_in_seconds"`
+}
+
+type ContainerRegistryTaskTimerTrigger struct {
+	Name     string `tfschema:"name"`
+	Schedule string `tfschema:"schedule"`
+	Enabled  bool   `tfschema:"enabled"`
+}
+
+type ContainerRegistryTaskRegistryCredential struct {
+	Source []ContainerRegistryTaskSourceRegistryCredential `tfschema:"source"`
+	Custom []ContainerRegistryTaskCustomRegistryCredential `tfschema:"custom"`
+}
+
+type ContainerRegistryTaskSourceRegistryCredential struct {
+	LoginMode string `tfschema:"login_mode"`
+}
+
+type ContainerRegistryTaskCustomRegistryCredential struct {
+	LoginServer string `tfschema:"login_server"`
+	Username    string `tfschema:"username"`
+	Password    string `tfschema:"password"`
+	Identity    string `tfschema:"identity"`
+}
+
+func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]{5,50}$`), "`name` must be between 5 and 50 characters and may only contain alpha numeric characters, underscores and hyphens"),
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.RegistryID,
+		},
+
+		"platform": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"os": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerregistry.Linux),
+							string(containerregistry.Windows),
+						}, false),
+					},
+
+					"architecture": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerregistry.Amd64),
+							string(containerregistry.Arm),
+							string(containerregistry.Arm64),
+							string(containerregistry.ThreeEightSix),
+							string(containerregistry.X86),
+						}, false),
+					},
+
+					"variant": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerregistry.V6),
+							string(containerregistry.V7),
+							string(containerregistry.V8),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"docker_step": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"file_step", "encoded_step"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dockerfile_path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"context_path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"context_access_token": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"image_names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"cache_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"push_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"target": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"arguments": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"secret_arguments": {
+						Type:      pluginsdk.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"file_step": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"docker_step", "encoded_step"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"task_file_path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value_file_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"context_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"context_access_token": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"values": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"secret_values": {
+						Type:      pluginsdk.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"encoded_step": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"docker_step", "file_step"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"task_content": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value_content": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"context_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"context_access_token": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"values": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"secret_values": {
+						Type:      pluginsdk.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"base_image_trigger": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerregistry.All),
+							string(containerregistry.Runtime),
+						}, false),
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"update_trigger_endpoint": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"update_trigger_payload_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerregistry.UpdateTriggerPayloadTypeDefault),
+							string(containerregistry.UpdateTriggerPayloadTypeToken),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"source_trigger": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"events": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Commit),
+								string(containerregistry.Pullrequest),
+							}, false),
+						},
+					},
+
+					"repository_url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"source_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerregistry.Github),
+							string(containerregistry.VisualStudioTeamService),
+						}, false),
+					},
+
+					"branch": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"authentication": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"token": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"token_type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(containerregistry.PAT),
+										string(containerregistry.OAuth),
+									}, false),
+								},
+
+								"refresh_token": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"scope": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"expire_in_seconds": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+							},
+						},
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"timer_trigger": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"schedule": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(containerregistry.ResourceIdentityTypeSystemAssigned),
+							string(containerregistry.ResourceIdentityTypeUserAssigned),
+							string(containerregistry.ResourceIdentityTypeSystemAssignedUserAssigned),
+						}, false),
+					},
+
+					"identity_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: identityValidate.UserAssignedIdentityID,
+						},
+					},
+
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"registry_credential": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						AtLeastOneOf: []string{"registry_credential.0.source", "registry_credential.0.custom"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"login_mode": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(containerregistry.SourceRegistryLoginModeNone),
+										string(containerregistry.SourceRegistryLoginModeDefault),
+									}, false),
+								},
+							},
+						},
+					},
+
+					"custom": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"registry_credential.0.source", "registry_credential.0.custom"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"login_server": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"username": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"password": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"identity": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"agent_pool_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: containerValidate.ContainerRegistryAgentPoolName,
+		},
+
+		"agent_setting": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"cpu": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"log_template": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"is_system_task": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3600,
+			ValidateFunc: validation.IntBetween(300, 28800),
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerRegistryTaskResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryTaskResource) ModelObject() interface{} {
+	return &ContainerRegistryTaskModel{}
+}
+
+func (r ContainerRegistryTaskResource) ResourceType() string {
+	return "azurerm_container_registry_task"
+}
+
+func (r ContainerRegistryTaskResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerValidate.ContainerRegistryTaskID
+}
+
+func (r ContainerRegistryTaskResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryTasksClient
+			registryClient := metadata.Client.Containers.RegistriesClient
+
+			var model ContainerRegistryTaskModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := parse.RegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerRegistryTaskID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			registry, err := registryClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *registryId, err)
+			}
+
+			parameters, err := expandContainerRegistryTask(metadata, model)
+			if err != nil {
+				return err
+			}
+			parameters.Location = utils.String(location.NormalizeNilable(registry.Location))
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.TaskName, *parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryTaskResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryTasksClient
+
+			id, err := parse.ContainerRegistryTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API doesn't return any of the secret values, so these are retrieved from the existing state
+			var config ContainerRegistryTaskModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ContainerRegistryTaskModel{
+				Name:                id.TaskName,
+				ContainerRegistryId: parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName).ID(),
+				Tags:                tags.Flatten(resp.Tags),
+			}
+
+			if props := resp.TaskProperties; props != nil {
+				state.AgentPoolName = utils.NormalizeNilableString(props.AgentPoolName)
+				state.Enabled = props.Status == containerregistry.TaskStatusEnabled
+				state.LogTemplate = utils.NormalizeNilableString(props.LogTemplate)
+				if props.IsSystemTask != nil {
+					state.IsSystemTask = *props.IsSystemTask
+				}
+				if props.Timeout != nil {
+					state.TimeoutInSeconds = int(*props.Timeout)
+				}
+
+				if agent := props.AgentConfiguration; agent != nil && agent.CPU != nil {
+					state.AgentSetting = []ContainerRegistryTaskAgentSetting{
+						{
+							CPU: int(*agent.CPU),
+						},
+					}
+				}
+
+				if platform := props.Platform; platform != nil {
+					state.Platform = []ContainerRegistryTaskPlatform{
+						{
+							OS:           string(platform.Os),
+							Architecture: string(platform.Architecture),
+							Variant:      string(platform.Variant),
+						},
+					}
+				}
+
+				if props.Step != nil {
+					if step, ok := props.Step.AsDockerBuildStep(); ok && step != nil {
+						state.DockerStep = flattenContainerRegistryTaskDockerStep(step, config.DockerStep)
+					}
+					if step, ok := props.Step.AsFileTaskStep(); ok && step != nil {
+						state.FileStep = flattenContainerRegistryTaskFileStep(step, config.FileStep)
+					}
+					if step, ok := props.Step.AsEncodedTaskStep(); ok && step != nil {
+						encodedStep, err := flattenContainerRegistryTaskEncodedStep(step, config.EncodedStep)
+						if err != nil {
+							return err
+						}
+						state.EncodedStep = encodedStep
+					}
+				}
+
+				if trigger := props.Trigger; trigger != nil {
+					state.BaseImageTrigger = flattenContainerRegistryTaskBaseImageTrigger(trigger.BaseImageTrigger)
+					state.SourceTrigger = flattenContainerRegistryTaskSourceTriggers(trigger.SourceTriggers, config.SourceTrigger)
+					state.TimerTrigger = flattenContainerRegistryTaskTimerTriggers(trigger.TimerTriggers)
+				}
+
+				state.RegistryCredential = flattenContainerRegistryTaskRegistryCredential(props.Credentials, config.RegistryCredential)
+			}
+
+			identity, err := flattenIdentityProperties(resp.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			if len(identity) > 0 && resp.Identity.Type == containerregistry.ResourceIdentityTypeNone {
+				identity = make([]interface{}, 0)
+			}
+			if err := metadata.ResourceData.Set("identity", identity); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryTasksClient
+
+			id, err := parse.ContainerRegistryTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryTaskModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			parameters, err := expandContainerRegistryTask(metadata, model)
+			if err != nil {
+				return err
+			}
+			parameters.Location = existing.Location
+
+			// the Task is replaced in full, since the PATCH endpoint requires a separate set of (Step) models
+			future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.TaskName, *parameters)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryTaskResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryTasksClient
+
+			id, err := parse.ContainerRegistryTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryTask(metadata sdk.ResourceMetaData, model ContainerRegistryTaskModel) (*containerregistry.Task, error) {
+	stepCount := len(model.DockerStep) + len(model.FileStep) + len(model.EncodedStep)
+	if model.IsSystemTask {
+		if stepCount > 0 || len(model.Platform) > 0 || len(model.BaseImageTrigger) > 0 || len(model.SourceTrigger) > 0 {
+			return nil, fmt.Errorf("`docker_step`, `file_step`, `encoded_step`, `platform`, `base_image_trigger` and `source_trigger` cannot be specified when `is_system_task` is `true`")
+		}
+	} else {
+		if stepCount == 0 {
+			return nil, fmt.Errorf("one of `docker_step`, `file_step` or `encoded_step` must be specified when `is_system_task` is `false`")
+		}
+		if len(model.Platform) == 0 {
+			return nil, fmt.Errorf("`platform` must be specified when `is_system_task` is `false`")
+		}
+	}
+
+	status := containerregistry.TaskStatusDisabled
+	if model.Enabled {
+		status = containerregistry.TaskStatusEnabled
+	}
+
+	props := containerregistry.TaskProperties{
+		Status:       status,
+		IsSystemTask: utils.Bool(model.IsSystemTask),
+		Timeout:      utils.Int32(int32(model.TimeoutInSeconds)),
+		Credentials:  expandContainerRegistryTaskRegistryCredential(model.RegistryCredential),
+	}
+
+	if model.AgentPoolName != "" {
+		props.AgentPoolName = utils.String(model.AgentPoolName)
+	}
+	if model.LogTemplate != "" {
+		props.LogTemplate = utils.String(model.LogTemplate)
+	}
+	if len(model.AgentSetting) > 0 {
+		props.AgentConfiguration = &containerregistry.AgentProperties{
+			CPU: utils.Int32(int32(model.AgentSetting[0].CPU)),
+		}
+	}
+	if len(model.Platform) > 0 {
+		platform := model.Platform[0]
+		props.Platform = &containerregistry.PlatformProperties{
+			Os:           containerregistry.OS(platform.OS),
+			Architecture: containerregistry.Architecture(platform.Architecture),
+			Variant:      containerregistry.Variant(platform.Variant),
+		}
+	}
+
+	switch {
+	case len(model.DockerStep) > 0:
+		props.Step = expandContainerRegistryTaskDockerStep(model.DockerStep[0])
+	case len(model.FileStep) > 0:
+		props.Step = expandContainerRegistryTaskFileStep(model.FileStep[0])
+	case len(model.EncodedStep) > 0:
+		props.Step = expandContainerRegistryTaskEncodedStep(model.EncodedStep[0])
+	}
+
+	if len(model.BaseImageTrigger) > 0 || len(model.SourceTrigger) > 0 || len(model.TimerTrigger) > 0 {
+		props.Trigger = &containerregistry.TriggerProperties{
+			BaseImageTrigger: expandContainerRegistryTaskBaseImageTrigger(model.BaseImageTrigger),
+			SourceTriggers:   expandContainerRegistryTaskSourceTriggers(model.SourceTrigger),
+			TimerTriggers:    expandContainerRegistryTaskTimerTriggers(model.TimerTrigger),
+		}
+	}
+
+	return &containerregistry.Task{
+		Identity:       expandIdentityProperties(metadata.ResourceData.Get("identity").([]interface{})),
+		TaskProperties: &props,
+		Tags:           tags.Expand(model.Tags),
+	}, nil
+}
+
+func expandContainerRegistryTaskArguments(values map[string]string, secretValues map[string]string) *[]containerregistry.Argument {
+	output := make([]containerregistry.Argument, 0)
+	for k, v := range values {
+		output = append(output, containerregistry.Argument{
+			Name:     utils.String(k),
+			Value:    utils.String(v),
+			IsSecret: utils.Bool(false),
+		})
+	}
+	for k, v := range secretValues {
+		output = append(output, containerregistry.Argument{
+			Name:     utils.String(k),
+			Value:    utils.String(v),
+			IsSecret: utils.Bool(true),
+		})
+	}
+	return &output
+}
+
+func expandContainerRegistryTaskSetValues(values map[string]string, secretValues map[string]string) *[]containerregistry.SetValue {
+	output := make([]containerregistry.SetValue, 0)
+	for k, v := range values {
+		output = append(output, containerregistry.SetValue{
+			Name:     utils.String(k),
+			Value:    utils.String(v),
+			IsSecret: utils.Bool(false),
+		})
+	}
+	for k, v := range secretValues {
+		output = append(output, containerregistry.SetValue{
+			Name:     utils.String(k),
+			Value:    utils.String(v),
+			IsSecret: utils.Bool(true),
+		})
+	}
+	return &output
+}
+
+func expandContainerRegistryTaskDockerStep(input ContainerRegistryTaskDockerStep) containerregistry.DockerBuildStep {
+	imageNames := input.ImageNames
+	step := containerregistry.DockerBuildStep{
+		DockerFilePath: utils.String(input.DockerfilePath),
+		ContextPath:    utils.String(input.ContextPath),
+		ImageNames:     &imageNames,
+		NoCache:        utils.Bool(!input.CacheEnabled),
+		IsPushEnabled:  utils.Bool(input.PushEnabled),
+		Arguments:      expandContainerRegistryTaskArguments(input.Arguments, input.SecretArguments),
+		Type:           containerregistry.TypeDocker,
+	}
+	if input.ContextAccessToken != "" {
+		step.ContextAccessToken = utils.String(input.ContextAccessToken)
+	}
+	if input.Target != "" {
+		step.Target = utils.String(input.Target)
+	}
+	return step
+}
+
+func expandContainerRegistryTaskFileStep(input ContainerRegistryTaskFileStep) containerregistry.FileTaskStep {
+	step := containerregistry.FileTaskStep{
+		TaskFilePath: utils.String(input.TaskFilePath),
+		Values:       expandContainerRegistryTaskSetValues(input.Values, input.SecretValues),
+		Type:         containerregistry.TypeFileTask,
+	}
+	if input.ValueFilePath != "" {
+		step.ValuesFilePath = utils.String(input.ValueFilePath)
+	}
+	if input.ContextPath != "" {
+		step.ContextPath = utils.String(input.ContextPath)
+	}
+	if input.ContextAccessToken != "" {
+		step.ContextAccessToken = utils.String(input.ContextAccessToken)
+	}
+	return step
+}
+
+func expandContainerRegistryTaskEncodedStep(input ContainerRegistryTaskEncodedStep) containerregistry.EncodedTaskStep {
+	step := containerregistry.EncodedTaskStep{
+		EncodedTaskContent: utils.String(base64.StdEncoding.EncodeToString([]byte(input.TaskContent))),
+		Values:             expandContainerRegistryTaskSetValues(input.Values, input.SecretValues),
+		Type:               containerregistry.TypeEncodedTask,
+	}
+	if input.ValueContent != "" {
+		step.EncodedValuesContent = utils.String(base64.StdEncoding.EncodeToString([]byte(input.ValueContent)))
+	}
+	if input.ContextPath != "" {
+		step.ContextPath = utils.String(input.ContextPath)
+	}
+	if input.ContextAccessToken != "" {
+		step.ContextAccessToken = utils.String(input.ContextAccessToken)
+	}
+	return step
+}
+
+func expandContainerRegistryTaskBaseImageTrigger(input []ContainerRegistryTaskBaseImageTrigger) *containerregistry.BaseImageTrigger {
+	if len(input) == 0 {
+		return nil
+	}
+
+	trigger := input[0]
+	status := containerregistry.TriggerStatusDisabled
+	if trigger.Enabled {
+		status = containerregistry.TriggerStatusEnabled
+	}
+
+	output := &containerregistry.BaseImageTrigger{
+		Name:                     utils.String(trigger.Name),
+		BaseImageTriggerType:     containerregistry.BaseImageTriggerType(trigger.Type),
+		Status:                   status,
+		UpdateTriggerPayloadType: containerregistry.UpdateTriggerPayloadType(trigger.UpdateTriggerPayloadType),
+	}
+	if trigger.UpdateTriggerEndpoint != "" {
+		output.UpdateTriggerEndpoint = utils.String(trigger.UpdateTriggerEndpoint)
+	}
+	return output
+}
+
+func expandContainerRegistryTaskSourceTriggers(input []ContainerRegistryTaskSourceTrigger) *[]containerregistry.SourceTrigger {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]containerregistry.SourceTrigger, 0)
+	for _, trigger := range input {
+		status := containerregistry.TriggerStatusDisabled
+		if trigger.Enabled {
+			status = containerregistry.TriggerStatusEnabled
+		}
+
+		events := make([]containerregistry.SourceTriggerEvent, 0)
+		for _, event := range trigger.Events {
+			events = append(events, containerregistry.SourceTriggerEvent(event))
+		}
+
+		source := &containerregistry.SourceProperties{
+			SourceControlType: containerregistry.SourceControlType(trigger.SourceType),
+			RepositoryURL:     utils.String(trigger.RepositoryURL),
+		}
+		if trigger.Branch != "" {
+			source.Branch = utils.String(trigger.Branch)
+		}
+		if len(trigger.Authentication) > 0 {
+			auth := trigger.Authentication[0]
+			source.SourceControlAuthProperties = &containerregistry.AuthInfo{
+				Token:     utils.String(auth.Token),
+				TokenType: containerregistry.TokenType(auth.TokenType),
+			}
+			if auth.RefreshToken != "" {
+				source.SourceControlAuthProperties.RefreshToken = utils.String(auth.RefreshToken)
+			}
+			if auth.Scope != "" {
+				source.SourceControlAuthProperties.Scope = utils.String(auth.Scope)
+			}
+			if auth.ExpireInSeconds != 0 {
+				source.SourceControlAuthProperties.ExpiresIn = utils.Int32(int32(auth.ExpireInSeconds))
+			}
+		}
+
+		output = append(output, containerregistry.SourceTrigger{
+			Name:                utils.String(trigger.Name),
+			SourceRepository:    source,
+			SourceTriggerEvents: &events,
+			Status:              status,
+		})
+	}
+	return &output
+}
+
+func expandContainerRegistryTaskTimerTriggers(input []ContainerRegistryTaskTimerTrigger) *[]containerregistry.TimerTrigger {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]containerregistry.TimerTrigger, 0)
+	for _, trigger := range input {
+		status := containerregistry.TriggerStatusDisabled
+		if trigger.Enabled {
+			status = containerregistry.TriggerStatusEnabled
+		}
+
+		output = append(output, containerregistry.TimerTrigger{
+			Name:     utils.String(trigger.Name),
+			Schedule: utils.String(trigger.Schedule),
+			Status:   status,
+		})
+	}
+	return &output
+}
+
+func expandContainerRegistryTaskRegistryCredential(input []ContainerRegistryTaskRegistryCredential) *containerregistry.Credentials {
+	if len(input) == 0 {
+		return nil
+	}
+
+	credential := input[0]
+	output := &containerregistry.Credentials{}
+
+	if len(credential.Source) > 0 {
+		output.SourceRegistry = &containerregistry.SourceRegistryCredentials{
+			LoginMode: containerregistry.SourceRegistryLoginMode(credential.Source[0].LoginMode),
+		}
+	}
+
+	if len(credential.Custom) > 0 {
+		customRegistries := make(map[string]*containerregistry.CustomRegistryCredentials)
+		for _, custom := range credential.Custom {
+			customCredential := &containerregistry.CustomRegistryCredentials{}
+			if custom.Username != "" {
+				customCredential.UserName = &containerregistry.SecretObject{
+					Value: utils.String(custom.Username),
+					Type:  containerregistry.Opaque,
+				}
+			}
+			if custom.Password != "" {
+				customCredential.Password = &containerregistry.SecretObject{
+					Value: utils.String(custom.Password),
+					Type:  containerregistry.Opaque,
+				}
+			}
+			if custom.Identity != "" {
+				customCredential.Identity = utils.String(custom.Identity)
+			}
+			customRegistries[custom.LoginServer] = customCredential
+		}
+		output.CustomRegistries = customRegistries
+	}
+
+	return output
+}
+
+func flattenContainerRegistryTaskArguments(input *[]containerregistry.Argument, existing map[string]string) (map[string]string, map[string]string) {
+	values := make(map[string]string)
+	secretValues := make(map[string]string)
+	if input == nil {
+		return values, secretValues
+	}
+
+	for _, v := range *input {
+		if v.Name == nil {
+			continue
+		}
+		if v.IsSecret != nil && *v.IsSecret {
+			// the API doesn't return the value of secret arguments
+			secretValues[*v.Name] = existing[*v.Name]
+			continue
+		}
+		values[*v.Name] = utils.NormalizeNilableString(v.Value)
+	}
+	return values, secretValues
+}
+
+func flattenContainerRegistryTaskSetValues(input *[]containerregistry.SetValue, existing map[string]string) (map[string]string, map[string]string) {
+	values := make(map[string]string)
+	secretValues := make(map[string]string)
+	if input == nil {
+		return values, secretValues
+	}
+
+	for _, v := range *input {
+		if v.Name == nil {
+			continue
+		}
+		if v.IsSecret != nil && *v.IsSecret {
+			// the API doesn't return the value of secret values
+			secretValues[*v.Name] = existing[*v.Name]
+			continue
+		}
+		values[*v.Name] = utils.NormalizeNilableString(v.Value)
+	}
+	return values, secretValues
+}
+
+func flattenContainerRegistryTaskDockerStep(input *containerregistry.DockerBuildStep, config []ContainerRegistryTaskDockerStep) []ContainerRegistryTaskDockerStep {
+	var existing ContainerRegistryTaskDockerStep
+	if len(config) > 0 {
+		existing = config[0]
+	}
+
+	output := ContainerRegistryTaskDockerStep{
+		DockerfilePath:     utils.NormalizeNilableString(input.DockerFilePath),
+		ContextPath:        utils.NormalizeNilableString(input.ContextPath),
+		ContextAccessToken: existing.ContextAccessToken,
+		Target:             utils.NormalizeNilableString(input.Target),
+		CacheEnabled:       input.NoCache == nil || !*input.NoCache,
+		PushEnabled:        input.IsPushEnabled != nil && *input.IsPushEnabled,
+	}
+	if input.ImageNames != nil {
+		output.ImageNames = *input.ImageNames
+	}
+	output.Arguments, output.SecretArguments = flattenContainerRegistryTaskArguments(input.Arguments, existing.SecretArguments)
+
+	return []ContainerRegistryTaskDockerStep{output}
+}
+
+func flattenContainerRegistryTaskFileStep(input *containerregistry.FileTaskStep, config []ContainerRegistryTaskFileStep) []ContainerRegistryTaskFileStep {
+	var existing ContainerRegistryTaskFileStep
+	if len(config) > 0 {
+		existing = config[0]
+	}
+
+	output := ContainerRegistryTaskFileStep{
+		TaskFilePath:       utils.NormalizeNilableString(input.TaskFilePath),
+		ValueFilePath:      utils.NormalizeNilableString(input.ValuesFilePath),
+		ContextPath:        utils.NormalizeNilableString(input.ContextPath),
+		ContextAccessToken: existing.ContextAccessToken,
+	}
+	output.Values, output.SecretValues = flattenContainerRegistryTaskSetValues(input.Values, existing.SecretValues)
+
+	return []ContainerRegistryTaskFileStep{output}
+}
+
+func flattenContainerRegistryTaskEncodedStep(input *containerregistry.EncodedTaskStep, config []ContainerRegistryTaskEncodedStep) ([]ContainerRegistryTaskEncodedStep, error) {
+	var existing ContainerRegistryTaskEncodedStep
+	if len(config) > 0 {
+		existing = config[0]
+	}
+
+	output := ContainerRegistryTaskEncodedStep{
+		ContextPath:        utils.NormalizeNilableString(input.ContextPath),
+		ContextAccessToken: existing.ContextAccessToken,
+		// the API doesn't return the encoded values content
+		ValueContent: existing.ValueContent,
+	}
+
+	if input.EncodedTaskContent != nil {
+		taskContent, err := base64.StdEncoding.DecodeString(*input.EncodedTaskContent)
+		if err != nil {
+			return nil, fmt.Errorf("decoding `task_content`: %+v", err)
+		}
+		output.TaskContent = string(taskContent)
+	}
+	output.Values, output.SecretValues = flattenContainerRegistryTaskSetValues(input.Values, existing.SecretValues)
+
+	return []ContainerRegistryTaskEncodedStep{output}, nil
+}
+
+func flattenContainerRegistryTaskBaseImageTrigger(input *containerregistry.BaseImageTrigger) []ContainerRegistryTaskBaseImageTrigger {
+	if input == nil {
+		return []ContainerRegistryTaskBaseImageTrigger{}
+	}
+
+	return []ContainerRegistryTaskBaseImageTrigger{
+		{
+			Name:                     utils.NormalizeNilableString(input.Name),
+			Type:                     string(input.BaseImageTriggerType),
+			Enabled:                  input.Status == containerregistry.TriggerStatusEnabled,
+			UpdateTriggerEndpoint:    utils.NormalizeNilableString(input.UpdateTriggerEndpoint),
+			UpdateTriggerPayloadType: string(input.UpdateTriggerPayloadType),
+		},
+	}
+}
+
+func flattenContainerRegistryTaskSourceTriggers(input *[]containerregistry.SourceTrigger, config []ContainerRegistryTaskSourceTrigger) []ContainerRegistryTaskSourceTrigger {
+	output := make([]ContainerRegistryTaskSourceTrigger, 0)
+	if input == nil {
+		return output
+	}
+
+	existingAuth := make(map[string][]ContainerRegistryTaskSourceTriggerAuthInfo)
+	for _, v := range config {
+		existingAuth[v.Name] = v.Authentication
+	}
+
+	for _, trigger := range *input {
+		name := utils.NormalizeNilableString(trigger.Name)
+		item := ContainerRegistryTaskSourceTrigger{
+			Name:    name,
+			Enabled: trigger.Status == containerregistry.TriggerStatusEnabled,
+			// the API doesn't return the authentication tokens
+			Authentication: existingAuth[name],
+		}
+
+		events := make([]string, 0)
+		if trigger.SourceTriggerEvents != nil {
+			for _, event := range *trigger.SourceTriggerEvents {
+				events = append(events, string(event))
+			}
+		}
+		item.Events = events
+
+		if source := trigger.SourceRepository; source != nil {
+			item.SourceType = string(source.SourceControlType)
+			item.RepositoryURL = utils.NormalizeNilableString(source.RepositoryURL)
+			item.Branch = utils.NormalizeNilableString(source.Branch)
+		}
+
+		output = append(output, item)
+	}
+	return output
+}
+
+func flattenContainerRegistryTaskTimerTriggers(input *[]containerregistry.TimerTrigger) []ContainerRegistryTaskTimerTrigger {
+	output := make([]ContainerRegistryTaskTimerTrigger, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, trigger := range *input {
+		output = append(output, ContainerRegistryTaskTimerTrigger{
+			Name:     utils.NormalizeNilableString(trigger.Name),
+			Schedule: utils.NormalizeNilableString(trigger.Schedule),
+			Enabled:  trigger.Status == containerregistry.TriggerStatusEnabled,
+		})
+	}
+	return output
+}
+
+func flattenContainerRegistryTaskRegistryCredential(input *containerregistry.Credentials, config []ContainerRegistryTaskRegistryCredential) []ContainerRegistryTaskRegistryCredential {
+	if input == nil || (input.SourceRegistry == nil && len(input.CustomRegistries) == 0) {
+		return []ContainerRegistryTaskRegistryCredential{}
+	}
+
+	existingCustom := make(map[string]ContainerRegistryTaskCustomRegistryCredential)
+	if len(config) > 0 {
+		for _, v := range config[0].Custom {
+			existingCustom[v.LoginServer] = v
+		}
+	}
+
+	output := ContainerRegistryTaskRegistryCredential{
+		Source: []ContainerRegistryTaskSourceRegistryCredential{},
+		Custom: []ContainerRegistryTaskCustomRegistryCredential{},
+	}
+
+	if source := input.SourceRegistry; source != nil {
+		output.Source = append(output.Source, ContainerRegistryTaskSourceRegistryCredential{
+			LoginMode: string(source.LoginMode),
+		})
+	}
+
+	for loginServer, v := range input.CustomRegistries {
+		// the API doesn't return the username or password, so these are retrieved from the existing state
+		custom := ContainerRegistryTaskCustomRegistryCredential{
+			LoginServer: loginServer,
+			Username:    existingCustom[loginServer].Username,
+			Password:    existingCustom[loginServer].Password,
+		}
+		if v != nil {
+			custom.Identity = utils.NormalizeNilableString(v.Identity)
+		}
+		output.Custom = append(output.Custom, custom)
+	}
+
+	return []ContainerRegistryTaskRegistryCredential{output}
+}
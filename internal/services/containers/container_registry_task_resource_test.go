@@ -0,0 +1,297 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryTaskResource struct{}
+
+func TestAccContainerRegistryTask_dockerStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("docker_step.0.secret_arguments"),
+	})
+}
+
+func TestAccContainerRegistryTask_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryTask_encodedStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encodedStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryTask_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("docker_step.0.secret_arguments"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep("docker_step.0.secret_arguments"),
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("docker_step.0.secret_arguments"),
+	})
+}
+
+func TestAccContainerRegistryTask_systemTask(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.systemTask(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryTaskResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryTaskID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryTasksClient.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.TaskProperties != nil), nil
+}
+
+func (r ContainerRegistryTaskResource) dockerStep(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  platform {
+    os = "Linux"
+  }
+
+  docker_step {
+    dockerfile_path = "Dockerfile"
+    context_path    = "https://github.com/Azure-Samples/acr-build-helloworld-node#main"
+    image_names     = ["helloworld:{{.Run.ID}}"]
+
+    arguments = {
+      FOO = "bar"
+    }
+
+    secret_arguments = {
+      SECRET = "value"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "import" {
+  name                  = azurerm_container_registry_task.test.name
+  container_registry_id = azurerm_container_registry_task.test.container_registry_id
+
+  platform {
+    os = "Linux"
+  }
+
+  docker_step {
+    dockerfile_path = "Dockerfile"
+    context_path    = "https://github.com/Azure-Samples/acr-build-helloworld-node#main"
+    image_names     = ["helloworld:{{.Run.ID}}"]
+  }
+}
+`, r.dockerStep(data))
+}
+
+func (r ContainerRegistryTaskResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  enabled               = false
+  log_template          = "acr/tasks:{{.Run.OS}}"
+  timeout_in_seconds    = 1800
+
+  platform {
+    os           = "Linux"
+    architecture = "arm"
+    variant      = "v7"
+  }
+
+  agent_setting {
+    cpu = 2
+  }
+
+  docker_step {
+    dockerfile_path = "Dockerfile"
+    context_path    = "https://github.com/Azure-Samples/acr-build-helloworld-node#main"
+    image_names     = ["helloworld:{{.Run.ID}}", "helloworld:latest"]
+    cache_enabled   = false
+    push_enabled    = false
+
+    arguments = {
+      FOO = "bar"
+    }
+
+    secret_arguments = {
+      SECRET = "value"
+    }
+  }
+
+  base_image_trigger {
+    name                        = "default"
+    type                        = "Runtime"
+    update_trigger_payload_type = "Default"
+  }
+
+  timer_trigger {
+    name     = "nightly"
+    schedule = "0 2 * * *"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  registry_credential {
+    source {
+      login_mode = "Default"
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) encodedStep(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  platform {
+    os = "Linux"
+  }
+
+  encoded_step {
+    task_content = <<EOF
+version: v1.1.0
+steps:
+  - cmd: mcr.microsoft.com/hello-world
+EOF
+
+    values = {
+      FOO = "bar"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) systemTask(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "quickrun"
+  container_registry_id = azurerm_container_registry.test.id
+  is_system_task        = true
+}
+`, r.template(data))
+}
+
+func (ContainerRegistryTaskResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
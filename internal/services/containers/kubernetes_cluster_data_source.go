@@ -459,6 +459,11 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"local_account_disabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"linux_profile": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -568,6 +573,11 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 										},
 									},
 
+									"azure_rbac_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
 									"client_app_id": {
 										Type:     pluginsdk.TypeString,
 										Computed: true,
@@ -650,6 +660,12 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("kubernetes_version", props.KubernetesVersion)
 		d.Set("node_resource_group", props.NodeResourceGroup)
 
+		localAccountDisabled := false
+		if props.DisableLocalAccounts != nil {
+			localAccountDisabled = *props.DisableLocalAccounts
+		}
+		d.Set("local_account_disabled", localAccountDisabled)
+
 		// TODO: 2.0 we should introduce a access_profile block to match the new API design,
 		if accessProfile := props.APIServerAccessProfile; accessProfile != nil {
 			apiServerAuthorizedIPRanges := utils.FlattenStringSlice(accessProfile.AuthorizedIPRanges)
@@ -750,6 +766,11 @@ func flattenKubernetesClusterDataSourceRoleBasedAccessControl(input *containerse
 	if profile := input.AadProfile; profile != nil {
 		adminGroupObjectIds := utils.FlattenStringSlice(profile.AdminGroupObjectIDs)
 
+		azureRbacEnabled := false
+		if profile.EnableAzureRBAC != nil {
+			azureRbacEnabled = *profile.EnableAzureRBAC
+		}
+
 		clientAppId := ""
 		if profile.ClientAppID != nil {
 			clientAppId = *profile.ClientAppID
@@ -772,6 +793,7 @@ func flattenKubernetesClusterDataSourceRoleBasedAccessControl(input *containerse
 
 		results = append(results, map[string]interface{}{
 			"admin_group_object_ids": adminGroupObjectIds,
+			"azure_rbac_enabled":     azureRbacEnabled,
 			"client_app_id":          clientAppId,
 			"managed":                managed,
 			"server_app_id":          serverAppId,
@@ -161,6 +161,7 @@ func testAccDataSourceKubernetesCluster_localAccountDisabled(t *testing.T) {
 		{
 			Config: r.localAccountDisabled(data, clientData.TenantID),
 			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("local_account_disabled").HasValue("true"),
 				check.That(data.ResourceName).Key("role_based_access_control.#").HasValue("1"),
 				check.That(data.ResourceName).Key("role_based_access_control.0.enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("role_based_access_control.0.azure_active_directory.#").HasValue("1"),
@@ -0,0 +1,364 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/azuresdkhacks"
+	laparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	kubernetesClusterKeyVaultNetworkAccessPrivate = "Private"
+	kubernetesClusterKeyVaultNetworkAccessPublic  = "Public"
+)
+
+// expandKubernetesClusterExtendedProperties returns the extended properties which should be sent to the API - only those
+// which have been specified (or have changed, e.g. have been removed) are sent, such that the request for a Managed Cluster
+// which isn't using any of them is unchanged - nil is returned when there's nothing to send
+func expandKubernetesClusterExtendedProperties(d *pluginsdk.ResourceData) *azuresdkhacks.ManagedClusterExtendedProperties {
+	shouldSend := func(key string) bool {
+		_, ok := d.GetOk(key)
+		return ok || d.HasChange(key)
+	}
+
+	output := azuresdkhacks.ManagedClusterExtendedProperties{}
+	securityProfile := azuresdkhacks.ManagedClusterSecurityProfile{}
+	send := false
+
+	if shouldSend("web_app_routing") {
+		output.IngressProfile = &azuresdkhacks.ManagedClusterIngressProfile{
+			WebAppRouting: expandKubernetesClusterWebAppRouting(d.Get("web_app_routing").([]interface{})),
+		}
+		send = true
+	}
+
+	if shouldSend("oidc_issuer_enabled") {
+		output.OidcIssuerProfile = &azuresdkhacks.ManagedClusterOidcIssuerProfile{
+			Enabled: utils.Bool(d.Get("oidc_issuer_enabled").(bool)),
+		}
+		send = true
+	}
+
+	if shouldSend("workload_autoscaler_profile") {
+		output.WorkloadAutoScalerProfile = expandKubernetesClusterWorkloadAutoScalerProfile(d.Get("workload_autoscaler_profile").([]interface{}))
+		send = true
+	}
+
+	if shouldSend("key_management_service") {
+		securityProfile.AzureKeyVaultKms = expandKubernetesClusterAzureKeyVaultKms(d.Get("key_management_service").([]interface{}))
+		output.SecurityProfile = &securityProfile
+		send = true
+	}
+
+	if shouldSend("microsoft_defender") {
+		securityProfile.Defender = expandKubernetesClusterMicrosoftDefender(d.Get("microsoft_defender").([]interface{}))
+		output.SecurityProfile = &securityProfile
+		send = true
+	}
+
+	if shouldSend("image_cleaner") {
+		securityProfile.ImageCleaner = expandKubernetesClusterImageCleaner(d.Get("image_cleaner").([]interface{}))
+		output.SecurityProfile = &securityProfile
+		send = true
+	}
+
+	if shouldSend("workload_identity_enabled") {
+		securityProfile.WorkloadIdentity = &azuresdkhacks.ManagedClusterSecurityProfileWorkloadIdentity{
+			Enabled: utils.Bool(d.Get("workload_identity_enabled").(bool)),
+		}
+		output.SecurityProfile = &securityProfile
+		send = true
+	}
+
+	if !send {
+		return nil
+	}
+
+	return &output
+}
+
+func expandKubernetesClusterWebAppRouting(input []interface{}) *azuresdkhacks.ManagedClusterIngressProfileWebAppRouting {
+	if len(input) == 0 {
+		return &azuresdkhacks.ManagedClusterIngressProfileWebAppRouting{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	// an empty `web_app_routing` block enables the add-on without any DNS Zones
+	output := &azuresdkhacks.ManagedClusterIngressProfileWebAppRouting{
+		Enabled: utils.Bool(true),
+	}
+	if raw, ok := input[0].(map[string]interface{}); ok {
+		output.DnsZoneResourceIds = utils.ExpandStringSlice(raw["dns_zone_ids"].([]interface{}))
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterWebAppRouting(input *azuresdkhacks.ManagedClusterIngressProfileWebAppRouting) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"dns_zone_ids": utils.FlattenStringSlice(input.DnsZoneResourceIds),
+		},
+	}
+}
+
+func expandKubernetesClusterWorkloadAutoScalerProfile(input []interface{}) *azuresdkhacks.ManagedClusterWorkloadAutoScalerProfile {
+	kedaEnabled := false
+	verticalPodAutoscalerEnabled := false
+	if len(input) > 0 && input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		kedaEnabled = raw["keda_enabled"].(bool)
+		verticalPodAutoscalerEnabled = raw["vertical_pod_autoscaler_enabled"].(bool)
+	}
+
+	return &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfile{
+		Keda: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfileToggle{
+			Enabled: utils.Bool(kedaEnabled),
+		},
+		VerticalPodAutoscaler: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfileToggle{
+			Enabled: utils.Bool(verticalPodAutoscalerEnabled),
+		},
+	}
+}
+
+func flattenKubernetesClusterWorkloadAutoScalerProfile(input *azuresdkhacks.ManagedClusterWorkloadAutoScalerProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	kedaEnabled := false
+	if input.Keda != nil && input.Keda.Enabled != nil {
+		kedaEnabled = *input.Keda.Enabled
+	}
+
+	verticalPodAutoscalerEnabled := false
+	if input.VerticalPodAutoscaler != nil && input.VerticalPodAutoscaler.Enabled != nil {
+		verticalPodAutoscalerEnabled = *input.VerticalPodAutoscaler.Enabled
+	}
+
+	if !kedaEnabled && !verticalPodAutoscalerEnabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"keda_enabled":                    kedaEnabled,
+			"vertical_pod_autoscaler_enabled": verticalPodAutoscalerEnabled,
+		},
+	}
+}
+
+func expandKubernetesClusterAzureKeyVaultKms(input []interface{}) *azuresdkhacks.ManagedClusterAzureKeyVaultKms {
+	if len(input) == 0 || input[0] == nil {
+		return &azuresdkhacks.ManagedClusterAzureKeyVaultKms{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &azuresdkhacks.ManagedClusterAzureKeyVaultKms{
+		Enabled:               utils.Bool(true),
+		KeyId:                 utils.String(raw["key_vault_key_id"].(string)),
+		KeyVaultNetworkAccess: utils.String(raw["key_vault_network_access"].(string)),
+	}
+}
+
+func flattenKubernetesClusterAzureKeyVaultKms(input *azuresdkhacks.ManagedClusterAzureKeyVaultKms) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []interface{}{}
+	}
+
+	keyId := ""
+	if input.KeyId != nil {
+		keyId = *input.KeyId
+	}
+
+	networkAccess := kubernetesClusterKeyVaultNetworkAccessPublic
+	if input.KeyVaultNetworkAccess != nil && *input.KeyVaultNetworkAccess != "" {
+		networkAccess = *input.KeyVaultNetworkAccess
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":         keyId,
+			"key_vault_network_access": networkAccess,
+		},
+	}
+}
+
+func expandKubernetesClusterMicrosoftDefender(input []interface{}) *azuresdkhacks.ManagedClusterSecurityProfileDefender {
+	if len(input) == 0 || input[0] == nil {
+		return &azuresdkhacks.ManagedClusterSecurityProfileDefender{
+			SecurityMonitoring: &azuresdkhacks.ManagedClusterSecurityProfileDefenderMonitoring{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &azuresdkhacks.ManagedClusterSecurityProfileDefender{
+		LogAnalyticsWorkspaceResourceId: utils.String(raw["log_analytics_workspace_id"].(string)),
+		SecurityMonitoring: &azuresdkhacks.ManagedClusterSecurityProfileDefenderMonitoring{
+			Enabled: utils.Bool(true),
+		},
+	}
+}
+
+func flattenKubernetesClusterMicrosoftDefender(input *azuresdkhacks.ManagedClusterSecurityProfileDefender) []interface{} {
+	if input == nil || input.SecurityMonitoring == nil || input.SecurityMonitoring.Enabled == nil || !*input.SecurityMonitoring.Enabled {
+		return []interface{}{}
+	}
+
+	workspaceId := ""
+	if input.LogAnalyticsWorkspaceResourceId != nil {
+		if id, err := laparse.LogAnalyticsWorkspaceID(*input.LogAnalyticsWorkspaceResourceId); err == nil {
+			workspaceId = id.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics_workspace_id": workspaceId,
+		},
+	}
+}
+
+func expandKubernetesClusterImageCleaner(input []interface{}) *azuresdkhacks.ManagedClusterSecurityProfileImageCleaner {
+	if len(input) == 0 || input[0] == nil {
+		return &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+		Enabled:       utils.Bool(raw["enabled"].(bool)),
+		IntervalHours: utils.Int64(int64(raw["interval_hours"].(int))),
+	}
+}
+
+func flattenKubernetesClusterImageCleaner(input *azuresdkhacks.ManagedClusterSecurityProfileImageCleaner, configured bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	// the API returns a default interval when Image Cleaner is disabled, even when it's never been configured - as such
+	// a disabled Image Cleaner is only flattened when the `image_cleaner` block is present
+	if !enabled && !configured {
+		return []interface{}{}
+	}
+
+	intervalHours := 0
+	if input.IntervalHours != nil {
+		intervalHours = int(*input.IntervalHours)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":        enabled,
+			"interval_hours": intervalHours,
+		},
+	}
+}
+
+func flattenKubernetesClusterExtendedProperties(d *pluginsdk.ResourceData, input *azuresdkhacks.ManagedClusterExtendedProperties) error {
+	oidcIssuerEnabled := false
+	oidcIssuerURL := ""
+	workloadIdentityEnabled := false
+	keyManagementService := make([]interface{}, 0)
+	microsoftDefender := make([]interface{}, 0)
+	imageCleaner := make([]interface{}, 0)
+	webAppRouting := make([]interface{}, 0)
+	workloadAutoScalerProfile := make([]interface{}, 0)
+
+	if input != nil {
+		if profile := input.IngressProfile; profile != nil {
+			webAppRouting = flattenKubernetesClusterWebAppRouting(profile.WebAppRouting)
+		}
+
+		workloadAutoScalerProfile = flattenKubernetesClusterWorkloadAutoScalerProfile(input.WorkloadAutoScalerProfile)
+
+		if profile := input.OidcIssuerProfile; profile != nil {
+			if profile.Enabled != nil {
+				oidcIssuerEnabled = *profile.Enabled
+			}
+			if profile.IssuerURL != nil {
+				oidcIssuerURL = *profile.IssuerURL
+			}
+		}
+
+		if profile := input.SecurityProfile; profile != nil {
+			keyManagementService = flattenKubernetesClusterAzureKeyVaultKms(profile.AzureKeyVaultKms)
+			microsoftDefender = flattenKubernetesClusterMicrosoftDefender(profile.Defender)
+			imageCleaner = flattenKubernetesClusterImageCleaner(profile.ImageCleaner, len(d.Get("image_cleaner").([]interface{})) > 0)
+
+			if workloadIdentity := profile.WorkloadIdentity; workloadIdentity != nil && workloadIdentity.Enabled != nil {
+				workloadIdentityEnabled = *workloadIdentity.Enabled
+			}
+		}
+	}
+
+	d.Set("oidc_issuer_enabled", oidcIssuerEnabled)
+	d.Set("oidc_issuer_url", oidcIssuerURL)
+	d.Set("workload_identity_enabled", workloadIdentityEnabled)
+
+	if err := d.Set("key_management_service", keyManagementService); err != nil {
+		return fmt.Errorf("setting `key_management_service`: %+v", err)
+	}
+
+	if err := d.Set("microsoft_defender", microsoftDefender); err != nil {
+		return fmt.Errorf("setting `microsoft_defender`: %+v", err)
+	}
+
+	if err := d.Set("image_cleaner", imageCleaner); err != nil {
+		return fmt.Errorf("setting `image_cleaner`: %+v", err)
+	}
+
+	if err := d.Set("web_app_routing", webAppRouting); err != nil {
+		return fmt.Errorf("setting `web_app_routing`: %+v", err)
+	}
+
+	if err := d.Set("workload_autoscaler_profile", workloadAutoScalerProfile); err != nil {
+		return fmt.Errorf("setting `workload_autoscaler_profile`: %+v", err)
+	}
+
+	return nil
+}
+
+// kubernetesClusterExtendedPropertyKeys are the fields which map to properties which aren't available in the
+// Container Service SDK
+var kubernetesClusterExtendedPropertyKeys = []string{
+	"image_cleaner",
+	"key_management_service",
+	"microsoft_defender",
+	"oidc_issuer_enabled",
+	"web_app_routing",
+	"workload_autoscaler_profile",
+	"workload_identity_enabled",
+}
+
+// createOrUpdateKubernetesCluster creates/updates the Managed Cluster, using a newer API version only when properties
+// which aren't available in the Container Service SDK are being set or changed - since the newer API version has
+// different semantics, other updates continue to use the API version from the SDK
+func createOrUpdateKubernetesCluster(ctx context.Context, client *containerservice.ManagedClustersClient, d *pluginsdk.ResourceData, resourceGroup string, name string, parameters containerservice.ManagedCluster) (containerservice.ManagedClustersCreateOrUpdateFuture, error) {
+	extendedProperties := expandKubernetesClusterExtendedProperties(d)
+	if extendedProperties == nil || (!d.IsNewResource() && !d.HasChanges(kubernetesClusterExtendedPropertyKeys...)) {
+		return client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	}
+
+	return azuresdkhacks.CreateOrUpdateManagedClusterWithExtendedProperties(ctx, client, resourceGroup, name, parameters, *extendedProperties)
+}
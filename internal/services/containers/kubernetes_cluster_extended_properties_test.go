@@ -0,0 +1,394 @@
+package containers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpandKubernetesClusterExtendedProperties(t *testing.T) {
+	keyId := "https://example.vault.azure.net/keys/key1/00000000000000000000000000000000"
+	workspaceId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1"
+
+	cases := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected *azuresdkhacks.ManagedClusterExtendedProperties
+	}{
+		{
+			Name:     "none specified",
+			Config:   map[string]interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "disabled values aren't sent",
+			Config: map[string]interface{}{
+				"oidc_issuer_enabled":       false,
+				"workload_identity_enabled": false,
+			},
+			Expected: nil,
+		},
+		{
+			Name: "oidc issuer and workload identity",
+			Config: map[string]interface{}{
+				"oidc_issuer_enabled":       true,
+				"workload_identity_enabled": true,
+			},
+			Expected: &azuresdkhacks.ManagedClusterExtendedProperties{
+				OidcIssuerProfile: &azuresdkhacks.ManagedClusterOidcIssuerProfile{
+					Enabled: utils.Bool(true),
+				},
+				SecurityProfile: &azuresdkhacks.ManagedClusterSecurityProfile{
+					WorkloadIdentity: &azuresdkhacks.ManagedClusterSecurityProfileWorkloadIdentity{
+						Enabled: utils.Bool(true),
+					},
+				},
+			},
+		},
+		{
+			Name: "image cleaner only",
+			Config: map[string]interface{}{
+				"image_cleaner": []interface{}{
+					map[string]interface{}{
+						"enabled":        true,
+						"interval_hours": 48,
+					},
+				},
+			},
+			Expected: &azuresdkhacks.ManagedClusterExtendedProperties{
+				SecurityProfile: &azuresdkhacks.ManagedClusterSecurityProfile{
+					ImageCleaner: &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+						Enabled:       utils.Bool(true),
+						IntervalHours: utils.Int64(48),
+					},
+				},
+			},
+		},
+		{
+			Name: "key management service and microsoft defender",
+			Config: map[string]interface{}{
+				"key_management_service": []interface{}{
+					map[string]interface{}{
+						"key_vault_key_id": keyId,
+					},
+				},
+				"microsoft_defender": []interface{}{
+					map[string]interface{}{
+						"log_analytics_workspace_id": workspaceId,
+					},
+				},
+			},
+			Expected: &azuresdkhacks.ManagedClusterExtendedProperties{
+				SecurityProfile: &azuresdkhacks.ManagedClusterSecurityProfile{
+					AzureKeyVaultKms: &azuresdkhacks.ManagedClusterAzureKeyVaultKms{
+						Enabled:               utils.Bool(true),
+						KeyId:                 utils.String(keyId),
+						KeyVaultNetworkAccess: utils.String(kubernetesClusterKeyVaultNetworkAccessPublic),
+					},
+					Defender: &azuresdkhacks.ManagedClusterSecurityProfileDefender{
+						LogAnalyticsWorkspaceResourceId: utils.String(workspaceId),
+						SecurityMonitoring: &azuresdkhacks.ManagedClusterSecurityProfileDefenderMonitoring{
+							Enabled: utils.Bool(true),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "workload autoscaler profile",
+			Config: map[string]interface{}{
+				"workload_autoscaler_profile": []interface{}{
+					map[string]interface{}{
+						"keda_enabled": true,
+					},
+				},
+			},
+			Expected: &azuresdkhacks.ManagedClusterExtendedProperties{
+				WorkloadAutoScalerProfile: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfile{
+					Keda: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfileToggle{
+						Enabled: utils.Bool(true),
+					},
+					VerticalPodAutoscaler: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfileToggle{
+						Enabled: utils.Bool(false),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceKubernetesCluster().Schema, tc.Config)
+			actual := expandKubernetesClusterExtendedProperties(d)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestExpandKubernetesClusterWebAppRouting(t *testing.T) {
+	zoneId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/dnsZones/example.com"
+
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *azuresdkhacks.ManagedClusterIngressProfileWebAppRouting
+	}{
+		{
+			Name:  "removed",
+			Input: []interface{}{},
+			Expected: &azuresdkhacks.ManagedClusterIngressProfileWebAppRouting{
+				Enabled: utils.Bool(false),
+			},
+		},
+		{
+			Name:  "empty block",
+			Input: []interface{}{nil},
+			Expected: &azuresdkhacks.ManagedClusterIngressProfileWebAppRouting{
+				Enabled: utils.Bool(true),
+			},
+		},
+		{
+			Name: "dns zones",
+			Input: []interface{}{
+				map[string]interface{}{
+					"dns_zone_ids": []interface{}{zoneId},
+				},
+			},
+			Expected: &azuresdkhacks.ManagedClusterIngressProfileWebAppRouting{
+				Enabled:            utils.Bool(true),
+				DnsZoneResourceIds: &[]string{zoneId},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := expandKubernetesClusterWebAppRouting(tc.Input)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenKubernetesClusterAzureKeyVaultKms(t *testing.T) {
+	keyId := "https://example.vault.azure.net/keys/key1/00000000000000000000000000000000"
+
+	cases := []struct {
+		Name     string
+		Input    *azuresdkhacks.ManagedClusterAzureKeyVaultKms
+		Expected []interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "disabled",
+			Input: &azuresdkhacks.ManagedClusterAzureKeyVaultKms{
+				Enabled: utils.Bool(false),
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "enabled without network access defaults to public",
+			Input: &azuresdkhacks.ManagedClusterAzureKeyVaultKms{
+				Enabled: utils.Bool(true),
+				KeyId:   utils.String(keyId),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id":         keyId,
+					"key_vault_network_access": kubernetesClusterKeyVaultNetworkAccessPublic,
+				},
+			},
+		},
+		{
+			Name: "enabled with private network access",
+			Input: &azuresdkhacks.ManagedClusterAzureKeyVaultKms{
+				Enabled:               utils.Bool(true),
+				KeyId:                 utils.String(keyId),
+				KeyVaultNetworkAccess: utils.String(kubernetesClusterKeyVaultNetworkAccessPrivate),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id":         keyId,
+					"key_vault_network_access": kubernetesClusterKeyVaultNetworkAccessPrivate,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := flattenKubernetesClusterAzureKeyVaultKms(tc.Input)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenKubernetesClusterMicrosoftDefender(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *azuresdkhacks.ManagedClusterSecurityProfileDefender
+		Expected []interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "disabled",
+			Input: &azuresdkhacks.ManagedClusterSecurityProfileDefender{
+				SecurityMonitoring: &azuresdkhacks.ManagedClusterSecurityProfileDefenderMonitoring{
+					Enabled: utils.Bool(false),
+				},
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "enabled with an inconsistently cased workspace id",
+			Input: &azuresdkhacks.ManagedClusterSecurityProfileDefender{
+				LogAnalyticsWorkspaceResourceId: utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1"),
+				SecurityMonitoring: &azuresdkhacks.ManagedClusterSecurityProfileDefenderMonitoring{
+					Enabled: utils.Bool(true),
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"log_analytics_workspace_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := flattenKubernetesClusterMicrosoftDefender(tc.Input)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenKubernetesClusterWorkloadAutoScalerProfile(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *azuresdkhacks.ManagedClusterWorkloadAutoScalerProfile
+		Expected []interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "both disabled",
+			Input: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfile{
+				Keda: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfileToggle{
+					Enabled: utils.Bool(false),
+				},
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "vertical pod autoscaler enabled",
+			Input: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfile{
+				VerticalPodAutoscaler: &azuresdkhacks.ManagedClusterWorkloadAutoScalerProfileToggle{
+					Enabled: utils.Bool(true),
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"keda_enabled":                    false,
+					"vertical_pod_autoscaler_enabled": true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := flattenKubernetesClusterWorkloadAutoScalerProfile(tc.Input)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenKubernetesClusterImageCleaner(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Input      *azuresdkhacks.ManagedClusterSecurityProfileImageCleaner
+		Configured bool
+		Expected   []interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "disabled with the default interval",
+			Input: &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+				Enabled:       utils.Bool(false),
+				IntervalHours: utils.Int64(168),
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "default interval without enabled",
+			Input: &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+				IntervalHours: utils.Int64(168),
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "disabled when configured",
+			Input: &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+				Enabled:       utils.Bool(false),
+				IntervalHours: utils.Int64(72),
+			},
+			Configured: true,
+			Expected: []interface{}{
+				map[string]interface{}{
+					"enabled":        false,
+					"interval_hours": 72,
+				},
+			},
+		},
+		{
+			Name: "enabled",
+			Input: &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+				Enabled:       utils.Bool(true),
+				IntervalHours: utils.Int64(48),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"enabled":        true,
+					"interval_hours": 48,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := flattenKubernetesClusterImageCleaner(tc.Input, tc.Configured)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
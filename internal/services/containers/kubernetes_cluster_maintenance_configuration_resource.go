@@ -0,0 +1,508 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-01/maintenanceconfigurations"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	maintenanceConfigurationNameAksManagedAutoUpgradeSchedule   = "aksManagedAutoUpgradeSchedule"
+	maintenanceConfigurationNameAksManagedNodeOSUpgradeSchedule = "aksManagedNodeOSUpgradeSchedule"
+
+	maintenanceWindowFrequencyAbsoluteMonthly = "AbsoluteMonthly"
+	maintenanceWindowFrequencyDaily           = "Daily"
+	maintenanceWindowFrequencyRelativeMonthly = "RelativeMonthly"
+	maintenanceWindowFrequencyWeekly          = "Weekly"
+
+	// maintenanceWindowDateFormat is the format used by the API for dates within a Maintenance Window
+	maintenanceWindowDateFormat = "2006-01-02"
+)
+
+var (
+	dateRegex      = regexp.MustCompile(`^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])$`)
+	timeOfDayRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+	utcOffsetRegex = regexp.MustCompile(`^(-|\+)[0-9]{2}:[0-9]{2}$`)
+)
+
+// maintenanceWindowMaxInterval is the maximum `interval` supported by the API for each `frequency`
+var maintenanceWindowMaxInterval = map[string]int{
+	maintenanceWindowFrequencyAbsoluteMonthly: 6,
+	maintenanceWindowFrequencyDaily:           7,
+	maintenanceWindowFrequencyRelativeMonthly: 6,
+	maintenanceWindowFrequencyWeekly:          4,
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = KubernetesClusterMaintenanceConfigurationResource{}
+	_ sdk.ResourceWithCustomizeDiff = KubernetesClusterMaintenanceConfigurationResource{}
+)
+
+type KubernetesClusterMaintenanceConfigurationResource struct{}
+
+type KubernetesClusterMaintenanceConfigurationModel struct {
+	Name                string                                `tfschema:"name"`
+	KubernetesClusterId string                                `tfschema:"kubernetes_cluster_id"`
+	MaintenanceWindow   []MaintenanceConfigurationWindowModel `tfschema:"maintenance_window"`
+}
+
+type MaintenanceConfigurationNotAllowedModel struct {
+	End   string `tfschema:"end"`
+	Start string `tfschema:"start"`
+}
+
+type MaintenanceConfigurationWindowModel struct {
+	Frequency       string                                    `tfschema:"frequency"`
+	Interval        int                                       `tfschema:"interval"`
+	DurationInHours int                                       `tfschema:"duration_in_hours"`
+	DayOfWeek       string                                    `tfschema:"day_of_week"`
+	DayOfMonth      int                                       `tfschema:"day_of_month"`
+	WeekIndex       string                                    `tfschema:"week_index"`
+	StartTime       string                                    `tfschema:"start_time"`
+	StartDate       string                                    `tfschema:"start_date"`
+	UtcOffset       string                                    `tfschema:"utc_offset"`
+	NotAllowed      []MaintenanceConfigurationNotAllowedModel `tfschema:"not_allowed"`
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			// the `default` Maintenance Configuration is managed using the `maintenance_window` block within the
+			// `azurerm_kubernetes_cluster` resource, as such it can't be managed using this resource
+			ValidateFunc: validation.StringInSlice([]string{
+				maintenanceConfigurationNameAksManagedAutoUpgradeSchedule,
+				maintenanceConfigurationNameAksManagedNodeOSUpgradeSchedule,
+			}, false),
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ClusterID,
+		},
+
+		"maintenance_window": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"frequency": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							maintenanceWindowFrequencyAbsoluteMonthly,
+							maintenanceWindowFrequencyDaily,
+							maintenanceWindowFrequencyRelativeMonthly,
+							maintenanceWindowFrequencyWeekly,
+						}, false),
+					},
+
+					"interval": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+						// the maximum depends on the `frequency`, which is validated in the CustomizeDiff
+						ValidateFunc: validation.IntBetween(1, 7),
+					},
+
+					"duration_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(4, 24),
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(timeOfDayRegex, "`start_time` must be in the format `HH:mm`"),
+					},
+
+					"day_of_week": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+					},
+
+					"day_of_month": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 31),
+					},
+
+					"week_index": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForType(), false),
+					},
+
+					"start_date": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringMatch(dateRegex, "`start_date` must be in the format `YYYY-MM-DD`"),
+					},
+
+					"utc_offset": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "+00:00",
+						ValidateFunc: validation.StringMatch(utcOffsetRegex, "`utc_offset` must be in the format `+/-HH:mm`"),
+					},
+
+					"not_allowed": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"end": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringMatch(dateRegex, "`end` must be in the format `YYYY-MM-DD`"),
+								},
+
+								"start": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringMatch(dateRegex, "`start` must be in the format `YYYY-MM-DD`"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) ModelObject() interface{} {
+	return &KubernetesClusterMaintenanceConfigurationModel{}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_maintenance_configuration"
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			frequency := rd.Get("maintenance_window.0.frequency").(string)
+			interval := rd.Get("maintenance_window.0.interval").(int)
+			if max, ok := maintenanceWindowMaxInterval[frequency]; ok && interval > max {
+				return fmt.Errorf("`interval` must be between 1 and %d when `frequency` is %q, got %d", max, frequency, interval)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return maintenanceconfigurations.ValidateMaintenanceConfigurationID
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsV2Client
+
+			var model KubernetesClusterMaintenanceConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := parse.ClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := maintenanceconfigurations.NewMaintenanceConfigurationID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ManagedClusterName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandMaintenanceConfigurationProperties(model)
+			if err != nil {
+				return err
+			}
+
+			parameters := maintenanceconfigurations.MaintenanceConfiguration{
+				Properties: properties,
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsV2Client
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterMaintenanceConfigurationModel{
+				Name:                id.MaintenanceConfigurationName,
+				KubernetesClusterId: parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.MaintenanceWindow = flattenMaintenanceConfigurationWindow(props.MaintenanceWindow)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsV2Client
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterMaintenanceConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties, err := expandMaintenanceConfigurationProperties(model)
+			if err != nil {
+				return err
+			}
+
+			// the API doesn't support PATCH, so we send the full payload
+			parameters := maintenanceconfigurations.MaintenanceConfiguration{
+				Properties: properties,
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsV2Client
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMaintenanceConfigurationProperties(input KubernetesClusterMaintenanceConfigurationModel) (*maintenanceconfigurations.MaintenanceConfigurationProperties, error) {
+	if len(input.MaintenanceWindow) == 0 {
+		return nil, fmt.Errorf("`maintenance_window` must be specified")
+	}
+
+	window, err := expandMaintenanceConfigurationWindow(input.MaintenanceWindow[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &maintenanceconfigurations.MaintenanceConfigurationProperties{
+		MaintenanceWindow: window,
+	}, nil
+}
+
+func expandMaintenanceConfigurationWindow(input MaintenanceConfigurationWindowModel) (*maintenanceconfigurations.MaintenanceWindow, error) {
+	schedule := maintenanceconfigurations.Schedule{}
+	switch input.Frequency {
+	case maintenanceWindowFrequencyDaily:
+		schedule.Daily = &maintenanceconfigurations.DailySchedule{
+			IntervalDays: int64(input.Interval),
+		}
+
+	case maintenanceWindowFrequencyWeekly:
+		if input.DayOfWeek == "" {
+			return nil, fmt.Errorf("`day_of_week` must be specified when `frequency` is %q", input.Frequency)
+		}
+		schedule.Weekly = &maintenanceconfigurations.WeeklySchedule{
+			DayOfWeek:     maintenanceconfigurations.WeekDay(input.DayOfWeek),
+			IntervalWeeks: int64(input.Interval),
+		}
+
+	case maintenanceWindowFrequencyAbsoluteMonthly:
+		if input.DayOfMonth == 0 {
+			return nil, fmt.Errorf("`day_of_month` must be specified when `frequency` is %q", input.Frequency)
+		}
+		schedule.AbsoluteMonthly = &maintenanceconfigurations.AbsoluteMonthlySchedule{
+			DayOfMonth:     int64(input.DayOfMonth),
+			IntervalMonths: int64(input.Interval),
+		}
+
+	case maintenanceWindowFrequencyRelativeMonthly:
+		if input.DayOfWeek == "" || input.WeekIndex == "" {
+			return nil, fmt.Errorf("`day_of_week` and `week_index` must be specified when `frequency` is %q", input.Frequency)
+		}
+		schedule.RelativeMonthly = &maintenanceconfigurations.RelativeMonthlySchedule{
+			DayOfWeek:      maintenanceconfigurations.WeekDay(input.DayOfWeek),
+			IntervalMonths: int64(input.Interval),
+			WeekIndex:      maintenanceconfigurations.Type(input.WeekIndex),
+		}
+	}
+
+	notAllowedDates, err := expandMaintenanceConfigurationNotAllowedDates(input.NotAllowed)
+	if err != nil {
+		return nil, err
+	}
+
+	output := maintenanceconfigurations.MaintenanceWindow{
+		DurationHours:   int64(input.DurationInHours),
+		NotAllowedDates: notAllowedDates,
+		Schedule:        schedule,
+		StartTime:       input.StartTime,
+		UtcOffset:       utils.String(input.UtcOffset),
+	}
+
+	if input.StartDate != "" {
+		if _, err := time.Parse(maintenanceWindowDateFormat, input.StartDate); err != nil {
+			return nil, fmt.Errorf("parsing `start_date`: %+v", err)
+		}
+		output.StartDate = utils.String(input.StartDate)
+	}
+
+	return &output, nil
+}
+
+func expandMaintenanceConfigurationNotAllowedDates(input []MaintenanceConfigurationNotAllowedModel) (*[]maintenanceconfigurations.DateSpan, error) {
+	results := make([]maintenanceconfigurations.DateSpan, 0)
+	for _, item := range input {
+		start, err := time.Parse(maintenanceWindowDateFormat, item.Start)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `start` %q of `not_allowed`: %+v", item.Start, err)
+		}
+
+		end, err := time.Parse(maintenanceWindowDateFormat, item.End)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `end` %q of `not_allowed`: %+v", item.End, err)
+		}
+
+		if end.Before(start) {
+			return nil, fmt.Errorf("the `end` %q of `not_allowed` must not be before the `start` %q", item.End, item.Start)
+		}
+
+		results = append(results, maintenanceconfigurations.DateSpan{
+			End:   item.End,
+			Start: item.Start,
+		})
+	}
+	return &results, nil
+}
+
+func flattenMaintenanceConfigurationWindow(input *maintenanceconfigurations.MaintenanceWindow) []MaintenanceConfigurationWindowModel {
+	if input == nil {
+		return []MaintenanceConfigurationWindowModel{}
+	}
+
+	output := MaintenanceConfigurationWindowModel{
+		DurationInHours: int(input.DurationHours),
+		StartTime:       input.StartTime,
+	}
+
+	if schedule := input.Schedule.Daily; schedule != nil {
+		output.Frequency = maintenanceWindowFrequencyDaily
+		output.Interval = int(schedule.IntervalDays)
+	}
+	if schedule := input.Schedule.Weekly; schedule != nil {
+		output.Frequency = maintenanceWindowFrequencyWeekly
+		output.Interval = int(schedule.IntervalWeeks)
+		output.DayOfWeek = string(schedule.DayOfWeek)
+	}
+	if schedule := input.Schedule.AbsoluteMonthly; schedule != nil {
+		output.Frequency = maintenanceWindowFrequencyAbsoluteMonthly
+		output.Interval = int(schedule.IntervalMonths)
+		output.DayOfMonth = int(schedule.DayOfMonth)
+	}
+	if schedule := input.Schedule.RelativeMonthly; schedule != nil {
+		output.Frequency = maintenanceWindowFrequencyRelativeMonthly
+		output.Interval = int(schedule.IntervalMonths)
+		output.DayOfWeek = string(schedule.DayOfWeek)
+		output.WeekIndex = string(schedule.WeekIndex)
+	}
+
+	if input.StartDate != nil {
+		output.StartDate = *input.StartDate
+	}
+
+	if input.UtcOffset != nil {
+		output.UtcOffset = *input.UtcOffset
+	}
+
+	notAllowed := make([]MaintenanceConfigurationNotAllowedModel, 0)
+	if input.NotAllowedDates != nil {
+		for _, item := range *input.NotAllowedDates {
+			notAllowed = append(notAllowed, MaintenanceConfigurationNotAllowedModel{
+				End:   item.End,
+				Start: item.Start,
+			})
+		}
+	}
+	output.NotAllowed = notAllowed
+
+	return []MaintenanceConfigurationWindowModel{output}
+}
@@ -0,0 +1,202 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterMaintenanceConfigurationResource struct{}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoUpgradeWeeklyConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImportConfig),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_autoUpgradeSchedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoUpgradeWeeklyConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoUpgradeRelativeMonthlyConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoUpgradeWeeklyConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_nodeOSUpgradeSchedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.nodeOSUpgradeDailyConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KubernetesClusterMaintenanceConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.MaintenanceConfigurationsV2Client.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) requiresImportConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "import" {
+  name                  = azurerm_kubernetes_cluster_maintenance_configuration.test.name
+  kubernetes_cluster_id = azurerm_kubernetes_cluster_maintenance_configuration.test.kubernetes_cluster_id
+
+  maintenance_window {
+    frequency         = "Weekly"
+    interval          = 1
+    duration_in_hours = 4
+    day_of_week       = "Saturday"
+    start_time        = "00:00"
+    utc_offset        = "+01:00"
+  }
+}
+`, r.autoUpgradeWeeklyConfig(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) autoUpgradeWeeklyConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency         = "Weekly"
+    interval          = 1
+    duration_in_hours = 4
+    day_of_week       = "Saturday"
+    start_time        = "00:00"
+    utc_offset        = "+01:00"
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) autoUpgradeRelativeMonthlyConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency         = "RelativeMonthly"
+    interval          = 2
+    duration_in_hours = 8
+    day_of_week       = "Sunday"
+    week_index        = "First"
+    start_time        = "02:30"
+
+    not_allowed {
+      start = "2030-12-20"
+      end   = "2031-01-05"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) nodeOSUpgradeDailyConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedNodeOSUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency         = "Daily"
+    interval          = 1
+    duration_in_hours = 4
+    start_time        = "01:00"
+  }
+}
+`, r.template(data))
+}
+
+func (KubernetesClusterMaintenanceConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
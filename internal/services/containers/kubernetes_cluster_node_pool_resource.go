@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,7 +10,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
@@ -98,6 +101,13 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"host_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.DedicatedHostGroupID,
+			},
+
 			"eviction_policy": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -256,6 +266,13 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.ProximityPlacementGroupID,
 			},
 
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.NodePoolSnapshotID,
+			},
+
 			"spot_max_price": {
 				Type:         pluginsdk.TypeFloat,
 				Optional:     true,
@@ -424,6 +441,12 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.ProximityPlacementGroupID = &proximityPlacementGroupId
 	}
 
+	if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	if osDiskType := d.Get("os_disk_type").(string); osDiskType != "" {
 		profile.OsDiskType = containerservice.OSDiskType(osDiskType)
 	}
@@ -484,7 +507,8 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		ManagedClusterAgentPoolProfileProperties: &profile,
 	}
 
-	future, err := poolsClient.CreateOrUpdate(ctx, resourceGroup, clusterName, name, parameters)
+	id := parse.NewNodePoolID(kubernetesClusterId.SubscriptionId, resourceGroup, clusterName, name)
+	future, err := createOrUpdateKubernetesClusterNodePool(ctx, poolsClient, d, id, parameters)
 	if err != nil {
 		return fmt.Errorf("creating/updating Managed Kubernetes Cluster Node Pool %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -641,7 +665,7 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 
 	log.Printf("[DEBUG] Updating existing Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup)
 	existing.ManagedClusterAgentPoolProfileProperties = props
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, existing)
+	future, err := createOrUpdateKubernetesClusterNodePool(ctx, client, d, *id, existing)
 	if err != nil {
 		return fmt.Errorf("updating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err)
 	}
@@ -787,6 +811,12 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 
 		d.Set("proximity_placement_group_id", props.ProximityPlacementGroupID)
 
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+			snapshotId = *props.CreationData.SourceResourceID
+		}
+		d.Set("snapshot_id", snapshotId)
+
 		spotMaxPrice := -1.0
 		if props.SpotMaxPrice != nil {
 			spotMaxPrice = *props.SpotMaxPrice
@@ -801,6 +831,22 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
+	extendedProperties, err := azuresdkhacks.GetAgentPoolExtendedProperties(ctx, poolsClient, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
+	if err != nil {
+		return fmt.Errorf("retrieving extended properties for Node Pool %q (Managed Kubernetes Cluster %q / Resource Group %q): %+v", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	hostGroupId := ""
+	if extendedProperties != nil && extendedProperties.HostGroupID != nil {
+		// the API returns the Host Group ID using inconsistent casing
+		parsedHostGroupId, err := computeParse.DedicatedHostGroupIDInsensitively(*extendedProperties.HostGroupID)
+		if err != nil {
+			return fmt.Errorf("parsing `host_group_id`: %+v", err)
+		}
+		hostGroupId = parsedHostGroupId.ID()
+	}
+	d.Set("host_group_id", hostGroupId)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -886,3 +932,18 @@ func flattenUpgradeSettings(input *containerservice.AgentPoolUpgradeSettings) []
 		},
 	}
 }
+
+// createOrUpdateKubernetesClusterNodePool creates/updates the Node Pool, using a newer API version only when
+// creating a Node Pool with properties which aren't available in the Container Service SDK - since these are
+// ForceNew, updates continue to use the API version from the SDK
+func createOrUpdateKubernetesClusterNodePool(ctx context.Context, client *containerservice.AgentPoolsClient, d *pluginsdk.ResourceData, id parse.NodePoolId, parameters containerservice.AgentPool) (containerservice.AgentPoolsCreateOrUpdateFuture, error) {
+	hostGroupId := d.Get("host_group_id").(string)
+	if hostGroupId == "" || !d.IsNewResource() {
+		return client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, parameters)
+	}
+
+	extendedProperties := azuresdkhacks.AgentPoolExtendedProperties{
+		HostGroupID: utils.String(hostGroupId),
+	}
+	return azuresdkhacks.CreateOrUpdateAgentPoolWithExtendedProperties(ctx, client, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, parameters, extendedProperties)
+}
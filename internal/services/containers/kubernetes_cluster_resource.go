@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
@@ -328,11 +331,122 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"image_cleaner": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+						"interval_hours": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(24, 2160),
+						},
+					},
+				},
+			},
+
+			"key_management_service": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+						"key_vault_network_access": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  kubernetesClusterKeyVaultNetworkAccessPublic,
+							ValidateFunc: validation.StringInSlice([]string{
+								kubernetesClusterKeyVaultNetworkAccessPrivate,
+								kubernetesClusterKeyVaultNetworkAccessPublic,
+							}, false),
+						},
+					},
+				},
+			},
+
 			"local_account_disabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 			},
 
+			"microsoft_defender": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"log_analytics_workspace_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+						},
+					},
+				},
+			},
+
+			"oidc_issuer_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"oidc_issuer_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"workload_identity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"web_app_routing": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"dns_zone_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+					},
+				},
+			},
+
+			"workload_autoscaler_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"keda_enabled": {
+							Type:         pluginsdk.TypeBool,
+							Optional:     true,
+							AtLeastOneOf: []string{"workload_autoscaler_profile.0.keda_enabled", "workload_autoscaler_profile.0.vertical_pod_autoscaler_enabled"},
+						},
+						"vertical_pod_autoscaler_enabled": {
+							Type:         pluginsdk.TypeBool,
+							Optional:     true,
+							AtLeastOneOf: []string{"workload_autoscaler_profile.0.keda_enabled", "workload_autoscaler_profile.0.vertical_pod_autoscaler_enabled"},
+						},
+					},
+				},
+			},
+
 			"maintenance_window": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1170,7 +1284,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.ManagedClusterProperties.DiskEncryptionSetID = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+	future, err := createOrUpdateKubernetesCluster(ctx, client, d, resGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("creating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -1373,6 +1487,10 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.LinuxProfile = linuxProfile
 	}
 
+	if d.HasChanges(kubernetesClusterExtendedPropertyKeys...) {
+		updateCluster = true
+	}
+
 	if d.HasChange("local_account_disabled") {
 		updateCluster = true
 		existing.ManagedClusterProperties.DisableLocalAccounts = utils.Bool(d.Get("local_account_disabled").(bool))
@@ -1503,7 +1621,7 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	if updateCluster {
 		log.Printf("[DEBUG] Updating the Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
-		future, err := clusterClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, existing)
+		future, err := createOrUpdateKubernetesCluster(ctx, clusterClient, d, id.ResourceGroup, id.ManagedClusterName, existing)
 		if err != nil {
 			return fmt.Errorf("updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
 		}
@@ -1528,7 +1646,7 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Upgrading the version of Kubernetes to %q..", kubernetesVersion)
 		existing.ManagedClusterProperties.KubernetesVersion = utils.String(kubernetesVersion)
 
-		future, err := clusterClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, existing)
+		future, err := createOrUpdateKubernetesCluster(ctx, clusterClient, d, id.ResourceGroup, id.ManagedClusterName, existing)
 		if err != nil {
 			return fmt.Errorf("updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
 		}
@@ -1611,12 +1729,21 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
 	}
 
+	extendedProperties, err := azuresdkhacks.GetManagedClusterExtendedProperties(ctx, client, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		return fmt.Errorf("retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
+	if err := flattenKubernetesClusterExtendedProperties(d, extendedProperties); err != nil {
+		return fmt.Errorf("setting the extended properties: %+v", err)
+	}
+
 	skuTier := string(containerservice.ManagedClusterSKUTierFree)
 	if resp.Sku != nil && resp.Sku.Tier != "" {
 		skuTier = string(resp.Sku.Tier)
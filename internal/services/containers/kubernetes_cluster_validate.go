@@ -39,6 +39,10 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *containerserv
 		}
 	}
 
+	if d.Get("workload_identity_enabled").(bool) && !d.Get("oidc_issuer_enabled").(bool) {
+		return fmt.Errorf("`oidc_issuer_enabled` must be set to `true` to enable `workload_identity_enabled`")
+	}
+
 	// @tombuildsstuff: As of 2020-03-30 it's no longer possible to create a cluster using a Service Principal
 	// for authentication (albeit this worked on 2020-03-27 via API version 2019-10-01 :shrug:). However it's
 	// possible to rotate the Service Principal for an existing Cluster - so this needs to be supported via
@@ -0,0 +1,379 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/updateruns"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateRunResource{}
+
+type KubernetesFleetUpdateRunResource struct{}
+
+type KubernetesFleetUpdateRunModel struct {
+	Name                     string                                         `tfschema:"name"`
+	KubernetesFleetManagerId string                                         `tfschema:"kubernetes_fleet_manager_id"`
+	ManagedClusterUpdate     []KubernetesFleetUpdateRunManagedClusterUpdate `tfschema:"managed_cluster_update"`
+	FleetUpdateStrategyId    string                                         `tfschema:"fleet_update_strategy_id"`
+	Stage                    []KubernetesFleetStageModel                    `tfschema:"stage"`
+}
+
+type KubernetesFleetUpdateRunManagedClusterUpdate struct {
+	Upgrade            []KubernetesFleetUpdateRunUpgrade            `tfschema:"upgrade"`
+	NodeImageSelection []KubernetesFleetUpdateRunNodeImageSelection `tfschema:"node_image_selection"`
+}
+
+type KubernetesFleetUpdateRunUpgrade struct {
+	Type              string `tfschema:"type"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+}
+
+type KubernetesFleetUpdateRunNodeImageSelection struct {
+	Type string `tfschema:"type"`
+}
+
+func (r KubernetesFleetUpdateRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.KubernetesFleetName,
+		},
+
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleets.ValidateFleetID,
+		},
+
+		"managed_cluster_update": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"upgrade": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForManagedClusterUpgradeType(), false),
+								},
+
+								"kubernetes_version": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"node_image_selection": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForNodeImageSelectionType(), false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"fleet_update_strategy_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  fleetupdatestrategies.ValidateUpdateStrategyID,
+			ConflictsWith: []string{"stage"},
+		},
+
+		"stage": func() *pluginsdk.Schema {
+			s := kubernetesFleetStageSchema(false)
+			s.ConflictsWith = []string{"fleet_update_strategy_id"}
+			return s
+		}(),
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetUpdateRunResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateRunModel{}
+}
+
+func (r KubernetesFleetUpdateRunResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_run"
+}
+
+func (r KubernetesFleetUpdateRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return updateruns.ValidateUpdateRunID
+}
+
+func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateRunsClient
+
+			var model KubernetesFleetUpdateRunModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleets.ParseFleetID(model.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := updateruns.NewUpdateRunID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandKubernetesFleetUpdateRun(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetUpdateRunModel{
+				Name:                     id.UpdateRunName,
+				KubernetesFleetManagerId: fleets.NewFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ManagedClusterUpdate = flattenKubernetesFleetUpdateRunManagedClusterUpdate(props.ManagedClusterUpdate)
+
+					if props.UpdateStrategyId != nil {
+						strategyId, err := fleetupdatestrategies.ParseUpdateStrategyIDInsensitively(*props.UpdateStrategyId)
+						if err != nil {
+							return err
+						}
+						state.FleetUpdateStrategyId = strategyId.ID()
+					}
+
+					// when an Update Strategy is used the API returns a copy of its stages, which shouldn't be tracked here
+					if state.FleetUpdateStrategyId == "" && props.Strategy != nil {
+						state.Stage = flattenKubernetesFleetUpdateRunStages(props.Strategy.Stages)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetUpdateRunModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandKubernetesFleetUpdateRun(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateRun(input KubernetesFleetUpdateRunModel) updateruns.UpdateRun {
+	output := updateruns.UpdateRun{
+		Properties: &updateruns.UpdateRunProperties{
+			ManagedClusterUpdate: expandKubernetesFleetUpdateRunManagedClusterUpdate(input.ManagedClusterUpdate),
+		},
+	}
+
+	if input.FleetUpdateStrategyId != "" {
+		output.Properties.UpdateStrategyId = utils.String(input.FleetUpdateStrategyId)
+	}
+
+	if len(input.Stage) > 0 {
+		output.Properties.Strategy = &updateruns.UpdateRunStrategy{
+			Stages: expandKubernetesFleetUpdateRunStages(input.Stage),
+		}
+	}
+
+	return output
+}
+
+func expandKubernetesFleetUpdateRunManagedClusterUpdate(input []KubernetesFleetUpdateRunManagedClusterUpdate) updateruns.ManagedClusterUpdate {
+	output := updateruns.ManagedClusterUpdate{}
+	if len(input) == 0 {
+		return output
+	}
+
+	update := input[0]
+	if len(update.Upgrade) > 0 {
+		output.Upgrade = updateruns.ManagedClusterUpgradeSpec{
+			Type: updateruns.ManagedClusterUpgradeType(update.Upgrade[0].Type),
+		}
+		if v := update.Upgrade[0].KubernetesVersion; v != "" {
+			output.Upgrade.KubernetesVersion = utils.String(v)
+		}
+	}
+
+	if len(update.NodeImageSelection) > 0 {
+		output.NodeImageSelection = &updateruns.NodeImageSelection{
+			Type: updateruns.NodeImageSelectionType(update.NodeImageSelection[0].Type),
+		}
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateRunManagedClusterUpdate(input updateruns.ManagedClusterUpdate) []KubernetesFleetUpdateRunManagedClusterUpdate {
+	upgrade := KubernetesFleetUpdateRunUpgrade{
+		Type: string(input.Upgrade.Type),
+	}
+	if input.Upgrade.KubernetesVersion != nil {
+		upgrade.KubernetesVersion = *input.Upgrade.KubernetesVersion
+	}
+
+	nodeImageSelection := make([]KubernetesFleetUpdateRunNodeImageSelection, 0)
+	if input.NodeImageSelection != nil {
+		nodeImageSelection = append(nodeImageSelection, KubernetesFleetUpdateRunNodeImageSelection{
+			Type: string(input.NodeImageSelection.Type),
+		})
+	}
+
+	return []KubernetesFleetUpdateRunManagedClusterUpdate{
+		{
+			Upgrade:            []KubernetesFleetUpdateRunUpgrade{upgrade},
+			NodeImageSelection: nodeImageSelection,
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateRunStages(input []KubernetesFleetStageModel) []updateruns.UpdateStage {
+	output := make([]updateruns.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]updateruns.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, updateruns.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		output = append(output, updateruns.UpdateStage{
+			AfterStageWaitInSeconds: utils.Int64(int64(stage.AfterStageWaitInSeconds)),
+			Groups:                  &groups,
+			Name:                    stage.Name,
+		})
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateRunStages(input []updateruns.UpdateStage) []KubernetesFleetStageModel {
+	output := make([]KubernetesFleetStageModel, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetGroupModel, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetGroupModel{
+					Name: group.Name,
+				})
+			}
+		}
+
+		afterStageWaitInSeconds := 0
+		if stage.AfterStageWaitInSeconds != nil {
+			afterStageWaitInSeconds = int(*stage.AfterStageWaitInSeconds)
+		}
+
+		output = append(output, KubernetesFleetStageModel{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: afterStageWaitInSeconds,
+		})
+	}
+
+	return output
+}
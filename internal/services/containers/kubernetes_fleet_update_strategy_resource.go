@@ -0,0 +1,290 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetupdatestrategies"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateStrategyResource{}
+
+type KubernetesFleetUpdateStrategyResource struct{}
+
+type KubernetesFleetUpdateStrategyModel struct {
+	Name                     string                      `tfschema:"name"`
+	KubernetesFleetManagerId string                      `tfschema:"kubernetes_fleet_manager_id"`
+	Stage                    []KubernetesFleetStageModel `tfschema:"stage"`
+}
+
+type KubernetesFleetStageModel struct {
+	Name                    string                      `tfschema:"name"`
+	Group                   []KubernetesFleetGroupModel `tfschema:"group"`
+	AfterStageWaitInSeconds int                         `tfschema:"after_stage_wait_in_seconds"`
+}
+
+type KubernetesFleetGroupModel struct {
+	Name string `tfschema:"name"`
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.KubernetesFleetName,
+		},
+
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleets.ValidateFleetID,
+		},
+
+		"stage": kubernetesFleetStageSchema(true),
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateStrategyModel{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_strategy"
+}
+
+func (r KubernetesFleetUpdateStrategyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleetupdatestrategies.ValidateUpdateStrategyID
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			var model KubernetesFleetUpdateStrategyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleets.ParseFleetID(model.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := fleetupdatestrategies.NewUpdateStrategyID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := fleetupdatestrategies.FleetUpdateStrategy{
+				Properties: &fleetupdatestrategies.FleetUpdateStrategyProperties{
+					Strategy: fleetupdatestrategies.UpdateRunStrategy{
+						Stages: expandKubernetesFleetUpdateStrategyStages(model.Stage),
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetUpdateStrategyModel{
+				Name:                     id.UpdateStrategyName,
+				KubernetesFleetManagerId: fleets.NewFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Stage = flattenKubernetesFleetUpdateStrategyStages(model.Properties.Strategy.Stages)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetUpdateStrategyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := fleetupdatestrategies.FleetUpdateStrategy{
+				Properties: &fleetupdatestrategies.FleetUpdateStrategyProperties{
+					Strategy: fleetupdatestrategies.UpdateRunStrategy{
+						Stages: expandKubernetesFleetUpdateStrategyStages(model.Stage),
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// kubernetesFleetStageSchema returns the schema for the stages of an Update Strategy, which can
+// either be defined on an Update Strategy or inline on an Update Run
+func kubernetesFleetStageSchema(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"group": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"after_stage_wait_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+			},
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateStrategyStages(input []KubernetesFleetStageModel) []fleetupdatestrategies.UpdateStage {
+	output := make([]fleetupdatestrategies.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]fleetupdatestrategies.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, fleetupdatestrategies.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		output = append(output, fleetupdatestrategies.UpdateStage{
+			AfterStageWaitInSeconds: utils.Int64(int64(stage.AfterStageWaitInSeconds)),
+			Groups:                  &groups,
+			Name:                    stage.Name,
+		})
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateStrategyStages(input []fleetupdatestrategies.UpdateStage) []KubernetesFleetStageModel {
+	output := make([]KubernetesFleetStageModel, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetGroupModel, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetGroupModel{
+					Name: group.Name,
+				})
+			}
+		}
+
+		afterStageWaitInSeconds := 0
+		if stage.AfterStageWaitInSeconds != nil {
+			afterStageWaitInSeconds = int(*stage.AfterStageWaitInSeconds)
+		}
+
+		output = append(output, KubernetesFleetStageModel{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: afterStageWaitInSeconds,
+		})
+	}
+
+	return output
+}
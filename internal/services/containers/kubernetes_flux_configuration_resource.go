@@ -0,0 +1,773 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/fluxconfiguration"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	fluxRepositoryReferenceTypeBranch = "branch"
+	fluxRepositoryReferenceTypeCommit = "commit"
+	fluxRepositoryReferenceTypeSemver = "semver"
+	fluxRepositoryReferenceTypeTag    = "tag"
+
+	// the keys used for the secrets within `configurationProtectedSettings`
+	fluxProtectedSettingBucketSecretKey = "bucketSecretKey"
+	fluxProtectedSettingHttpsKey        = "httpsKey"
+	fluxProtectedSettingSshPrivateKey   = "sshPrivateKey"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFluxConfigurationResource{}
+
+type KubernetesFluxConfigurationResource struct{}
+
+type KubernetesFluxConfigurationModel struct {
+	Name                            string                                `tfschema:"name"`
+	ClusterId                       string                                `tfschema:"cluster_id"`
+	Namespace                       string                                `tfschema:"namespace"`
+	Scope                           string                                `tfschema:"scope"`
+	GitRepository                   []FluxConfigurationGitRepositoryModel `tfschema:"git_repository"`
+	Bucket                          []FluxConfigurationBucketModel        `tfschema:"bucket"`
+	Kustomizations                  []FluxConfigurationKustomizationModel `tfschema:"kustomizations"`
+	ContinuousReconciliationEnabled bool                                  `tfschema:"continuous_reconciliation_enabled"`
+}
+
+type FluxConfigurationGitRepositoryModel struct {
+	Url                   string `tfschema:"url"`
+	ReferenceType         string `tfschema:"reference_type"`
+	ReferenceValue        string `tfschema:"reference_value"`
+	HttpsUser             string `tfschema:"https_user"`
+	HttpsKeyBase64        string `tfschema:"https_key_base64"`
+	HttpsCACertBase64     string `tfschema:"https_ca_cert_base64"`
+	SshPrivateKeyBase64   string `tfschema:"ssh_private_key_base64"`
+	SshKnownHostsBase64   string `tfschema:"ssh_known_hosts_base64"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int    `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int    `tfschema:"timeout_in_seconds"`
+}
+
+type FluxConfigurationBucketModel struct {
+	Url                   string `tfschema:"url"`
+	BucketName            string `tfschema:"bucket_name"`
+	AccessKey             string `tfschema:"access_key"`
+	SecretKeyBase64       string `tfschema:"secret_key_base64"`
+	TlsEnabled            bool   `tfschema:"tls_enabled"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int    `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int    `tfschema:"timeout_in_seconds"`
+}
+
+type FluxConfigurationKustomizationModel struct {
+	Name                     string   `tfschema:"name"`
+	Path                     string   `tfschema:"path"`
+	DependsOn                []string `tfschema:"depends_on"`
+	GarbageCollectionEnabled bool     `tfschema:"garbage_collection_enabled"`
+	RecreatingEnabled        bool     `tfschema:"recreating_enabled"`
+	RetryIntervalInSeconds   int      `tfschema:"retry_interval_in_seconds"`
+	SyncIntervalInSeconds    int      `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds         int      `tfschema:"timeout_in_seconds"`
+}
+
+func (r KubernetesFluxConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.KubernetesFluxConfigurationName,
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ClusterID,
+		},
+
+		"namespace": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kustomizations": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"depends_on": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"garbage_collection_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"recreating_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"retry_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"git_repository": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"git_repository", "bucket"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(http://|https://|git@|ssh://)`), "`url` must start with `http://`, `https://`, `git@` or `ssh://`"),
+					},
+
+					"reference_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							fluxRepositoryReferenceTypeBranch,
+							fluxRepositoryReferenceTypeCommit,
+							fluxRepositoryReferenceTypeSemver,
+							fluxRepositoryReferenceTypeTag,
+						}, false),
+					},
+
+					"reference_value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"https_user": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						RequiredWith:  []string{"git_repository.0.https_key_base64"},
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"https_key_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"git_repository.0.https_user"},
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"https_ca_cert_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"git_repository.0.https_user"},
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"ssh_private_key_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.https_user"},
+						ValidateFunc:  validation.StringIsBase64,
+					},
+
+					"ssh_known_hosts_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"git_repository.0.https_user", "git_repository.0.ssh_private_key_base64"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"bucket": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"git_repository", "bucket"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"bucket_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"access_key": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						RequiredWith:  []string{"bucket.0.secret_key_base64"},
+						ConflictsWith: []string{"bucket.0.local_auth_reference"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"secret_key_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"bucket.0.access_key"},
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"tls_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"bucket.0.access_key"},
+						ValidateFunc:  validation.StringIsNotEmpty,
+					},
+
+					"sync_interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      600,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(fluxconfiguration.ScopeTypeNamespace),
+			ValidateFunc: validation.StringInSlice(fluxconfiguration.PossibleValuesForScopeType(), false),
+		},
+
+		"continuous_reconciliation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFluxConfigurationResource) ModelObject() interface{} {
+	return &KubernetesFluxConfigurationModel{}
+}
+
+func (r KubernetesFluxConfigurationResource) ResourceType() string {
+	return "azurerm_kubernetes_flux_configuration"
+}
+
+func (r KubernetesFluxConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fluxconfiguration.ValidateScopedFluxConfigurationID
+}
+
+func (r KubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			var model KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := parse.ClusterID(model.ClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := fluxconfiguration.NewScopedFluxConfigurationID(clusterId.ID(), model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandKubernetesFluxConfigurationProperties(model)
+			if err != nil {
+				return err
+			}
+
+			parameters := fluxconfiguration.FluxConfiguration{
+				Properties: properties,
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			clusterId, err := parse.ClusterID(id.Scope)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the secrets aren't returned by the API, so we load them from the existing state
+			var config KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := KubernetesFluxConfigurationModel{
+				Name:      id.FluxConfigurationName,
+				ClusterId: clusterId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Bucket = flattenKubernetesFluxConfigurationBucket(props.Bucket, config.Bucket)
+					state.ContinuousReconciliationEnabled = props.Suspend == nil || !*props.Suspend
+					state.GitRepository = flattenKubernetesFluxConfigurationGitRepository(props.GitRepository, config.GitRepository)
+					state.Kustomizations = flattenKubernetesFluxConfigurationKustomizations(props.Kustomizations)
+
+					if props.Namespace != nil {
+						state.Namespace = *props.Namespace
+					}
+
+					if props.Scope != nil {
+						state.Scope = string(*props.Scope)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties, err := expandKubernetesFluxConfigurationProperties(model)
+			if err != nil {
+				return err
+			}
+
+			// the whole configuration is sent, so any Kustomizations which have been removed are removed from the cluster
+			parameters := fluxconfiguration.FluxConfiguration{
+				Properties: properties,
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFluxConfigurationProperties(input KubernetesFluxConfigurationModel) (*fluxconfiguration.FluxConfigurationProperties, error) {
+	scope := fluxconfiguration.ScopeType(input.Scope)
+	protectedSettings := make(map[string]string)
+
+	output := fluxconfiguration.FluxConfigurationProperties{
+		ConfigurationProtectedSettings: &protectedSettings,
+		Kustomizations:                 expandKubernetesFluxConfigurationKustomizations(input.Kustomizations),
+		Namespace:                      utils.String(input.Namespace),
+		Scope:                          &scope,
+		Suspend:                        utils.Bool(!input.ContinuousReconciliationEnabled),
+	}
+
+	if len(input.GitRepository) > 0 {
+		repository := input.GitRepository[0]
+		sourceKind := fluxconfiguration.SourceKindTypeGitRepository
+		output.SourceKind = &sourceKind
+
+		repositoryRef, err := expandKubernetesFluxConfigurationRepositoryRef(repository.ReferenceType, repository.ReferenceValue)
+		if err != nil {
+			return nil, err
+		}
+
+		output.GitRepository = &fluxconfiguration.GitRepositoryDefinition{
+			RepositoryRef:         repositoryRef,
+			SyncIntervalInSeconds: utils.Int64(int64(repository.SyncIntervalInSeconds)),
+			TimeoutInSeconds:      utils.Int64(int64(repository.TimeoutInSeconds)),
+			Url:                   utils.String(repository.Url),
+		}
+
+		if repository.HttpsCACertBase64 != "" {
+			output.GitRepository.HttpsCACert = utils.String(repository.HttpsCACertBase64)
+		}
+
+		if repository.HttpsUser != "" {
+			output.GitRepository.HttpsUser = utils.String(repository.HttpsUser)
+		}
+
+		if repository.LocalAuthReference != "" {
+			output.GitRepository.LocalAuthRef = utils.String(repository.LocalAuthReference)
+		}
+
+		if repository.SshKnownHostsBase64 != "" {
+			output.GitRepository.SshKnownHosts = utils.String(repository.SshKnownHostsBase64)
+		}
+
+		if repository.HttpsKeyBase64 != "" {
+			protectedSettings[fluxProtectedSettingHttpsKey] = repository.HttpsKeyBase64
+		}
+
+		if repository.SshPrivateKeyBase64 != "" {
+			protectedSettings[fluxProtectedSettingSshPrivateKey] = repository.SshPrivateKeyBase64
+		}
+	}
+
+	if len(input.Bucket) > 0 {
+		bucket := input.Bucket[0]
+		sourceKind := fluxconfiguration.SourceKindTypeBucket
+		output.SourceKind = &sourceKind
+
+		output.Bucket = &fluxconfiguration.BucketDefinition{
+			BucketName:            utils.String(bucket.BucketName),
+			Insecure:              utils.Bool(!bucket.TlsEnabled),
+			SyncIntervalInSeconds: utils.Int64(int64(bucket.SyncIntervalInSeconds)),
+			TimeoutInSeconds:      utils.Int64(int64(bucket.TimeoutInSeconds)),
+			Url:                   utils.String(bucket.Url),
+		}
+
+		if bucket.AccessKey != "" {
+			output.Bucket.AccessKey = utils.String(bucket.AccessKey)
+		}
+
+		if bucket.LocalAuthReference != "" {
+			output.Bucket.LocalAuthRef = utils.String(bucket.LocalAuthReference)
+		}
+
+		if bucket.SecretKeyBase64 != "" {
+			protectedSettings[fluxProtectedSettingBucketSecretKey] = bucket.SecretKeyBase64
+		}
+	}
+
+	return &output, nil
+}
+
+func expandKubernetesFluxConfigurationRepositoryRef(referenceType, referenceValue string) (*fluxconfiguration.RepositoryRefDefinition, error) {
+	output := fluxconfiguration.RepositoryRefDefinition{}
+
+	switch referenceType {
+	case fluxRepositoryReferenceTypeBranch:
+		output.Branch = utils.String(referenceValue)
+	case fluxRepositoryReferenceTypeCommit:
+		output.Commit = utils.String(referenceValue)
+	case fluxRepositoryReferenceTypeSemver:
+		output.Semver = utils.String(referenceValue)
+	case fluxRepositoryReferenceTypeTag:
+		output.Tag = utils.String(referenceValue)
+	default:
+		return nil, fmt.Errorf("unsupported `reference_type` %q", referenceType)
+	}
+
+	return &output, nil
+}
+
+func expandKubernetesFluxConfigurationKustomizations(input []FluxConfigurationKustomizationModel) *map[string]fluxconfiguration.KustomizationDefinition {
+	output := make(map[string]fluxconfiguration.KustomizationDefinition)
+	for _, item := range input {
+		dependsOn := item.DependsOn
+		if dependsOn == nil {
+			dependsOn = make([]string, 0)
+		}
+
+		output[item.Name] = fluxconfiguration.KustomizationDefinition{
+			DependsOn:              &dependsOn,
+			Force:                  utils.Bool(item.RecreatingEnabled),
+			Path:                   utils.String(item.Path),
+			Prune:                  utils.Bool(item.GarbageCollectionEnabled),
+			RetryIntervalInSeconds: utils.Int64(int64(item.RetryIntervalInSeconds)),
+			SyncIntervalInSeconds:  utils.Int64(int64(item.SyncIntervalInSeconds)),
+			TimeoutInSeconds:       utils.Int64(int64(item.TimeoutInSeconds)),
+		}
+	}
+	return &output
+}
+
+func flattenKubernetesFluxConfigurationKustomizations(input *map[string]fluxconfiguration.KustomizationDefinition) []FluxConfigurationKustomizationModel {
+	output := make([]FluxConfigurationKustomizationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for name, item := range *input {
+		kustomization := FluxConfigurationKustomizationModel{
+			Name:                     name,
+			DependsOn:                make([]string, 0),
+			GarbageCollectionEnabled: item.Prune != nil && *item.Prune,
+			RecreatingEnabled:        item.Force != nil && *item.Force,
+		}
+
+		if item.DependsOn != nil {
+			kustomization.DependsOn = *item.DependsOn
+		}
+
+		if item.Path != nil {
+			kustomization.Path = *item.Path
+		}
+
+		if item.RetryIntervalInSeconds != nil {
+			kustomization.RetryIntervalInSeconds = int(*item.RetryIntervalInSeconds)
+		}
+
+		if item.SyncIntervalInSeconds != nil {
+			kustomization.SyncIntervalInSeconds = int(*item.SyncIntervalInSeconds)
+		}
+
+		if item.TimeoutInSeconds != nil {
+			kustomization.TimeoutInSeconds = int(*item.TimeoutInSeconds)
+		}
+
+		output = append(output, kustomization)
+	}
+
+	return output
+}
+
+func flattenKubernetesFluxConfigurationGitRepository(input *fluxconfiguration.GitRepositoryDefinition, config []FluxConfigurationGitRepositoryModel) []FluxConfigurationGitRepositoryModel {
+	if input == nil {
+		return []FluxConfigurationGitRepositoryModel{}
+	}
+
+	output := FluxConfigurationGitRepositoryModel{}
+
+	// the secrets aren't returned by the API
+	if len(config) > 0 {
+		output.HttpsKeyBase64 = config[0].HttpsKeyBase64
+		output.SshPrivateKeyBase64 = config[0].SshPrivateKeyBase64
+	}
+
+	if input.HttpsCACert != nil {
+		output.HttpsCACertBase64 = *input.HttpsCACert
+	}
+
+	if input.HttpsUser != nil {
+		output.HttpsUser = *input.HttpsUser
+	}
+
+	if input.LocalAuthRef != nil {
+		output.LocalAuthReference = *input.LocalAuthRef
+	}
+
+	if ref := input.RepositoryRef; ref != nil {
+		switch {
+		case ref.Branch != nil:
+			output.ReferenceType = fluxRepositoryReferenceTypeBranch
+			output.ReferenceValue = *ref.Branch
+		case ref.Commit != nil:
+			output.ReferenceType = fluxRepositoryReferenceTypeCommit
+			output.ReferenceValue = *ref.Commit
+		case ref.Semver != nil:
+			output.ReferenceType = fluxRepositoryReferenceTypeSemver
+			output.ReferenceValue = *ref.Semver
+		case ref.Tag != nil:
+			output.ReferenceType = fluxRepositoryReferenceTypeTag
+			output.ReferenceValue = *ref.Tag
+		}
+	}
+
+	if input.SshKnownHosts != nil {
+		output.SshKnownHostsBase64 = *input.SshKnownHosts
+	}
+
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = int(*input.SyncIntervalInSeconds)
+	}
+
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = int(*input.TimeoutInSeconds)
+	}
+
+	if input.Url != nil {
+		output.Url = *input.Url
+	}
+
+	return []FluxConfigurationGitRepositoryModel{output}
+}
+
+func flattenKubernetesFluxConfigurationBucket(input *fluxconfiguration.BucketDefinition, config []FluxConfigurationBucketModel) []FluxConfigurationBucketModel {
+	if input == nil {
+		return []FluxConfigurationBucketModel{}
+	}
+
+	output := FluxConfigurationBucketModel{
+		TlsEnabled: input.Insecure == nil || !*input.Insecure,
+	}
+
+	// the secret key isn't returned by the API
+	if len(config) > 0 {
+		output.SecretKeyBase64 = config[0].SecretKeyBase64
+	}
+
+	if input.AccessKey != nil {
+		output.AccessKey = *input.AccessKey
+	}
+
+	if input.BucketName != nil {
+		output.BucketName = *input.BucketName
+	}
+
+	if input.LocalAuthRef != nil {
+		output.LocalAuthReference = *input.LocalAuthRef
+	}
+
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = int(*input.SyncIntervalInSeconds)
+	}
+
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = int(*input.TimeoutInSeconds)
+	}
+
+	if input.Url != nil {
+		output.Url = *input.Url
+	}
+
+	return []FluxConfigurationBucketModel{output}
+}
@@ -214,6 +214,12 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					ForceNew:     true,
 					ValidateFunc: computeValidate.ProximityPlacementGroupID,
 				},
+				"snapshot_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NodePoolSnapshotID,
+				},
 				"only_critical_addons_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
@@ -594,6 +600,7 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 			Type:                      defaultCluster.Type,
 			OrchestratorVersion:       defaultCluster.OrchestratorVersion,
 			ProximityPlacementGroupID: defaultCluster.ProximityPlacementGroupID,
+			CreationData:              defaultCluster.CreationData,
 			AvailabilityZones:         defaultCluster.AvailabilityZones,
 			EnableNodePublicIP:        defaultCluster.EnableNodePublicIP,
 			NodePublicIPPrefixID:      defaultCluster.NodePublicIPPrefixID,
@@ -710,6 +717,12 @@ func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]containerservice.Manag
 		profile.ProximityPlacementGroupID = utils.String(proximityPlacementGroupId)
 	}
 
+	if snapshotId := raw["snapshot_id"].(string); snapshotId != "" {
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	count := raw["node_count"].(int)
 	maxCount := raw["max_count"].(int)
 	minCount := raw["min_count"].(int)
@@ -1057,6 +1070,11 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 		proximityPlacementGroupId = *agentPool.ProximityPlacementGroupID
 	}
 
+	snapshotId := ""
+	if agentPool.CreationData != nil && agentPool.CreationData.SourceResourceID != nil {
+		snapshotId = *agentPool.CreationData.SourceResourceID
+	}
+
 	vmSize := ""
 	if agentPool.VMSize != nil {
 		vmSize = *agentPool.VMSize
@@ -1093,6 +1111,7 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 			"pod_subnet_id":                podSubnetId,
 			"orchestrator_version":         orchestratorVersion,
 			"proximity_placement_group_id": proximityPlacementGroupId,
+			"snapshot_id":                  snapshotId,
 			"upgrade_settings":             upgradeSettings,
 			"vnet_subnet_id":               vnetSubnetId,
 			"only_critical_addons_enabled": criticalAddonsEnabled,
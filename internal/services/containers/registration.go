@@ -1,11 +1,17 @@
 package containers
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var (
+	_ sdk.TypedServiceRegistration   = Registration{}
+	_ sdk.UntypedServiceRegistration = Registration{}
+)
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Container Services"
@@ -33,12 +39,41 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_container_group":              resourceContainerGroup(),
-		"azurerm_container_registry_webhook":   resourceContainerRegistryWebhook(),
-		"azurerm_container_registry":           resourceContainerRegistry(),
-		"azurerm_container_registry_token":     resourceContainerRegistryToken(),
-		"azurerm_container_registry_scope_map": resourceContainerRegistryScopeMap(),
-		"azurerm_kubernetes_cluster":           resourceKubernetesCluster(),
-		"azurerm_kubernetes_cluster_node_pool": resourceKubernetesClusterNodePool(),
+		"azurerm_container_group":               resourceContainerGroup(),
+		"azurerm_container_registry_webhook":    resourceContainerRegistryWebhook(),
+		"azurerm_container_registry":            resourceContainerRegistry(),
+		"azurerm_container_registry_token":      resourceContainerRegistryToken(),
+		"azurerm_container_registry_scope_map":  resourceContainerRegistryScopeMap(),
+		"azurerm_kubernetes_cluster":            resourceKubernetesCluster(),
+		"azurerm_kubernetes_cluster_node_pool":  resourceKubernetesClusterNodePool(),
+		"azurerm_kubernetes_node_pool_snapshot": resourceKubernetesNodePoolSnapshot(),
+	}
+}
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ContainerAppEnvironmentCertificateResource{},
+		ContainerAppEnvironmentDaprComponentResource{},
+		ContainerAppEnvironmentResource{},
+		ContainerAppEnvironmentStorageResource{},
+		ContainerAppResource{},
+		ContainerRegistryAgentPoolResource{},
+		ContainerRegistryCacheRuleResource{},
+		ContainerRegistryConnectedRegistryResource{},
+		ContainerRegistryCredentialSetResource{},
+		ContainerRegistryTaskResource{},
+		KubernetesClusterExtensionResource{},
+		KubernetesClusterMaintenanceConfigurationResource{},
+		KubernetesFleetManagerResource{},
+		KubernetesFleetMemberResource{},
+		KubernetesFleetUpdateRunResource{},
+		KubernetesFleetUpdateStrategyResource{},
+		KubernetesFluxConfigurationResource{},
 	}
 }
@@ -0,0 +1,71 @@
+package extensions
+
+import "strings"
+
+type AKSIdentityType string
+
+const (
+	AKSIdentityTypeSystemAssigned AKSIdentityType = "SystemAssigned"
+	AKSIdentityTypeUserAssigned   AKSIdentityType = "UserAssigned"
+)
+
+func PossibleValuesForAKSIdentityType() []string {
+	return []string{
+		string(AKSIdentityTypeSystemAssigned),
+		string(AKSIdentityTypeUserAssigned),
+	}
+}
+
+func parseAKSIdentityType(input string) (*AKSIdentityType, error) {
+	vals := map[string]AKSIdentityType{
+		"systemassigned": AKSIdentityTypeSystemAssigned,
+		"userassigned":   AKSIdentityTypeUserAssigned,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AKSIdentityType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
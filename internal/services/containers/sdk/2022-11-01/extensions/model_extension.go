@@ -0,0 +1,9 @@
+package extensions
+
+type Extension struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Plan       *Plan                `json:"plan,omitempty"`
+	Properties *ExtensionProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
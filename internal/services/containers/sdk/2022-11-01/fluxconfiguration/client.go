@@ -0,0 +1,15 @@
+package fluxconfiguration
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FluxConfigurationClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFluxConfigurationClientWithBaseURI(endpoint string) FluxConfigurationClient {
+	return FluxConfigurationClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
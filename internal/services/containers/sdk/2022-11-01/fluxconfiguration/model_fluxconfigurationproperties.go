@@ -0,0 +1,17 @@
+package fluxconfiguration
+
+type FluxConfigurationProperties struct {
+	Bucket                         *BucketDefinition                   `json:"bucket,omitempty"`
+	ComplianceState                *FluxComplianceState                `json:"complianceState,omitempty"`
+	ConfigurationProtectedSettings *map[string]string                  `json:"configurationProtectedSettings,omitempty"`
+	ErrorMessage                   *string                             `json:"errorMessage,omitempty"`
+	GitRepository                  *GitRepositoryDefinition            `json:"gitRepository,omitempty"`
+	Kustomizations                 *map[string]KustomizationDefinition `json:"kustomizations,omitempty"`
+	Namespace                      *string                             `json:"namespace,omitempty"`
+	ProvisioningState              *ProvisioningState                  `json:"provisioningState,omitempty"`
+	RepositoryPublicKey            *string                             `json:"repositoryPublicKey,omitempty"`
+	Scope                          *ScopeType                          `json:"scope,omitempty"`
+	SourceKind                     *SourceKindType                     `json:"sourceKind,omitempty"`
+	SourceSyncedCommitId           *string                             `json:"sourceSyncedCommitId,omitempty"`
+	Suspend                        *bool                               `json:"suspend,omitempty"`
+}
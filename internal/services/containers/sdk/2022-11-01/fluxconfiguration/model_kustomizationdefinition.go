@@ -0,0 +1,12 @@
+package fluxconfiguration
+
+type KustomizationDefinition struct {
+	DependsOn              *[]string `json:"dependsOn,omitempty"`
+	Force                  *bool     `json:"force,omitempty"`
+	Name                   *string   `json:"name,omitempty"`
+	Path                   *string   `json:"path,omitempty"`
+	Prune                  *bool     `json:"prune,omitempty"`
+	RetryIntervalInSeconds *int64    `json:"retryIntervalInSeconds,omitempty"`
+	SyncIntervalInSeconds  *int64    `json:"syncIntervalInSeconds,omitempty"`
+	TimeoutInSeconds       *int64    `json:"timeoutInSeconds,omitempty"`
+}
@@ -0,0 +1,15 @@
+package certificates
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CertificatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCertificatesClientWithBaseURI(endpoint string) CertificatesClient {
+	return CertificatesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
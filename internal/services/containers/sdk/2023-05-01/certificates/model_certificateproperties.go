@@ -0,0 +1,14 @@
+package certificates
+
+type CertificateProperties struct {
+	ExpirationDate    *string `json:"expirationDate,omitempty"`
+	IssueDate         *string `json:"issueDate,omitempty"`
+	Issuer            *string `json:"issuer,omitempty"`
+	Password          *string `json:"password,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	PublicKeyHash     *string `json:"publicKeyHash,omitempty"`
+	SubjectName       *string `json:"subjectName,omitempty"`
+	Thumbprint        *string `json:"thumbprint,omitempty"`
+	Valid             *bool   `json:"valid,omitempty"`
+	Value             *string `json:"value,omitempty"`
+}
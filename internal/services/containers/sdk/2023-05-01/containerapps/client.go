@@ -0,0 +1,15 @@
+package containerapps
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ContainerAppsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContainerAppsClientWithBaseURI(endpoint string) ContainerAppsClient {
+	return ContainerAppsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,121 @@
+package containerapps
+
+import "strings"
+
+type ActiveRevisionsMode string
+
+const (
+	ActiveRevisionsModeMultiple ActiveRevisionsMode = "Multiple"
+	ActiveRevisionsModeSingle   ActiveRevisionsMode = "Single"
+)
+
+func PossibleValuesForActiveRevisionsMode() []string {
+	return []string{
+		string(ActiveRevisionsModeMultiple),
+		string(ActiveRevisionsModeSingle),
+	}
+}
+
+func parseActiveRevisionsMode(input string) (*ActiveRevisionsMode, error) {
+	vals := map[string]ActiveRevisionsMode{
+		"multiple": ActiveRevisionsModeMultiple,
+		"single":   ActiveRevisionsModeSingle,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ActiveRevisionsMode(input)
+	return &out, nil
+}
+
+type AppProtocol string
+
+const (
+	AppProtocolGrpc AppProtocol = "grpc"
+	AppProtocolHTTP AppProtocol = "http"
+)
+
+func PossibleValuesForAppProtocol() []string {
+	return []string{
+		string(AppProtocolGrpc),
+		string(AppProtocolHTTP),
+	}
+}
+
+func parseAppProtocol(input string) (*AppProtocol, error) {
+	vals := map[string]AppProtocol{
+		"grpc": AppProtocolGrpc,
+		"http": AppProtocolHTTP,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AppProtocol(input)
+	return &out, nil
+}
+
+type IngressTransportMethod string
+
+const (
+	IngressTransportMethodAuto    IngressTransportMethod = "auto"
+	IngressTransportMethodHTTP    IngressTransportMethod = "http"
+	IngressTransportMethodHTTPTwo IngressTransportMethod = "http2"
+	IngressTransportMethodTcp     IngressTransportMethod = "tcp"
+)
+
+func PossibleValuesForIngressTransportMethod() []string {
+	return []string{
+		string(IngressTransportMethodAuto),
+		string(IngressTransportMethodHTTP),
+		string(IngressTransportMethodHTTPTwo),
+		string(IngressTransportMethodTcp),
+	}
+}
+
+func parseIngressTransportMethod(input string) (*IngressTransportMethod, error) {
+	vals := map[string]IngressTransportMethod{
+		"auto":  IngressTransportMethodAuto,
+		"http":  IngressTransportMethodHTTP,
+		"http2": IngressTransportMethodHTTPTwo,
+		"tcp":   IngressTransportMethodTcp,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IngressTransportMethod(input)
+	return &out, nil
+}
+
+type StorageType string
+
+const (
+	StorageTypeAzureFile StorageType = "AzureFile"
+	StorageTypeEmptyDir  StorageType = "EmptyDir"
+)
+
+func PossibleValuesForStorageType() []string {
+	return []string{
+		string(StorageTypeAzureFile),
+		string(StorageTypeEmptyDir),
+	}
+}
+
+func parseStorageType(input string) (*StorageType, error) {
+	vals := map[string]StorageType{
+		"azurefile": StorageTypeAzureFile,
+		"emptydir":  StorageTypeEmptyDir,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageType(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package containerapps
+
+type Configuration struct {
+	ActiveRevisionsMode *ActiveRevisionsMode   `json:"activeRevisionsMode,omitempty"`
+	Dapr                *Dapr                  `json:"dapr,omitempty"`
+	Ingress             *Ingress               `json:"ingress,omitempty"`
+	Registries          *[]RegistryCredentials `json:"registries,omitempty"`
+	Secrets             *[]Secret              `json:"secrets,omitempty"`
+}
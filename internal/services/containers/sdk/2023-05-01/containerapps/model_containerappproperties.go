@@ -0,0 +1,15 @@
+package containerapps
+
+type ContainerAppProperties struct {
+	Configuration              *Configuration `json:"configuration,omitempty"`
+	CustomDomainVerificationId *string        `json:"customDomainVerificationId,omitempty"`
+	EnvironmentId              *string        `json:"environmentId,omitempty"`
+	LatestReadyRevisionName    *string        `json:"latestReadyRevisionName,omitempty"`
+	LatestRevisionFqdn         *string        `json:"latestRevisionFqdn,omitempty"`
+	LatestRevisionName         *string        `json:"latestRevisionName,omitempty"`
+	ManagedEnvironmentId       *string        `json:"managedEnvironmentId,omitempty"`
+	OutboundIPAddresses        *[]string      `json:"outboundIpAddresses,omitempty"`
+	ProvisioningState          *string        `json:"provisioningState,omitempty"`
+	Template                   *Template      `json:"template,omitempty"`
+	WorkloadProfileName        *string        `json:"workloadProfileName,omitempty"`
+}
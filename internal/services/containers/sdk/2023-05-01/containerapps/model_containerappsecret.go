@@ -0,0 +1,6 @@
+package containerapps
+
+type ContainerAppSecret struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
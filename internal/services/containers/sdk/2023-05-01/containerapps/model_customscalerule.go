@@ -0,0 +1,7 @@
+package containerapps
+
+type CustomScaleRule struct {
+	Auth     *[]ScaleRuleAuth   `json:"auth,omitempty"`
+	Metadata *map[string]string `json:"metadata,omitempty"`
+	Type     *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerapps
+
+type HTTPScaleRule struct {
+	Auth     *[]ScaleRuleAuth   `json:"auth,omitempty"`
+	Metadata *map[string]string `json:"metadata,omitempty"`
+}
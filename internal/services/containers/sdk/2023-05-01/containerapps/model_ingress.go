@@ -0,0 +1,10 @@
+package containerapps
+
+type Ingress struct {
+	AllowInsecure *bool                   `json:"allowInsecure,omitempty"`
+	External      *bool                   `json:"external,omitempty"`
+	Fqdn          *string                 `json:"fqdn,omitempty"`
+	TargetPort    *int64                  `json:"targetPort,omitempty"`
+	Traffic       *[]TrafficWeight        `json:"traffic,omitempty"`
+	Transport     *IngressTransportMethod `json:"transport,omitempty"`
+}
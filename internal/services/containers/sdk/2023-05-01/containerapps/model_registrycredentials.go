@@ -0,0 +1,7 @@
+package containerapps
+
+type RegistryCredentials struct {
+	PasswordSecretRef *string `json:"passwordSecretRef,omitempty"`
+	Server            *string `json:"server,omitempty"`
+	Username          *string `json:"username,omitempty"`
+}
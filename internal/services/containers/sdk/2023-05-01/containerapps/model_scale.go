@@ -0,0 +1,7 @@
+package containerapps
+
+type Scale struct {
+	MaxReplicas *int64       `json:"maxReplicas,omitempty"`
+	MinReplicas *int64       `json:"minReplicas,omitempty"`
+	Rules       *[]ScaleRule `json:"rules,omitempty"`
+}
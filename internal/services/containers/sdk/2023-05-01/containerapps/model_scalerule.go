@@ -0,0 +1,7 @@
+package containerapps
+
+type ScaleRule struct {
+	Custom *CustomScaleRule `json:"custom,omitempty"`
+	HTTP   *HTTPScaleRule   `json:"http,omitempty"`
+	Name   *string          `json:"name,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerapps
+
+type ScaleRuleAuth struct {
+	SecretRef        *string `json:"secretRef,omitempty"`
+	TriggerParameter *string `json:"triggerParameter,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerapps
+
+type Secret struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containerapps
+
+type VolumeMount struct {
+	MountPath  *string `json:"mountPath,omitempty"`
+	VolumeName *string `json:"volumeName,omitempty"`
+}
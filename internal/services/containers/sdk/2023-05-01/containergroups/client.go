@@ -0,0 +1,15 @@
+package containergroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ContainerGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContainerGroupsClientWithBaseURI(endpoint string) ContainerGroupsClient {
+	return ContainerGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
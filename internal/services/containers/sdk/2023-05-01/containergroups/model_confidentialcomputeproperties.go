@@ -0,0 +1,5 @@
+package containergroups
+
+type ConfidentialComputeProperties struct {
+	CcePolicy *string `json:"ccePolicy,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containergroups
+
+type Container struct {
+	Name       string              `json:"name"`
+	Properties ContainerProperties `json:"properties"`
+}
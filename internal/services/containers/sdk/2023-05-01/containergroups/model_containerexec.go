@@ -0,0 +1,5 @@
+package containergroups
+
+type ContainerExec struct {
+	Command *[]string `json:"command,omitempty"`
+}
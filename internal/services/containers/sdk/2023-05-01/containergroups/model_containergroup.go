@@ -0,0 +1,11 @@
+package containergroups
+
+type ContainerGroup struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *ContainerGroupIdentity  `json:"identity,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties ContainerGroupProperties `json:"properties"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
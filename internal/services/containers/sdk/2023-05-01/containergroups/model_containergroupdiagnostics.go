@@ -0,0 +1,5 @@
+package containergroups
+
+type ContainerGroupDiagnostics struct {
+	LogAnalytics *LogAnalytics `json:"logAnalytics,omitempty"`
+}
@@ -0,0 +1,18 @@
+package containergroups
+
+type ContainerGroupProperties struct {
+	ConfidentialComputeProperties *ConfidentialComputeProperties `json:"confidentialComputeProperties,omitempty"`
+	Containers                    []Container                    `json:"containers"`
+	Diagnostics                   *ContainerGroupDiagnostics     `json:"diagnostics,omitempty"`
+	DnsConfig                     *DnsConfiguration              `json:"dnsConfig,omitempty"`
+	IPAddress                     *IPAddress                     `json:"ipAddress,omitempty"`
+	ImageRegistryCredentials      *[]ImageRegistryCredential     `json:"imageRegistryCredentials,omitempty"`
+	InitContainers                *[]InitContainerDefinition     `json:"initContainers,omitempty"`
+	OsType                        OperatingSystemTypes           `json:"osType"`
+	Priority                      *ContainerGroupPriority        `json:"priority,omitempty"`
+	ProvisioningState             *string                        `json:"provisioningState,omitempty"`
+	RestartPolicy                 *ContainerGroupRestartPolicy   `json:"restartPolicy,omitempty"`
+	Sku                           *ContainerGroupSku             `json:"sku,omitempty"`
+	SubnetIds                     *[]ContainerGroupSubnetId      `json:"subnetIds,omitempty"`
+	Volumes                       *[]Volume                      `json:"volumes,omitempty"`
+}
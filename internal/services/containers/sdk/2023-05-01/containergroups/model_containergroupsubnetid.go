@@ -0,0 +1,6 @@
+package containergroups
+
+type ContainerGroupSubnetId struct {
+	Id   string  `json:"id"`
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,7 @@
+package containergroups
+
+type ContainerHTTPGet struct {
+	Path   *string `json:"path,omitempty"`
+	Port   int64   `json:"port"`
+	Scheme *Scheme `json:"scheme,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containergroups
+
+type ContainerPort struct {
+	Port     int64                     `json:"port"`
+	Protocol *ContainerNetworkProtocol `json:"protocol,omitempty"`
+}
@@ -0,0 +1,11 @@
+package containergroups
+
+type ContainerProbe struct {
+	Exec                *ContainerExec    `json:"exec,omitempty"`
+	FailureThreshold    *int64            `json:"failureThreshold,omitempty"`
+	HTTPGet             *ContainerHTTPGet `json:"httpGet,omitempty"`
+	InitialDelaySeconds *int64            `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       *int64            `json:"periodSeconds,omitempty"`
+	SuccessThreshold    *int64            `json:"successThreshold,omitempty"`
+	TimeoutSeconds      *int64            `json:"timeoutSeconds,omitempty"`
+}
@@ -0,0 +1,12 @@
+package containergroups
+
+type ContainerProperties struct {
+	Command              *[]string              `json:"command,omitempty"`
+	EnvironmentVariables *[]EnvironmentVariable `json:"environmentVariables,omitempty"`
+	Image                string                 `json:"image"`
+	LivenessProbe        *ContainerProbe        `json:"livenessProbe,omitempty"`
+	Ports                *[]ContainerPort       `json:"ports,omitempty"`
+	ReadinessProbe       *ContainerProbe        `json:"readinessProbe,omitempty"`
+	Resources            ResourceRequirements   `json:"resources"`
+	VolumeMounts         *[]VolumeMount         `json:"volumeMounts,omitempty"`
+}
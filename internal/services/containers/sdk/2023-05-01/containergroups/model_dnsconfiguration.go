@@ -0,0 +1,7 @@
+package containergroups
+
+type DnsConfiguration struct {
+	NameServers   []string `json:"nameServers"`
+	Options       *string  `json:"options,omitempty"`
+	SearchDomains *string  `json:"searchDomains,omitempty"`
+}
@@ -0,0 +1,7 @@
+package containergroups
+
+type EnvironmentVariable struct {
+	Name        string  `json:"name"`
+	SecureValue *string `json:"secureValue,omitempty"`
+	Value       *string `json:"value,omitempty"`
+}
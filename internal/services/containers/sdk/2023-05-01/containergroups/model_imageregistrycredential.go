@@ -0,0 +1,7 @@
+package containergroups
+
+type ImageRegistryCredential struct {
+	Password *string `json:"password,omitempty"`
+	Server   string  `json:"server"`
+	Username *string `json:"username,omitempty"`
+}
@@ -0,0 +1,6 @@
+package containergroups
+
+type InitContainerDefinition struct {
+	Name       string                            `json:"name"`
+	Properties InitContainerPropertiesDefinition `json:"properties"`
+}
@@ -0,0 +1,8 @@
+package containergroups
+
+type InitContainerPropertiesDefinition struct {
+	Command              *[]string              `json:"command,omitempty"`
+	EnvironmentVariables *[]EnvironmentVariable `json:"environmentVariables,omitempty"`
+	Image                *string                `json:"image,omitempty"`
+	VolumeMounts         *[]VolumeMount         `json:"volumeMounts,omitempty"`
+}
@@ -0,0 +1,9 @@
+package containergroups
+
+type IPAddress struct {
+	DnsNameLabel *string                     `json:"dnsNameLabel,omitempty"`
+	Fqdn         *string                     `json:"fqdn,omitempty"`
+	IP           *string                     `json:"ip,omitempty"`
+	Ports        []Port                      `json:"ports"`
+	Type         ContainerGroupIPAddressType `json:"type"`
+}
@@ -0,0 +1,8 @@
+package containergroups
+
+type LogAnalytics struct {
+	LogType      *LogAnalyticsLogType `json:"logType,omitempty"`
+	Metadata     *map[string]string   `json:"metadata,omitempty"`
+	WorkspaceId  string               `json:"workspaceId"`
+	WorkspaceKey string               `json:"workspaceKey"`
+}
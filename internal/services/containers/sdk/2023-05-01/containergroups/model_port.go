@@ -0,0 +1,6 @@
+package containergroups
+
+type Port struct {
+	Port     int64                          `json:"port"`
+	Protocol *ContainerGroupNetworkProtocol `json:"protocol,omitempty"`
+}
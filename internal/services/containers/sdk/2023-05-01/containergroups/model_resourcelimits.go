@@ -0,0 +1,7 @@
+package containergroups
+
+type ResourceLimits struct {
+	Cpu        *float64     `json:"cpu,omitempty"`
+	Gpu        *GpuResource `json:"gpu,omitempty"`
+	MemoryInGB *float64     `json:"memoryInGB,omitempty"`
+}
@@ -0,0 +1,7 @@
+package containergroups
+
+type ResourceRequests struct {
+	Cpu        float64      `json:"cpu"`
+	Gpu        *GpuResource `json:"gpu,omitempty"`
+	MemoryInGB float64      `json:"memoryInGB"`
+}
@@ -0,0 +1,6 @@
+package containergroups
+
+type ResourceRequirements struct {
+	Limits   *ResourceLimits  `json:"limits,omitempty"`
+	Requests ResourceRequests `json:"requests"`
+}
@@ -0,0 +1,9 @@
+package containergroups
+
+type Volume struct {
+	AzureFile *AzureFileVolume   `json:"azureFile,omitempty"`
+	EmptyDir  *interface{}       `json:"emptyDir,omitempty"`
+	GitRepo   *GitRepoVolume     `json:"gitRepo,omitempty"`
+	Name      string             `json:"name"`
+	Secret    *map[string]string `json:"secret,omitempty"`
+}
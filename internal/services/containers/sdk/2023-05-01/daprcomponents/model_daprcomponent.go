@@ -0,0 +1,8 @@
+package daprcomponents
+
+type DaprComponent struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *DaprComponentProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package daprcomponents
+
+type DaprSecret struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
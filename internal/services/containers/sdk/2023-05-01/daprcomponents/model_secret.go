@@ -0,0 +1,6 @@
+package daprcomponents
+
+type Secret struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
@@ -0,0 +1,15 @@
+package managedenvironments
+
+type ManagedEnvironmentProperties struct {
+	AppLogsConfiguration        *AppLogsConfiguration `json:"appLogsConfiguration,omitempty"`
+	DaprAIConnectionString      *string               `json:"daprAIConnectionString,omitempty"`
+	DaprAIInstrumentationKey    *string               `json:"daprAIInstrumentationKey,omitempty"`
+	DefaultDomain               *string               `json:"defaultDomain,omitempty"`
+	DeploymentErrors            *string               `json:"deploymentErrors,omitempty"`
+	InfrastructureResourceGroup *string               `json:"infrastructureResourceGroup,omitempty"`
+	ProvisioningState           *string               `json:"provisioningState,omitempty"`
+	StaticIP                    *string               `json:"staticIp,omitempty"`
+	VnetConfiguration           *VnetConfiguration    `json:"vnetConfiguration,omitempty"`
+	WorkloadProfiles            *[]WorkloadProfile    `json:"workloadProfiles,omitempty"`
+	ZoneRedundant               *bool                 `json:"zoneRedundant,omitempty"`
+}
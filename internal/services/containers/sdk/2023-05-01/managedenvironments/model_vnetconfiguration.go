@@ -0,0 +1,9 @@
+package managedenvironments
+
+type VnetConfiguration struct {
+	DockerBridgeCidr       *string `json:"dockerBridgeCidr,omitempty"`
+	InfrastructureSubnetId *string `json:"infrastructureSubnetId,omitempty"`
+	Internal               *bool   `json:"internal,omitempty"`
+	PlatformReservedCidr   *string `json:"platformReservedCidr,omitempty"`
+	PlatformReservedDnsIP  *string `json:"platformReservedDnsIP,omitempty"`
+}
@@ -0,0 +1,15 @@
+package managedenvironmentsstorages
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ManagedEnvironmentsStoragesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedEnvironmentsStoragesClientWithBaseURI(endpoint string) ManagedEnvironmentsStoragesClient {
+	return ManagedEnvironmentsStoragesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
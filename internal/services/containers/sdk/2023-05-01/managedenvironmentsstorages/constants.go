@@ -0,0 +1,31 @@
+package managedenvironmentsstorages
+
+import "strings"
+
+type AccessMode string
+
+const (
+	AccessModeReadOnly  AccessMode = "ReadOnly"
+	AccessModeReadWrite AccessMode = "ReadWrite"
+)
+
+func PossibleValuesForAccessMode() []string {
+	return []string{
+		string(AccessModeReadOnly),
+		string(AccessModeReadWrite),
+	}
+}
+
+func parseAccessMode(input string) (*AccessMode, error) {
+	vals := map[string]AccessMode{
+		"readonly":  AccessModeReadOnly,
+		"readwrite": AccessModeReadWrite,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AccessMode(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package managedenvironmentsstorages
+
+type AzureFileProperties struct {
+	AccessMode  *AccessMode `json:"accessMode,omitempty"`
+	AccountKey  *string     `json:"accountKey,omitempty"`
+	AccountName *string     `json:"accountName,omitempty"`
+	ShareName   *string     `json:"shareName,omitempty"`
+}
@@ -0,0 +1,8 @@
+package managedenvironmentsstorages
+
+type ManagedEnvironmentStorage struct {
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *ManagedEnvironmentStorageProperties `json:"properties,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
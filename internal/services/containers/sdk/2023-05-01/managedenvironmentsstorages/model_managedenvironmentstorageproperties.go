@@ -0,0 +1,5 @@
+package managedenvironmentsstorages
+
+type ManagedEnvironmentStorageProperties struct {
+	AzureFile *AzureFileProperties `json:"azureFile,omitempty"`
+}
@@ -0,0 +1,9 @@
+package managedenvironmentsstorages
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/managedenvironmentsstorages/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package cacherules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CacheRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCacheRulesClientWithBaseURI(endpoint string) CacheRulesClient {
+	return CacheRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
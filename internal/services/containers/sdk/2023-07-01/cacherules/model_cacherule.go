@@ -0,0 +1,8 @@
+package cacherules
+
+type CacheRule struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *CacheRuleProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
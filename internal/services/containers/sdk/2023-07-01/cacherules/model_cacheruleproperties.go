@@ -0,0 +1,9 @@
+package cacherules
+
+type CacheRuleProperties struct {
+	CreationDate            *string            `json:"creationDate,omitempty"`
+	CredentialSetResourceId *string            `json:"credentialSetResourceId,omitempty"`
+	ProvisioningState       *ProvisioningState `json:"provisioningState,omitempty"`
+	SourceRepository        *string            `json:"sourceRepository,omitempty"`
+	TargetRepository        *string            `json:"targetRepository,omitempty"`
+}
@@ -0,0 +1,15 @@
+package credentialsets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CredentialSetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCredentialSetsClientWithBaseURI(endpoint string) CredentialSetsClient {
+	return CredentialSetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,7 @@
+package credentialsets
+
+type CredentialHealth struct {
+	ErrorCode    *string                 `json:"errorCode,omitempty"`
+	ErrorMessage *string                 `json:"errorMessage,omitempty"`
+	Status       *CredentialHealthStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,9 @@
+package credentialsets
+
+type CredentialSet struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *IdentityProperties      `json:"identity,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *CredentialSetProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package credentialsets
+
+type CredentialSetProperties struct {
+	AuthCredentials   *[]AuthCredential  `json:"authCredentials,omitempty"`
+	CreationDate      *string            `json:"creationDate,omitempty"`
+	LoginServer       *string            `json:"loginServer,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,6 @@
+package credentialsets
+
+type CredentialSetUpdateParameters struct {
+	Identity   *IdentityProperties            `json:"identity,omitempty"`
+	Properties *CredentialSetUpdateProperties `json:"properties,omitempty"`
+}
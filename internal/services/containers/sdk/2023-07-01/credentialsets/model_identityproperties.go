@@ -0,0 +1,7 @@
+package credentialsets
+
+type IdentityProperties struct {
+	PrincipalId *string               `json:"principalId,omitempty"`
+	TenantId    *string               `json:"tenantId,omitempty"`
+	Type        *ResourceIdentityType `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package registries
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RegistriesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRegistriesClientWithBaseURI(endpoint string) RegistriesClient {
+	return RegistriesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,168 @@
+package registries
+
+import "strings"
+
+type ExportPolicyStatus string
+
+const (
+	ExportPolicyStatusDisabled ExportPolicyStatus = "disabled"
+	ExportPolicyStatusEnabled  ExportPolicyStatus = "enabled"
+)
+
+func PossibleValuesForExportPolicyStatus() []string {
+	return []string{
+		string(ExportPolicyStatusDisabled),
+		string(ExportPolicyStatusEnabled),
+	}
+}
+
+func parseExportPolicyStatus(input string) (*ExportPolicyStatus, error) {
+	vals := map[string]ExportPolicyStatus{
+		"disabled": ExportPolicyStatusDisabled,
+		"enabled":  ExportPolicyStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExportPolicyStatus(input)
+	return &out, nil
+}
+
+type NetworkRuleBypassOptions string
+
+const (
+	NetworkRuleBypassOptionsAzureServices NetworkRuleBypassOptions = "AzureServices"
+	NetworkRuleBypassOptionsNone          NetworkRuleBypassOptions = "None"
+)
+
+func PossibleValuesForNetworkRuleBypassOptions() []string {
+	return []string{
+		string(NetworkRuleBypassOptionsAzureServices),
+		string(NetworkRuleBypassOptionsNone),
+	}
+}
+
+func parseNetworkRuleBypassOptions(input string) (*NetworkRuleBypassOptions, error) {
+	vals := map[string]NetworkRuleBypassOptions{
+		"azureservices": NetworkRuleBypassOptionsAzureServices,
+		"none":          NetworkRuleBypassOptionsNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NetworkRuleBypassOptions(input)
+	return &out, nil
+}
+
+type PolicyStatus string
+
+const (
+	PolicyStatusDisabled PolicyStatus = "disabled"
+	PolicyStatusEnabled  PolicyStatus = "enabled"
+)
+
+func PossibleValuesForPolicyStatus() []string {
+	return []string{
+		string(PolicyStatusDisabled),
+		string(PolicyStatusEnabled),
+	}
+}
+
+func parsePolicyStatus(input string) (*PolicyStatus, error) {
+	vals := map[string]PolicyStatus{
+		"disabled": PolicyStatusDisabled,
+		"enabled":  PolicyStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PolicyStatus(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
+
+type TrustPolicyType string
+
+const (
+	TrustPolicyTypeNotary TrustPolicyType = "Notary"
+)
+
+func PossibleValuesForTrustPolicyType() []string {
+	return []string{
+		string(TrustPolicyTypeNotary),
+	}
+}
+
+func parseTrustPolicyType(input string) (*TrustPolicyType, error) {
+	vals := map[string]TrustPolicyType{
+		"notary": TrustPolicyTypeNotary,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TrustPolicyType(input)
+	return &out, nil
+}
+
+type ZoneRedundancy string
+
+const (
+	ZoneRedundancyDisabled ZoneRedundancy = "Disabled"
+	ZoneRedundancyEnabled  ZoneRedundancy = "Enabled"
+)
+
+func PossibleValuesForZoneRedundancy() []string {
+	return []string{
+		string(ZoneRedundancyDisabled),
+		string(ZoneRedundancyEnabled),
+	}
+}
+
+func parseZoneRedundancy(input string) (*ZoneRedundancy, error) {
+	vals := map[string]ZoneRedundancy{
+		"disabled": ZoneRedundancyDisabled,
+		"enabled":  ZoneRedundancyEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ZoneRedundancy(input)
+	return &out, nil
+}
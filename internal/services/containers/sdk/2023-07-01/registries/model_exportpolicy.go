@@ -0,0 +1,5 @@
+package registries
+
+type ExportPolicy struct {
+	Status *ExportPolicyStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,8 @@
+package registries
+
+type Policies struct {
+	ExportPolicy     *ExportPolicy     `json:"exportPolicy,omitempty"`
+	QuarantinePolicy *QuarantinePolicy `json:"quarantinePolicy,omitempty"`
+	RetentionPolicy  *RetentionPolicy  `json:"retentionPolicy,omitempty"`
+	TrustPolicy      *TrustPolicy      `json:"trustPolicy,omitempty"`
+}
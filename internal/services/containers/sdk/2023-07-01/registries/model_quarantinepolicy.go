@@ -0,0 +1,5 @@
+package registries
+
+type QuarantinePolicy struct {
+	Status *PolicyStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,14 @@
+package registries
+
+type RegistryProperties struct {
+	AdminUserEnabled         *bool                     `json:"adminUserEnabled,omitempty"`
+	AnonymousPullEnabled     *bool                     `json:"anonymousPullEnabled,omitempty"`
+	CreationDate             *string                   `json:"creationDate,omitempty"`
+	DataEndpointEnabled      *bool                     `json:"dataEndpointEnabled,omitempty"`
+	DataEndpointHostNames    *[]string                 `json:"dataEndpointHostNames,omitempty"`
+	LoginServer              *string                   `json:"loginServer,omitempty"`
+	NetworkRuleBypassOptions *NetworkRuleBypassOptions `json:"networkRuleBypassOptions,omitempty"`
+	Policies                 *Policies                 `json:"policies,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess      `json:"publicNetworkAccess,omitempty"`
+	ZoneRedundancy           *ZoneRedundancy           `json:"zoneRedundancy,omitempty"`
+}
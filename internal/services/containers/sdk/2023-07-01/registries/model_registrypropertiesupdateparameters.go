@@ -0,0 +1,10 @@
+package registries
+
+type RegistryPropertiesUpdateParameters struct {
+	AdminUserEnabled         *bool                     `json:"adminUserEnabled,omitempty"`
+	AnonymousPullEnabled     *bool                     `json:"anonymousPullEnabled,omitempty"`
+	DataEndpointEnabled      *bool                     `json:"dataEndpointEnabled,omitempty"`
+	NetworkRuleBypassOptions *NetworkRuleBypassOptions `json:"networkRuleBypassOptions,omitempty"`
+	Policies                 *Policies                 `json:"policies,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess      `json:"publicNetworkAccess,omitempty"`
+}
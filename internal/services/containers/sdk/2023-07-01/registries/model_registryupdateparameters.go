@@ -0,0 +1,6 @@
+package registries
+
+type RegistryUpdateParameters struct {
+	Properties *RegistryPropertiesUpdateParameters `json:"properties,omitempty"`
+	Tags       *map[string]string                  `json:"tags,omitempty"`
+}
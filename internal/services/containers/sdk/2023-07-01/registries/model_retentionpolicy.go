@@ -0,0 +1,7 @@
+package registries
+
+type RetentionPolicy struct {
+	Days            *int64        `json:"days,omitempty"`
+	LastUpdatedTime *string       `json:"lastUpdatedTime,omitempty"`
+	Status          *PolicyStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,6 @@
+package registries
+
+type TrustPolicy struct {
+	Status *PolicyStatus    `json:"status,omitempty"`
+	Type   *TrustPolicyType `json:"type,omitempty"`
+}
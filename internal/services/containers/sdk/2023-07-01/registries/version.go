@@ -0,0 +1,9 @@
+package registries
+
+import "fmt"
+
+const defaultApiVersion = "2023-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/registries/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package maintenanceconfigurations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MaintenanceConfigurationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMaintenanceConfigurationsClientWithBaseURI(endpoint string) MaintenanceConfigurationsClient {
+	return MaintenanceConfigurationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
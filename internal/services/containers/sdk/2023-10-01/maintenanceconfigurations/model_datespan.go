@@ -0,0 +1,6 @@
+package maintenanceconfigurations
+
+type DateSpan struct {
+	End   string `json:"end"`
+	Start string `json:"start"`
+}
@@ -0,0 +1,8 @@
+package maintenanceconfigurations
+
+type MaintenanceConfiguration struct {
+	Id         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Properties *MaintenanceConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                             `json:"type,omitempty"`
+}
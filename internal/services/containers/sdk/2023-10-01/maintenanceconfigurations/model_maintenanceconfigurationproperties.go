@@ -0,0 +1,7 @@
+package maintenanceconfigurations
+
+type MaintenanceConfigurationProperties struct {
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	NotAllowedTime    *[]TimeSpan        `json:"notAllowedTime,omitempty"`
+	TimeInWeek        *[]TimeInWeek      `json:"timeInWeek,omitempty"`
+}
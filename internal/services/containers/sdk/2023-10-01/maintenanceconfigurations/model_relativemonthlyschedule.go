@@ -0,0 +1,7 @@
+package maintenanceconfigurations
+
+type RelativeMonthlySchedule struct {
+	DayOfWeek      WeekDay `json:"dayOfWeek"`
+	IntervalMonths int64   `json:"intervalMonths"`
+	WeekIndex      Type    `json:"weekIndex"`
+}
@@ -0,0 +1,9 @@
+package maintenanceconfigurations
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/maintenanceconfigurations/%s", defaultApiVersion)
+}
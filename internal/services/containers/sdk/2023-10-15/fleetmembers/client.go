@@ -0,0 +1,15 @@
+package fleetmembers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FleetMembersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFleetMembersClientWithBaseURI(endpoint string) FleetMembersClient {
+	return FleetMembersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package fleetmembers
+
+type FleetMember struct {
+	ETag       *string                `json:"eTag,omitempty"`
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *FleetMemberProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
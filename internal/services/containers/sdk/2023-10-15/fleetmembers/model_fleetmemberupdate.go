@@ -0,0 +1,5 @@
+package fleetmembers
+
+type FleetMemberUpdate struct {
+	Properties *FleetMemberUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,15 @@
+package fleets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FleetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFleetsClientWithBaseURI(endpoint string) FleetsClient {
+	return FleetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
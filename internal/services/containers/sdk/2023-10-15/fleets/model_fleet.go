@@ -0,0 +1,11 @@
+package fleets
+
+type Fleet struct {
+	ETag       *string            `json:"eTag,omitempty"`
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *FleetProperties   `json:"properties,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package fleets
+
+type FleetPatch struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package fleetupdatestrategies
+
+type FleetUpdateStrategy struct {
+	ETag       *string                        `json:"eTag,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *FleetUpdateStrategyProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package fleetupdatestrategies
+
+type UpdateGroup struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,5 @@
+package fleetupdatestrategies
+
+type UpdateRunStrategy struct {
+	Stages []UpdateStage `json:"stages"`
+}
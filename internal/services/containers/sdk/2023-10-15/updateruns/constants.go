@@ -0,0 +1,59 @@
+package updateruns
+
+import "strings"
+
+type ManagedClusterUpgradeType string
+
+const (
+	ManagedClusterUpgradeTypeFull          ManagedClusterUpgradeType = "Full"
+	ManagedClusterUpgradeTypeNodeImageOnly ManagedClusterUpgradeType = "NodeImageOnly"
+)
+
+func PossibleValuesForManagedClusterUpgradeType() []string {
+	return []string{
+		string(ManagedClusterUpgradeTypeFull),
+		string(ManagedClusterUpgradeTypeNodeImageOnly),
+	}
+}
+
+func parseManagedClusterUpgradeType(input string) (*ManagedClusterUpgradeType, error) {
+	vals := map[string]ManagedClusterUpgradeType{
+		"full":          ManagedClusterUpgradeTypeFull,
+		"nodeimageonly": ManagedClusterUpgradeTypeNodeImageOnly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedClusterUpgradeType(input)
+	return &out, nil
+}
+
+type NodeImageSelectionType string
+
+const (
+	NodeImageSelectionTypeConsistent NodeImageSelectionType = "Consistent"
+	NodeImageSelectionTypeLatest     NodeImageSelectionType = "Latest"
+)
+
+func PossibleValuesForNodeImageSelectionType() []string {
+	return []string{
+		string(NodeImageSelectionTypeConsistent),
+		string(NodeImageSelectionTypeLatest),
+	}
+}
+
+func parseNodeImageSelectionType(input string) (*NodeImageSelectionType, error) {
+	vals := map[string]NodeImageSelectionType{
+		"consistent": NodeImageSelectionTypeConsistent,
+		"latest":     NodeImageSelectionTypeLatest,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NodeImageSelectionType(input)
+	return &out, nil
+}
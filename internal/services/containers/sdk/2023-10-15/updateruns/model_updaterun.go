@@ -0,0 +1,9 @@
+package updateruns
+
+type UpdateRun struct {
+	ETag       *string              `json:"eTag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *UpdateRunProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
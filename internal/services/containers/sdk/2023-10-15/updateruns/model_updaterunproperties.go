@@ -0,0 +1,8 @@
+package updateruns
+
+type UpdateRunProperties struct {
+	ManagedClusterUpdate ManagedClusterUpdate `json:"managedClusterUpdate"`
+	ProvisioningState    *string              `json:"provisioningState,omitempty"`
+	Strategy             *UpdateRunStrategy   `json:"strategy,omitempty"`
+	UpdateStrategyId     *string              `json:"updateStrategyId,omitempty"`
+}
@@ -0,0 +1,7 @@
+package updateruns
+
+type UpdateStage struct {
+	AfterStageWaitInSeconds *int64         `json:"afterStageWaitInSeconds,omitempty"`
+	Groups                  *[]UpdateGroup `json:"groups,omitempty"`
+	Name                    string         `json:"name"`
+}
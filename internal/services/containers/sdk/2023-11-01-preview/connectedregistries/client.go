@@ -0,0 +1,15 @@
+package connectedregistries
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ConnectedRegistriesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConnectedRegistriesClientWithBaseURI(endpoint string) ConnectedRegistriesClient {
+	return ConnectedRegistriesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
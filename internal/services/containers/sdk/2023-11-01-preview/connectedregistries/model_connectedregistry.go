@@ -0,0 +1,8 @@
+package connectedregistries
+
+type ConnectedRegistry struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *ConnectedRegistryProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
@@ -0,0 +1,14 @@
+package connectedregistries
+
+type ConnectedRegistryProperties struct {
+	ClientTokenIds    *[]string              `json:"clientTokenIds,omitempty"`
+	ConnectionState   *ConnectionState       `json:"connectionState,omitempty"`
+	LastActivityTime  *string                `json:"lastActivityTime,omitempty"`
+	Logging           *LoggingProperties     `json:"logging,omitempty"`
+	LoginServer       *LoginServerProperties `json:"loginServer,omitempty"`
+	Mode              ConnectedRegistryMode  `json:"mode"`
+	NotificationsList *[]string              `json:"notificationsList,omitempty"`
+	Parent            ParentProperties       `json:"parent"`
+	ProvisioningState *ProvisioningState     `json:"provisioningState,omitempty"`
+	Version           *string                `json:"version,omitempty"`
+}
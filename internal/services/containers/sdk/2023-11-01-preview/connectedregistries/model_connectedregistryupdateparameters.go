@@ -0,0 +1,5 @@
+package connectedregistries
+
+type ConnectedRegistryUpdateParameters struct {
+	Properties *ConnectedRegistryUpdateProperties `json:"properties,omitempty"`
+}
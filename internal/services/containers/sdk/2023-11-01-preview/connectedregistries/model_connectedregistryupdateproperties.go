@@ -0,0 +1,8 @@
+package connectedregistries
+
+type ConnectedRegistryUpdateProperties struct {
+	ClientTokenIds    *[]string             `json:"clientTokenIds,omitempty"`
+	Logging           *LoggingProperties    `json:"logging,omitempty"`
+	NotificationsList *[]string             `json:"notificationsList,omitempty"`
+	SyncProperties    *SyncUpdateProperties `json:"syncProperties,omitempty"`
+}
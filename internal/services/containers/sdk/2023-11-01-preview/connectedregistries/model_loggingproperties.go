@@ -0,0 +1,6 @@
+package connectedregistries
+
+type LoggingProperties struct {
+	AuditLogStatus *AuditLogStatus `json:"auditLogStatus,omitempty"`
+	LogLevel       *LogLevel       `json:"logLevel,omitempty"`
+}
@@ -0,0 +1,5 @@
+package connectedregistries
+
+type LoginServerProperties struct {
+	Host *string `json:"host,omitempty"`
+}
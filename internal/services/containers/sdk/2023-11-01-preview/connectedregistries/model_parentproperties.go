@@ -0,0 +1,6 @@
+package connectedregistries
+
+type ParentProperties struct {
+	Id             *string        `json:"id,omitempty"`
+	SyncProperties SyncProperties `json:"syncProperties"`
+}
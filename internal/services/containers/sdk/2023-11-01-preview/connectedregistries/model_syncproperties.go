@@ -0,0 +1,10 @@
+package connectedregistries
+
+type SyncProperties struct {
+	GatewayEndpoint *string `json:"gatewayEndpoint,omitempty"`
+	LastSyncTime    *string `json:"lastSyncTime,omitempty"`
+	MessageTtl      string  `json:"messageTtl"`
+	Schedule        *string `json:"schedule,omitempty"`
+	SyncWindow      *string `json:"syncWindow,omitempty"`
+	TokenId         string  `json:"tokenId"`
+}
@@ -0,0 +1,7 @@
+package connectedregistries
+
+type SyncUpdateProperties struct {
+	MessageTtl *string `json:"messageTtl,omitempty"`
+	Schedule   *string `json:"schedule,omitempty"`
+	SyncWindow *string `json:"syncWindow,omitempty"`
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ContainerAppEnvironmentName validates the name of a Container App Environment
+func ContainerAppEnvironmentName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z][a-z0-9-]{0,58}[a-z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 60 characters, can only contain lowercase alphanumeric characters and hyphens, must start with a letter and end with an alphanumeric character: %q", k, value))
+	}
+
+	return warnings, errors
+}
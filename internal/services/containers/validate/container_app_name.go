@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ContainerAppName validates the name of a Container App
+func ContainerAppName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z][a-z0-9-]{0,30}[a-z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 32 characters, can only contain lowercase alphanumeric characters and hyphens, must start with a letter and end with an alphanumeric character: %q", k, value))
+	}
+
+	if strings.Contains(value, "--") {
+		errors = append(errors, fmt.Errorf("%q must not contain consecutive hyphens: %q", k, value))
+	}
+
+	return warnings, errors
+}
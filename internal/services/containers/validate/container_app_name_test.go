@@ -0,0 +1,63 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerAppName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ab",
+			ErrCount: 0,
+		},
+		{
+			Value:    "my-app-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1app",
+			ErrCount: 1,
+		},
+		{
+			Value:    "app-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "my--app",
+			ErrCount: 1,
+		},
+		{
+			Value:    "MyApp",
+			ErrCount: 1,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerAppName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the ContainerAppName to trigger a validation error for '%s'", tc.Value)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ContainerRegistryAgentPoolName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9\-]{3,20}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 3 and 20 characters and may only contain alpha numeric characters and hyphens: %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,54 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryAgentPoolName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "agent-pool-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "agentPool1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "agent_pool",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a1234567890123456789",
+			ErrCount: 0,
+		},
+		{
+			Value:    "a12345678901234567890",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryAgentPoolName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Container Registry Agent Pool Name to trigger a validation error for '%s'", tc.Value)
+		}
+	}
+}
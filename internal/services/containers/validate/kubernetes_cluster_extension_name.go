@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func KubernetesClusterExtensionName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]([-.a-z0-9]{0,251}[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 253 characters, can only contain lowercase alphanumeric characters, hyphens and periods, and must start and end with an alphanumeric character: %q", k, value))
+	}
+
+	return warnings, errors
+}
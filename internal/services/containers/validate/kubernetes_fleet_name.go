@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// KubernetesFleetName validates the name of a Kubernetes Fleet Manager and its child resources
+// (Members, Update Runs and Update Strategies) which all share the same naming rules
+func KubernetesFleetName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 63 characters, can only contain lowercase alphanumeric characters and hyphens, and must start and end with an alphanumeric character: %q", k, value))
+	}
+
+	return warnings, errors
+}
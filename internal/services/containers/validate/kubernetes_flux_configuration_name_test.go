@@ -0,0 +1,63 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestKubernetesFluxConfigurationName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "flux-config",
+			ErrCount: 0,
+		},
+		{
+			Value:    "config1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-config",
+			ErrCount: 1,
+		},
+		{
+			Value:    "config-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Config",
+			ErrCount: 1,
+		},
+		{
+			Value:    "flux.config",
+			ErrCount: 1,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.KubernetesFluxConfigurationName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the KubernetesFluxConfigurationName to trigger a validation error for '%s'", tc.Value)
+		}
+	}
+}
@@ -11,12 +11,14 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultSuppress "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -53,6 +55,16 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				return old.(string) == string(documentdb.TypeContinuous) && new.(string) == string(documentdb.TypePeriodic)
 			}),
 
+			pluginsdk.ForceNewIfChange("analytical_storage_enabled", func(ctx context.Context, old, new, _ interface{}) bool {
+				// analytical storage can be enabled on an existing account but cannot be disabled
+				return old.(bool) && !new.(bool)
+			}),
+
+			pluginsdk.ForceNewIfChange("analytical_storage.0.schema_type", func(ctx context.Context, old, new, _ interface{}) bool {
+				// the schema type can only be changed from WellDefined to FullFidelity
+				return old.(string) == string(documentdb.AnalyticalStorageSchemaTypeFullFidelity) && new.(string) == string(documentdb.AnalyticalStorageSchemaTypeWellDefined)
+			}),
+
 			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
 				caps := diff.Get("capabilities")
 				mongo34found := false
@@ -143,7 +155,25 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
+			},
+
+			"analytical_storage": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"schema_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(documentdb.AnalyticalStorageSchemaTypeFullFidelity),
+								string(documentdb.AnalyticalStorageSchemaTypeWellDefined),
+							}, false),
+						},
+					},
+				},
 			},
 
 			"public_network_access_enabled": {
@@ -375,6 +405,68 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"create_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(documentdb.CreateModeDefault),
+					string(documentdb.CreateModeRestore),
+				}, false),
+			},
+
+			"restore": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"create_mode"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_cosmosdb_account_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.RestorableDatabaseAccountID,
+						},
+
+						"restore_timestamp_in_utc": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+
+						"database": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"collection_names": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"identity": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -580,6 +672,10 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		Tags: tags.Expand(t),
 	}
 
+	if v, ok := d.GetOk("analytical_storage"); ok {
+		account.DatabaseAccountCreateUpdateProperties.AnalyticalStorageConfiguration = expandCosmosdbAccountAnalyticalStorageConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("mongo_server_version"); ok {
 		account.DatabaseAccountCreateUpdateProperties.APIProperties = &documentdb.APIProperties{
 			ServerVersion: documentdb.ServerVersion(v.(string)),
@@ -594,6 +690,29 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		account.DatabaseAccountCreateUpdateProperties.BackupPolicy = policy
 	}
 
+	createMode := d.Get("create_mode").(string)
+	restore := d.Get("restore").([]interface{})
+	if createMode == string(documentdb.CreateModeRestore) {
+		if len(restore) == 0 || restore[0] == nil {
+			return fmt.Errorf("`restore` must be set when `create_mode` is %q", createMode)
+		}
+		if d.Get("backup.0.type").(string) != string(documentdb.TypeContinuous) {
+			return fmt.Errorf("`backup.0.type` must be %q when `create_mode` is %q", documentdb.TypeContinuous, createMode)
+		}
+	} else if len(restore) > 0 {
+		return fmt.Errorf("`restore` can only be set when `create_mode` is %q", documentdb.CreateModeRestore)
+	}
+
+	if createMode != "" {
+		account.DatabaseAccountCreateUpdateProperties.CreateMode = documentdb.CreateMode(createMode)
+
+		restoreParameters, err := expandCosmosdbAccountRestoreParameters(restore)
+		if err != nil {
+			return fmt.Errorf("expanding `restore`: %+v", err)
+		}
+		account.DatabaseAccountCreateUpdateProperties.RestoreParameters = restoreParameters
+	}
+
 	if keyVaultKeyIDRaw, ok := d.GetOk("key_vault_key_id"); ok {
 		keyVaultKey, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyVaultKeyIDRaw.(string))
 		if err != nil {
@@ -722,6 +841,10 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		account.DatabaseAccountCreateUpdateProperties.KeyVaultKeyURI = utils.String(keyVaultKey.ID())
 	}
 
+	if v, ok := d.GetOk("analytical_storage"); ok {
+		account.DatabaseAccountCreateUpdateProperties.AnalyticalStorageConfiguration = expandCosmosdbAccountAnalyticalStorageConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("mongo_server_version"); ok {
 		account.DatabaseAccountCreateUpdateProperties.APIProperties = &documentdb.APIProperties{
 			ServerVersion: documentdb.ServerVersion(v.(string)),
@@ -736,6 +859,17 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		account.DatabaseAccountCreateUpdateProperties.BackupPolicy = policy
 	}
 
+	// the create mode and restore parameters of a restored account have to be sent on every update
+	if v, ok := d.GetOk("create_mode"); ok {
+		account.DatabaseAccountCreateUpdateProperties.CreateMode = documentdb.CreateMode(v.(string))
+
+		restoreParameters, err := expandCosmosdbAccountRestoreParameters(d.Get("restore").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `restore`: %+v", err)
+		}
+		account.DatabaseAccountCreateUpdateProperties.RestoreParameters = restoreParameters
+	}
+
 	if _, err = resourceCosmosDbAccountApiUpsert(client, ctx, resourceGroup, name, account, d); err != nil {
 		return fmt.Errorf("updating CosmosDB Account %q properties (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -831,6 +965,10 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 		d.Set("enable_free_tier", props.EnableFreeTier)
 		d.Set("analytical_storage_enabled", props.EnableAnalyticalStorage)
+
+		if err := d.Set("analytical_storage", flattenCosmosdbAccountAnalyticalStorageConfiguration(props.AnalyticalStorageConfiguration)); err != nil {
+			return fmt.Errorf("setting `analytical_storage`: %+v", err)
+		}
 		d.Set("public_network_access_enabled", props.PublicNetworkAccess == documentdb.PublicNetworkAccessEnabled)
 
 		if v := resp.IsVirtualNetworkFilterEnabled; v != nil {
@@ -884,6 +1022,16 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 			return fmt.Errorf("setting `backup`: %+v", err)
 		}
 
+		d.Set("create_mode", string(props.CreateMode))
+
+		restore, err := flattenCosmosdbAccountRestoreParameters(props.RestoreParameters)
+		if err != nil {
+			return fmt.Errorf("flattening `restore`: %+v", err)
+		}
+		if err = d.Set("restore", restore); err != nil {
+			return fmt.Errorf("setting `restore`: %+v", err)
+		}
+
 		d.Set("cors_rule", common.FlattenCosmosCorsRule(props.Cors))
 	}
 
@@ -1373,6 +1521,29 @@ func flattenCosmosdbAccountBackup(input documentdb.BasicBackupPolicy) ([]interfa
 	}
 }
 
+func expandCosmosdbAccountAnalyticalStorageConfiguration(input []interface{}) *documentdb.AnalyticalStorageConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &documentdb.AnalyticalStorageConfiguration{
+		SchemaType: documentdb.AnalyticalStorageSchemaType(v["schema_type"].(string)),
+	}
+}
+
+func flattenCosmosdbAccountAnalyticalStorageConfiguration(input *documentdb.AnalyticalStorageConfiguration) []interface{} {
+	if input == nil || input.SchemaType == "" {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"schema_type": string(input.SchemaType),
+		},
+	}
+}
+
 func expandCosmosdbAccountIdentity(vs []interface{}) *documentdb.ManagedServiceIdentity {
 	if len(vs) == 0 || vs[0] == nil {
 		return &documentdb.ManagedServiceIdentity{
@@ -1408,3 +1579,66 @@ func flattenAzureRmdocumentdbMachineIdentity(identity *documentdb.ManagedService
 	},
 	}
 }
+
+func expandCosmosdbAccountRestoreParameters(input []interface{}) (*documentdb.RestoreParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	restoreTimestamp, err := time.Parse(time.RFC3339, v["restore_timestamp_in_utc"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `restore_timestamp_in_utc`: %+v", err)
+	}
+
+	databases := make([]documentdb.DatabaseRestoreResource, 0)
+	for _, item := range v["database"].(*pluginsdk.Set).List() {
+		database := item.(map[string]interface{})
+		databases = append(databases, documentdb.DatabaseRestoreResource{
+			DatabaseName:    utils.String(database["name"].(string)),
+			CollectionNames: utils.ExpandStringSlice(database["collection_names"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &documentdb.RestoreParameters{
+		RestoreMode:           documentdb.RestoreModePointInTime,
+		RestoreSource:         utils.String(v["source_cosmosdb_account_id"].(string)),
+		RestoreTimestampInUtc: &date.Time{Time: restoreTimestamp},
+		DatabasesToRestore:    &databases,
+	}, nil
+}
+
+func flattenCosmosdbAccountRestoreParameters(input *documentdb.RestoreParameters) ([]interface{}, error) {
+	if input == nil || input.RestoreSource == nil {
+		return []interface{}{}, nil
+	}
+
+	sourceId, err := parse.RestorableDatabaseAccountID(*input.RestoreSource)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreTimestamp := ""
+	if input.RestoreTimestampInUtc != nil {
+		restoreTimestamp = input.RestoreTimestampInUtc.Format(time.RFC3339)
+	}
+
+	databases := make([]interface{}, 0)
+	if input.DatabasesToRestore != nil {
+		for _, item := range *input.DatabasesToRestore {
+			databases = append(databases, map[string]interface{}{
+				"name":             utils.NormalizeNilableString(item.DatabaseName),
+				"collection_names": utils.FlattenStringSlice(item.CollectionNames),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"source_cosmosdb_account_id": sourceId.ID(),
+			"restore_timestamp_in_utc":   restoreTimestamp,
+			"database":                   databases,
+		},
+	}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -23,6 +24,7 @@ func resourceCassandraCluster() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCassandraClusterCreate,
 		Read:   resourceCassandraClusterRead,
+		Update: resourceCassandraClusterUpdate,
 		Delete: resourceCassandraClusterDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -33,6 +35,7 @@ func resourceCassandraCluster() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -62,6 +65,97 @@ func resourceCassandraCluster() *pluginsdk.Resource {
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"authentication_method": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(documentdb.AuthenticationMethodCassandra),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(documentdb.AuthenticationMethodNone),
+					string(documentdb.AuthenticationMethodCassandra),
+				}, false),
+			},
+
+			"client_certificate_pems": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"external_gossip_certificate_pems": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"external_seed_node_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+
+			"hours_between_backups": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// only system assigned identity is supported
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(documentdb.ManagedCassandraResourceIdentityTypeSystemAssigned),
+							}, false),
+						},
+
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"repair_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "3.11",
+				ValidateFunc: validation.StringInSlice([]string{
+					"3.11",
+					"4.0",
+				}, false),
+			},
+
+			"tags": tags.Schema(),
 		},
 	}
 }
@@ -88,10 +182,19 @@ func resourceCassandraClusterCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	body := documentdb.ClusterResource{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Identity: expandCassandraClusterIdentity(d.Get("identity").([]interface{})),
 		Properties: &documentdb.ClusterResourceProperties{
+			AuthenticationMethod:          documentdb.AuthenticationMethod(d.Get("authentication_method").(string)),
+			CassandraVersion:              utils.String(d.Get("version").(string)),
+			ClientCertificates:            expandCassandraClusterCertificate(d.Get("client_certificate_pems").([]interface{})),
 			DelegatedManagementSubnetID:   utils.String(d.Get("delegated_management_subnet_id").(string)),
+			ExternalGossipCertificates:    expandCassandraClusterCertificate(d.Get("external_gossip_certificate_pems").([]interface{})),
+			ExternalSeedNodes:             expandCassandraClusterExternalSeedNode(d.Get("external_seed_node_ip_addresses").([]interface{})),
+			HoursBetweenBackups:           utils.Int32(int32(d.Get("hours_between_backups").(int))),
 			InitialCassandraAdminPassword: utils.String(d.Get("default_admin_password").(string)),
+			RepairEnabled:                 utils.Bool(d.Get("repair_enabled").(bool)),
 		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateUpdate(ctx, id.ResourceGroup, id.Name, body)
@@ -133,13 +236,78 @@ func resourceCassandraClusterRead(d *pluginsdk.ResourceData, meta interface{}) e
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("name", id.Name)
 	if props := resp.Properties; props != nil {
-		if res := props; res != nil {
-			d.Set("delegated_management_subnet_id", props.DelegatedManagementSubnetID)
+		d.Set("delegated_management_subnet_id", props.DelegatedManagementSubnetID)
+		d.Set("authentication_method", string(props.AuthenticationMethod))
+		d.Set("repair_enabled", props.RepairEnabled)
+		d.Set("version", props.CassandraVersion)
+
+		hoursBetweenBackups := 0
+		if props.HoursBetweenBackups != nil {
+			hoursBetweenBackups = int(*props.HoursBetweenBackups)
+		}
+		d.Set("hours_between_backups", hoursBetweenBackups)
+
+		if err := d.Set("client_certificate_pems", flattenCassandraClusterCertificate(props.ClientCertificates)); err != nil {
+			return fmt.Errorf("setting `client_certificate_pems`: %+v", err)
 		}
+
+		if err := d.Set("external_gossip_certificate_pems", flattenCassandraClusterCertificate(props.ExternalGossipCertificates)); err != nil {
+			return fmt.Errorf("setting `external_gossip_certificate_pems`: %+v", err)
+		}
+
+		if err := d.Set("external_seed_node_ip_addresses", flattenCassandraClusterExternalSeedNode(props.ExternalSeedNodes)); err != nil {
+			return fmt.Errorf("setting `external_seed_node_ip_addresses`: %+v", err)
+		}
+	}
+
+	if err := d.Set("identity", flattenCassandraClusterIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
 	}
+
 	// The "default_admin_password" is not returned in GET response, hence setting it from config.
 	d.Set("default_admin_password", d.Get("default_admin_password").(string))
-	return nil
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceCassandraClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.CassandraClustersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CassandraClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the full payload is sent so that the properties not managed by this update are retained
+	body := documentdb.ClusterResource{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Identity: expandCassandraClusterIdentity(d.Get("identity").([]interface{})),
+		Properties: &documentdb.ClusterResourceProperties{
+			AuthenticationMethod:          documentdb.AuthenticationMethod(d.Get("authentication_method").(string)),
+			CassandraVersion:              utils.String(d.Get("version").(string)),
+			ClientCertificates:            expandCassandraClusterCertificate(d.Get("client_certificate_pems").([]interface{})),
+			DelegatedManagementSubnetID:   utils.String(d.Get("delegated_management_subnet_id").(string)),
+			ExternalGossipCertificates:    expandCassandraClusterCertificate(d.Get("external_gossip_certificate_pems").([]interface{})),
+			ExternalSeedNodes:             expandCassandraClusterExternalSeedNode(d.Get("external_seed_node_ip_addresses").([]interface{})),
+			HoursBetweenBackups:           utils.Int32(int32(d.Get("hours_between_backups").(int))),
+			InitialCassandraAdminPassword: utils.String(d.Get("default_admin_password").(string)),
+			RepairEnabled:                 utils.Bool(d.Get("repair_enabled").(bool)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateUpdate(ctx, id.ResourceGroup, id.Name, body)
+	if err != nil {
+		return fmt.Errorf("updating %q: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on update for %q: %+v", id, err)
+	}
+
+	return resourceCassandraClusterRead(d, meta)
 }
 
 func resourceCassandraClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -166,3 +334,94 @@ func resourceCassandraClusterDelete(d *pluginsdk.ResourceData, meta interface{})
 
 	return nil
 }
+
+func expandCassandraClusterIdentity(input []interface{}) *documentdb.ManagedCassandraManagedServiceIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &documentdb.ManagedCassandraManagedServiceIdentity{
+			Type: documentdb.ManagedCassandraResourceIdentityTypeNone,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &documentdb.ManagedCassandraManagedServiceIdentity{
+		Type: documentdb.ManagedCassandraResourceIdentityType(v["type"].(string)),
+	}
+}
+
+func flattenCassandraClusterIdentity(input *documentdb.ManagedCassandraManagedServiceIdentity) []interface{} {
+	if input == nil || input.Type == documentdb.ManagedCassandraResourceIdentityTypeNone {
+		return make([]interface{}, 0)
+	}
+
+	var principalId, tenantId string
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func expandCassandraClusterCertificate(input []interface{}) *[]documentdb.Certificate {
+	results := make([]documentdb.Certificate, 0)
+
+	for _, pem := range input {
+		results = append(results, documentdb.Certificate{
+			Pem: utils.String(pem.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenCassandraClusterCertificate(input *[]documentdb.Certificate) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Pem != nil {
+			results = append(results, *item.Pem)
+		}
+	}
+
+	return results
+}
+
+func expandCassandraClusterExternalSeedNode(input []interface{}) *[]documentdb.SeedNode {
+	results := make([]documentdb.SeedNode, 0)
+
+	for _, ipAddress := range input {
+		results = append(results, documentdb.SeedNode{
+			IPAddress: utils.String(ipAddress.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenCassandraClusterExternalSeedNode(input *[]documentdb.SeedNode) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.IPAddress != nil {
+			results = append(results, *item.IPAddress)
+		}
+	}
+
+	return results
+}
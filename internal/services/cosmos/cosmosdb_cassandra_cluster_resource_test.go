@@ -50,6 +50,50 @@ func TestAccCassandraCluster_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccCassandraCluster_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_cassandra_cluster", "test")
+	r := CassandraClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_admin_password"),
+	})
+}
+
+func TestAccCassandraCluster_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_cassandra_cluster", "test")
+	r := CassandraClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_admin_password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_admin_password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_admin_password"),
+	})
+}
+
 func (t CassandraClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.CassandraClusterID(state.ID)
 	if err != nil {
@@ -64,9 +108,49 @@ func (t CassandraClusterResource) Exists(ctx context.Context, clients *clients.C
 	return utils.Bool(resp.ID != nil), nil
 }
 
-func (CassandraClusterResource) basic(data acceptance.TestData) string {
+func (r CassandraClusterResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
+%[1]s
 
+resource "azurerm_cosmosdb_cassandra_cluster" "test" {
+  name                           = "acctca-mi-cluster-%[2]d"
+  resource_group_name            = azurerm_resource_group.test.name
+  location                       = azurerm_resource_group.test.location
+  delegated_management_subnet_id = azurerm_subnet.test.id
+  default_admin_password         = "Password1234"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CassandraClusterResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_cassandra_cluster" "test" {
+  name                            = "acctca-mi-cluster-%[2]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  delegated_management_subnet_id  = azurerm_subnet.test.id
+  default_admin_password          = "Password1234"
+  authentication_method           = "Cassandra"
+  external_seed_node_ip_addresses = ["10.52.221.2"]
+  hours_between_backups           = 12
+  repair_enabled                  = false
+  version                         = "3.11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (CassandraClusterResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
 }
@@ -95,14 +179,6 @@ resource "azurerm_role_assignment" "test" {
   role_definition_name = "Network Contributor"
   principal_id         = "255f3c8e-0c3d-4f06-ba9d-2fb68af0faed"
 }
-
-resource "azurerm_cosmosdb_cassandra_cluster" "test" {
-  name                           = "acctca-mi-cluster-%[1]d"
-  resource_group_name            = azurerm_resource_group.test.name
-  location                       = azurerm_resource_group.test.location
-  delegated_management_subnet_id = azurerm_subnet.test.id
-  default_admin_password         = "Password1234"
-}
 `, data.RandomInteger, data.Locations.Secondary)
 }
 
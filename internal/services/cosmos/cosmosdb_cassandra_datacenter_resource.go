@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -86,6 +87,32 @@ func resourceCassandraDatacenter() *pluginsdk.Resource {
 				Optional: true,
 				Default:  true,
 			},
+
+			"backup_storage_customer_key_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			"base64_encoded_yaml_fragment": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+
+			"disk_sku": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "P30",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"managed_disk_customer_key_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
 		},
 	}
 }
@@ -116,9 +143,22 @@ func resourceCassandraDatacenterCreate(d *pluginsdk.ResourceData, meta interface
 			AvailabilityZone:   utils.Bool(d.Get("availability_zones_enabled").(bool)),
 			DiskCapacity:       utils.Int32(int32(d.Get("disk_count").(int))),
 			DataCenterLocation: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+			DiskSku:            utils.String(d.Get("disk_sku").(string)),
 		},
 	}
 
+	if v, ok := d.GetOk("backup_storage_customer_key_uri"); ok {
+		body.Properties.BackupStorageCustomerKeyURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("base64_encoded_yaml_fragment"); ok {
+		body.Properties.Base64EncodedCassandraYamlFragment = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("managed_disk_customer_key_uri"); ok {
+		body.Properties.ManagedDiskCustomerKeyURI = utils.String(v.(string))
+	}
+
 	future, err := client.CreateUpdate(ctx, id.ResourceGroup, id.CassandraClusterName, id.DataCenterName, body)
 	if err != nil {
 		return fmt.Errorf("creating %q: %+v", id, err)
@@ -164,6 +204,10 @@ func resourceCassandraDatacenterRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("disk_count", int(*props.DiskCapacity))
 			d.Set("sku_name", props.Sku)
 			d.Set("availability_zones_enabled", props.AvailabilityZone)
+			d.Set("backup_storage_customer_key_uri", props.BackupStorageCustomerKeyURI)
+			d.Set("base64_encoded_yaml_fragment", props.Base64EncodedCassandraYamlFragment)
+			d.Set("disk_sku", props.DiskSku)
+			d.Set("managed_disk_customer_key_uri", props.ManagedDiskCustomerKeyURI)
 		}
 	}
 	return nil
@@ -184,9 +228,22 @@ func resourceCassandraDatacenterUpdate(d *pluginsdk.ResourceData, meta interface
 			DelegatedSubnetID:  utils.String(d.Get("delegated_management_subnet_id").(string)),
 			NodeCount:          utils.Int32(int32(d.Get("node_count").(int))),
 			DataCenterLocation: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+			DiskSku:            utils.String(d.Get("disk_sku").(string)),
 		},
 	}
 
+	if v, ok := d.GetOk("backup_storage_customer_key_uri"); ok {
+		body.Properties.BackupStorageCustomerKeyURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("base64_encoded_yaml_fragment"); ok {
+		body.Properties.Base64EncodedCassandraYamlFragment = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("managed_disk_customer_key_uri"); ok {
+		body.Properties.ManagedDiskCustomerKeyURI = utils.String(v.(string))
+	}
+
 	future, err := client.CreateUpdate(ctx, id.ResourceGroup, id.CassandraClusterName, id.DataCenterName, body)
 	if err != nil {
 		return fmt.Errorf("updating %q: %+v", id, err)
@@ -0,0 +1,169 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceCosmosDbRestorableDatabaseAccounts() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCosmosDbRestorableDatabaseAccountsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"accounts": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"api_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"creation_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"deletion_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"restorable_locations": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"creation_time": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"deletion_time": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"location": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"regional_database_account_instance_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCosmosDbRestorableDatabaseAccountsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.RestorableDatabaseAccountsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	loc := location.Normalize(d.Get("location").(string))
+
+	resp, err := client.ListByLocation(ctx, loc)
+	if err != nil {
+		return fmt.Errorf("retrieving Cosmos DB Restorable Database Accounts (Location %q): %+v", loc, err)
+	}
+
+	accounts := make([]interface{}, 0)
+	if resp.Value != nil {
+		for _, item := range *resp.Value {
+			if props := item.RestorableDatabaseAccountProperties; props != nil && props.AccountName != nil && *props.AccountName == name {
+				accounts = append(accounts, flattenCosmosDbRestorableDatabaseAccount(item))
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.DocumentDB/locations/%s/restorableDatabaseAccounts", subscriptionId, loc))
+	d.Set("name", name)
+	d.Set("location", loc)
+
+	if err := d.Set("accounts", accounts); err != nil {
+		return fmt.Errorf("setting `accounts`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenCosmosDbRestorableDatabaseAccount(input documentdb.RestorableDatabaseAccountGetResult) map[string]interface{} {
+	props := input.RestorableDatabaseAccountProperties
+
+	creationTime := ""
+	if props.CreationTime != nil {
+		creationTime = props.CreationTime.Format(time.RFC3339)
+	}
+
+	deletionTime := ""
+	if props.DeletionTime != nil {
+		deletionTime = props.DeletionTime.Format(time.RFC3339)
+	}
+
+	restorableLocations := make([]interface{}, 0)
+	if props.RestorableLocations != nil {
+		for _, item := range *props.RestorableLocations {
+			locationCreationTime := ""
+			if item.CreationTime != nil {
+				locationCreationTime = item.CreationTime.Format(time.RFC3339)
+			}
+
+			locationDeletionTime := ""
+			if item.DeletionTime != nil {
+				locationDeletionTime = item.DeletionTime.Format(time.RFC3339)
+			}
+
+			restorableLocations = append(restorableLocations, map[string]interface{}{
+				"creation_time":                         locationCreationTime,
+				"deletion_time":                         locationDeletionTime,
+				"location":                              location.NormalizeNilable(item.LocationName),
+				"regional_database_account_instance_id": utils.NormalizeNilableString(item.RegionalDatabaseAccountInstanceID),
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                   utils.NormalizeNilableString(input.ID),
+		"api_type":             string(props.APIType),
+		"creation_time":        creationTime,
+		"deletion_time":        deletionTime,
+		"restorable_locations": restorableLocations,
+	}
+}
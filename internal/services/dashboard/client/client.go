@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2022-08-01/grafanaresource"
+)
+
+type Client struct {
+	GrafanaResourceClient *grafanaresource.GrafanaResourceClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	grafanaResourceClient := grafanaresource.NewGrafanaResourceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&grafanaResourceClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		GrafanaResourceClient: &grafanaResourceClient,
+	}
+}
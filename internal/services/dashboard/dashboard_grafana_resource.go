@@ -0,0 +1,400 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/sdk/2022-08-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = DashboardGrafanaResource{}
+
+type DashboardGrafanaResource struct{}
+
+type DashboardGrafanaModel struct {
+	Name                              string                                  `tfschema:"name"`
+	ResourceGroupName                 string                                  `tfschema:"resource_group_name"`
+	Location                          string                                  `tfschema:"location"`
+	ApiKeyEnabled                     bool                                    `tfschema:"api_key_enabled"`
+	AutoGeneratedDomainNameLabelScope string                                  `tfschema:"auto_generated_domain_name_label_scope"`
+	AzureMonitorWorkspaceIntegrations []AzureMonitorWorkspaceIntegrationModel `tfschema:"azure_monitor_workspace_integrations"`
+	DeterministicOutboundIPEnabled    bool                                    `tfschema:"deterministic_outbound_ip_enabled"`
+	PublicNetworkAccessEnabled        bool                                    `tfschema:"public_network_access_enabled"`
+	Sku                               string                                  `tfschema:"sku"`
+	ZoneRedundancyEnabled             bool                                    `tfschema:"zone_redundancy_enabled"`
+	Tags                              map[string]string                       `tfschema:"tags"`
+	Endpoint                          string                                  `tfschema:"endpoint"`
+	GrafanaVersion                    string                                  `tfschema:"grafana_version"`
+	OutboundIPs                       []string                                `tfschema:"outbound_ip"`
+}
+
+type AzureMonitorWorkspaceIntegrationModel struct {
+	ResourceId string `tfschema:"resource_id"`
+}
+
+func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GrafanaName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"api_key_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"auto_generated_domain_name_label_scope": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(grafanaresource.AutoGeneratedDomainNameLabelScopeTenantReuse),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(grafanaresource.AutoGeneratedDomainNameLabelScopeTenantReuse),
+			}, false),
+		},
+
+		"azure_monitor_workspace_integrations": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+			},
+		},
+
+		"deterministic_outbound_ip_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"identity": identity.SystemAssigned{}.Schema(),
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"sku": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "Standard",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Standard",
+			}, false),
+		},
+
+		"zone_redundancy_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r DashboardGrafanaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"grafana_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DashboardGrafanaResource) ModelObject() interface{} {
+	return &DashboardGrafanaModel{}
+}
+
+func (r DashboardGrafanaResource) ResourceType() string {
+	return "azurerm_dashboard_grafana"
+}
+
+func (r DashboardGrafanaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return grafanaresource.ValidateGrafanaID
+}
+
+func (r DashboardGrafanaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.GrafanaResourceClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DashboardGrafanaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := grafanaresource.NewGrafanaID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.GrafanaGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := expandDashboardGrafana(model, metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return err
+			}
+
+			if err := client.GrafanaCreateThenPoll(ctx, id, *parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.GrafanaResourceClient
+
+			id, err := grafanaresource.ParseGrafanaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GrafanaGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DashboardGrafanaModel{
+				Name:              id.GrafanaName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if model.Sku != nil {
+					state.Sku = model.Sku.Name
+				}
+
+				if props := model.Properties; props != nil {
+					state.ApiKeyEnabled = props.ApiKey != nil && *props.ApiKey == grafanaresource.ApiKeyEnabled
+					state.DeterministicOutboundIPEnabled = props.DeterministicOutboundIP != nil && *props.DeterministicOutboundIP == grafanaresource.DeterministicOutboundIPEnabled
+					state.PublicNetworkAccessEnabled = props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == grafanaresource.PublicNetworkAccessEnabled
+					state.ZoneRedundancyEnabled = props.ZoneRedundancy != nil && *props.ZoneRedundancy == grafanaresource.ZoneRedundancyEnabled
+
+					if props.AutoGeneratedDomainNameLabelScope != nil {
+						state.AutoGeneratedDomainNameLabelScope = string(*props.AutoGeneratedDomainNameLabelScope)
+					}
+
+					if props.Endpoint != nil {
+						state.Endpoint = *props.Endpoint
+					}
+
+					if props.GrafanaVersion != nil {
+						state.GrafanaVersion = *props.GrafanaVersion
+					}
+
+					if props.OutboundIPs != nil {
+						state.OutboundIPs = *props.OutboundIPs
+					}
+
+					state.AzureMonitorWorkspaceIntegrations = flattenAzureMonitorWorkspaceIntegrations(props.GrafanaIntegrations)
+				}
+
+				if err := metadata.ResourceData.Set("identity", flattenDashboardGrafanaIdentity(model.Identity)); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.GrafanaResourceClient
+
+			id, err := grafanaresource.ParseGrafanaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DashboardGrafanaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters, err := expandDashboardGrafana(model, metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return err
+			}
+
+			if err := client.GrafanaCreateThenPoll(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DashboardGrafanaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.GrafanaResourceClient
+
+			id, err := grafanaresource.ParseGrafanaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.GrafanaDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDashboardGrafana(model DashboardGrafanaModel, identityRaw []interface{}) (*grafanaresource.ManagedGrafana, error) {
+	apiKey := grafanaresource.ApiKeyDisabled
+	if model.ApiKeyEnabled {
+		apiKey = grafanaresource.ApiKeyEnabled
+	}
+
+	deterministicOutboundIP := grafanaresource.DeterministicOutboundIPDisabled
+	if model.DeterministicOutboundIPEnabled {
+		deterministicOutboundIP = grafanaresource.DeterministicOutboundIPEnabled
+	}
+
+	publicNetworkAccess := grafanaresource.PublicNetworkAccessDisabled
+	if model.PublicNetworkAccessEnabled {
+		publicNetworkAccess = grafanaresource.PublicNetworkAccessEnabled
+	}
+
+	zoneRedundancy := grafanaresource.ZoneRedundancyDisabled
+	if model.ZoneRedundancyEnabled {
+		zoneRedundancy = grafanaresource.ZoneRedundancyEnabled
+	}
+
+	domainNameLabelScope := grafanaresource.AutoGeneratedDomainNameLabelScope(model.AutoGeneratedDomainNameLabelScope)
+
+	expandedIdentity, err := identity.SystemAssigned{}.Expand(identityRaw)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+	grafanaIdentity := &identity.SystemAssignedIdentity{}
+	grafanaIdentity.FromExpandedConfig(*expandedIdentity)
+
+	return &grafanaresource.ManagedGrafana{
+		Identity: grafanaIdentity,
+		Location: utils.String(location.Normalize(model.Location)),
+		Properties: &grafanaresource.ManagedGrafanaProperties{
+			ApiKey:                            &apiKey,
+			AutoGeneratedDomainNameLabelScope: &domainNameLabelScope,
+			DeterministicOutboundIP:           &deterministicOutboundIP,
+			GrafanaIntegrations:               expandAzureMonitorWorkspaceIntegrations(model.AzureMonitorWorkspaceIntegrations),
+			PublicNetworkAccess:               &publicNetworkAccess,
+			ZoneRedundancy:                    &zoneRedundancy,
+		},
+		Sku: &grafanaresource.ResourceSku{
+			Name: model.Sku,
+		},
+		Tags: &model.Tags,
+	}, nil
+}
+
+func expandAzureMonitorWorkspaceIntegrations(input []AzureMonitorWorkspaceIntegrationModel) *grafanaresource.GrafanaIntegrations {
+	integrations := make([]grafanaresource.AzureMonitorWorkspaceIntegration, 0)
+	for _, v := range input {
+		integrations = append(integrations, grafanaresource.AzureMonitorWorkspaceIntegration{
+			AzureMonitorWorkspaceResourceId: utils.String(v.ResourceId),
+		})
+	}
+
+	return &grafanaresource.GrafanaIntegrations{
+		AzureMonitorWorkspaceIntegrations: &integrations,
+	}
+}
+
+func flattenAzureMonitorWorkspaceIntegrations(input *grafanaresource.GrafanaIntegrations) []AzureMonitorWorkspaceIntegrationModel {
+	results := make([]AzureMonitorWorkspaceIntegrationModel, 0)
+	if input == nil || input.AzureMonitorWorkspaceIntegrations == nil {
+		return results
+	}
+
+	for _, v := range *input.AzureMonitorWorkspaceIntegrations {
+		if v.AzureMonitorWorkspaceResourceId != nil {
+			results = append(results, AzureMonitorWorkspaceIntegrationModel{
+				ResourceId: *v.AzureMonitorWorkspaceResourceId,
+			})
+		}
+	}
+
+	return results
+}
+
+func flattenDashboardGrafanaIdentity(input *identity.SystemAssignedIdentity) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	config := input.ToExpandedConfig()
+	return identity.SystemAssigned{}.Flatten(&config)
+}
@@ -0,0 +1,15 @@
+package grafanaresource
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GrafanaResourceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGrafanaResourceClientWithBaseURI(endpoint string) GrafanaResourceClient {
+	return GrafanaResourceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
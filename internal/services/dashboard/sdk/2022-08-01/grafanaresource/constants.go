@@ -0,0 +1,140 @@
+package grafanaresource
+
+import "strings"
+
+type ApiKey string
+
+const (
+	ApiKeyDisabled ApiKey = "Disabled"
+	ApiKeyEnabled  ApiKey = "Enabled"
+)
+
+func PossibleValuesForApiKey() []string {
+	return []string{
+		string(ApiKeyDisabled),
+		string(ApiKeyEnabled),
+	}
+}
+
+func parseApiKey(input string) (*ApiKey, error) {
+	vals := map[string]ApiKey{
+		"disabled": ApiKeyDisabled,
+		"enabled":  ApiKeyEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ApiKey(input)
+	return &out, nil
+}
+
+type AutoGeneratedDomainNameLabelScope string
+
+const (
+	AutoGeneratedDomainNameLabelScopeTenantReuse AutoGeneratedDomainNameLabelScope = "TenantReuse"
+)
+
+func PossibleValuesForAutoGeneratedDomainNameLabelScope() []string {
+	return []string{
+		string(AutoGeneratedDomainNameLabelScopeTenantReuse),
+	}
+}
+
+func parseAutoGeneratedDomainNameLabelScope(input string) (*AutoGeneratedDomainNameLabelScope, error) {
+	vals := map[string]AutoGeneratedDomainNameLabelScope{
+		"tenantreuse": AutoGeneratedDomainNameLabelScopeTenantReuse,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AutoGeneratedDomainNameLabelScope(input)
+	return &out, nil
+}
+
+type DeterministicOutboundIP string
+
+const (
+	DeterministicOutboundIPDisabled DeterministicOutboundIP = "Disabled"
+	DeterministicOutboundIPEnabled  DeterministicOutboundIP = "Enabled"
+)
+
+func PossibleValuesForDeterministicOutboundIP() []string {
+	return []string{
+		string(DeterministicOutboundIPDisabled),
+		string(DeterministicOutboundIPEnabled),
+	}
+}
+
+func parseDeterministicOutboundIP(input string) (*DeterministicOutboundIP, error) {
+	vals := map[string]DeterministicOutboundIP{
+		"disabled": DeterministicOutboundIPDisabled,
+		"enabled":  DeterministicOutboundIPEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DeterministicOutboundIP(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
+
+type ZoneRedundancy string
+
+const (
+	ZoneRedundancyDisabled ZoneRedundancy = "Disabled"
+	ZoneRedundancyEnabled  ZoneRedundancy = "Enabled"
+)
+
+func PossibleValuesForZoneRedundancy() []string {
+	return []string{
+		string(ZoneRedundancyDisabled),
+		string(ZoneRedundancyEnabled),
+	}
+}
+
+func parseZoneRedundancy(input string) (*ZoneRedundancy, error) {
+	vals := map[string]ZoneRedundancy{
+		"disabled": ZoneRedundancyDisabled,
+		"enabled":  ZoneRedundancyEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ZoneRedundancy(input)
+	return &out, nil
+}
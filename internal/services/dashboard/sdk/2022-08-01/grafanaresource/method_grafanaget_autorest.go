@@ -0,0 +1,64 @@
+package grafanaresource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GrafanaGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ManagedGrafana
+}
+
+// GrafanaGet ...
+func (c GrafanaResourceClient) GrafanaGet(ctx context.Context, id GrafanaId) (result GrafanaGetResponse, err error) {
+	req, err := c.preparerForGrafanaGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "grafanaresource.GrafanaResourceClient", "GrafanaGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "grafanaresource.GrafanaResourceClient", "GrafanaGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGrafanaGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "grafanaresource.GrafanaResourceClient", "GrafanaGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGrafanaGet prepares the GrafanaGet request.
+func (c GrafanaResourceClient) preparerForGrafanaGet(ctx context.Context, id GrafanaId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGrafanaGet handles the response to the GrafanaGet request. The method always
+// closes the http.Response Body.
+func (c GrafanaResourceClient) responderForGrafanaGet(resp *http.Response) (result GrafanaGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,5 @@
+package grafanaresource
+
+type AzureMonitorWorkspaceIntegration struct {
+	AzureMonitorWorkspaceResourceId *string `json:"azureMonitorWorkspaceResourceId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package grafanaresource
+
+type GrafanaIntegrations struct {
+	AzureMonitorWorkspaceIntegrations *[]AzureMonitorWorkspaceIntegration `json:"azureMonitorWorkspaceIntegrations,omitempty"`
+}
@@ -0,0 +1,16 @@
+package grafanaresource
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type ManagedGrafana struct {
+	Id         *string                          `json:"id,omitempty"`
+	Identity   *identity.SystemAssignedIdentity `json:"identity,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *ManagedGrafanaProperties        `json:"properties,omitempty"`
+	Sku        *ResourceSku                     `json:"sku,omitempty"`
+	Tags       *map[string]string               `json:"tags,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+}
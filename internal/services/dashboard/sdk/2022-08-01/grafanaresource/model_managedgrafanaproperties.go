@@ -0,0 +1,14 @@
+package grafanaresource
+
+type ManagedGrafanaProperties struct {
+	ApiKey                            *ApiKey                            `json:"apiKey,omitempty"`
+	AutoGeneratedDomainNameLabelScope *AutoGeneratedDomainNameLabelScope `json:"autoGeneratedDomainNameLabelScope,omitempty"`
+	DeterministicOutboundIP           *DeterministicOutboundIP           `json:"deterministicOutboundIP,omitempty"`
+	Endpoint                          *string                            `json:"endpoint,omitempty"`
+	GrafanaIntegrations               *GrafanaIntegrations               `json:"grafanaIntegrations,omitempty"`
+	GrafanaVersion                    *string                            `json:"grafanaVersion,omitempty"`
+	OutboundIPs                       *[]string                          `json:"outboundIPs,omitempty"`
+	ProvisioningState                 *string                            `json:"provisioningState,omitempty"`
+	PublicNetworkAccess               *PublicNetworkAccess               `json:"publicNetworkAccess,omitempty"`
+	ZoneRedundancy                    *ZoneRedundancy                    `json:"zoneRedundancy,omitempty"`
+}
@@ -0,0 +1,5 @@
+package grafanaresource
+
+type ResourceSku struct {
+	Name string `json:"name"`
+}
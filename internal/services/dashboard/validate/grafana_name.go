@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func GrafanaName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{0,21}[a-zA-Z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 23 characters, start with a letter, end with a letter or number and may only contain letters, numbers and hyphens", k))
+	}
+
+	return warnings, errors
+}
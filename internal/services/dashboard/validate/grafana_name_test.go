@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGrafanaName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"a", true},
+		{"ab", false},
+		{"a-1", false},
+		{"Grafana1", false},
+		{"1grafana", true},
+		{"-grafana", true},
+		{"grafana-", true},
+		{"grafana_1", true},
+		{"a" + strings.Repeat("b", 22), false},
+		{"a" + strings.Repeat("b", 23), true},
+	}
+
+	for _, test := range testCases {
+		_, es := GrafanaName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q to succeed", test.input)
+		}
+	}
+}
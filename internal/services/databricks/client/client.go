@@ -1,19 +1,51 @@
 package client
 
 import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2021-04-01-preview/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2.1/unitycatalog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2022-04-01-preview/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2022-10-01-preview/accessconnector"
 )
 
+// databricksResourceId is the well-known Application ID of the Azure Databricks service, which
+// is used as the audience when obtaining tokens for the Databricks APIs
+const databricksResourceId = "2ff814a6-3304-4ab8-85cb-cd0e6f879c1d"
+
 type Client struct {
-	WorkspacesClient *workspaces.WorkspacesClient
+	AccessConnectorClient *accessconnector.AccessConnectorClient
+	WorkspacesClient      *workspaces.WorkspacesClient
+
+	tokenFunc           func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc func(c *autorest.Client, authorizer autorest.Authorizer)
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	AccessConnectorClient := accessconnector.NewAccessConnectorClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AccessConnectorClient.Client, o.ResourceManagerAuthorizer)
+
 	WorkspacesClient := workspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&WorkspacesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		WorkspacesClient: &WorkspacesClient,
+		AccessConnectorClient: &AccessConnectorClient,
+		WorkspacesClient:      &WorkspacesClient,
+
+		tokenFunc:           o.TokenFunc,
+		configureClientFunc: o.ConfigureClient,
 	}
 }
+
+// UnityCatalogClient returns a client for the Unity Catalog API of the Databricks Workspace at the specified URL
+func (c Client) UnityCatalogClient(workspaceUrl string) (*unitycatalog.BaseClient, error) {
+	auth, err := c.tokenFunc(databricksResourceId)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for the Databricks API: %+v", err)
+	}
+
+	client := unitycatalog.NewWithoutDefaults(fmt.Sprintf("https://%s", workspaceUrl))
+	c.configureClientFunc(&client.Client, auth)
+	return &client, nil
+}
@@ -0,0 +1,243 @@
+package databricks
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2.1/unitycatalog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2022-04-01-preview/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDatabricksWorkspaceMetastoreAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDatabricksWorkspaceMetastoreAssignmentCreate,
+		Read:   resourceDatabricksWorkspaceMetastoreAssignmentRead,
+		Update: resourceDatabricksWorkspaceMetastoreAssignmentUpdate,
+		Delete: resourceDatabricksWorkspaceMetastoreAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.MetastoreAssignmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"metastore_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"default_catalog_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "hive_metastore",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceDatabricksWorkspaceMetastoreAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	workspacesClient := meta.(*clients.Client).DataBricks.WorkspacesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := workspaces.ParseWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewMetastoreAssignmentID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, d.Get("metastore_id").(string))
+
+	workspace, err := workspacesClient.Get(ctx, *workspaceId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+	}
+
+	dataPlaneClient, databricksWorkspaceId, err := databricksUnityCatalogClient(meta, *workspaceId, workspace.Model)
+	if err != nil {
+		return err
+	}
+
+	// a Workspace can only be assigned to a single Metastore, so check for any existing assignment
+	existing, err := dataPlaneClient.GetCurrentMetastoreAssignment(ctx)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Metastore assignment for %s: %+v", *workspaceId, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) && existing.MetastoreID != nil && *existing.MetastoreID != "" {
+		return tf.ImportAsExistsError("azurerm_databricks_workspace_metastore_assignment", parse.NewMetastoreAssignmentID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, *existing.MetastoreID).ID())
+	}
+
+	parameters := unitycatalog.CreateMetastoreAssignment{
+		MetastoreID:        utils.String(id.Name),
+		DefaultCatalogName: utils.String(d.Get("default_catalog_name").(string)),
+	}
+	if _, err := dataPlaneClient.CreateMetastoreAssignment(ctx, databricksWorkspaceId, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDatabricksWorkspaceMetastoreAssignmentRead(d, meta)
+}
+
+func resourceDatabricksWorkspaceMetastoreAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	workspacesClient := meta.(*clients.Client).DataBricks.WorkspacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MetastoreAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+	workspace, err := workspacesClient.Get(ctx, workspaceId)
+	if err != nil {
+		if response.WasNotFound(workspace.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", workspaceId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+
+	dataPlaneClient, _, err := databricksUnityCatalogClient(meta, workspaceId, workspace.Model)
+	if err != nil {
+		return err
+	}
+
+	resp, err := dataPlaneClient.GetCurrentMetastoreAssignment(ctx)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the Workspace may since have been assigned to a different Metastore
+	if resp.MetastoreID == nil || !strings.EqualFold(*resp.MetastoreID, id.Name) {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("workspace_id", workspaceId.ID())
+	d.Set("metastore_id", id.Name)
+	d.Set("default_catalog_name", resp.DefaultCatalogName)
+
+	return nil
+}
+
+func resourceDatabricksWorkspaceMetastoreAssignmentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	workspacesClient := meta.(*clients.Client).DataBricks.WorkspacesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MetastoreAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+	workspace, err := workspacesClient.Get(ctx, workspaceId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+
+	dataPlaneClient, databricksWorkspaceId, err := databricksUnityCatalogClient(meta, workspaceId, workspace.Model)
+	if err != nil {
+		return err
+	}
+
+	parameters := unitycatalog.UpdateMetastoreAssignment{
+		MetastoreID:        utils.String(id.Name),
+		DefaultCatalogName: utils.String(d.Get("default_catalog_name").(string)),
+	}
+	if _, err := dataPlaneClient.UpdateMetastoreAssignment(ctx, databricksWorkspaceId, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceDatabricksWorkspaceMetastoreAssignmentRead(d, meta)
+}
+
+func resourceDatabricksWorkspaceMetastoreAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	workspacesClient := meta.(*clients.Client).DataBricks.WorkspacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MetastoreAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+	workspace, err := workspacesClient.Get(ctx, workspaceId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+
+	dataPlaneClient, databricksWorkspaceId, err := databricksUnityCatalogClient(meta, workspaceId, workspace.Model)
+	if err != nil {
+		return err
+	}
+
+	if _, err := dataPlaneClient.DeleteMetastoreAssignment(ctx, databricksWorkspaceId, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// databricksUnityCatalogClient returns a Unity Catalog client for the specified Workspace, along with
+// the numeric ID Databricks uses to identify the Workspace
+func databricksUnityCatalogClient(meta interface{}, workspaceId workspaces.WorkspaceId, workspace *workspaces.Workspace) (*unitycatalog.BaseClient, int64, error) {
+	if workspace == nil || workspace.Properties.WorkspaceId == nil || workspace.Properties.WorkspaceUrl == nil {
+		return nil, 0, fmt.Errorf("retrieving %s: `workspaceId` and `workspaceUrl` must be set", workspaceId)
+	}
+
+	databricksWorkspaceId, err := strconv.ParseInt(*workspace.Properties.WorkspaceId, 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("parsing the Databricks Workspace ID %q for %s: %+v", *workspace.Properties.WorkspaceId, workspaceId, err)
+	}
+
+	client, err := meta.(*clients.Client).DataBricks.UnityCatalogClient(*workspace.Properties.WorkspaceUrl)
+	if err != nil {
+		return nil, 0, fmt.Errorf("building Unity Catalog client for %s: %+v", workspaceId, err)
+	}
+
+	return client, databricksWorkspaceId, nil
+}
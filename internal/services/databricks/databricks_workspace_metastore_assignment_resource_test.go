@@ -0,0 +1,172 @@
+package databricks_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2022-04-01-preview/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DatabricksWorkspaceMetastoreAssignmentResource struct {
+	metastoreId string
+}
+
+func newDatabricksWorkspaceMetastoreAssignmentResource(t *testing.T) DatabricksWorkspaceMetastoreAssignmentResource {
+	// Unity Catalog Metastores are managed at the Databricks Account level, so an existing Metastore
+	// in the same region as the test Workspace needs to be provided via ARM_TEST_DATABRICKS_METASTORE_ID.
+	metastoreId := os.Getenv("ARM_TEST_DATABRICKS_METASTORE_ID")
+	if metastoreId == "" {
+		t.Skip("Skipping as ARM_TEST_DATABRICKS_METASTORE_ID is not specified")
+	}
+
+	return DatabricksWorkspaceMetastoreAssignmentResource{
+		metastoreId: metastoreId,
+	}
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_basic(t *testing.T) {
+	r := newDatabricksWorkspaceMetastoreAssignmentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_requiresImport(t *testing.T) {
+	r := newDatabricksWorkspaceMetastoreAssignmentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_update(t *testing.T) {
+	r := newDatabricksWorkspaceMetastoreAssignmentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.defaultCatalog(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_catalog_name").HasValue("main"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DatabricksWorkspaceMetastoreAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.MetastoreAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+	workspace, err := clients.DataBricks.WorkspacesClient.Get(ctx, workspaceId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+	if workspace.Model == nil || workspace.Model.Properties.WorkspaceUrl == nil {
+		return nil, fmt.Errorf("retrieving %s: `workspaceUrl` was nil", workspaceId)
+	}
+
+	client, err := clients.DataBricks.UnityCatalogClient(*workspace.Model.Properties.WorkspaceUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCurrentMetastoreAssignment(ctx)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.MetastoreID != nil && strings.EqualFold(*resp.MetastoreID, id.Name)), nil
+}
+
+func (DatabricksWorkspaceMetastoreAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-db-%d"
+  location = "%s"
+}
+
+resource "azurerm_databricks_workspace" "test" {
+  name                = "acctestDBW-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "premium"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "test" {
+  workspace_id = azurerm_databricks_workspace.test.id
+  metastore_id = "%s"
+}
+`, r.template(data), r.metastoreId)
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "import" {
+  workspace_id = azurerm_databricks_workspace_metastore_assignment.test.workspace_id
+  metastore_id = azurerm_databricks_workspace_metastore_assignment.test.metastore_id
+}
+`, r.basic(data))
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) defaultCatalog(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "test" {
+  workspace_id         = azurerm_databricks_workspace.test.id
+  metastore_id         = "%s"
+  default_catalog_name = "main"
+}
+`, r.template(data), r.metastoreId)
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/parse"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2021-04-01-preview/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2022-04-01-preview/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/validate"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -86,10 +86,21 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 			"managed_services_cmk_key_vault_key_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: keyVaultValidate.KeyVaultChildID,
 			},
 
+			"managed_disk_cmk_key_vault_key_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: keyVaultValidate.KeyVaultChildID,
+			},
+
+			"managed_disk_cmk_rotation_to_latest_version_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"managed_disk_cmk_key_vault_key_id"},
+			},
+
 			"infrastructure_encryption_enabled": {
 				Type:     pluginsdk.TypeBool,
 				ForceNew: true,
@@ -240,6 +251,36 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"disk_encryption_set_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"managed_disk_identity": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"principal_id": {
+							Type:      pluginsdk.TypeString,
+							Sensitive: true,
+							Computed:  true,
+						},
+
+						"tenant_id": {
+							Type:      pluginsdk.TypeString,
+							Sensitive: true,
+							Computed:  true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"storage_account_identity": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -275,6 +316,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 			_, requireNsgRules := d.GetChange("network_security_group_rules_required")
 			_, backendPool := d.GetChange("load_balancer_backend_address_pool_id")
 			_, managedServicesCMK := d.GetChange("managed_services_cmk_key_vault_key_id")
+			_, managedDiskCMK := d.GetChange("managed_disk_cmk_key_vault_key_id")
 
 			oldSku, newSku := d.GetChange("sku")
 
@@ -300,8 +342,20 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				}
 			}
 
-			if (customerEncryptionEnabled.(bool) || infrastructureEncryptionEnabled.(bool) || managedServicesCMK.(string) != "") && !strings.EqualFold("premium", newSku.(string)) {
-				return fmt.Errorf("'customer_managed_key_enabled', 'infrastructure_encryption_enabled' and 'managed_services_cmk_key_vault_key_id' are only available with a 'premium' workspace 'sku', got %q", newSku)
+			if (customerEncryptionEnabled.(bool) || infrastructureEncryptionEnabled.(bool) || managedServicesCMK.(string) != "" || managedDiskCMK.(string) != "") && !strings.EqualFold("premium", newSku.(string)) {
+				return fmt.Errorf("'customer_managed_key_enabled', 'infrastructure_encryption_enabled', 'managed_services_cmk_key_vault_key_id' and 'managed_disk_cmk_key_vault_key_id' are only available with a 'premium' workspace 'sku', got %q", newSku)
+			}
+
+			// once a customer-managed key has been configured for managed services or managed disks it can be rotated, but not removed
+			if d.HasChange("managed_services_cmk_key_vault_key_id") {
+				if oldKey, newKey := d.GetChange("managed_services_cmk_key_vault_key_id"); oldKey.(string) != "" && newKey.(string) == "" {
+					d.ForceNew("managed_services_cmk_key_vault_key_id")
+				}
+			}
+			if d.HasChange("managed_disk_cmk_key_vault_key_id") {
+				if oldKey, newKey := d.GetChange("managed_disk_cmk_key_vault_key_id"); oldKey.(string) != "" && newKey.(string) == "" {
+					d.ForceNew("managed_disk_cmk_key_vault_key_id")
+				}
 			}
 
 			return nil
@@ -414,15 +468,13 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 			return err
 		}
 
-		encrypt.Entities = workspaces.EncryptionEntitiesDefinition{
-			ManagedServices: &workspaces.EncryptionV2{
-				// There is only one valid source for this field at this point in time so I have hardcoded the value
-				KeySource: workspaces.EncryptionKeySourceMicrosoftPointKeyvault,
-				KeyVaultProperties: &workspaces.EncryptionV2KeyVaultProperties{
-					KeyName:     key.Name,
-					KeyVersion:  key.Version,
-					KeyVaultUri: key.KeyVaultBaseUrl,
-				},
+		encrypt.Entities.ManagedServices = &workspaces.EncryptionV2{
+			// There is only one valid source for this field at this point in time so I have hardcoded the value
+			KeySource: workspaces.EncryptionKeySourceMicrosoftPointKeyvault,
+			KeyVaultProperties: &workspaces.EncryptionV2KeyVaultProperties{
+				KeyName:     key.Name,
+				KeyVersion:  key.Version,
+				KeyVaultUri: key.KeyVaultBaseUrl,
 			},
 		}
 
@@ -433,6 +485,33 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	// Set up customer-managed keys for managed disks encryption
+	diskKeyIdRaw := d.Get("managed_disk_cmk_key_vault_key_id").(string)
+	if diskKeyIdRaw != "" {
+		setEncrypt = true
+		key, err := keyVaultParse.ParseNestedItemID(diskKeyIdRaw)
+		if err != nil {
+			return err
+		}
+
+		encrypt.Entities.ManagedDisk = &workspaces.ManagedDiskEncryption{
+			// There is only one valid source for this field at this point in time so I have hardcoded the value
+			KeySource: workspaces.EncryptionKeySourceMicrosoftPointKeyvault,
+			KeyVaultProperties: workspaces.ManagedDiskEncryptionKeyVaultProperties{
+				KeyName:     key.Name,
+				KeyVersion:  key.Version,
+				KeyVaultUri: key.KeyVaultBaseUrl,
+			},
+			RotationToLatestKeyVersionEnabled: utils.Bool(d.Get("managed_disk_cmk_rotation_to_latest_version_enabled").(bool)),
+		}
+
+		// make sure the key vault exists
+		keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, meta.(*clients.Client).Resource, key.KeyVaultBaseUrl)
+		if err != nil || keyVaultIdRaw == nil {
+			return fmt.Errorf("retrieving the Resource ID for the customer-managed keys for managed disks Key Vault at URL %q: %+v", key.KeyVaultBaseUrl, err)
+		}
+	}
+
 	// Including the Tags in the workspace parameters will update the tags on
 	// the workspace only
 	workspace := workspaces.Workspace{
@@ -492,6 +571,10 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		d.Set("managed_services_cmk_key_vault_key_id", keyIdRaw)
 	}
 
+	if encrypt != nil && diskKeyIdRaw != "" {
+		d.Set("managed_disk_cmk_key_vault_key_id", diskKeyIdRaw)
+	}
+
 	return resourceDatabricksWorkspaceRead(d, meta)
 }
 
@@ -568,10 +651,16 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("load_balancer_backend_address_pool_id", backendPoolReadId)
 		}
 
-		if err := d.Set("storage_account_identity", flattenWorkspaceStorageAccountIdentity(model.Properties.StorageAccountIdentity)); err != nil {
+		if err := d.Set("storage_account_identity", flattenWorkspaceManagedIdentity(model.Properties.StorageAccountIdentity)); err != nil {
 			return fmt.Errorf("setting `storage_account_identity`: %+v", err)
 		}
 
+		if err := d.Set("managed_disk_identity", flattenWorkspaceManagedIdentity(model.Properties.ManagedDiskIdentity)); err != nil {
+			return fmt.Errorf("setting `managed_disk_identity`: %+v", err)
+		}
+
+		d.Set("disk_encryption_set_id", model.Properties.DiskEncryptionSetId)
+
 		if model.Properties.WorkspaceUrl != nil {
 			d.Set("workspace_url", model.Properties.WorkspaceUrl)
 		}
@@ -599,6 +688,35 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 				d.Set("managed_services_cmk_key_vault_key_id", key.ID())
 			}
 		}
+
+		// customer managed key for managed disks
+		diskKeyName := ""
+		diskKeyVersion := ""
+		diskKeyVaultURI := ""
+		diskKeyRotationEnabled := false
+
+		if encryption := model.Properties.Encryption; encryption != nil {
+			if encryptionProps := encryption.Entities.ManagedDisk; encryptionProps != nil {
+				diskKeyName = encryptionProps.KeyVaultProperties.KeyName
+				diskKeyVersion = encryptionProps.KeyVaultProperties.KeyVersion
+				diskKeyVaultURI = encryptionProps.KeyVaultProperties.KeyVaultUri
+				if encryptionProps.RotationToLatestKeyVersionEnabled != nil {
+					diskKeyRotationEnabled = *encryptionProps.RotationToLatestKeyVersionEnabled
+				}
+			}
+		}
+
+		diskKeyId := ""
+		if diskKeyVaultURI != "" {
+			key, err := keyVaultParse.NewNestedItemID(diskKeyVaultURI, "keys", diskKeyName, diskKeyVersion)
+			if err != nil {
+				return fmt.Errorf("parsing the Managed Disk Customer Managed Key ID: %+v", err)
+			}
+			diskKeyId = key.ID()
+		}
+		d.Set("managed_disk_cmk_key_vault_key_id", diskKeyId)
+		d.Set("managed_disk_cmk_rotation_to_latest_version_enabled", diskKeyRotationEnabled)
+
 		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
@@ -622,7 +740,7 @@ func resourceDatabricksWorkspaceDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
-func flattenWorkspaceStorageAccountIdentity(input *workspaces.ManagedIdentityConfiguration) []interface{} {
+func flattenWorkspaceManagedIdentity(input *workspaces.ManagedIdentityConfiguration) []interface{} {
 	if input == nil {
 		return nil
 	}
@@ -29,7 +29,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_databricks_access_connector":               resourceDatabricksAccessConnector(),
 		"azurerm_databricks_workspace":                      resourceDatabricksWorkspace(),
 		"azurerm_databricks_workspace_customer_managed_key": resourceDatabricksWorkspaceCustomerManagedKey(),
+		"azurerm_databricks_workspace_metastore_assignment": resourceDatabricksWorkspaceMetastoreAssignment(),
 	}
 }
@@ -0,0 +1,319 @@
+// Package unitycatalog implements a client for the Databricks Unity Catalog API version 2.1.
+package unitycatalog
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/tracing"
+)
+
+// BaseClient is the base client for Unitycatalog.
+type BaseClient struct {
+	autorest.Client
+	Endpoint string
+}
+
+// New creates an instance of the BaseClient client.
+func New(endpoint string) BaseClient {
+	return NewWithoutDefaults(endpoint)
+}
+
+// NewWithoutDefaults creates an instance of the BaseClient client.
+func NewWithoutDefaults(endpoint string) BaseClient {
+	return BaseClient{
+		Client:   autorest.NewClientWithUserAgent(UserAgent()),
+		Endpoint: endpoint,
+	}
+}
+
+// CreateMetastoreAssignment assigns a Unity Catalog Metastore to a Workspace. If an assignment for the
+// same Workspace already exists it will be overwritten.
+// Parameters:
+// workspaceID - the numeric ID of the Databricks Workspace.
+// parameters - the Metastore assignment.
+func (client BaseClient) CreateMetastoreAssignment(ctx context.Context, workspaceID int64, parameters CreateMetastoreAssignment) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.CreateMetastoreAssignment")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.CreateMetastoreAssignmentPreparer(ctx, workspaceID, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "CreateMetastoreAssignment", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateMetastoreAssignmentSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "CreateMetastoreAssignment", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateMetastoreAssignmentResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "CreateMetastoreAssignment", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateMetastoreAssignmentPreparer prepares the CreateMetastoreAssignment request.
+func (client BaseClient) CreateMetastoreAssignmentPreparer(ctx context.Context, workspaceID int64, parameters CreateMetastoreAssignment) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"workspace_id": autorest.Encode("path", workspaceID),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/api/2.1/unity-catalog/workspaces/{workspace_id}/metastore", pathParameters),
+		autorest.WithJSON(parameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// CreateMetastoreAssignmentSender sends the CreateMetastoreAssignment request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) CreateMetastoreAssignmentSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// CreateMetastoreAssignmentResponder handles the response to the CreateMetastoreAssignment request. The method always
+// closes the http.Response Body.
+func (client BaseClient) CreateMetastoreAssignmentResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// UpdateMetastoreAssignment updates the Unity Catalog Metastore assignment of a Workspace.
+// Parameters:
+// workspaceID - the numeric ID of the Databricks Workspace.
+// parameters - the updated Metastore assignment.
+func (client BaseClient) UpdateMetastoreAssignment(ctx context.Context, workspaceID int64, parameters UpdateMetastoreAssignment) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.UpdateMetastoreAssignment")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.UpdateMetastoreAssignmentPreparer(ctx, workspaceID, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "UpdateMetastoreAssignment", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateMetastoreAssignmentSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "UpdateMetastoreAssignment", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateMetastoreAssignmentResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "UpdateMetastoreAssignment", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateMetastoreAssignmentPreparer prepares the UpdateMetastoreAssignment request.
+func (client BaseClient) UpdateMetastoreAssignmentPreparer(ctx context.Context, workspaceID int64, parameters UpdateMetastoreAssignment) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"workspace_id": autorest.Encode("path", workspaceID),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/api/2.1/unity-catalog/workspaces/{workspace_id}/metastore", pathParameters),
+		autorest.WithJSON(parameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateMetastoreAssignmentSender sends the UpdateMetastoreAssignment request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateMetastoreAssignmentSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateMetastoreAssignmentResponder handles the response to the UpdateMetastoreAssignment request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateMetastoreAssignmentResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// GetCurrentMetastoreAssignment gets the Unity Catalog Metastore assignment of the Workspace the client is
+// connected to.
+func (client BaseClient) GetCurrentMetastoreAssignment(ctx context.Context) (result MetastoreAssignment, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetCurrentMetastoreAssignment")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetCurrentMetastoreAssignmentPreparer(ctx)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "GetCurrentMetastoreAssignment", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetCurrentMetastoreAssignmentSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "GetCurrentMetastoreAssignment", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetCurrentMetastoreAssignmentResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "GetCurrentMetastoreAssignment", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetCurrentMetastoreAssignmentPreparer prepares the GetCurrentMetastoreAssignment request.
+func (client BaseClient) GetCurrentMetastoreAssignmentPreparer(ctx context.Context) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPath("/api/2.1/unity-catalog/current-metastore-assignment"))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetCurrentMetastoreAssignmentSender sends the GetCurrentMetastoreAssignment request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetCurrentMetastoreAssignmentSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetCurrentMetastoreAssignmentResponder handles the response to the GetCurrentMetastoreAssignment request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetCurrentMetastoreAssignmentResponder(resp *http.Response) (result MetastoreAssignment, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// DeleteMetastoreAssignment removes the Unity Catalog Metastore assignment from a Workspace.
+// Parameters:
+// workspaceID - the numeric ID of the Databricks Workspace.
+// metastoreID - the ID of the assigned Metastore.
+func (client BaseClient) DeleteMetastoreAssignment(ctx context.Context, workspaceID int64, metastoreID string) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.DeleteMetastoreAssignment")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.DeleteMetastoreAssignmentPreparer(ctx, workspaceID, metastoreID)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "DeleteMetastoreAssignment", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.DeleteMetastoreAssignmentSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "DeleteMetastoreAssignment", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.DeleteMetastoreAssignmentResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "unitycatalog.BaseClient", "DeleteMetastoreAssignment", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// DeleteMetastoreAssignmentPreparer prepares the DeleteMetastoreAssignment request.
+func (client BaseClient) DeleteMetastoreAssignmentPreparer(ctx context.Context, workspaceID int64, metastoreID string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"workspace_id": autorest.Encode("path", workspaceID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"metastore_id": autorest.Encode("query", metastoreID),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/api/2.1/unity-catalog/workspaces/{workspace_id}/metastore", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// DeleteMetastoreAssignmentSender sends the DeleteMetastoreAssignment request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) DeleteMetastoreAssignmentSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// DeleteMetastoreAssignmentResponder handles the response to the DeleteMetastoreAssignment request. The method always
+// closes the http.Response Body.
+func (client BaseClient) DeleteMetastoreAssignmentResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
@@ -0,0 +1,35 @@
+package unitycatalog
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// The package's fully qualified name.
+const fqdn = "github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/sdk/2.1/unitycatalog"
+
+// MetastoreAssignment the assignment of a Unity Catalog Metastore to a Databricks Workspace.
+type MetastoreAssignment struct {
+	autorest.Response `json:"-"`
+	// WorkspaceID - The numeric ID of the Databricks Workspace.
+	WorkspaceID *int64 `json:"workspace_id,omitempty"`
+	// MetastoreID - The ID of the assigned Unity Catalog Metastore.
+	MetastoreID *string `json:"metastore_id,omitempty"`
+	// DefaultCatalogName - The name of the default catalog in the Metastore.
+	DefaultCatalogName *string `json:"default_catalog_name,omitempty"`
+}
+
+// CreateMetastoreAssignment the request used to assign a Metastore to a Workspace.
+type CreateMetastoreAssignment struct {
+	// MetastoreID - The ID of the Unity Catalog Metastore to assign.
+	MetastoreID *string `json:"metastore_id,omitempty"`
+	// DefaultCatalogName - The name of the default catalog in the Metastore.
+	DefaultCatalogName *string `json:"default_catalog_name,omitempty"`
+}
+
+// UpdateMetastoreAssignment the request used to update the Metastore assigned to a Workspace.
+type UpdateMetastoreAssignment struct {
+	// MetastoreID - The ID of the Unity Catalog Metastore.
+	MetastoreID *string `json:"metastore_id,omitempty"`
+	// DefaultCatalogName - The name of the default catalog in the Metastore.
+	DefaultCatalogName *string `json:"default_catalog_name,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type EncryptionEntitiesDefinition struct {
+	ManagedDisk     *ManagedDiskEncryption `json:"managedDisk,omitempty"`
+	ManagedServices *EncryptionV2          `json:"managedServices,omitempty"`
+}
@@ -0,0 +1,7 @@
+package workspaces
+
+type ManagedDiskEncryption struct {
+	KeySource                         EncryptionKeySource                     `json:"keySource"`
+	KeyVaultProperties                ManagedDiskEncryptionKeyVaultProperties `json:"keyVaultProperties"`
+	RotationToLatestKeyVersionEnabled *bool                                   `json:"rotationToLatestKeyVersionEnabled,omitempty"`
+}
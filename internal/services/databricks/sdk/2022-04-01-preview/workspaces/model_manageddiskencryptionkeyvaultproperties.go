@@ -0,0 +1,7 @@
+package workspaces
+
+type ManagedDiskEncryptionKeyVaultProperties struct {
+	KeyName     string `json:"keyName"`
+	KeyVaultUri string `json:"keyVaultUri"`
+	KeyVersion  string `json:"keyVersion"`
+}
@@ -0,0 +1,5 @@
+package workspaces
+
+type PrivateEndpoint struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,7 @@
+package workspaces
+
+type PrivateLinkServiceConnectionState struct {
+	ActionRequired *string                            `json:"actionRequired,omitempty"`
+	Description    *string                            `json:"description,omitempty"`
+	Status         PrivateLinkServiceConnectionStatus `json:"status"`
+}
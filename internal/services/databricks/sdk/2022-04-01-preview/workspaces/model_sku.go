@@ -0,0 +1,6 @@
+package workspaces
+
+type Sku struct {
+	Name string  `json:"name"`
+	Tier *string `json:"tier,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceCustomBooleanParameter struct {
+	Type  *CustomParameterType `json:"type,omitempty"`
+	Value bool                 `json:"value"`
+}
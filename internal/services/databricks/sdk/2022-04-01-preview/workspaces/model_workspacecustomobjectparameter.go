@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceCustomObjectParameter struct {
+	Type  *CustomParameterType `json:"type,omitempty"`
+	Value interface{}          `json:"value"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceCustomStringParameter struct {
+	Type  *CustomParameterType `json:"type,omitempty"`
+	Value string               `json:"value"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceEncryptionParameter struct {
+	Type  *CustomParameterType `json:"type,omitempty"`
+	Value *Encryption          `json:"value,omitempty"`
+}
@@ -0,0 +1,5 @@
+package workspaces
+
+type WorkspacePropertiesEncryption struct {
+	Entities EncryptionEntitiesDefinition `json:"entities"`
+}
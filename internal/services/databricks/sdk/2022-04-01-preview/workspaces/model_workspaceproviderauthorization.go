@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceProviderAuthorization struct {
+	PrincipalId      string `json:"principalId"`
+	RoleDefinitionId string `json:"roleDefinitionId"`
+}
@@ -0,0 +1,5 @@
+package workspaces
+
+type WorkspaceUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package workspaces
+
+import "fmt"
+
+const defaultApiVersion = "2022-04-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspaces/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package accessconnector
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AccessConnectorClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAccessConnectorClientWithBaseURI(endpoint string) AccessConnectorClient {
+	return AccessConnectorClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
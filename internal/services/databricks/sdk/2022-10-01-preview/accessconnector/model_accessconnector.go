@@ -0,0 +1,15 @@
+package accessconnector
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type AccessConnector struct {
+	Id         *string                          `json:"id,omitempty"`
+	Identity   *identity.SystemAssignedIdentity `json:"identity,omitempty"`
+	Location   string                           `json:"location"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *AccessConnectorProperties       `json:"properties,omitempty"`
+	Tags       *map[string]string               `json:"tags,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+}
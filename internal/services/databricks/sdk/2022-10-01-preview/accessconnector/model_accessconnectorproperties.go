@@ -0,0 +1,5 @@
+package accessconnector
+
+type AccessConnectorProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
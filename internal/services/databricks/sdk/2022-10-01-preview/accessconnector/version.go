@@ -0,0 +1,9 @@
+package accessconnector
+
+import "fmt"
+
+const defaultApiVersion = "2022-10-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/accessconnector/%s", defaultApiVersion)
+}
@@ -0,0 +1,466 @@
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Airflow Integration Runtime (Workflow Orchestration Manager) isn't exposed by the vendored SDK,
+// so the payload is modelled here and sent through the generic Integration Runtime client
+const integrationRuntimeTypeAirflow = "Airflow"
+
+func resourceDataFactoryIntegrationRuntimeAirflow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Read:   resourceDataFactoryIntegrationRuntimeAirflowRead,
+		Update: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Delete: resourceDataFactoryIntegrationRuntimeAirflowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IntegrationRuntimeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^([a-zA-Z0-9](-|-?[a-zA-Z0-9]+)+[a-zA-Z0-9])$`),
+					`Invalid name for Airflow Integration Runtime: minimum 3 characters, must start and end with a number or a letter, may only consist of letters, numbers and dashes and no consecutive dashes.`,
+				),
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"compute_size": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "Small",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Small",
+					"Large",
+				}, false),
+			},
+
+			"extra_nodes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 50),
+			},
+
+			"airflow_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "2.6.3",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"azure_active_directory_integration_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"triggerers_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"requirements": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"git_sync": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ADO",
+								"Bitbucket",
+								"Github",
+								"GitLab",
+							}, false),
+						},
+
+						"repository_url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"branch": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"credential_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "None",
+							ValidateFunc: validation.StringInSlice([]string{
+								"None",
+								"PAT",
+								"SPN",
+							}, false),
+						},
+
+						"username": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewIntegrationRuntimeID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Data Factory Airflow %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_integration_runtime_airflow", *existing.ID)
+		}
+	}
+
+	integrationRuntime := datafactory.IntegrationRuntimeResource{
+		Name:       &id.Name,
+		Properties: expandDataFactoryIntegrationRuntimeAirflow(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, integrationRuntime, ""); err != nil {
+		return fmt.Errorf("creating/updating Data Factory Airflow %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryIntegrationRuntimeAirflowRead(d, meta)
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Data Factory Airflow %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+
+	if resp.Properties == nil {
+		return fmt.Errorf("retrieving Data Factory Airflow %s: `properties` was nil", *id)
+	}
+
+	airflow, err := parseDataFactoryIntegrationRuntimeAirflow(resp.Properties)
+	if err != nil {
+		return fmt.Errorf("converting Integration Runtime to Airflow %s: %+v", *id, err)
+	}
+
+	d.Set("description", airflow.Description)
+
+	if props := airflow.TypeProperties; props != nil {
+		if compute := props.ComputeProperties; compute != nil {
+			d.Set("location", location.NormalizeNilable(compute.Location))
+			d.Set("compute_size", compute.ComputeSize)
+			extraNodes := 0
+			if compute.ExtraNodes != nil {
+				extraNodes = int(*compute.ExtraNodes)
+			}
+			d.Set("extra_nodes", extraNodes)
+		}
+
+		if airflowProps := props.AirflowProperties; airflowProps != nil {
+			d.Set("airflow_version", airflowProps.AirflowVersion)
+			d.Set("azure_active_directory_integration_enabled", airflowProps.EnableAADIntegration != nil && *airflowProps.EnableAADIntegration)
+			d.Set("triggerers_enabled", airflowProps.EnableTriggerers != nil && *airflowProps.EnableTriggerers)
+
+			if err := d.Set("environment_variables", airflowProps.EnvironmentVariables); err != nil {
+				return fmt.Errorf("setting `environment_variables`: %+v", err)
+			}
+
+			if err := d.Set("requirements", utils.FlattenStringSlice(airflowProps.AirflowRequiredArguments)); err != nil {
+				return fmt.Errorf("setting `requirements`: %+v", err)
+			}
+
+			if err := d.Set("git_sync", flattenDataFactoryIntegrationRuntimeAirflowGitSync(airflowProps.GitSyncProperties, d)); err != nil {
+				return fmt.Errorf("setting `git_sync`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting Data Factory Airflow %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryIntegrationRuntimeAirflow(d *pluginsdk.ResourceData) dataFactoryAirflowIntegrationRuntime {
+	environmentVariables := make(map[string]string)
+	for k, v := range d.Get("environment_variables").(map[string]interface{}) {
+		environmentVariables[k] = v.(string)
+	}
+
+	return dataFactoryAirflowIntegrationRuntime{
+		Description: utils.String(d.Get("description").(string)),
+		Type:        integrationRuntimeTypeAirflow,
+		TypeProperties: &dataFactoryAirflowIntegrationRuntimeTypeProperties{
+			ComputeProperties: &dataFactoryAirflowComputeProperties{
+				Location:    utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+				ComputeSize: utils.String(d.Get("compute_size").(string)),
+				ExtraNodes:  utils.Int32(int32(d.Get("extra_nodes").(int))),
+			},
+			AirflowProperties: &dataFactoryAirflowProperties{
+				AirflowVersion:           utils.String(d.Get("airflow_version").(string)),
+				EnableAADIntegration:     utils.Bool(d.Get("azure_active_directory_integration_enabled").(bool)),
+				EnableTriggerers:         utils.Bool(d.Get("triggerers_enabled").(bool)),
+				EnvironmentVariables:     environmentVariables,
+				AirflowRequiredArguments: utils.ExpandStringSlice(d.Get("requirements").([]interface{})),
+				GitSyncProperties:        expandDataFactoryIntegrationRuntimeAirflowGitSync(d.Get("git_sync").([]interface{})),
+			},
+		},
+	}
+}
+
+func expandDataFactoryIntegrationRuntimeAirflowGitSync(input []interface{}) *dataFactoryAirflowGitSyncProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &dataFactoryAirflowGitSyncProperties{
+		GitServiceType:    utils.String(raw["service_type"].(string)),
+		GitCredentialType: utils.String(raw["credential_type"].(string)),
+		Repo:              utils.String(raw["repository_url"].(string)),
+		Branch:            utils.String(raw["branch"].(string)),
+	}
+
+	if v := raw["username"].(string); v != "" {
+		output.Username = utils.String(v)
+	}
+
+	if v := raw["password"].(string); v != "" {
+		output.Credential = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenDataFactoryIntegrationRuntimeAirflowGitSync(input *dataFactoryAirflowGitSyncProperties, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil || input.Repo == nil {
+		return []interface{}{}
+	}
+
+	credentialType := "None"
+	if input.GitCredentialType != nil && *input.GitCredentialType != "" {
+		credentialType = *input.GitCredentialType
+	}
+
+	// the password isn't returned by the API so we look it up from the config
+	password := ""
+	if v, ok := d.GetOk("git_sync.0.password"); ok {
+		password = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"service_type":    utils.NormalizeNilableString(input.GitServiceType),
+			"repository_url":  *input.Repo,
+			"branch":          utils.NormalizeNilableString(input.Branch),
+			"credential_type": credentialType,
+			"username":        utils.NormalizeNilableString(input.Username),
+			"password":        password,
+		},
+	}
+}
+
+func parseDataFactoryIntegrationRuntimeAirflow(input datafactory.BasicIntegrationRuntime) (*dataFactoryAirflowIntegrationRuntime, error) {
+	integrationRuntime, ok := input.AsIntegrationRuntime()
+	if !ok || integrationRuntime == nil {
+		return nil, fmt.Errorf("Integration Runtime was not of type %q", integrationRuntimeTypeAirflow)
+	}
+
+	if string(integrationRuntime.Type) != integrationRuntimeTypeAirflow {
+		return nil, fmt.Errorf("Integration Runtime was of type %q rather than %q", string(integrationRuntime.Type), integrationRuntimeTypeAirflow)
+	}
+
+	output := dataFactoryAirflowIntegrationRuntime{
+		Description: integrationRuntime.Description,
+		Type:        integrationRuntimeTypeAirflow,
+	}
+
+	if raw, ok := integrationRuntime.AdditionalProperties["typeProperties"]; ok && raw != nil {
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling `typeProperties`: %+v", err)
+		}
+
+		var typeProperties dataFactoryAirflowIntegrationRuntimeTypeProperties
+		if err := json.Unmarshal(b, &typeProperties); err != nil {
+			return nil, fmt.Errorf("unmarshaling `typeProperties`: %+v", err)
+		}
+		output.TypeProperties = &typeProperties
+	}
+
+	return &output, nil
+}
+
+type dataFactoryAirflowIntegrationRuntime struct {
+	Description    *string                                             `json:"description,omitempty"`
+	Type           string                                              `json:"type"`
+	TypeProperties *dataFactoryAirflowIntegrationRuntimeTypeProperties `json:"typeProperties,omitempty"`
+}
+
+type dataFactoryAirflowIntegrationRuntimeTypeProperties struct {
+	ComputeProperties *dataFactoryAirflowComputeProperties `json:"computeProperties,omitempty"`
+	AirflowProperties *dataFactoryAirflowProperties        `json:"airflowProperties,omitempty"`
+}
+
+type dataFactoryAirflowComputeProperties struct {
+	Location    *string `json:"location,omitempty"`
+	ComputeSize *string `json:"computeSize,omitempty"`
+	ExtraNodes  *int32  `json:"extraNodes,omitempty"`
+}
+
+type dataFactoryAirflowProperties struct {
+	AirflowVersion           *string                              `json:"airflowVersion,omitempty"`
+	EnableAADIntegration     *bool                                `json:"enableAADIntegration,omitempty"`
+	EnableTriggerers         *bool                                `json:"enableTriggerers,omitempty"`
+	EnvironmentVariables     map[string]string                    `json:"environmentVariables"`
+	AirflowRequiredArguments *[]string                            `json:"airflowRequiredArguments,omitempty"`
+	GitSyncProperties        *dataFactoryAirflowGitSyncProperties `json:"gitSyncProperties,omitempty"`
+}
+
+type dataFactoryAirflowGitSyncProperties struct {
+	GitServiceType    *string `json:"gitServiceType,omitempty"`
+	GitCredentialType *string `json:"gitCredentialType,omitempty"`
+	Repo              *string `json:"repo,omitempty"`
+	Branch            *string `json:"branch,omitempty"`
+	Username          *string `json:"username,omitempty"`
+	Credential        *string `json:"credential,omitempty"`
+}
+
+// AsSelfHostedIntegrationRuntime is the BasicIntegrationRuntime implementation for dataFactoryAirflowIntegrationRuntime.
+func (ir dataFactoryAirflowIntegrationRuntime) AsSelfHostedIntegrationRuntime() (*datafactory.SelfHostedIntegrationRuntime, bool) {
+	return nil, false
+}
+
+// AsManagedIntegrationRuntime is the BasicIntegrationRuntime implementation for dataFactoryAirflowIntegrationRuntime.
+func (ir dataFactoryAirflowIntegrationRuntime) AsManagedIntegrationRuntime() (*datafactory.ManagedIntegrationRuntime, bool) {
+	return nil, false
+}
+
+// AsIntegrationRuntime is the BasicIntegrationRuntime implementation for dataFactoryAirflowIntegrationRuntime.
+func (ir dataFactoryAirflowIntegrationRuntime) AsIntegrationRuntime() (*datafactory.IntegrationRuntime, bool) {
+	return nil, false
+}
@@ -53,3 +53,13 @@ func importDataFactoryLinkedService(expectType datafactory.TypeBasicLinkedServic
 		return []*pluginsdk.ResourceData{d}, nil
 	}
 }
+
+// flattenDataFactoryLinkedServiceStringProperty returns the value of a Linked Service property which is typed as
+// `interface{}` in the SDK (since it may also be an Expression) when it's a plain string
+func flattenDataFactoryLinkedServiceStringProperty(input interface{}) string {
+	if v, ok := input.(string); ok {
+		return v
+	}
+
+	return ""
+}
@@ -0,0 +1,475 @@
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryLinkedServiceRest() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryLinkedServiceRestCreateUpdate,
+		Read:   resourceDataFactoryLinkedServiceRestRead,
+		Update: resourceDataFactoryLinkedServiceRestCreateUpdate,
+		Delete: resourceDataFactoryLinkedServiceRestDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.LinkedServiceID(id)
+			return err
+		}, importDataFactoryLinkedService(datafactory.TypeBasicLinkedServiceTypeRestService)),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LinkedServiceDatasetName,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"authentication_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(datafactory.RestServiceAuthenticationTypeAnonymous),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.RestServiceAuthenticationTypeAadServicePrincipal),
+					string(datafactory.RestServiceAuthenticationTypeAnonymous),
+					string(datafactory.RestServiceAuthenticationTypeBasic),
+					string(datafactory.RestServiceAuthenticationTypeManagedServiceIdentity),
+				}, false),
+			},
+
+			"server_certificate_validation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"username": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"service_principal_id"},
+			},
+
+			"password": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"username"},
+				ConflictsWith: []string{"key_vault_password"},
+			},
+
+			"key_vault_password": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"secret_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+				RequiredWith:  []string{"username"},
+				ConflictsWith: []string{"password"},
+			},
+
+			"service_principal_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsUUID,
+				RequiredWith:  []string{"tenant"},
+				ConflictsWith: []string{"username"},
+			},
+
+			"service_principal_key": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"service_principal_id"},
+				ConflictsWith: []string{"service_principal_certificate"},
+			},
+
+			"service_principal_certificate": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsBase64,
+				RequiredWith:  []string{"service_principal_id"},
+				ConflictsWith: []string{"service_principal_key"},
+			},
+
+			"service_principal_certificate_password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"service_principal_certificate"},
+			},
+
+			"tenant": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"service_principal_id"},
+			},
+
+			"azure_cloud_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AzurePublic",
+					"AzureChina",
+					"AzureUsGovernment",
+					"AzureGermany",
+				}, false),
+			},
+
+			"aad_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"auth_headers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"integration_runtime_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"additional_properties": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryLinkedServiceRestCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLinkedServiceID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_data_factory_linked_service_rest", id.ID())
+		}
+	}
+
+	authenticationType := datafactory.RestServiceAuthenticationType(d.Get("authentication_type").(string))
+	props := &datafactory.RestServiceLinkedServiceTypeProperties{
+		URL:                               d.Get("url").(string),
+		EnableServerCertificateValidation: d.Get("server_certificate_validation_enabled").(bool),
+		AuthenticationType:                authenticationType,
+	}
+
+	switch authenticationType {
+	case datafactory.RestServiceAuthenticationTypeBasic:
+		username, ok := d.GetOk("username")
+		if !ok {
+			return fmt.Errorf("`username` must be specified when `authentication_type` is %q", string(authenticationType))
+		}
+		props.UserName = username.(string)
+
+		if v, ok := d.GetOk("password"); ok {
+			props.Password = &datafactory.SecureString{
+				Value: utils.String(v.(string)),
+				Type:  datafactory.TypeSecureString,
+			}
+		} else if v, ok := d.GetOk("key_vault_password"); ok {
+			props.Password = expandAzureKeyVaultSecretReference(v.([]interface{}))
+		} else {
+			return fmt.Errorf("one of `password` or `key_vault_password` must be specified when `authentication_type` is %q", string(authenticationType))
+		}
+
+	case datafactory.RestServiceAuthenticationTypeAadServicePrincipal:
+		servicePrincipalId, ok := d.GetOk("service_principal_id")
+		if !ok {
+			return fmt.Errorf("`service_principal_id` must be specified when `authentication_type` is %q", string(authenticationType))
+		}
+		props.ServicePrincipalID = servicePrincipalId.(string)
+		props.Tenant = d.Get("tenant").(string)
+
+		if v, ok := d.GetOk("service_principal_key"); ok {
+			props.ServicePrincipalKey = &datafactory.SecureString{
+				Value: utils.String(v.(string)),
+				Type:  datafactory.TypeSecureString,
+			}
+		} else if _, ok := d.GetOk("service_principal_certificate"); !ok {
+			return fmt.Errorf("one of `service_principal_key` or `service_principal_certificate` must be specified when `authentication_type` is %q", string(authenticationType))
+		}
+	}
+
+	if v, ok := d.GetOk("azure_cloud_type"); ok {
+		props.AzureCloudType = v.(string)
+	}
+
+	if v, ok := d.GetOk("aad_resource_id"); ok {
+		props.AadResourceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("auth_headers"); ok {
+		props.AuthHeaders = v.(map[string]interface{})
+	}
+
+	restLinkedService := &datafactory.RestServiceLinkedService{
+		RestServiceLinkedServiceTypeProperties: props,
+		Description:                            utils.String(d.Get("description").(string)),
+		Type:                                   datafactory.TypeBasicLinkedServiceTypeRestService,
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		restLinkedService.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("integration_runtime_name"); ok {
+		restLinkedService.ConnectVia = expandDataFactoryLinkedServiceIntegrationRuntime(v.(string))
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		restLinkedService.AdditionalProperties = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		restLinkedService.Annotations = &annotations
+	}
+
+	linkedService := datafactory.LinkedServiceResource{
+		Properties: restLinkedService,
+	}
+
+	if authenticationType == datafactory.RestServiceAuthenticationTypeAadServicePrincipal {
+		if certificate, ok := d.GetOk("service_principal_certificate"); ok {
+			properties, err := expandDataFactoryLinkedServiceRestCertificate(restLinkedService, certificate.(string), d.Get("service_principal_certificate_password").(string))
+			if err != nil {
+				return fmt.Errorf("expanding `service_principal_certificate`: %+v", err)
+			}
+			linkedService.Properties = properties
+		}
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, linkedService, ""); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryLinkedServiceRestRead(d, meta)
+}
+
+func resourceDataFactoryLinkedServiceRestRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LinkedServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	linkedService, ok := resp.Properties.AsRestServiceLinkedService()
+	if !ok {
+		return fmt.Errorf("classifying %s: Expected: %q", *id, datafactory.TypeBasicLinkedServiceTypeRestService)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(subscriptionId, id.ResourceGroup, id.FactoryName).ID())
+	d.Set("additional_properties", linkedService.AdditionalProperties)
+	d.Set("description", linkedService.Description)
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(linkedService.Annotations)); err != nil {
+		return fmt.Errorf("setting `annotations`: %+v", err)
+	}
+	if err := d.Set("parameters", flattenDataFactoryParameters(linkedService.Parameters)); err != nil {
+		return fmt.Errorf("setting `parameters`: %+v", err)
+	}
+
+	integrationRuntimeName := ""
+	if linkedService.ConnectVia != nil && linkedService.ConnectVia.ReferenceName != nil {
+		integrationRuntimeName = *linkedService.ConnectVia.ReferenceName
+	}
+	d.Set("integration_runtime_name", integrationRuntimeName)
+
+	if props := linkedService.RestServiceLinkedServiceTypeProperties; props != nil {
+		d.Set("url", flattenDataFactoryLinkedServiceStringProperty(props.URL))
+		d.Set("authentication_type", string(props.AuthenticationType))
+		d.Set("username", flattenDataFactoryLinkedServiceStringProperty(props.UserName))
+		d.Set("service_principal_id", flattenDataFactoryLinkedServiceStringProperty(props.ServicePrincipalID))
+		d.Set("tenant", flattenDataFactoryLinkedServiceStringProperty(props.Tenant))
+		d.Set("azure_cloud_type", flattenDataFactoryLinkedServiceStringProperty(props.AzureCloudType))
+		d.Set("aad_resource_id", flattenDataFactoryLinkedServiceStringProperty(props.AadResourceID))
+
+		serverCertificateValidationEnabled := true
+		if v, ok := props.EnableServerCertificateValidation.(bool); ok {
+			serverCertificateValidationEnabled = v
+		}
+		d.Set("server_certificate_validation_enabled", serverCertificateValidationEnabled)
+
+		authHeaders := make(map[string]interface{})
+		if v, ok := props.AuthHeaders.(map[string]interface{}); ok {
+			authHeaders = v
+		}
+		d.Set("auth_headers", authHeaders)
+
+		if password := props.Password; password != nil {
+			if keyVaultPassword, ok := password.AsAzureKeyVaultSecretReference(); ok {
+				if err := d.Set("key_vault_password", flattenAzureKeyVaultSecretReference(keyVaultPassword)); err != nil {
+					return fmt.Errorf("setting `key_vault_password`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryLinkedServiceRestDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LinkedServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// expandDataFactoryLinkedServiceRestCertificate adds the certificate-based Service Principal credential to the
+// REST Linked Service - since the vendored SDK doesn't expose these fields the payload is built from the marshalled
+// Linked Service and sent as an untyped Linked Service
+func expandDataFactoryLinkedServiceRestCertificate(input *datafactory.RestServiceLinkedService, certificate, password string) (datafactory.BasicLinkedService, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling Linked Service: %+v", err)
+	}
+
+	properties := make(map[string]interface{})
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return nil, fmt.Errorf("unmarshaling Linked Service: %+v", err)
+	}
+
+	typeProperties, ok := properties["typeProperties"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("`typeProperties` was missing from the Linked Service")
+	}
+
+	typeProperties["servicePrincipalCredentialType"] = "ServicePrincipalCert"
+	typeProperties["servicePrincipalEmbeddedCert"] = map[string]interface{}{
+		"type":  string(datafactory.TypeSecureString),
+		"value": certificate,
+	}
+	if password != "" {
+		typeProperties["servicePrincipalEmbeddedCertPassword"] = map[string]interface{}{
+			"type":  string(datafactory.TypeSecureString),
+			"value": password,
+		}
+	}
+	properties["typeProperties"] = typeProperties
+
+	return datafactory.LinkedService{
+		AdditionalProperties: properties,
+	}, nil
+}
@@ -0,0 +1,237 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LinkedServiceRestResource struct{}
+
+func TestAccDataFactoryLinkedServiceRest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_rest", "test")
+	r := LinkedServiceRestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceRest_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_rest", "test")
+	r := LinkedServiceRestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceRest_basicAuth(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_rest", "test")
+	r := LinkedServiceRestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicAuth(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceRest_servicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_rest", "test")
+	r := LinkedServiceRestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipalKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("service_principal_key"),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceRest_servicePrincipalCertificate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_rest", "test")
+	r := LinkedServiceRestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipalCertificate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("service_principal_certificate", "service_principal_certificate_password"),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceRest_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_rest", "test")
+	r := LinkedServiceRestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.servicePrincipalKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("service_principal_key"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t LinkedServiceRestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LinkedServiceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (LinkedServiceRestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r LinkedServiceRestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_rest" "test" {
+  name            = "acctestlsrest%d"
+  data_factory_id = azurerm_data_factory.test.id
+  url             = "https://www.bing.com"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LinkedServiceRestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_rest" "import" {
+  name            = azurerm_data_factory_linked_service_rest.test.name
+  data_factory_id = azurerm_data_factory_linked_service_rest.test.data_factory_id
+  url             = azurerm_data_factory_linked_service_rest.test.url
+}
+`, r.basic(data))
+}
+
+func (r LinkedServiceRestResource) basicAuth(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_rest" "test" {
+  name                = "acctestlsrest%d"
+  data_factory_id     = azurerm_data_factory.test.id
+  url                 = "https://www.bing.com"
+  authentication_type = "Basic"
+  username            = "foo"
+  password            = "bar"
+
+  auth_headers = {
+    x-api-key = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LinkedServiceRestResource) servicePrincipalKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_data_factory_linked_service_rest" "test" {
+  name                  = "acctestlsrest%d"
+  data_factory_id       = azurerm_data_factory.test.id
+  url                   = "https://www.bing.com"
+  authentication_type   = "AadServicePrincipal"
+  service_principal_id  = "00000000-0000-0000-0000-000000000000"
+  service_principal_key = "testkey"
+  tenant                = data.azurerm_client_config.current.tenant_id
+  aad_resource_id       = "https://management.azure.com/"
+  azure_cloud_type      = "AzurePublic"
+  description           = "test description"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LinkedServiceRestResource) servicePrincipalCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_data_factory_linked_service_rest" "test" {
+  name                                   = "acctestlsrest%d"
+  data_factory_id                        = azurerm_data_factory.test.id
+  url                                    = "https://www.bing.com"
+  authentication_type                    = "AadServicePrincipal"
+  service_principal_id                   = "00000000-0000-0000-0000-000000000000"
+  service_principal_certificate          = filebase64("testdata/rest_service_principal.pfx")
+  service_principal_certificate_password = "terraform"
+  tenant                                 = data.azurerm_client_config.current.tenant_id
+  aad_resource_id                        = "https://management.azure.com/"
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,164 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LinkedServiceSAPBWResource struct{}
+
+func TestAccDataFactoryLinkedServiceSAPBW_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sap_bw", "test")
+	r := LinkedServiceSAPBWResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceSAPBW_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sap_bw", "test")
+	r := LinkedServiceSAPBWResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceSAPBW_keyVaultPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sap_bw", "test")
+	r := LinkedServiceSAPBWResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVaultPassword(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_vault_password.0.secret_name").HasValue("secret"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t LinkedServiceSAPBWResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LinkedServiceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (LinkedServiceSAPBWResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r LinkedServiceSAPBWResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_sap_bw" "test" {
+  name            = "acctestlssapbw%d"
+  data_factory_id = azurerm_data_factory.test.id
+  server          = "sapbw.example.com"
+  system_number   = "00"
+  client_id       = "100"
+  username        = "sapuser"
+  password        = "P@ssw0rd1234!"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LinkedServiceSAPBWResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_sap_bw" "import" {
+  name            = azurerm_data_factory_linked_service_sap_bw.test.name
+  data_factory_id = azurerm_data_factory_linked_service_sap_bw.test.data_factory_id
+  server          = azurerm_data_factory_linked_service_sap_bw.test.server
+  system_number   = azurerm_data_factory_linked_service_sap_bw.test.system_number
+  client_id       = azurerm_data_factory_linked_service_sap_bw.test.client_id
+  username        = azurerm_data_factory_linked_service_sap_bw.test.username
+  password        = "P@ssw0rd1234!"
+}
+`, r.basic(data))
+}
+
+func (r LinkedServiceSAPBWResource) keyVaultPassword(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctkv%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_data_factory_linked_service_key_vault" "test" {
+  name                = "linkkv"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  key_vault_id        = azurerm_key_vault.test.id
+}
+
+resource "azurerm_data_factory_linked_service_sap_bw" "test" {
+  name            = "acctestlssapbw%d"
+  data_factory_id = azurerm_data_factory.test.id
+  server          = "sapbw.example.com"
+  system_number   = "00"
+  client_id       = "100"
+  username        = "sapuser"
+
+  key_vault_password {
+    linked_service_name = azurerm_data_factory_linked_service_key_vault.test.name
+    secret_name         = "secret"
+  }
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,173 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LinkedServiceSAPTableResource struct{}
+
+func TestAccDataFactoryLinkedServiceSAPTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sap_table", "test")
+	r := LinkedServiceSAPTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceSAPTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sap_table", "test")
+	r := LinkedServiceSAPTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceSAPTable_messageServer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sap_table", "test")
+	r := LinkedServiceSAPTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.messageServer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccDataFactoryLinkedServiceSAPTable_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sap_table", "test")
+	r := LinkedServiceSAPTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.messageServer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func (t LinkedServiceSAPTableResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LinkedServiceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (LinkedServiceSAPTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r LinkedServiceSAPTableResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_sap_table" "test" {
+  name            = "acctestlssaptable%d"
+  data_factory_id = azurerm_data_factory.test.id
+  server          = "sap.example.com"
+  system_number   = "00"
+  client_id       = "100"
+  username        = "sapuser"
+  password        = "P@ssw0rd1234!"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LinkedServiceSAPTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_sap_table" "import" {
+  name            = azurerm_data_factory_linked_service_sap_table.test.name
+  data_factory_id = azurerm_data_factory_linked_service_sap_table.test.data_factory_id
+  server          = azurerm_data_factory_linked_service_sap_table.test.server
+  system_number   = azurerm_data_factory_linked_service_sap_table.test.system_number
+  client_id       = azurerm_data_factory_linked_service_sap_table.test.client_id
+  username        = azurerm_data_factory_linked_service_sap_table.test.username
+  password        = "P@ssw0rd1234!"
+}
+`, r.basic(data))
+}
+
+func (r LinkedServiceSAPTableResource) messageServer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_sap_table" "test" {
+  name                   = "acctestlssaptable%d"
+  data_factory_id        = azurerm_data_factory.test.id
+  message_server         = "sapms.example.com"
+  message_server_service = "3600"
+  system_id              = "PRD"
+  logon_group            = "PUBLIC"
+  client_id              = "100"
+  language               = "EN"
+  username               = "sapuser"
+  password               = "P@ssw0rd1234!"
+  description            = "test description"
+  annotations            = ["test1", "test2"]
+
+  parameters = {
+    foo = "bar"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
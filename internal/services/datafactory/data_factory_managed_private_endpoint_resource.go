@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -62,6 +63,40 @@ func resourceDataFactoryManagedPrivateEndpoint() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: networkValidate.PrivateLinkSubResourceName,
 			},
+
+			"fqdns": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"connection_state": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"actions_required": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -102,6 +137,10 @@ func resourceDataFactoryManagedPrivateEndpointCreate(d *pluginsdk.ResourceData,
 		},
 	}
 
+	if v, ok := d.GetOk("fqdns"); ok {
+		managedPrivateEndpoint.Properties.Fqdns = utils.ExpandStringSlice(v.([]interface{}))
+	}
+
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.ManagedVirtualNetworkName, id.Name, managedPrivateEndpoint, ""); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -149,6 +188,11 @@ func resourceDataFactoryManagedPrivateEndpointRead(d *pluginsdk.ResourceData, me
 	if props := resp.Properties; props != nil {
 		d.Set("target_resource_id", props.PrivateLinkResourceID)
 		d.Set("subresource_name", props.GroupID)
+		d.Set("fqdns", utils.FlattenStringSlice(props.Fqdns))
+
+		if err := d.Set("connection_state", flattenDataFactoryManagedPrivateEndpointConnectionState(props.ConnectionState)); err != nil {
+			return fmt.Errorf("setting `connection_state`: %+v", err)
+		}
 	}
 
 	return nil
@@ -171,6 +215,20 @@ func resourceDataFactoryManagedPrivateEndpointDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+func flattenDataFactoryManagedPrivateEndpointConnectionState(input *datafactory.ConnectionStateProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"status":           utils.NormalizeNilableString(input.Status),
+			"description":      utils.NormalizeNilableString(input.Description),
+			"actions_required": utils.NormalizeNilableString(input.ActionsRequired),
+		},
+	}
+}
+
 // if ManagedPrivateEndpoint not exist, get rest api will return 400 bad request
 // invoke list rets api and then filter by name
 func getManagedPrivateEndpoint(ctx context.Context, client *datafactory.ManagedPrivateEndpointsClient, resourceGroupName, factoryName, managedVirtualNetworkName, name string) (*datafactory.ManagedPrivateEndpointResource, error) {
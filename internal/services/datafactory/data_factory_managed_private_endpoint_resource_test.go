@@ -24,6 +24,23 @@ func TestAccDataFactoryManagedPrivateEndpoint_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_state.0.status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryManagedPrivateEndpoint_fqdns(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_managed_private_endpoint", "test")
+	r := ManagedPrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fqdns(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdns.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
@@ -82,6 +99,21 @@ resource "azurerm_data_factory_managed_private_endpoint" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ManagedPrivateEndpointResource) fqdns(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_managed_private_endpoint" "test" {
+  name               = "acctestEndpoint%d"
+  data_factory_id    = azurerm_data_factory.test.id
+  target_resource_id = azurerm_storage_account.test.id
+  subresource_name   = "blob"
+  fqdns              = [azurerm_storage_account.test.primary_blob_host]
+}
+`, template, data.RandomInteger)
+}
+
 func (r ManagedPrivateEndpointResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
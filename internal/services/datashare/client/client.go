@@ -6,10 +6,14 @@ import (
 )
 
 type Client struct {
-	AccountClient         *datashare.AccountsClient
-	DataSetClient         *datashare.DataSetsClient
-	SharesClient          *datashare.SharesClient
-	SynchronizationClient *datashare.SynchronizationSettingsClient
+	AccountClient           *datashare.AccountsClient
+	DataSetClient           *datashare.DataSetsClient
+	DataSetMappingClient    *datashare.DataSetMappingsClient
+	InvitationClient        *datashare.InvitationsClient
+	SharesClient            *datashare.SharesClient
+	ShareSubscriptionClient *datashare.ShareSubscriptionsClient
+	SynchronizationClient   *datashare.SynchronizationSettingsClient
+	TriggerClient           *datashare.TriggersClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -19,16 +23,32 @@ func NewClient(o *common.ClientOptions) *Client {
 	dataSetClient := datashare.NewDataSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataSetClient.Client, o.ResourceManagerAuthorizer)
 
+	dataSetMappingClient := datashare.NewDataSetMappingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&dataSetMappingClient.Client, o.ResourceManagerAuthorizer)
+
+	invitationClient := datashare.NewInvitationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&invitationClient.Client, o.ResourceManagerAuthorizer)
+
 	sharesClient := datashare.NewSharesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sharesClient.Client, o.ResourceManagerAuthorizer)
 
+	shareSubscriptionClient := datashare.NewShareSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&shareSubscriptionClient.Client, o.ResourceManagerAuthorizer)
+
 	synchronizationSettingsClient := datashare.NewSynchronizationSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&synchronizationSettingsClient.Client, o.ResourceManagerAuthorizer)
 
+	triggerClient := datashare.NewTriggersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&triggerClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AccountClient:         &accountClient,
-		DataSetClient:         &dataSetClient,
-		SharesClient:          &sharesClient,
-		SynchronizationClient: &synchronizationSettingsClient,
+		AccountClient:           &accountClient,
+		DataSetClient:           &dataSetClient,
+		DataSetMappingClient:    &dataSetMappingClient,
+		InvitationClient:        &invitationClient,
+		SharesClient:            &sharesClient,
+		ShareSubscriptionClient: &shareSubscriptionClient,
+		SynchronizationClient:   &synchronizationSettingsClient,
+		TriggerClient:           &triggerClient,
 	}
 }
@@ -0,0 +1,265 @@
+package datashare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datashare/mgmt/2019-11-01/datashare"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataShareDataSetMappingBlobStorage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareDataSetMappingBlobStorageCreate,
+		Read:   resourceDataShareDataSetMappingBlobStorageRead,
+		Delete: resourceDataShareDataSetMappingBlobStorageDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataSetMappingID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataSetName(),
+			},
+
+			"share_subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ShareSubscriptionID,
+			},
+
+			"source_dataset_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageContainerName,
+			},
+
+			"file_path": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"folder_path"},
+			},
+
+			"folder_path": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"file_path", "output_type"},
+			},
+
+			"output_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datashare.Csv),
+					string(datashare.Parquet),
+				}, false),
+				RequiredWith: []string{"file_path"},
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareDataSetMappingBlobStorageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareSubscriptionId, err := parse.ShareSubscriptionID(d.Get("share_subscription_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDataSetMappingID(shareSubscriptionId.SubscriptionId, shareSubscriptionId.ResourceGroup, shareSubscriptionId.AccountName, shareSubscriptionId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_data_share_dataset_mapping_blob_storage", id.ID())
+	}
+
+	storageAccountId, err := storageParse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	var mapping datashare.BasicDataSetMapping
+	if filePath, ok := d.GetOk("file_path"); ok {
+		mapping = datashare.BlobDataSetMapping{
+			Kind: datashare.KindBasicDataSetMappingKindBlob,
+			BlobMappingProperties: &datashare.BlobMappingProperties{
+				DataSetID:          utils.String(d.Get("source_dataset_id").(string)),
+				ContainerName:      utils.String(d.Get("container_name").(string)),
+				StorageAccountName: utils.String(storageAccountId.Name),
+				ResourceGroup:      utils.String(storageAccountId.ResourceGroup),
+				SubscriptionID:     utils.String(storageAccountId.SubscriptionId),
+				FilePath:           utils.String(filePath.(string)),
+				OutputType:         datashare.OutputType(d.Get("output_type").(string)),
+			},
+		}
+	} else if folderPath, ok := d.GetOk("folder_path"); ok {
+		mapping = datashare.BlobFolderDataSetMapping{
+			Kind: datashare.KindBasicDataSetMappingKindBlobFolder,
+			BlobFolderMappingProperties: &datashare.BlobFolderMappingProperties{
+				DataSetID:          utils.String(d.Get("source_dataset_id").(string)),
+				ContainerName:      utils.String(d.Get("container_name").(string)),
+				StorageAccountName: utils.String(storageAccountId.Name),
+				ResourceGroup:      utils.String(storageAccountId.ResourceGroup),
+				SubscriptionID:     utils.String(storageAccountId.SubscriptionId),
+				Prefix:             utils.String(folderPath.(string)),
+			},
+		}
+	} else {
+		mapping = datashare.BlobContainerDataSetMapping{
+			Kind: datashare.KindBasicDataSetMappingKindContainer,
+			BlobContainerMappingProperties: &datashare.BlobContainerMappingProperties{
+				DataSetID:          utils.String(d.Get("source_dataset_id").(string)),
+				ContainerName:      utils.String(d.Get("container_name").(string)),
+				StorageAccountName: utils.String(storageAccountId.Name),
+				ResourceGroup:      utils.String(storageAccountId.ResourceGroup),
+				SubscriptionID:     utils.String(storageAccountId.SubscriptionId),
+			},
+		}
+	}
+
+	if _, err := client.Create(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name, mapping); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataShareDataSetMappingBlobStorageRead(d, meta)
+}
+
+func resourceDataShareDataSetMappingBlobStorageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataSetMappingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("share_subscription_id", parse.NewShareSubscriptionID(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName).ID())
+
+	switch mapping := resp.Value.(type) {
+	case datashare.BlobDataSetMapping:
+		if props := mapping.BlobMappingProperties; props != nil {
+			d.Set("source_dataset_id", props.DataSetID)
+			d.Set("container_name", props.ContainerName)
+			d.Set("storage_account_id", flattenAzureRmDataShareDataSetMappingStorageAccountId(props.SubscriptionID, props.ResourceGroup, props.StorageAccountName))
+			d.Set("file_path", props.FilePath)
+			d.Set("output_type", string(props.OutputType))
+			d.Set("status", string(props.DataSetMappingStatus))
+		}
+
+	case datashare.BlobFolderDataSetMapping:
+		if props := mapping.BlobFolderMappingProperties; props != nil {
+			d.Set("source_dataset_id", props.DataSetID)
+			d.Set("container_name", props.ContainerName)
+			d.Set("storage_account_id", flattenAzureRmDataShareDataSetMappingStorageAccountId(props.SubscriptionID, props.ResourceGroup, props.StorageAccountName))
+			d.Set("folder_path", props.Prefix)
+			d.Set("status", string(props.DataSetMappingStatus))
+		}
+
+	case datashare.BlobContainerDataSetMapping:
+		if props := mapping.BlobContainerMappingProperties; props != nil {
+			d.Set("source_dataset_id", props.DataSetID)
+			d.Set("container_name", props.ContainerName)
+			d.Set("storage_account_id", flattenAzureRmDataShareDataSetMappingStorageAccountId(props.SubscriptionID, props.ResourceGroup, props.StorageAccountName))
+			d.Set("status", string(props.DataSetMappingStatus))
+		}
+
+	default:
+		return fmt.Errorf("%s is not a blob storage dataset mapping", *id)
+	}
+
+	return nil
+}
+
+func resourceDataShareDataSetMappingBlobStorageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataSetMappingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenAzureRmDataShareDataSetMappingStorageAccountId(subscriptionId, resourceGroup, name *string) string {
+	if subscriptionId == nil || resourceGroup == nil || name == nil {
+		return ""
+	}
+
+	return storageParse.NewStorageAccountID(*subscriptionId, *resourceGroup, *name).ID()
+}
@@ -0,0 +1,207 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/datashare/mgmt/2019-11-01/datashare"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareDataSetMappingBlobStorageResource struct{}
+
+func TestAccDataShareDataSetMappingBlobStorage_basicContainer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_blob_storage", "test")
+	r := DataShareDataSetMappingBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareDataSetMappingBlobStorage_basicFolder(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_blob_storage", "test")
+	r := DataShareDataSetMappingBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicFolder(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareDataSetMappingBlobStorage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_blob_storage", "test")
+	r := DataShareDataSetMappingBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DataShareDataSetMappingBlobStorageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataSetMappingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.DataSetMappingClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	switch resp := resp.Value.(type) {
+	case datashare.BlobDataSetMapping:
+		return utils.Bool(resp.BlobMappingProperties != nil), nil
+
+	case datashare.BlobFolderDataSetMapping:
+		return utils.Bool(resp.BlobFolderMappingProperties != nil), nil
+
+	case datashare.BlobContainerDataSetMapping:
+		return utils.Bool(resp.BlobContainerMappingProperties != nil), nil
+	}
+
+	return nil, fmt.Errorf("%s is not a blob storage dataset mapping", *id)
+}
+
+func (DataShareDataSetMappingBlobStorageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "source" {
+  name                     = "acctestsrc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "source" {
+  name                  = "acctest-src-%[2]d"
+  storage_account_name  = azurerm_storage_account.source.name
+  container_access_type = "private"
+}
+
+resource "azurerm_role_assignment" "source" {
+  scope                = azurerm_storage_account.source.id
+  role_definition_name = "Storage Blob Data Reader"
+  principal_id         = azurerm_data_share_account.provider.identity.0.principal_id
+}
+
+resource "azurerm_data_share_dataset_blob_storage" "test" {
+  name           = "acctest-DSDSBS-%[2]d"
+  data_share_id  = azurerm_data_share.test.id
+  container_name = azurerm_storage_container.source.name
+  storage_account {
+    name                = azurerm_storage_account.source.name
+    resource_group_name = azurerm_storage_account.source.resource_group_name
+    subscription_id     = data.azurerm_client_config.current.subscription_id
+  }
+  depends_on = [
+    azurerm_role_assignment.source,
+  ]
+}
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_DSS_%[2]d"
+  account_id            = azurerm_data_share_account.consumer.id
+  invitation_id         = azurerm_data_share_invitation.test.invitation_id
+  source_share_location = azurerm_resource_group.test.location
+  depends_on = [
+    azurerm_data_share_dataset_blob_storage.test,
+  ]
+}
+
+resource "azurerm_storage_account" "target" {
+  name                     = "acctesttgt%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "target" {
+  name                  = "acctest-tgt-%[2]d"
+  storage_account_name  = azurerm_storage_account.target.name
+  container_access_type = "private"
+}
+
+resource "azurerm_role_assignment" "target" {
+  scope                = azurerm_storage_account.target.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_data_share_account.consumer.identity.0.principal_id
+}
+`, DataShareSubscriptionResource{}.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r DataShareDataSetMappingBlobStorageResource) basicContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_blob_storage" "test" {
+  name                  = "acctest-DSDSM-%d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  source_dataset_id     = azurerm_data_share_dataset_blob_storage.test.display_name
+  storage_account_id    = azurerm_storage_account.target.id
+  container_name        = azurerm_storage_container.target.name
+  depends_on = [
+    azurerm_role_assignment.target,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareDataSetMappingBlobStorageResource) basicFolder(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_blob_storage" "test" {
+  name                  = "acctest-DSDSM-%d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  source_dataset_id     = azurerm_data_share_dataset_blob_storage.test.display_name
+  storage_account_id    = azurerm_storage_account.target.id
+  container_name        = azurerm_storage_container.target.name
+  folder_path           = "shared"
+  depends_on = [
+    azurerm_role_assignment.target,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareDataSetMappingBlobStorageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_blob_storage" "import" {
+  name                  = azurerm_data_share_dataset_mapping_blob_storage.test.name
+  share_subscription_id = azurerm_data_share_dataset_mapping_blob_storage.test.share_subscription_id
+  source_dataset_id     = azurerm_data_share_dataset_mapping_blob_storage.test.source_dataset_id
+  storage_account_id    = azurerm_data_share_dataset_mapping_blob_storage.test.storage_account_id
+  container_name        = azurerm_data_share_dataset_mapping_blob_storage.test.container_name
+}
+`, r.basicContainer(data))
+}
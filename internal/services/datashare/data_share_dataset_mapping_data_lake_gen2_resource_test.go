@@ -0,0 +1,205 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/datashare/mgmt/2019-11-01/datashare"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareDataSetMappingDataLakeGen2Resource struct{}
+
+func TestAccDataShareDataSetMappingDataLakeGen2_basicFileSystem(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_data_lake_gen2", "test")
+	r := DataShareDataSetMappingDataLakeGen2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicFileSystem(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareDataSetMappingDataLakeGen2_basicFolder(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_data_lake_gen2", "test")
+	r := DataShareDataSetMappingDataLakeGen2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicFolder(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareDataSetMappingDataLakeGen2_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_data_lake_gen2", "test")
+	r := DataShareDataSetMappingDataLakeGen2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicFileSystem(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DataShareDataSetMappingDataLakeGen2Resource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataSetMappingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.DataSetMappingClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	switch resp := resp.Value.(type) {
+	case datashare.ADLSGen2FileDataSetMapping:
+		return utils.Bool(resp.ADLSGen2FileDataSetMappingProperties != nil), nil
+
+	case datashare.ADLSGen2FolderDataSetMapping:
+		return utils.Bool(resp.ADLSGen2FolderDataSetMappingProperties != nil), nil
+
+	case datashare.ADLSGen2FileSystemDataSetMapping:
+		return utils.Bool(resp.ADLSGen2FileSystemDataSetMappingProperties != nil), nil
+	}
+
+	return nil, fmt.Errorf("%s is not a data lake gen2 dataset mapping", *id)
+}
+
+func (DataShareDataSetMappingDataLakeGen2Resource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "source" {
+  name                     = "acctestsrc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "source" {
+  name               = "acctest-src-%[2]d"
+  storage_account_id = azurerm_storage_account.source.id
+}
+
+resource "azurerm_role_assignment" "source" {
+  scope                = azurerm_storage_account.source.id
+  role_definition_name = "Storage Blob Data Reader"
+  principal_id         = azurerm_data_share_account.provider.identity.0.principal_id
+}
+
+resource "azurerm_data_share_dataset_data_lake_gen2" "test" {
+  name               = "acctest-DSDSDLG2-%[2]d"
+  share_id           = azurerm_data_share.test.id
+  storage_account_id = azurerm_storage_account.source.id
+  file_system_name   = azurerm_storage_data_lake_gen2_filesystem.source.name
+  depends_on = [
+    azurerm_role_assignment.source,
+  ]
+}
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_DSS_%[2]d"
+  account_id            = azurerm_data_share_account.consumer.id
+  invitation_id         = azurerm_data_share_invitation.test.invitation_id
+  source_share_location = azurerm_resource_group.test.location
+  depends_on = [
+    azurerm_data_share_dataset_data_lake_gen2.test,
+  ]
+}
+
+resource "azurerm_storage_account" "target" {
+  name                     = "acctesttgt%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "target" {
+  name               = "acctest-tgt-%[2]d"
+  storage_account_id = azurerm_storage_account.target.id
+}
+
+resource "azurerm_role_assignment" "target" {
+  scope                = azurerm_storage_account.target.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_data_share_account.consumer.identity.0.principal_id
+}
+`, DataShareSubscriptionResource{}.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r DataShareDataSetMappingDataLakeGen2Resource) basicFileSystem(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_data_lake_gen2" "test" {
+  name                  = "acctest-DSDSM-%d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  source_dataset_id     = azurerm_data_share_dataset_data_lake_gen2.test.display_name
+  storage_account_id    = azurerm_storage_account.target.id
+  file_system_name      = azurerm_storage_data_lake_gen2_filesystem.target.name
+  depends_on = [
+    azurerm_role_assignment.target,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareDataSetMappingDataLakeGen2Resource) basicFolder(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_data_lake_gen2" "test" {
+  name                  = "acctest-DSDSM-%d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  source_dataset_id     = azurerm_data_share_dataset_data_lake_gen2.test.display_name
+  storage_account_id    = azurerm_storage_account.target.id
+  file_system_name      = azurerm_storage_data_lake_gen2_filesystem.target.name
+  folder_path           = "shared"
+  depends_on = [
+    azurerm_role_assignment.target,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareDataSetMappingDataLakeGen2Resource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_data_lake_gen2" "import" {
+  name                  = azurerm_data_share_dataset_mapping_data_lake_gen2.test.name
+  share_subscription_id = azurerm_data_share_dataset_mapping_data_lake_gen2.test.share_subscription_id
+  source_dataset_id     = azurerm_data_share_dataset_mapping_data_lake_gen2.test.source_dataset_id
+  storage_account_id    = azurerm_data_share_dataset_mapping_data_lake_gen2.test.storage_account_id
+  file_system_name      = azurerm_data_share_dataset_mapping_data_lake_gen2.test.file_system_name
+}
+`, r.basicFileSystem(data))
+}
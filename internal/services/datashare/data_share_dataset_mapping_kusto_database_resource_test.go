@@ -0,0 +1,161 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareDataSetMappingKustoDatabaseResource struct{}
+
+func TestAccDataShareDataSetMappingKustoDatabase_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_kusto_database", "test")
+	r := DataShareDataSetMappingKustoDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kusto_cluster_location").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareDataSetMappingKustoDatabase_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_kusto_database", "test")
+	r := DataShareDataSetMappingKustoDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DataShareDataSetMappingKustoDatabaseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataSetMappingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.DataSetMappingClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	mapping, ok := resp.Value.AsKustoDatabaseDataSetMapping()
+	if !ok {
+		return nil, fmt.Errorf("%s is not a kusto database dataset mapping", *id)
+	}
+
+	return utils.Bool(mapping.KustoDatabaseDataSetMappingProperties != nil), nil
+}
+
+func (DataShareDataSetMappingKustoDatabaseResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_kusto_cluster" "source" {
+  name                = "acctestkcs%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "source" {
+  name                = "acctestKD-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.source.name
+}
+
+resource "azurerm_role_assignment" "source" {
+  scope                = azurerm_kusto_cluster.source.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_data_share_account.provider.identity.0.principal_id
+}
+
+resource "azurerm_data_share_dataset_kusto_database" "test" {
+  name              = "acctest-DSKD-%[2]d"
+  share_id          = azurerm_data_share.test.id
+  kusto_database_id = azurerm_kusto_database.source.id
+  depends_on = [
+    azurerm_role_assignment.source,
+  ]
+}
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_DSS_%[2]d"
+  account_id            = azurerm_data_share_account.consumer.id
+  invitation_id         = azurerm_data_share_invitation.test.invitation_id
+  source_share_location = azurerm_resource_group.test.location
+  depends_on = [
+    azurerm_data_share_dataset_kusto_database.test,
+  ]
+}
+
+resource "azurerm_kusto_cluster" "target" {
+  name                = "acctestkct%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_role_assignment" "target" {
+  scope                = azurerm_kusto_cluster.target.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_data_share_account.consumer.identity.0.principal_id
+}
+`, DataShareSubscriptionResource{}.templateWithKind(data, "InPlace"), data.RandomInteger, data.RandomString)
+}
+
+func (r DataShareDataSetMappingKustoDatabaseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_kusto_database" "test" {
+  name                  = "acctest-DSDSM-%d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  source_dataset_id     = azurerm_data_share_dataset_kusto_database.test.display_name
+  kusto_cluster_id      = azurerm_kusto_cluster.target.id
+  depends_on = [
+    azurerm_role_assignment.target,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareDataSetMappingKustoDatabaseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_kusto_database" "import" {
+  name                  = azurerm_data_share_dataset_mapping_kusto_database.test.name
+  share_subscription_id = azurerm_data_share_dataset_mapping_kusto_database.test.share_subscription_id
+  source_dataset_id     = azurerm_data_share_dataset_mapping_kusto_database.test.source_dataset_id
+  kusto_cluster_id      = azurerm_data_share_dataset_mapping_kusto_database.test.kusto_cluster_id
+}
+`, r.basic(data))
+}
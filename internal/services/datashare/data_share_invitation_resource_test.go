@@ -0,0 +1,145 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareInvitationResource struct{}
+
+func TestAccDataShareInvitation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_invitation", "test")
+	r := DataShareInvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("invitation_id").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("Pending"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareInvitation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_invitation", "test")
+	r := DataShareInvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataShareInvitation_email(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_invitation", "test")
+	r := DataShareInvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.email(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DataShareInvitationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.InvitationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.InvitationClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.InvitationProperties != nil), nil
+}
+
+func (DataShareInvitationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-DSA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_data_share" "test" {
+  name       = "acctest_DS_%[1]d"
+  account_id = azurerm_data_share_account.test.id
+  kind       = "CopyBased"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DataShareInvitationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_invitation" "test" {
+  name             = "acctest-DSI-%d"
+  share_id         = azurerm_data_share.test.id
+  target_object_id = data.azurerm_client_config.current.object_id
+  target_tenant_id = data.azurerm_client_config.current.tenant_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareInvitationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_invitation" "import" {
+  name             = azurerm_data_share_invitation.test.name
+  share_id         = azurerm_data_share_invitation.test.share_id
+  target_object_id = azurerm_data_share_invitation.test.target_object_id
+  target_tenant_id = azurerm_data_share_invitation.test.target_tenant_id
+}
+`, r.basic(data))
+}
+
+func (r DataShareInvitationResource) email(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_invitation" "test" {
+  name         = "acctest-DSI-%d"
+  share_id     = azurerm_data_share.test.id
+  target_email = "acctest-%d@example.com"
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
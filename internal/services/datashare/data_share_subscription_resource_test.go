@@ -0,0 +1,198 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareSubscriptionResource struct{}
+
+func TestAccDataShareSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("share_name").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("Active"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataShareSubscription_snapshotSchedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+	startTime := time.Now().Add(time.Hour * 7).Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotSchedule(data, "Day", startTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotSchedule(data, "Hour", startTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DataShareSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ShareSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.ShareSubscriptionClient.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ShareSubscriptionProperties != nil), nil
+}
+
+func (r DataShareSubscriptionResource) template(data acceptance.TestData) string {
+	return r.templateWithKind(data, "CopyBased")
+}
+
+// templateWithKind provisions a Data Share which is shared with the current principal, so that it can be
+// subscribed to from a second Data Share Account within the same tenant
+func (DataShareSubscriptionResource) templateWithKind(data acceptance.TestData, kind string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_share_account" "provider" {
+  name                = "acctest-DSA-provider-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_data_share" "test" {
+  name       = "acctest_DS_%[1]d"
+  account_id = azurerm_data_share_account.provider.id
+  kind       = "%[3]s"
+}
+
+resource "azurerm_data_share_invitation" "test" {
+  name             = "acctest-DSI-%[1]d"
+  share_id         = azurerm_data_share.test.id
+  target_object_id = data.azurerm_client_config.current.object_id
+  target_tenant_id = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_data_share_account" "consumer" {
+  name                = "acctest-DSA-consumer-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, kind)
+}
+
+func (r DataShareSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_DSS_%d"
+  account_id            = azurerm_data_share_account.consumer.id
+  invitation_id         = azurerm_data_share_invitation.test.invitation_id
+  source_share_location = azurerm_resource_group.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DataShareSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "import" {
+  name                  = azurerm_data_share_subscription.test.name
+  account_id            = azurerm_data_share_subscription.test.account_id
+  invitation_id         = azurerm_data_share_subscription.test.invitation_id
+  source_share_location = azurerm_data_share_subscription.test.source_share_location
+}
+`, r.basic(data))
+}
+
+func (r DataShareSubscriptionResource) snapshotSchedule(data acceptance.TestData, recurrence, startTime string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_DSS_%d"
+  account_id            = azurerm_data_share_account.consumer.id
+  invitation_id         = azurerm_data_share_invitation.test.invitation_id
+  source_share_location = azurerm_resource_group.test.location
+
+  snapshot_schedule {
+    name                 = "acctest-ss-%d"
+    recurrence           = "%s"
+    start_time           = "%s"
+    synchronization_mode = "Incremental"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, recurrence, startTime)
+}
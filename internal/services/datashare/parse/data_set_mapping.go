@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type DataSetMappingId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	AccountName           string
+	ShareSubscriptionName string
+	Name                  string
+}
+
+func NewDataSetMappingID(subscriptionId, resourceGroup, accountName, shareSubscriptionName, name string) DataSetMappingId {
+	return DataSetMappingId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AccountName:           accountName,
+		ShareSubscriptionName: shareSubscriptionName,
+		Name:                  name,
+	}
+}
+
+func (id DataSetMappingId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Share Subscription Name %q", id.ShareSubscriptionName),
+		fmt.Sprintf("Account Name %q", id.AccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Data Set Mapping", segmentsStr)
+}
+
+func (id DataSetMappingId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataShare/accounts/%s/shareSubscriptions/%s/dataSetMappings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name)
+}
+
+// DataSetMappingID parses a DataSetMapping ID into an DataSetMappingId struct
+func DataSetMappingID(input string) (*DataSetMappingId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DataSetMappingId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.ShareSubscriptionName, err = id.PopSegment("shareSubscriptions"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("dataSetMappings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
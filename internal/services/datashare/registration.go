@@ -32,12 +32,18 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_data_share_account":                resourceDataShareAccount(),
-		"azurerm_data_share":                        resourceDataShare(),
-		"azurerm_data_share_dataset_blob_storage":   resourceDataShareDataSetBlobStorage(),
-		"azurerm_data_share_dataset_data_lake_gen1": resourceDataShareDataSetDataLakeGen1(),
-		"azurerm_data_share_dataset_data_lake_gen2": resourceDataShareDataSetDataLakeGen2(),
-		"azurerm_data_share_dataset_kusto_cluster":  resourceDataShareDataSetKustoCluster(),
-		"azurerm_data_share_dataset_kusto_database": resourceDataShareDataSetKustoDatabase(),
+		"azurerm_data_share_account":                        resourceDataShareAccount(),
+		"azurerm_data_share":                                resourceDataShare(),
+		"azurerm_data_share_dataset_blob_storage":           resourceDataShareDataSetBlobStorage(),
+		"azurerm_data_share_dataset_data_lake_gen1":         resourceDataShareDataSetDataLakeGen1(),
+		"azurerm_data_share_dataset_data_lake_gen2":         resourceDataShareDataSetDataLakeGen2(),
+		"azurerm_data_share_dataset_kusto_cluster":          resourceDataShareDataSetKustoCluster(),
+		"azurerm_data_share_dataset_kusto_database":         resourceDataShareDataSetKustoDatabase(),
+		"azurerm_data_share_dataset_mapping_blob_storage":   resourceDataShareDataSetMappingBlobStorage(),
+		"azurerm_data_share_dataset_mapping_data_lake_gen2": resourceDataShareDataSetMappingDataLakeGen2(),
+		"azurerm_data_share_dataset_mapping_kusto_cluster":  resourceDataShareDataSetMappingKustoCluster(),
+		"azurerm_data_share_dataset_mapping_kusto_database": resourceDataShareDataSetMappingKustoDatabase(),
+		"azurerm_data_share_invitation":                     resourceDataShareInvitation(),
+		"azurerm_data_share_subscription":                   resourceDataShareSubscription(),
 	}
 }
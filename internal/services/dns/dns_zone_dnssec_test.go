@@ -0,0 +1,73 @@
+package dns
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	azureSdk "github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestDnssecConfigurationIsUnavailable(t *testing.T) {
+	requestError := func(statusCode int, code string) error {
+		return autorest.NewErrorWithError(&azureSdk.RequestError{
+			DetailedError: autorest.DetailedError{
+				StatusCode: statusCode,
+			},
+			ServiceError: &azureSdk.ServiceError{
+				Code: code,
+			},
+		}, "dnssecconfigs.DnssecConfigsClient", "Get", nil, "Failure responding to request")
+	}
+
+	cases := []struct {
+		Name     string
+		Input    error
+		Expected bool
+	}{
+		{
+			Name:     "not a detailed error",
+			Input:    fmt.Errorf("boom"),
+			Expected: false,
+		},
+		{
+			Name:     "invalid api version",
+			Input:    requestError(http.StatusBadRequest, "InvalidApiVersionParameter"),
+			Expected: true,
+		},
+		{
+			Name:     "invalid resource type",
+			Input:    requestError(http.StatusBadRequest, "InvalidResourceType"),
+			Expected: true,
+		},
+		{
+			Name:     "no registered provider",
+			Input:    requestError(http.StatusBadRequest, "NoRegisteredProviderFound"),
+			Expected: true,
+		},
+		{
+			Name:     "other bad request",
+			Input:    requestError(http.StatusBadRequest, "BadRequest"),
+			Expected: false,
+		},
+		{
+			Name:     "forbidden",
+			Input:    requestError(http.StatusForbidden, "AuthorizationFailed"),
+			Expected: false,
+		},
+		{
+			Name:     "throttled",
+			Input:    requestError(http.StatusTooManyRequests, "TooManyRequests"),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if actual := dnssecConfigurationIsUnavailable(tc.Input); actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
@@ -1,16 +1,23 @@
 package dns
 
 import (
+	"errors"
 	"fmt"
+	"log"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/go-autorest/autorest"
+	azureSdk "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -140,6 +147,68 @@ func resourceDnsZone() *pluginsdk.Resource {
 				},
 			},
 
+			"dnssec_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"dnssec_signing_key": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"delegation_signer_info": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"digest_algorithm_type": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"digest_value": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"record": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"flags": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"key_tag": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"public_key": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_algorithm_type": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -203,6 +272,21 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.IsNewResource() || d.HasChange("dnssec_enabled") {
+		dnssecClient := meta.(*clients.Client).Dns.DnssecConfigsClient
+		dnssecId := dnssecconfigs.NewDnsZoneID(subscriptionId, resGroup, name)
+
+		if d.Get("dnssec_enabled").(bool) {
+			if err := dnssecClient.CreateOrUpdateThenPoll(ctx, dnssecId); err != nil {
+				return fmt.Errorf("enabling DNSSEC for DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		} else if !d.IsNewResource() {
+			if err := dnssecClient.DeleteThenPoll(ctx, dnssecId); err != nil {
+				return fmt.Errorf("disabling DNSSEC for DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceDnsZoneRead(d, meta)
@@ -211,6 +295,7 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 func resourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	zonesClient := meta.(*clients.Client).Dns.ZonesClient
 	recordSetsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnssecClient := meta.(*clients.Client).Dns.DnssecConfigsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -251,6 +336,30 @@ func resourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `soa_record`: %+v", err)
 	}
 
+	// the DNSSEC API is only available in a preview API version which isn't available in every cloud/region - as such
+	// when this API version isn't available DNSSEC is treated as being disabled
+	dnssecEnabled := false
+	var signingKeys *[]dnssecconfigs.SigningKey
+	dnssecResp, err := dnssecClient.Get(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		if !response.WasNotFound(dnssecResp.HttpResponse) {
+			if !dnssecConfigurationIsUnavailable(err) {
+				return fmt.Errorf("retrieving DNSSEC Configuration for DNS Zone %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			}
+			log.Printf("[DEBUG] the DNSSEC Configuration API isn't available for DNS Zone %q (Resource Group %q) - assuming DNSSEC is disabled: %+v", id.Name, id.ResourceGroup, err)
+		}
+	} else {
+		dnssecEnabled = true
+		if model := dnssecResp.Model; model != nil && model.Properties != nil {
+			signingKeys = model.Properties.SigningKeys
+		}
+	}
+
+	d.Set("dnssec_enabled", dnssecEnabled)
+	if err := d.Set("dnssec_signing_key", flattenArmDNSZoneDnssecSigningKeys(signingKeys)); err != nil {
+		return fmt.Errorf("setting `dnssec_signing_key`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -264,6 +373,14 @@ func resourceDnsZoneDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// DNSSEC has to be disabled before the DNS Zone can be deleted
+	if d.Get("dnssec_enabled").(bool) {
+		dnssecClient := meta.(*clients.Client).Dns.DnssecConfigsClient
+		if err := dnssecClient.DeleteThenPoll(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.Name)); err != nil {
+			return fmt.Errorf("disabling DNSSEC for DNS Zone %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
 	etag := ""
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name, etag)
 	if err != nil {
@@ -361,3 +478,59 @@ func flattenArmDNSZoneSOARecord(input *dns.RecordSet) []interface{} {
 		},
 	}
 }
+
+// dnssecConfigurationIsUnavailable returns whether the error is due to the DNSSEC Configuration API version (or
+// resource type) not being available, for example in a cloud or region where the preview API hasn't been rolled out
+func dnssecConfigurationIsUnavailable(err error) bool {
+	var detailed autorest.DetailedError
+	if !errors.As(err, &detailed) {
+		return false
+	}
+
+	var requestErr *azureSdk.RequestError
+	if !errors.As(detailed.Original, &requestErr) || requestErr.ServiceError == nil {
+		return false
+	}
+
+	if requestErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+
+	switch requestErr.ServiceError.Code {
+	case "InvalidApiVersionParameter", "InvalidResourceType", "NoRegisteredProviderFound":
+		return true
+	}
+
+	return false
+}
+
+func flattenArmDNSZoneDnssecSigningKeys(input *[]dnssecconfigs.SigningKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, key := range *input {
+		delegationSignerInfo := make([]interface{}, 0)
+		if key.DelegationSignerInfo != nil {
+			for _, info := range *key.DelegationSignerInfo {
+				delegationSignerInfo = append(delegationSignerInfo, map[string]interface{}{
+					"digest_algorithm_type": int(utils.NormaliseNilableInt64(info.DigestAlgorithmType)),
+					"digest_value":          utils.NormalizeNilableString(info.DigestValue),
+					"record":                utils.NormalizeNilableString(info.Record),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"delegation_signer_info":  delegationSignerInfo,
+			"flags":                   int(utils.NormaliseNilableInt64(key.Flags)),
+			"key_tag":                 int(utils.NormaliseNilableInt64(key.KeyTag)),
+			"protocol":                int(utils.NormaliseNilableInt64(key.Protocol)),
+			"public_key":              utils.NormalizeNilableString(key.PublicKey),
+			"security_algorithm_type": int(utils.NormaliseNilableInt64(key.SecurityAlgorithmType)),
+		})
+	}
+
+	return results
+}
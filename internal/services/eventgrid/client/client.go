@@ -3,6 +3,17 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2020-10-15-preview/eventgrid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/channels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnerconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnernamespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnerregistrations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/clientgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/permissionbindings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/topicspaces"
 )
 
 type Client struct {
@@ -12,6 +23,17 @@ type Client struct {
 	TopicsClient                        *eventgrid.TopicsClient
 	SystemTopicsClient                  *eventgrid.SystemTopicsClient
 	SystemTopicEventSubscriptionsClient *eventgrid.SystemTopicEventSubscriptionsClient
+	NamespacesClient                    *namespaces.NamespacesClient
+	NamespaceClientGroupsClient         *clientgroups.ClientGroupsClient
+	NamespaceClientsClient              *clients.ClientsClient
+	NamespacePermissionBindingsClient   *permissionbindings.PermissionBindingsClient
+	NamespaceTopicsClient               *namespacetopics.NamespaceTopicsClient
+	NamespaceTopicSpacesClient          *topicspaces.TopicSpacesClient
+	ChannelsClient                      *channels.ChannelsClient
+	PartnerConfigurationsClient         *partnerconfigurations.PartnerConfigurationsClient
+	PartnerNamespacesClient             *partnernamespaces.PartnerNamespacesClient
+	PartnerRegistrationsClient          *partnerregistrations.PartnerRegistrationsClient
+	PartnerTopicsClient                 *partnertopics.PartnerTopicsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -33,6 +55,39 @@ func NewClient(o *common.ClientOptions) *Client {
 	SystemTopicEventSubscriptionsClient := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SystemTopicEventSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
 
+	NamespacesClient := namespaces.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceClientGroupsClient := clientgroups.NewClientGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceClientGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceClientsClient := clients.NewClientsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceClientsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespacePermissionBindingsClient := permissionbindings.NewPermissionBindingsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacePermissionBindingsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceTopicsClient := namespacetopics.NewNamespaceTopicsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceTopicsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceTopicSpacesClient := topicspaces.NewTopicSpacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceTopicSpacesClient.Client, o.ResourceManagerAuthorizer)
+
+	ChannelsClient := channels.NewChannelsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ChannelsClient.Client, o.ResourceManagerAuthorizer)
+
+	PartnerConfigurationsClient := partnerconfigurations.NewPartnerConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PartnerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	PartnerNamespacesClient := partnernamespaces.NewPartnerNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PartnerNamespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	PartnerRegistrationsClient := partnerregistrations.NewPartnerRegistrationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PartnerRegistrationsClient.Client, o.ResourceManagerAuthorizer)
+
+	PartnerTopicsClient := partnertopics.NewPartnerTopicsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PartnerTopicsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		DomainsClient:                       &DomainsClient,
 		EventSubscriptionsClient:            &EventSubscriptionsClient,
@@ -40,5 +95,16 @@ func NewClient(o *common.ClientOptions) *Client {
 		TopicsClient:                        &TopicsClient,
 		SystemTopicsClient:                  &SystemTopicsClient,
 		SystemTopicEventSubscriptionsClient: &SystemTopicEventSubscriptionsClient,
+		NamespacesClient:                    &NamespacesClient,
+		NamespaceClientGroupsClient:         &NamespaceClientGroupsClient,
+		NamespaceClientsClient:              &NamespaceClientsClient,
+		NamespacePermissionBindingsClient:   &NamespacePermissionBindingsClient,
+		NamespaceTopicsClient:               &NamespaceTopicsClient,
+		NamespaceTopicSpacesClient:          &NamespaceTopicSpacesClient,
+		ChannelsClient:                      &ChannelsClient,
+		PartnerConfigurationsClient:         &PartnerConfigurationsClient,
+		PartnerNamespacesClient:             &PartnerNamespacesClient,
+		PartnerRegistrationsClient:          &PartnerRegistrationsClient,
+		PartnerTopicsClient:                 &PartnerTopicsClient,
 	}
 }
@@ -0,0 +1,495 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+
+type EventGridNamespaceResource struct{}
+
+type EventGridNamespaceModel struct {
+	Name                       string                                            `tfschema:"name"`
+	ResourceGroupName          string                                            `tfschema:"resource_group_name"`
+	Location                   string                                            `tfschema:"location"`
+	Capacity                   int64                                             `tfschema:"capacity"`
+	InboundIPRules             []EventGridNamespaceInboundIPRuleModel            `tfschema:"inbound_ip_rule"`
+	MinimumTlsVersion          string                                            `tfschema:"minimum_tls_version"`
+	PublicNetworkAccessEnabled bool                                              `tfschema:"public_network_access_enabled"`
+	Sku                        string                                            `tfschema:"sku"`
+	TopicSpacesConfiguration   []EventGridNamespaceTopicSpacesConfigurationModel `tfschema:"topic_spaces_configuration"`
+	ZoneRedundant              bool                                              `tfschema:"zone_redundant"`
+	Tags                       map[string]string                                 `tfschema:"tags"`
+	TopicsHostname             string                                            `tfschema:"topics_hostname"`
+}
+
+type EventGridNamespaceInboundIPRuleModel struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type EventGridNamespaceTopicSpacesConfigurationModel struct {
+	AlternativeAuthenticationNameSources       []string `tfschema:"alternative_authentication_name_sources"`
+	MaximumClientSessionsPerAuthenticationName int64    `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64    `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string   `tfschema:"route_topic_id"`
+	Hostname                                   string   `tfschema:"hostname"`
+}
+
+func (r EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": identity.SystemAssignedUserAssigned{}.Schema(),
+
+		"inbound_ip_rule": {
+			Type:       pluginsdk.TypeList,
+			Optional:   true,
+			MaxItems:   128,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(namespaces.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForIPActionType(), false),
+					},
+				},
+			},
+		},
+
+		"minimum_tls_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(namespaces.TlsVersionOnePointTwo),
+			ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForTlsVersion(), false),
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"sku": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(namespaces.SkuNameStandard),
+			ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForSkuName(), false),
+		},
+
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_sources": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForAlternativeAuthenticationNameSource(), false),
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+					},
+
+					"hostname": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"zone_redundant": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"topics_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) ModelObject() interface{} {
+	return &EventGridNamespaceModel{}
+}
+
+func (r EventGridNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaces.ValidateNamespaceID
+}
+
+func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := namespaces.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := expandEventGridNamespace(model, metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceModel{
+				Name:              id.NamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if sku := model.Sku; sku != nil {
+					if sku.Name != nil {
+						state.Sku = string(*sku.Name)
+					}
+					if sku.Capacity != nil {
+						state.Capacity = *sku.Capacity
+					}
+				}
+
+				if props := model.Properties; props != nil {
+					state.InboundIPRules = flattenEventGridNamespaceInboundIPRules(props.InboundIPRules)
+					state.PublicNetworkAccessEnabled = props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == namespaces.PublicNetworkAccessEnabled
+					state.TopicSpacesConfiguration = flattenEventGridNamespaceTopicSpacesConfiguration(props.TopicSpacesConfiguration)
+					state.ZoneRedundant = props.IsZoneRedundant != nil && *props.IsZoneRedundant
+
+					if props.MinimumTlsVersionAllowed != nil {
+						state.MinimumTlsVersion = string(*props.MinimumTlsVersionAllowed)
+					}
+
+					if props.TopicsConfiguration != nil && props.TopicsConfiguration.Hostname != nil {
+						state.TopicsHostname = *props.TopicsConfiguration.Hostname
+					}
+				}
+
+				if err := metadata.ResourceData.Set("identity", flattenEventGridNamespaceIdentity(model.Identity)); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters, err := expandEventGridNamespace(model, metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespace(model EventGridNamespaceModel, identityRaw []interface{}) (*namespaces.Namespace, error) {
+	publicNetworkAccess := namespaces.PublicNetworkAccessDisabled
+	if model.PublicNetworkAccessEnabled {
+		publicNetworkAccess = namespaces.PublicNetworkAccessEnabled
+	}
+
+	minimumTlsVersion := namespaces.TlsVersion(model.MinimumTlsVersion)
+	skuName := namespaces.SkuName(model.Sku)
+
+	expandedIdentity, err := identity.SystemAssignedUserAssigned{}.Expand(identityRaw)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+	namespaceIdentity := &identity.SystemUserAssignedIdentityMap{}
+	namespaceIdentity.FromExpandedConfig(*expandedIdentity)
+
+	return &namespaces.Namespace{
+		Identity: namespaceIdentity,
+		Location: location.Normalize(model.Location),
+		Properties: &namespaces.NamespaceProperties{
+			InboundIPRules:           expandEventGridNamespaceInboundIPRules(model.InboundIPRules),
+			IsZoneRedundant:          utils.Bool(model.ZoneRedundant),
+			MinimumTlsVersionAllowed: &minimumTlsVersion,
+			PublicNetworkAccess:      &publicNetworkAccess,
+			TopicSpacesConfiguration: expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration),
+		},
+		Sku: &namespaces.NamespaceSku{
+			Capacity: utils.Int64(model.Capacity),
+			Name:     &skuName,
+		},
+		Tags: &model.Tags,
+	}, nil
+}
+
+func expandEventGridNamespaceInboundIPRules(input []EventGridNamespaceInboundIPRuleModel) *[]namespaces.InboundIPRule {
+	rules := make([]namespaces.InboundIPRule, 0)
+	for _, v := range input {
+		action := namespaces.IPActionType(v.Action)
+		rules = append(rules, namespaces.InboundIPRule{
+			Action: &action,
+			IPMask: utils.String(v.IPMask),
+		})
+	}
+
+	return &rules
+}
+
+func flattenEventGridNamespaceInboundIPRules(input *[]namespaces.InboundIPRule) []EventGridNamespaceInboundIPRuleModel {
+	results := make([]EventGridNamespaceInboundIPRuleModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		rule := EventGridNamespaceInboundIPRuleModel{}
+		if v.Action != nil {
+			rule.Action = string(*v.Action)
+		}
+		if v.IPMask != nil {
+			rule.IPMask = *v.IPMask
+		}
+		results = append(results, rule)
+	}
+
+	return results
+}
+
+func expandEventGridNamespaceTopicSpacesConfiguration(input []EventGridNamespaceTopicSpacesConfigurationModel) *namespaces.TopicSpacesConfiguration {
+	if len(input) == 0 {
+		state := namespaces.TopicSpacesConfigurationStateDisabled
+		return &namespaces.TopicSpacesConfiguration{
+			State: &state,
+		}
+	}
+
+	v := input[0]
+	state := namespaces.TopicSpacesConfigurationStateEnabled
+
+	sources := make([]namespaces.AlternativeAuthenticationNameSource, 0)
+	for _, source := range v.AlternativeAuthenticationNameSources {
+		sources = append(sources, namespaces.AlternativeAuthenticationNameSource(source))
+	}
+
+	output := namespaces.TopicSpacesConfiguration{
+		ClientAuthentication: &namespaces.ClientAuthenticationSettings{
+			AlternativeAuthenticationNameSources: &sources,
+		},
+		MaximumClientSessionsPerAuthenticationName: utils.Int64(v.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                utils.Int64(v.MaximumSessionExpiryInHours),
+		State:                                      &state,
+	}
+
+	if v.RouteTopicId != "" {
+		output.RouteTopicResourceId = utils.String(v.RouteTopicId)
+	}
+
+	return &output
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *namespaces.TopicSpacesConfiguration) []EventGridNamespaceTopicSpacesConfigurationModel {
+	if input == nil || input.State == nil || *input.State != namespaces.TopicSpacesConfigurationStateEnabled {
+		return []EventGridNamespaceTopicSpacesConfigurationModel{}
+	}
+
+	output := EventGridNamespaceTopicSpacesConfigurationModel{
+		AlternativeAuthenticationNameSources: make([]string, 0),
+	}
+
+	if input.ClientAuthentication != nil && input.ClientAuthentication.AlternativeAuthenticationNameSources != nil {
+		for _, v := range *input.ClientAuthentication.AlternativeAuthenticationNameSources {
+			output.AlternativeAuthenticationNameSources = append(output.AlternativeAuthenticationNameSources, string(v))
+		}
+	}
+
+	if input.MaximumClientSessionsPerAuthenticationName != nil {
+		output.MaximumClientSessionsPerAuthenticationName = *input.MaximumClientSessionsPerAuthenticationName
+	}
+
+	if input.MaximumSessionExpiryInHours != nil {
+		output.MaximumSessionExpiryInHours = *input.MaximumSessionExpiryInHours
+	}
+
+	if input.RouteTopicResourceId != nil && *input.RouteTopicResourceId != "" {
+		routeTopicId, err := namespacetopics.ParseNamespaceTopicIDInsensitively(*input.RouteTopicResourceId)
+		if err == nil {
+			output.RouteTopicId = routeTopicId.ID()
+		} else {
+			output.RouteTopicId = *input.RouteTopicResourceId
+		}
+	}
+
+	if input.Hostname != nil {
+		output.Hostname = *input.Hostname
+	}
+
+	return []EventGridNamespaceTopicSpacesConfigurationModel{output}
+}
+
+func flattenEventGridNamespaceIdentity(input *identity.SystemUserAssignedIdentityMap) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	config := input.ToExpandedConfig()
+	return identity.SystemAssignedUserAssigned{}.Flatten(&config)
+}
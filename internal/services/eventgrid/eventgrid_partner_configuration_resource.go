@@ -0,0 +1,255 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnerconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridPartnerConfigurationResource{}
+
+type EventGridPartnerConfigurationResource struct{}
+
+type EventGridPartnerConfigurationModel struct {
+	ResourceGroupName                  string                               `tfschema:"resource_group_name"`
+	DefaultMaximumExpirationTimeInDays int64                                `tfschema:"default_maximum_expiration_time_in_days"`
+	PartnerAuthorizations              []EventGridPartnerAuthorizationModel `tfschema:"partner_authorization"`
+	Tags                               map[string]string                    `tfschema:"tags"`
+}
+
+type EventGridPartnerAuthorizationModel struct {
+	PartnerRegistrationId            string `tfschema:"partner_registration_id"`
+	PartnerName                      string `tfschema:"partner_name"`
+	AuthorizationExpirationTimeInUtc string `tfschema:"authorization_expiration_time_in_utc"`
+}
+
+func (r EventGridPartnerConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"default_maximum_expiration_time_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      7,
+			ValidateFunc: validation.IntBetween(1, 365),
+		},
+
+		"partner_authorization": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"partner_registration_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"partner_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"authorization_expiration_time_in_utc": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r EventGridPartnerConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridPartnerConfigurationResource) ModelObject() interface{} {
+	return &EventGridPartnerConfigurationModel{}
+}
+
+func (r EventGridPartnerConfigurationResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_configuration"
+}
+
+func (r EventGridPartnerConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return partnerconfigurations.ValidatePartnerConfigurationID
+}
+
+func (r EventGridPartnerConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurationsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridPartnerConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := partnerconfigurations.NewPartnerConfigurationID(subscriptionId, model.ResourceGroupName)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandEventGridPartnerConfiguration(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurationsClient
+
+			id, err := partnerconfigurations.ParsePartnerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerConfigurationModel{
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil && props.PartnerAuthorization != nil {
+					if v := props.PartnerAuthorization.DefaultMaximumExpirationTimeInDays; v != nil {
+						state.DefaultMaximumExpirationTimeInDays = *v
+					}
+
+					state.PartnerAuthorizations = flattenEventGridPartnerAuthorizations(props.PartnerAuthorization.AuthorizedPartnersList)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurationsClient
+
+			id, err := partnerconfigurations.ParsePartnerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridPartnerConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandEventGridPartnerConfiguration(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerConfigurationsClient
+
+			id, err := partnerconfigurations.ParsePartnerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridPartnerConfiguration(model EventGridPartnerConfigurationModel) partnerconfigurations.PartnerConfiguration {
+	partners := make([]partnerconfigurations.Partner, 0)
+	for _, v := range model.PartnerAuthorizations {
+		partner := partnerconfigurations.Partner{
+			PartnerName:                    utils.String(v.PartnerName),
+			PartnerRegistrationImmutableId: utils.String(v.PartnerRegistrationId),
+		}
+
+		if v.AuthorizationExpirationTimeInUtc != "" {
+			partner.AuthorizationExpirationTimeInUtc = utils.String(v.AuthorizationExpirationTimeInUtc)
+		}
+
+		partners = append(partners, partner)
+	}
+
+	return partnerconfigurations.PartnerConfiguration{
+		// Partner Configurations are global resources
+		Location: utils.String("global"),
+		Properties: &partnerconfigurations.PartnerConfigurationProperties{
+			PartnerAuthorization: &partnerconfigurations.PartnerAuthorization{
+				AuthorizedPartnersList:             &partners,
+				DefaultMaximumExpirationTimeInDays: utils.Int64(model.DefaultMaximumExpirationTimeInDays),
+			},
+		},
+		Tags: &model.Tags,
+	}
+}
+
+func flattenEventGridPartnerAuthorizations(input *[]partnerconfigurations.Partner) []EventGridPartnerAuthorizationModel {
+	results := make([]EventGridPartnerAuthorizationModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, EventGridPartnerAuthorizationModel{
+			PartnerRegistrationId:            utils.NormalizeNilableString(v.PartnerRegistrationImmutableId),
+			PartnerName:                      utils.NormalizeNilableString(v.PartnerName),
+			AuthorizationExpirationTimeInUtc: utils.NormalizeNilableString(v.AuthorizationExpirationTimeInUtc),
+		})
+	}
+
+	return results
+}
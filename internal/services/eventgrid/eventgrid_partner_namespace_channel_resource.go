@@ -0,0 +1,293 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/channels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridPartnerNamespaceChannelResource{}
+
+type EventGridPartnerNamespaceChannelResource struct{}
+
+type EventGridPartnerNamespaceChannelModel struct {
+	Name                              string                           `tfschema:"name"`
+	PartnerNamespaceId                string                           `tfschema:"partner_namespace_id"`
+	PartnerTopic                      []EventGridPartnerTopicInfoModel `tfschema:"partner_topic"`
+	ExpirationTimeIfNotActivatedInUtc string                           `tfschema:"expiration_time_if_not_activated_in_utc"`
+	MessageForActivation              string                           `tfschema:"message_for_activation"`
+	ReadinessState                    string                           `tfschema:"readiness_state"`
+}
+
+type EventGridPartnerTopicInfoModel struct {
+	Name              string `tfschema:"name"`
+	ResourceGroupName string `tfschema:"resource_group_name"`
+	SubscriptionId    string `tfschema:"subscription_id"`
+	Source            string `tfschema:"source"`
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid partner namespace channel name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"partner_namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: channels.ValidatePartnerNamespaceID,
+		},
+
+		"partner_topic": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+							"EventGrid partner topic name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+						),
+					},
+
+					"resource_group_name": azure.SchemaResourceGroupName(),
+
+					"subscription_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"expiration_time_if_not_activated_in_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"message_for_activation": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"readiness_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) ModelObject() interface{} {
+	return &EventGridPartnerNamespaceChannelModel{}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_namespace_channel"
+}
+
+func (r EventGridPartnerNamespaceChannelResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return channels.ValidateChannelID
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ChannelsClient
+
+			var model EventGridPartnerNamespaceChannelModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			partnerNamespaceId, err := channels.ParsePartnerNamespaceID(model.PartnerNamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := channels.NewChannelID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.PartnerNamespaceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandEventGridPartnerNamespaceChannel(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ChannelsClient
+
+			id, err := channels.ParseChannelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerNamespaceChannelModel{
+				Name:               id.ChannelName,
+				PartnerNamespaceId: channels.NewPartnerNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.PartnerNamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.ExpirationTimeIfNotActivatedInUtc = utils.NormalizeNilableString(props.ExpirationTimeIfNotActivatedUtc)
+				state.MessageForActivation = utils.NormalizeNilableString(props.MessageForActivation)
+				state.PartnerTopic = flattenEventGridPartnerTopicInfo(props.PartnerTopicInfo)
+
+				if props.ReadinessState != nil {
+					state.ReadinessState = string(*props.ReadinessState)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ChannelsClient
+
+			id, err := channels.ParseChannelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridPartnerNamespaceChannelModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandEventGridPartnerNamespaceChannel(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceChannelResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ChannelsClient
+
+			id, err := channels.ParseChannelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridPartnerNamespaceChannel(model EventGridPartnerNamespaceChannelModel) channels.Channel {
+	channelType := channels.ChannelTypePartnerTopic
+	properties := channels.ChannelProperties{
+		ChannelType: &channelType,
+	}
+
+	if len(model.PartnerTopic) > 0 {
+		v := model.PartnerTopic[0]
+		properties.PartnerTopicInfo = &channels.PartnerTopicInfo{
+			AzureSubscriptionId: utils.String(v.SubscriptionId),
+			Name:                utils.String(v.Name),
+			ResourceGroupName:   utils.String(v.ResourceGroupName),
+			Source:              utils.String(v.Source),
+		}
+	}
+
+	if model.ExpirationTimeIfNotActivatedInUtc != "" {
+		properties.ExpirationTimeIfNotActivatedUtc = utils.String(model.ExpirationTimeIfNotActivatedInUtc)
+	}
+
+	if model.MessageForActivation != "" {
+		properties.MessageForActivation = utils.String(model.MessageForActivation)
+	}
+
+	return channels.Channel{
+		Properties: &properties,
+	}
+}
+
+func flattenEventGridPartnerTopicInfo(input *channels.PartnerTopicInfo) []EventGridPartnerTopicInfoModel {
+	if input == nil {
+		return []EventGridPartnerTopicInfoModel{}
+	}
+
+	return []EventGridPartnerTopicInfoModel{
+		{
+			Name:              utils.NormalizeNilableString(input.Name),
+			ResourceGroupName: utils.NormalizeNilableString(input.ResourceGroupName),
+			SubscriptionId:    utils.NormalizeNilableString(input.AzureSubscriptionId),
+			Source:            utils.NormalizeNilableString(input.Source),
+		},
+	}
+}
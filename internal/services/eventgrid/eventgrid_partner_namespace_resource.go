@@ -0,0 +1,314 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnernamespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnerregistrations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridPartnerNamespaceResource{}
+
+type EventGridPartnerNamespaceResource struct{}
+
+type EventGridPartnerNamespaceModel struct {
+	Name                       string                                        `tfschema:"name"`
+	ResourceGroupName          string                                        `tfschema:"resource_group_name"`
+	Location                   string                                        `tfschema:"location"`
+	PartnerRegistrationId      string                                        `tfschema:"partner_registration_id"`
+	InboundIPRules             []EventGridPartnerNamespaceInboundIPRuleModel `tfschema:"inbound_ip_rule"`
+	LocalAuthEnabled           bool                                          `tfschema:"local_auth_enabled"`
+	PartnerTopicRoutingMode    string                                        `tfschema:"partner_topic_routing_mode"`
+	PublicNetworkAccessEnabled bool                                          `tfschema:"public_network_access_enabled"`
+	Tags                       map[string]string                             `tfschema:"tags"`
+	Endpoint                   string                                        `tfschema:"endpoint"`
+}
+
+type EventGridPartnerNamespaceInboundIPRuleModel struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+func (r EventGridPartnerNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid partner namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"partner_registration_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: partnerregistrations.ValidatePartnerRegistrationID,
+		},
+
+		"inbound_ip_rule": {
+			Type:       pluginsdk.TypeList,
+			Optional:   true,
+			MaxItems:   128,
+			ConfigMode: pluginsdk.SchemaConfigModeAttr,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(partnernamespaces.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice(partnernamespaces.PossibleValuesForIPActionType(), false),
+					},
+				},
+			},
+		},
+
+		"local_auth_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"partner_topic_routing_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(partnernamespaces.PartnerTopicRoutingModeChannelNameHeader),
+			ValidateFunc: validation.StringInSlice(partnernamespaces.PossibleValuesForPartnerTopicRoutingMode(), false),
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) ModelObject() interface{} {
+	return &EventGridPartnerNamespaceModel{}
+}
+
+func (r EventGridPartnerNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_namespace"
+}
+
+func (r EventGridPartnerNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return partnernamespaces.ValidatePartnerNamespaceID
+}
+
+func (r EventGridPartnerNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridPartnerNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := partnernamespaces.NewPartnerNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandEventGridPartnerNamespace(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespacesClient
+
+			id, err := partnernamespaces.ParsePartnerNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerNamespaceModel{
+				Name:              id.PartnerNamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.Endpoint = utils.NormalizeNilableString(props.Endpoint)
+					state.InboundIPRules = flattenEventGridPartnerNamespaceInboundIPRules(props.InboundIPRules)
+					state.LocalAuthEnabled = props.DisableLocalAuth == nil || !*props.DisableLocalAuth
+					state.PublicNetworkAccessEnabled = props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == partnernamespaces.PublicNetworkAccessEnabled
+
+					if props.PartnerRegistrationFullyQualifiedId != nil {
+						partnerRegistrationId, err := partnerregistrations.ParsePartnerRegistrationIDInsensitively(*props.PartnerRegistrationFullyQualifiedId)
+						if err != nil {
+							return err
+						}
+						state.PartnerRegistrationId = partnerRegistrationId.ID()
+					}
+
+					if props.PartnerTopicRoutingMode != nil {
+						state.PartnerTopicRoutingMode = string(*props.PartnerTopicRoutingMode)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespacesClient
+
+			id, err := partnernamespaces.ParsePartnerNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridPartnerNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandEventGridPartnerNamespace(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerNamespacesClient
+
+			id, err := partnernamespaces.ParsePartnerNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridPartnerNamespace(model EventGridPartnerNamespaceModel) partnernamespaces.PartnerNamespace {
+	publicNetworkAccess := partnernamespaces.PublicNetworkAccessDisabled
+	if model.PublicNetworkAccessEnabled {
+		publicNetworkAccess = partnernamespaces.PublicNetworkAccessEnabled
+	}
+
+	routingMode := partnernamespaces.PartnerTopicRoutingMode(model.PartnerTopicRoutingMode)
+
+	rules := make([]partnernamespaces.InboundIPRule, 0)
+	for _, v := range model.InboundIPRules {
+		action := partnernamespaces.IPActionType(v.Action)
+		rules = append(rules, partnernamespaces.InboundIPRule{
+			Action: &action,
+			IPMask: utils.String(v.IPMask),
+		})
+	}
+
+	return partnernamespaces.PartnerNamespace{
+		Location: location.Normalize(model.Location),
+		Properties: &partnernamespaces.PartnerNamespaceProperties{
+			DisableLocalAuth:                    utils.Bool(!model.LocalAuthEnabled),
+			InboundIPRules:                      &rules,
+			PartnerRegistrationFullyQualifiedId: utils.String(model.PartnerRegistrationId),
+			PartnerTopicRoutingMode:             &routingMode,
+			PublicNetworkAccess:                 &publicNetworkAccess,
+		},
+		Tags: &model.Tags,
+	}
+}
+
+func flattenEventGridPartnerNamespaceInboundIPRules(input *[]partnernamespaces.InboundIPRule) []EventGridPartnerNamespaceInboundIPRuleModel {
+	results := make([]EventGridPartnerNamespaceInboundIPRuleModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		rule := EventGridPartnerNamespaceInboundIPRuleModel{
+			IPMask: utils.NormalizeNilableString(v.IPMask),
+		}
+		if v.Action != nil {
+			rule.Action = string(*v.Action)
+		}
+		results = append(results, rule)
+	}
+
+	return results
+}
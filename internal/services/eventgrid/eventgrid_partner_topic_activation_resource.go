@@ -0,0 +1,150 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = EventGridPartnerTopicActivationResource{}
+
+// EventGridPartnerTopicActivationResource activates a Partner Topic which has been created within the
+// subscriber's Azure Subscription by a partner (via a Channel), so that its events can be delivered
+type EventGridPartnerTopicActivationResource struct{}
+
+type EventGridPartnerTopicActivationModel struct {
+	PartnerTopicId string `tfschema:"partner_topic_id"`
+	Source         string `tfschema:"source"`
+}
+
+func (r EventGridPartnerTopicActivationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"partner_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: partnertopics.ValidatePartnerTopicID,
+		},
+	}
+}
+
+func (r EventGridPartnerTopicActivationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridPartnerTopicActivationResource) ModelObject() interface{} {
+	return &EventGridPartnerTopicActivationModel{}
+}
+
+func (r EventGridPartnerTopicActivationResource) ResourceType() string {
+	return "azurerm_eventgrid_partner_topic_activation"
+}
+
+func (r EventGridPartnerTopicActivationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return partnertopics.ValidatePartnerTopicID
+}
+
+func (r EventGridPartnerTopicActivationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerTopicsClient
+
+			var model EventGridPartnerTopicActivationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := partnertopics.ParsePartnerTopicID(model.PartnerTopicId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.ActivationState != nil && *existing.Model.Properties.ActivationState == partnertopics.PartnerTopicActivationStateActivated {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.Activate(ctx, *id); err != nil {
+				return fmt.Errorf("activating %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridPartnerTopicActivationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerTopicsClient
+
+			id, err := partnertopics.ParsePartnerTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridPartnerTopicActivationModel{
+				PartnerTopicId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				if props.ActivationState == nil || *props.ActivationState != partnertopics.PartnerTopicActivationStateActivated {
+					return metadata.MarkAsGone(id)
+				}
+
+				state.Source = utils.NormalizeNilableString(props.Source)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridPartnerTopicActivationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.PartnerTopicsClient
+
+			id, err := partnertopics.ParsePartnerTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Deactivate(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deactivating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
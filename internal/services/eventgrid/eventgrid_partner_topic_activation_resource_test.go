@@ -0,0 +1,135 @@
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2022-06-15/partnertopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridPartnerTopicActivationResource struct{}
+
+func TestAccEventGridPartnerTopicActivation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic_activation", "test")
+	r := EventGridPartnerTopicActivationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source").HasValue("acctest-source"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridPartnerTopicActivation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_partner_topic_activation", "test")
+	r := EventGridPartnerTopicActivationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (EventGridPartnerTopicActivationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := partnertopics.ParsePartnerTopicID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.PartnerTopicsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.ActivationState != nil && *resp.Model.Properties.ActivationState == partnertopics.PartnerTopicActivationStateActivated), nil
+}
+
+func (EventGridPartnerTopicActivationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_eventgrid_partner_registration" "test" {
+  name                = "acctestpr-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_eventgrid_partner_namespace" "test" {
+  name                    = "acctestpn-%[1]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  partner_registration_id = azurerm_eventgrid_partner_registration.test.id
+}
+
+resource "azurerm_eventgrid_partner_configuration" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+
+  partner_authorization {
+    partner_registration_id = azurerm_eventgrid_partner_registration.test.partner_registration_id
+    partner_name            = azurerm_eventgrid_partner_registration.test.name
+  }
+}
+
+resource "azurerm_eventgrid_partner_namespace_channel" "test" {
+  name                 = "acctestch-%[1]d"
+  partner_namespace_id = azurerm_eventgrid_partner_namespace.test.id
+
+  partner_topic {
+    name                = "acctestpt-%[1]d"
+    resource_group_name = azurerm_resource_group.test.name
+    subscription_id     = data.azurerm_client_config.current.subscription_id
+    source              = "acctest-source"
+  }
+
+  depends_on = [azurerm_eventgrid_partner_configuration.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r EventGridPartnerTopicActivationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic_activation" "test" {
+  partner_topic_id = "${azurerm_resource_group.test.id}/providers/Microsoft.EventGrid/partnerTopics/${azurerm_eventgrid_partner_namespace_channel.test.partner_topic.0.name}"
+}
+`, r.template(data))
+}
+
+func (r EventGridPartnerTopicActivationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_partner_topic_activation" "import" {
+  partner_topic_id = azurerm_eventgrid_partner_topic_activation.test.partner_topic_id
+}
+`, r.basic(data))
+}
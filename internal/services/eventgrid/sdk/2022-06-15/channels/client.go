@@ -0,0 +1,15 @@
+package channels
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ChannelsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewChannelsClientWithBaseURI(endpoint string) ChannelsClient {
+	return ChannelsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
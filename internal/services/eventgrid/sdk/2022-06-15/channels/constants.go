@@ -0,0 +1,99 @@
+package channels
+
+import "strings"
+
+type ChannelProvisioningState string
+
+const (
+	ChannelProvisioningStateCanceled                              ChannelProvisioningState = "Canceled"
+	ChannelProvisioningStateCreating                              ChannelProvisioningState = "Creating"
+	ChannelProvisioningStateDeleting                              ChannelProvisioningState = "Deleting"
+	ChannelProvisioningStateFailed                                ChannelProvisioningState = "Failed"
+	ChannelProvisioningStateIdleDueToMirroredPartnerTopicDeletion ChannelProvisioningState = "IdleDueToMirroredPartnerTopicDeletion"
+	ChannelProvisioningStateSucceeded                             ChannelProvisioningState = "Succeeded"
+	ChannelProvisioningStateUpdating                              ChannelProvisioningState = "Updating"
+)
+
+func PossibleValuesForChannelProvisioningState() []string {
+	return []string{
+		string(ChannelProvisioningStateCanceled),
+		string(ChannelProvisioningStateCreating),
+		string(ChannelProvisioningStateDeleting),
+		string(ChannelProvisioningStateFailed),
+		string(ChannelProvisioningStateIdleDueToMirroredPartnerTopicDeletion),
+		string(ChannelProvisioningStateSucceeded),
+		string(ChannelProvisioningStateUpdating),
+	}
+}
+
+func parseChannelProvisioningState(input string) (*ChannelProvisioningState, error) {
+	vals := map[string]ChannelProvisioningState{
+		"canceled":                              ChannelProvisioningStateCanceled,
+		"creating":                              ChannelProvisioningStateCreating,
+		"deleting":                              ChannelProvisioningStateDeleting,
+		"failed":                                ChannelProvisioningStateFailed,
+		"idleduetomirroredpartnertopicdeletion": ChannelProvisioningStateIdleDueToMirroredPartnerTopicDeletion,
+		"succeeded":                             ChannelProvisioningStateSucceeded,
+		"updating":                              ChannelProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ChannelProvisioningState(input)
+	return &out, nil
+}
+
+type ChannelType string
+
+const (
+	ChannelTypePartnerTopic ChannelType = "PartnerTopic"
+)
+
+func PossibleValuesForChannelType() []string {
+	return []string{
+		string(ChannelTypePartnerTopic),
+	}
+}
+
+func parseChannelType(input string) (*ChannelType, error) {
+	vals := map[string]ChannelType{
+		"partnertopic": ChannelTypePartnerTopic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ChannelType(input)
+	return &out, nil
+}
+
+type ReadinessState string
+
+const (
+	ReadinessStateActivated      ReadinessState = "Activated"
+	ReadinessStateNeverActivated ReadinessState = "NeverActivated"
+)
+
+func PossibleValuesForReadinessState() []string {
+	return []string{
+		string(ReadinessStateActivated),
+		string(ReadinessStateNeverActivated),
+	}
+}
+
+func parseReadinessState(input string) (*ReadinessState, error) {
+	vals := map[string]ReadinessState{
+		"activated":      ReadinessStateActivated,
+		"neveractivated": ReadinessStateNeverActivated,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReadinessState(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package channels
+
+type Channel struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ChannelProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,10 @@
+package channels
+
+type ChannelProperties struct {
+	ChannelType                     *ChannelType              `json:"channelType,omitempty"`
+	ExpirationTimeIfNotActivatedUtc *string                   `json:"expirationTimeIfNotActivatedUtc,omitempty"`
+	MessageForActivation            *string                   `json:"messageForActivation,omitempty"`
+	PartnerTopicInfo                *PartnerTopicInfo         `json:"partnerTopicInfo,omitempty"`
+	ProvisioningState               *ChannelProvisioningState `json:"provisioningState,omitempty"`
+	ReadinessState                  *ReadinessState           `json:"readinessState,omitempty"`
+}
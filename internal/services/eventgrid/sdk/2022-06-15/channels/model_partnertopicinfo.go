@@ -0,0 +1,8 @@
+package channels
+
+type PartnerTopicInfo struct {
+	AzureSubscriptionId *string `json:"azureSubscriptionId,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	ResourceGroupName   *string `json:"resourceGroupName,omitempty"`
+	Source              *string `json:"source,omitempty"`
+}
@@ -0,0 +1,9 @@
+package channels
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/channels/%s", defaultApiVersion)
+}
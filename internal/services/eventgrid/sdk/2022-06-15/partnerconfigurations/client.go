@@ -0,0 +1,15 @@
+package partnerconfigurations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PartnerConfigurationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPartnerConfigurationsClientWithBaseURI(endpoint string) PartnerConfigurationsClient {
+	return PartnerConfigurationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,43 @@
+package partnerconfigurations
+
+import "strings"
+
+type PartnerConfigurationProvisioningState string
+
+const (
+	PartnerConfigurationProvisioningStateCanceled  PartnerConfigurationProvisioningState = "Canceled"
+	PartnerConfigurationProvisioningStateCreating  PartnerConfigurationProvisioningState = "Creating"
+	PartnerConfigurationProvisioningStateDeleting  PartnerConfigurationProvisioningState = "Deleting"
+	PartnerConfigurationProvisioningStateFailed    PartnerConfigurationProvisioningState = "Failed"
+	PartnerConfigurationProvisioningStateSucceeded PartnerConfigurationProvisioningState = "Succeeded"
+	PartnerConfigurationProvisioningStateUpdating  PartnerConfigurationProvisioningState = "Updating"
+)
+
+func PossibleValuesForPartnerConfigurationProvisioningState() []string {
+	return []string{
+		string(PartnerConfigurationProvisioningStateCanceled),
+		string(PartnerConfigurationProvisioningStateCreating),
+		string(PartnerConfigurationProvisioningStateDeleting),
+		string(PartnerConfigurationProvisioningStateFailed),
+		string(PartnerConfigurationProvisioningStateSucceeded),
+		string(PartnerConfigurationProvisioningStateUpdating),
+	}
+}
+
+func parsePartnerConfigurationProvisioningState(input string) (*PartnerConfigurationProvisioningState, error) {
+	vals := map[string]PartnerConfigurationProvisioningState{
+		"canceled":  PartnerConfigurationProvisioningStateCanceled,
+		"creating":  PartnerConfigurationProvisioningStateCreating,
+		"deleting":  PartnerConfigurationProvisioningStateDeleting,
+		"failed":    PartnerConfigurationProvisioningStateFailed,
+		"succeeded": PartnerConfigurationProvisioningStateSucceeded,
+		"updating":  PartnerConfigurationProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartnerConfigurationProvisioningState(input)
+	return &out, nil
+}
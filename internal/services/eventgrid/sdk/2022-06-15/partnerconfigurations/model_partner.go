@@ -0,0 +1,7 @@
+package partnerconfigurations
+
+type Partner struct {
+	AuthorizationExpirationTimeInUtc *string `json:"authorizationExpirationTimeInUtc,omitempty"`
+	PartnerName                      *string `json:"partnerName,omitempty"`
+	PartnerRegistrationImmutableId   *string `json:"partnerRegistrationImmutableId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package partnerconfigurations
+
+type PartnerAuthorization struct {
+	AuthorizedPartnersList             *[]Partner `json:"authorizedPartnersList,omitempty"`
+	DefaultMaximumExpirationTimeInDays *int64     `json:"defaultMaximumExpirationTimeInDays,omitempty"`
+}
@@ -0,0 +1,6 @@
+package partnerconfigurations
+
+type PartnerConfigurationProperties struct {
+	PartnerAuthorization *PartnerAuthorization                  `json:"partnerAuthorization,omitempty"`
+	ProvisioningState    *PartnerConfigurationProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,9 @@
+package partnerconfigurations
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/partnerconfigurations/%s", defaultApiVersion)
+}
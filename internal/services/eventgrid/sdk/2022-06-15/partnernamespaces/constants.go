@@ -0,0 +1,124 @@
+package partnernamespaces
+
+import "strings"
+
+type IPActionType string
+
+const (
+	IPActionTypeAllow IPActionType = "Allow"
+)
+
+func PossibleValuesForIPActionType() []string {
+	return []string{
+		string(IPActionTypeAllow),
+	}
+}
+
+func parseIPActionType(input string) (*IPActionType, error) {
+	vals := map[string]IPActionType{
+		"allow": IPActionTypeAllow,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPActionType(input)
+	return &out, nil
+}
+
+type PartnerNamespaceProvisioningState string
+
+const (
+	PartnerNamespaceProvisioningStateCanceled  PartnerNamespaceProvisioningState = "Canceled"
+	PartnerNamespaceProvisioningStateCreating  PartnerNamespaceProvisioningState = "Creating"
+	PartnerNamespaceProvisioningStateDeleting  PartnerNamespaceProvisioningState = "Deleting"
+	PartnerNamespaceProvisioningStateFailed    PartnerNamespaceProvisioningState = "Failed"
+	PartnerNamespaceProvisioningStateSucceeded PartnerNamespaceProvisioningState = "Succeeded"
+	PartnerNamespaceProvisioningStateUpdating  PartnerNamespaceProvisioningState = "Updating"
+)
+
+func PossibleValuesForPartnerNamespaceProvisioningState() []string {
+	return []string{
+		string(PartnerNamespaceProvisioningStateCanceled),
+		string(PartnerNamespaceProvisioningStateCreating),
+		string(PartnerNamespaceProvisioningStateDeleting),
+		string(PartnerNamespaceProvisioningStateFailed),
+		string(PartnerNamespaceProvisioningStateSucceeded),
+		string(PartnerNamespaceProvisioningStateUpdating),
+	}
+}
+
+func parsePartnerNamespaceProvisioningState(input string) (*PartnerNamespaceProvisioningState, error) {
+	vals := map[string]PartnerNamespaceProvisioningState{
+		"canceled":  PartnerNamespaceProvisioningStateCanceled,
+		"creating":  PartnerNamespaceProvisioningStateCreating,
+		"deleting":  PartnerNamespaceProvisioningStateDeleting,
+		"failed":    PartnerNamespaceProvisioningStateFailed,
+		"succeeded": PartnerNamespaceProvisioningStateSucceeded,
+		"updating":  PartnerNamespaceProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartnerNamespaceProvisioningState(input)
+	return &out, nil
+}
+
+type PartnerTopicRoutingMode string
+
+const (
+	PartnerTopicRoutingModeChannelNameHeader    PartnerTopicRoutingMode = "ChannelNameHeader"
+	PartnerTopicRoutingModeSourceEventAttribute PartnerTopicRoutingMode = "SourceEventAttribute"
+)
+
+func PossibleValuesForPartnerTopicRoutingMode() []string {
+	return []string{
+		string(PartnerTopicRoutingModeChannelNameHeader),
+		string(PartnerTopicRoutingModeSourceEventAttribute),
+	}
+}
+
+func parsePartnerTopicRoutingMode(input string) (*PartnerTopicRoutingMode, error) {
+	vals := map[string]PartnerTopicRoutingMode{
+		"channelnameheader":    PartnerTopicRoutingModeChannelNameHeader,
+		"sourceeventattribute": PartnerTopicRoutingModeSourceEventAttribute,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartnerTopicRoutingMode(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
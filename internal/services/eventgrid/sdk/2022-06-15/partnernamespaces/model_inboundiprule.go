@@ -0,0 +1,6 @@
+package partnernamespaces
+
+type InboundIPRule struct {
+	Action *IPActionType `json:"action,omitempty"`
+	IPMask *string       `json:"ipMask,omitempty"`
+}
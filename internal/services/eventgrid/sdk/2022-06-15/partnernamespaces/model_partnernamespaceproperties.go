@@ -0,0 +1,11 @@
+package partnernamespaces
+
+type PartnerNamespaceProperties struct {
+	DisableLocalAuth                    *bool                              `json:"disableLocalAuth,omitempty"`
+	Endpoint                            *string                            `json:"endpoint,omitempty"`
+	InboundIPRules                      *[]InboundIPRule                   `json:"inboundIpRules,omitempty"`
+	PartnerRegistrationFullyQualifiedId *string                            `json:"partnerRegistrationFullyQualifiedId,omitempty"`
+	PartnerTopicRoutingMode             *PartnerTopicRoutingMode           `json:"partnerTopicRoutingMode,omitempty"`
+	ProvisioningState                   *PartnerNamespaceProvisioningState `json:"provisioningState,omitempty"`
+	PublicNetworkAccess                 *PublicNetworkAccess               `json:"publicNetworkAccess,omitempty"`
+}
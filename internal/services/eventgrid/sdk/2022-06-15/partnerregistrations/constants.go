@@ -0,0 +1,43 @@
+package partnerregistrations
+
+import "strings"
+
+type PartnerRegistrationProvisioningState string
+
+const (
+	PartnerRegistrationProvisioningStateCanceled  PartnerRegistrationProvisioningState = "Canceled"
+	PartnerRegistrationProvisioningStateCreating  PartnerRegistrationProvisioningState = "Creating"
+	PartnerRegistrationProvisioningStateDeleting  PartnerRegistrationProvisioningState = "Deleting"
+	PartnerRegistrationProvisioningStateFailed    PartnerRegistrationProvisioningState = "Failed"
+	PartnerRegistrationProvisioningStateSucceeded PartnerRegistrationProvisioningState = "Succeeded"
+	PartnerRegistrationProvisioningStateUpdating  PartnerRegistrationProvisioningState = "Updating"
+)
+
+func PossibleValuesForPartnerRegistrationProvisioningState() []string {
+	return []string{
+		string(PartnerRegistrationProvisioningStateCanceled),
+		string(PartnerRegistrationProvisioningStateCreating),
+		string(PartnerRegistrationProvisioningStateDeleting),
+		string(PartnerRegistrationProvisioningStateFailed),
+		string(PartnerRegistrationProvisioningStateSucceeded),
+		string(PartnerRegistrationProvisioningStateUpdating),
+	}
+}
+
+func parsePartnerRegistrationProvisioningState(input string) (*PartnerRegistrationProvisioningState, error) {
+	vals := map[string]PartnerRegistrationProvisioningState{
+		"canceled":  PartnerRegistrationProvisioningStateCanceled,
+		"creating":  PartnerRegistrationProvisioningStateCreating,
+		"deleting":  PartnerRegistrationProvisioningStateDeleting,
+		"failed":    PartnerRegistrationProvisioningStateFailed,
+		"succeeded": PartnerRegistrationProvisioningStateSucceeded,
+		"updating":  PartnerRegistrationProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartnerRegistrationProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package partnerregistrations
+
+type PartnerRegistrationProperties struct {
+	PartnerRegistrationImmutableId *string                               `json:"partnerRegistrationImmutableId,omitempty"`
+	ProvisioningState              *PartnerRegistrationProvisioningState `json:"provisioningState,omitempty"`
+}
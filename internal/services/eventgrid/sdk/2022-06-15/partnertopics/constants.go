@@ -0,0 +1,77 @@
+package partnertopics
+
+import "strings"
+
+type PartnerTopicActivationState string
+
+const (
+	PartnerTopicActivationStateActivated      PartnerTopicActivationState = "Activated"
+	PartnerTopicActivationStateDeactivated    PartnerTopicActivationState = "Deactivated"
+	PartnerTopicActivationStateNeverActivated PartnerTopicActivationState = "NeverActivated"
+)
+
+func PossibleValuesForPartnerTopicActivationState() []string {
+	return []string{
+		string(PartnerTopicActivationStateActivated),
+		string(PartnerTopicActivationStateDeactivated),
+		string(PartnerTopicActivationStateNeverActivated),
+	}
+}
+
+func parsePartnerTopicActivationState(input string) (*PartnerTopicActivationState, error) {
+	vals := map[string]PartnerTopicActivationState{
+		"activated":      PartnerTopicActivationStateActivated,
+		"deactivated":    PartnerTopicActivationStateDeactivated,
+		"neveractivated": PartnerTopicActivationStateNeverActivated,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartnerTopicActivationState(input)
+	return &out, nil
+}
+
+type PartnerTopicProvisioningState string
+
+const (
+	PartnerTopicProvisioningStateCanceled                                 PartnerTopicProvisioningState = "Canceled"
+	PartnerTopicProvisioningStateCreating                                 PartnerTopicProvisioningState = "Creating"
+	PartnerTopicProvisioningStateDeleting                                 PartnerTopicProvisioningState = "Deleting"
+	PartnerTopicProvisioningStateFailed                                   PartnerTopicProvisioningState = "Failed"
+	PartnerTopicProvisioningStateIdleDueToMirroredChannelResourceDeletion PartnerTopicProvisioningState = "IdleDueToMirroredChannelResourceDeletion"
+	PartnerTopicProvisioningStateSucceeded                                PartnerTopicProvisioningState = "Succeeded"
+	PartnerTopicProvisioningStateUpdating                                 PartnerTopicProvisioningState = "Updating"
+)
+
+func PossibleValuesForPartnerTopicProvisioningState() []string {
+	return []string{
+		string(PartnerTopicProvisioningStateCanceled),
+		string(PartnerTopicProvisioningStateCreating),
+		string(PartnerTopicProvisioningStateDeleting),
+		string(PartnerTopicProvisioningStateFailed),
+		string(PartnerTopicProvisioningStateIdleDueToMirroredChannelResourceDeletion),
+		string(PartnerTopicProvisioningStateSucceeded),
+		string(PartnerTopicProvisioningStateUpdating),
+	}
+}
+
+func parsePartnerTopicProvisioningState(input string) (*PartnerTopicProvisioningState, error) {
+	vals := map[string]PartnerTopicProvisioningState{
+		"canceled": PartnerTopicProvisioningStateCanceled,
+		"creating": PartnerTopicProvisioningStateCreating,
+		"deleting": PartnerTopicProvisioningStateDeleting,
+		"failed":   PartnerTopicProvisioningStateFailed,
+		"idleduetomirroredchannelresourcedeletion": PartnerTopicProvisioningStateIdleDueToMirroredChannelResourceDeletion,
+		"succeeded": PartnerTopicProvisioningStateSucceeded,
+		"updating":  PartnerTopicProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartnerTopicProvisioningState(input)
+	return &out, nil
+}
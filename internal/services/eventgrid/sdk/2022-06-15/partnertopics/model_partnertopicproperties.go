@@ -0,0 +1,11 @@
+package partnertopics
+
+type PartnerTopicProperties struct {
+	ActivationState                 *PartnerTopicActivationState   `json:"activationState,omitempty"`
+	ExpirationTimeIfNotActivatedUtc *string                        `json:"expirationTimeIfNotActivatedUtc,omitempty"`
+	MessageForActivation            *string                        `json:"messageForActivation,omitempty"`
+	PartnerRegistrationImmutableId  *string                        `json:"partnerRegistrationImmutableId,omitempty"`
+	PartnerTopicFriendlyDescription *string                        `json:"partnerTopicFriendlyDescription,omitempty"`
+	ProvisioningState               *PartnerTopicProvisioningState `json:"provisioningState,omitempty"`
+	Source                          *string                        `json:"source,omitempty"`
+}
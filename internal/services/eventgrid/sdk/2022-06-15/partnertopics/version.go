@@ -0,0 +1,9 @@
+package partnertopics
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/partnertopics/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package clientgroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ClientGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClientGroupsClientWithBaseURI(endpoint string) ClientGroupsClient {
+	return ClientGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
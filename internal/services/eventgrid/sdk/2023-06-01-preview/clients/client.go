@@ -0,0 +1,15 @@
+package clients
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ClientsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClientsClientWithBaseURI(endpoint string) ClientsClient {
+	return ClientsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
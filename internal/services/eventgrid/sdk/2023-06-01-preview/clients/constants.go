@@ -0,0 +1,71 @@
+package clients
+
+import "strings"
+
+type ClientCertificateValidationScheme string
+
+const (
+	ClientCertificateValidationSchemeDnsMatchesAuthenticationName     ClientCertificateValidationScheme = "DnsMatchesAuthenticationName"
+	ClientCertificateValidationSchemeEmailMatchesAuthenticationName   ClientCertificateValidationScheme = "EmailMatchesAuthenticationName"
+	ClientCertificateValidationSchemeIPMatchesAuthenticationName      ClientCertificateValidationScheme = "IPMatchesAuthenticationName"
+	ClientCertificateValidationSchemeSubjectMatchesAuthenticationName ClientCertificateValidationScheme = "SubjectMatchesAuthenticationName"
+	ClientCertificateValidationSchemeThumbprintMatch                  ClientCertificateValidationScheme = "ThumbprintMatch"
+	ClientCertificateValidationSchemeUriMatchesAuthenticationName     ClientCertificateValidationScheme = "UriMatchesAuthenticationName"
+)
+
+func PossibleValuesForClientCertificateValidationScheme() []string {
+	return []string{
+		string(ClientCertificateValidationSchemeDnsMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeEmailMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeIPMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeSubjectMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeThumbprintMatch),
+		string(ClientCertificateValidationSchemeUriMatchesAuthenticationName),
+	}
+}
+
+func parseClientCertificateValidationScheme(input string) (*ClientCertificateValidationScheme, error) {
+	vals := map[string]ClientCertificateValidationScheme{
+		"dnsmatchesauthenticationname":     ClientCertificateValidationSchemeDnsMatchesAuthenticationName,
+		"emailmatchesauthenticationname":   ClientCertificateValidationSchemeEmailMatchesAuthenticationName,
+		"ipmatchesauthenticationname":      ClientCertificateValidationSchemeIPMatchesAuthenticationName,
+		"subjectmatchesauthenticationname": ClientCertificateValidationSchemeSubjectMatchesAuthenticationName,
+		"thumbprintmatch":                  ClientCertificateValidationSchemeThumbprintMatch,
+		"urimatchesauthenticationname":     ClientCertificateValidationSchemeUriMatchesAuthenticationName,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ClientCertificateValidationScheme(input)
+	return &out, nil
+}
+
+type ClientState string
+
+const (
+	ClientStateDisabled ClientState = "Disabled"
+	ClientStateEnabled  ClientState = "Enabled"
+)
+
+func PossibleValuesForClientState() []string {
+	return []string{
+		string(ClientStateDisabled),
+		string(ClientStateEnabled),
+	}
+}
+
+func parseClientState(input string) (*ClientState, error) {
+	vals := map[string]ClientState{
+		"disabled": ClientStateDisabled,
+		"enabled":  ClientStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ClientState(input)
+	return &out, nil
+}
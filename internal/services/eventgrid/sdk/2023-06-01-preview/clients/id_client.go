@@ -0,0 +1,137 @@
+package clients
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ClientId{}
+
+// ClientId is a struct representing the Resource ID for a Client
+type ClientId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	NamespaceName     string
+	ClientName        string
+}
+
+// NewClientID returns a new ClientId struct
+func NewClientID(subscriptionId string, resourceGroupName string, namespaceName string, clientName string) ClientId {
+	return ClientId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		NamespaceName:     namespaceName,
+		ClientName:        clientName,
+	}
+}
+
+// ParseClientID parses 'input' into a ClientId
+func ParseClientID(input string) (*ClientId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ClientId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ClientId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NamespaceName, ok = parsed.Parsed["namespaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'namespaceName' was not found in the resource id %q", input)
+	}
+
+	if id.ClientName, ok = parsed.Parsed["clientName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clientName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseClientIDInsensitively parses 'input' case-insensitively into a ClientId
+// note: this method should only be used for API response data and not user input
+func ParseClientIDInsensitively(input string) (*ClientId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ClientId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ClientId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NamespaceName, ok = parsed.Parsed["namespaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'namespaceName' was not found in the resource id %q", input)
+	}
+
+	if id.ClientName, ok = parsed.Parsed["clientName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clientName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateClientID checks that 'input' can be parsed as a Client ID
+func ValidateClientID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseClientID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Client ID
+func (id ClientId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/clients/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.ClientName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Client ID
+func (id ClientId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftEventGrid", "Microsoft.EventGrid", "Microsoft.EventGrid"),
+		resourceids.StaticSegment("staticNamespaces", "namespaces", "namespaces"),
+		resourceids.UserSpecifiedSegment("namespaceName", "namespaceValue"),
+		resourceids.StaticSegment("staticClients", "clients", "clients"),
+		resourceids.UserSpecifiedSegment("clientName", "clientValue"),
+	}
+}
+
+// String returns a human-readable description of this Client ID
+func (id ClientId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Namespace Name: %q", id.NamespaceName),
+		fmt.Sprintf("Client Name: %q", id.ClientName),
+	}
+	return fmt.Sprintf("Client (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package clients
+
+type Client struct {
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *ClientProperties `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
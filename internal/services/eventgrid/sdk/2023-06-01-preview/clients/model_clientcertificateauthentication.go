@@ -0,0 +1,6 @@
+package clients
+
+type ClientCertificateAuthentication struct {
+	AllowedThumbprints *[]string                          `json:"allowedThumbprints,omitempty"`
+	ValidationScheme   *ClientCertificateValidationScheme `json:"validationScheme,omitempty"`
+}
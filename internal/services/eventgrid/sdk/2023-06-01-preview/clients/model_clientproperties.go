@@ -0,0 +1,10 @@
+package clients
+
+type ClientProperties struct {
+	Attributes                      *map[string]interface{}          `json:"attributes,omitempty"`
+	AuthenticationName              *string                          `json:"authenticationName,omitempty"`
+	ClientCertificateAuthentication *ClientCertificateAuthentication `json:"clientCertificateAuthentication,omitempty"`
+	Description                     *string                          `json:"description,omitempty"`
+	ProvisioningState               *string                          `json:"provisioningState,omitempty"`
+	State                           *ClientState                     `json:"state,omitempty"`
+}
@@ -0,0 +1,9 @@
+package clients
+
+import "fmt"
+
+const defaultApiVersion = "2023-06-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/clients/%s", defaultApiVersion)
+}
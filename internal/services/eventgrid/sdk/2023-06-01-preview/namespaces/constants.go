@@ -0,0 +1,232 @@
+package namespaces
+
+import "strings"
+
+type AlternativeAuthenticationNameSource string
+
+const (
+	AlternativeAuthenticationNameSourceClientCertificateDns     AlternativeAuthenticationNameSource = "ClientCertificateDns"
+	AlternativeAuthenticationNameSourceClientCertificateEmail   AlternativeAuthenticationNameSource = "ClientCertificateEmail"
+	AlternativeAuthenticationNameSourceClientCertificateIp      AlternativeAuthenticationNameSource = "ClientCertificateIp"
+	AlternativeAuthenticationNameSourceClientCertificateSubject AlternativeAuthenticationNameSource = "ClientCertificateSubject"
+	AlternativeAuthenticationNameSourceClientCertificateUri     AlternativeAuthenticationNameSource = "ClientCertificateUri"
+)
+
+func PossibleValuesForAlternativeAuthenticationNameSource() []string {
+	return []string{
+		string(AlternativeAuthenticationNameSourceClientCertificateDns),
+		string(AlternativeAuthenticationNameSourceClientCertificateEmail),
+		string(AlternativeAuthenticationNameSourceClientCertificateIp),
+		string(AlternativeAuthenticationNameSourceClientCertificateSubject),
+		string(AlternativeAuthenticationNameSourceClientCertificateUri),
+	}
+}
+
+func parseAlternativeAuthenticationNameSource(input string) (*AlternativeAuthenticationNameSource, error) {
+	vals := map[string]AlternativeAuthenticationNameSource{
+		"clientcertificatedns":     AlternativeAuthenticationNameSourceClientCertificateDns,
+		"clientcertificateemail":   AlternativeAuthenticationNameSourceClientCertificateEmail,
+		"clientcertificateip":      AlternativeAuthenticationNameSourceClientCertificateIp,
+		"clientcertificatesubject": AlternativeAuthenticationNameSourceClientCertificateSubject,
+		"clientcertificateuri":     AlternativeAuthenticationNameSourceClientCertificateUri,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AlternativeAuthenticationNameSource(input)
+	return &out, nil
+}
+
+type IPActionType string
+
+const (
+	IPActionTypeAllow IPActionType = "Allow"
+)
+
+func PossibleValuesForIPActionType() []string {
+	return []string{
+		string(IPActionTypeAllow),
+	}
+}
+
+func parseIPActionType(input string) (*IPActionType, error) {
+	vals := map[string]IPActionType{
+		"allow": IPActionTypeAllow,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPActionType(input)
+	return &out, nil
+}
+
+type NamespaceProvisioningState string
+
+const (
+	NamespaceProvisioningStateCanceled      NamespaceProvisioningState = "Canceled"
+	NamespaceProvisioningStateCreateFailed  NamespaceProvisioningState = "CreateFailed"
+	NamespaceProvisioningStateCreating      NamespaceProvisioningState = "Creating"
+	NamespaceProvisioningStateDeleteFailed  NamespaceProvisioningState = "DeleteFailed"
+	NamespaceProvisioningStateDeleted       NamespaceProvisioningState = "Deleted"
+	NamespaceProvisioningStateDeleting      NamespaceProvisioningState = "Deleting"
+	NamespaceProvisioningStateFailed        NamespaceProvisioningState = "Failed"
+	NamespaceProvisioningStateSucceeded     NamespaceProvisioningState = "Succeeded"
+	NamespaceProvisioningStateUpdatedFailed NamespaceProvisioningState = "UpdatedFailed"
+	NamespaceProvisioningStateUpdating      NamespaceProvisioningState = "Updating"
+)
+
+func PossibleValuesForNamespaceProvisioningState() []string {
+	return []string{
+		string(NamespaceProvisioningStateCanceled),
+		string(NamespaceProvisioningStateCreateFailed),
+		string(NamespaceProvisioningStateCreating),
+		string(NamespaceProvisioningStateDeleteFailed),
+		string(NamespaceProvisioningStateDeleted),
+		string(NamespaceProvisioningStateDeleting),
+		string(NamespaceProvisioningStateFailed),
+		string(NamespaceProvisioningStateSucceeded),
+		string(NamespaceProvisioningStateUpdatedFailed),
+		string(NamespaceProvisioningStateUpdating),
+	}
+}
+
+func parseNamespaceProvisioningState(input string) (*NamespaceProvisioningState, error) {
+	vals := map[string]NamespaceProvisioningState{
+		"canceled":      NamespaceProvisioningStateCanceled,
+		"createfailed":  NamespaceProvisioningStateCreateFailed,
+		"creating":      NamespaceProvisioningStateCreating,
+		"deletefailed":  NamespaceProvisioningStateDeleteFailed,
+		"deleted":       NamespaceProvisioningStateDeleted,
+		"deleting":      NamespaceProvisioningStateDeleting,
+		"failed":        NamespaceProvisioningStateFailed,
+		"succeeded":     NamespaceProvisioningStateSucceeded,
+		"updatedfailed": NamespaceProvisioningStateUpdatedFailed,
+		"updating":      NamespaceProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NamespaceProvisioningState(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled           PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled            PublicNetworkAccess = "Enabled"
+	PublicNetworkAccessSecuredByPerimeter PublicNetworkAccess = "SecuredByPerimeter"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+		string(PublicNetworkAccessSecuredByPerimeter),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled":           PublicNetworkAccessDisabled,
+		"enabled":            PublicNetworkAccessEnabled,
+		"securedbyperimeter": PublicNetworkAccessSecuredByPerimeter,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameStandard SkuName = "Standard"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameStandard),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"standard": SkuNameStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
+type TlsVersion string
+
+const (
+	TlsVersionOnePointZero TlsVersion = "1.0"
+	TlsVersionOnePointOne  TlsVersion = "1.1"
+	TlsVersionOnePointTwo  TlsVersion = "1.2"
+)
+
+func PossibleValuesForTlsVersion() []string {
+	return []string{
+		string(TlsVersionOnePointZero),
+		string(TlsVersionOnePointOne),
+		string(TlsVersionOnePointTwo),
+	}
+}
+
+func parseTlsVersion(input string) (*TlsVersion, error) {
+	vals := map[string]TlsVersion{
+		"1.0": TlsVersionOnePointZero,
+		"1.1": TlsVersionOnePointOne,
+		"1.2": TlsVersionOnePointTwo,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TlsVersion(input)
+	return &out, nil
+}
+
+type TopicSpacesConfigurationState string
+
+const (
+	TopicSpacesConfigurationStateDisabled TopicSpacesConfigurationState = "Disabled"
+	TopicSpacesConfigurationStateEnabled  TopicSpacesConfigurationState = "Enabled"
+)
+
+func PossibleValuesForTopicSpacesConfigurationState() []string {
+	return []string{
+		string(TopicSpacesConfigurationStateDisabled),
+		string(TopicSpacesConfigurationStateEnabled),
+	}
+}
+
+func parseTopicSpacesConfigurationState(input string) (*TopicSpacesConfigurationState, error) {
+	vals := map[string]TopicSpacesConfigurationState{
+		"disabled": TopicSpacesConfigurationStateDisabled,
+		"enabled":  TopicSpacesConfigurationStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TopicSpacesConfigurationState(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package namespaces
+
+type InboundIPRule struct {
+	Action *IPActionType `json:"action,omitempty"`
+	IPMask *string       `json:"ipMask,omitempty"`
+}
@@ -0,0 +1,16 @@
+package namespaces
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type Namespace struct {
+	Id         *string                                 `json:"id,omitempty"`
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Location   string                                  `json:"location"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties *NamespaceProperties                    `json:"properties,omitempty"`
+	Sku        *NamespaceSku                           `json:"sku,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+	Type       *string                                 `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package namespaces
+
+type NamespaceProperties struct {
+	InboundIPRules           *[]InboundIPRule            `json:"inboundIpRules,omitempty"`
+	IsZoneRedundant          *bool                       `json:"isZoneRedundant,omitempty"`
+	MinimumTlsVersionAllowed *TlsVersion                 `json:"minimumTlsVersionAllowed,omitempty"`
+	ProvisioningState        *NamespaceProvisioningState `json:"provisioningState,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess        `json:"publicNetworkAccess,omitempty"`
+	TopicSpacesConfiguration *TopicSpacesConfiguration   `json:"topicSpacesConfiguration,omitempty"`
+	TopicsConfiguration      *TopicsConfiguration        `json:"topicsConfiguration,omitempty"`
+}
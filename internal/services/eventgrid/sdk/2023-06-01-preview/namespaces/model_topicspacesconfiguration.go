@@ -0,0 +1,10 @@
+package namespaces
+
+type TopicSpacesConfiguration struct {
+	ClientAuthentication                       *ClientAuthenticationSettings  `json:"clientAuthentication,omitempty"`
+	Hostname                                   *string                        `json:"hostname,omitempty"`
+	MaximumClientSessionsPerAuthenticationName *int64                         `json:"maximumClientSessionsPerAuthenticationName,omitempty"`
+	MaximumSessionExpiryInHours                *int64                         `json:"maximumSessionExpiryInHours,omitempty"`
+	RouteTopicResourceId                       *string                        `json:"routeTopicResourceId,omitempty"`
+	State                                      *TopicSpacesConfigurationState `json:"state,omitempty"`
+}
@@ -0,0 +1,53 @@
+package namespacetopics
+
+import "strings"
+
+type EventInputSchema string
+
+const (
+	EventInputSchemaCloudEventSchemaVOneZero EventInputSchema = "CloudEventSchemaV1_0"
+)
+
+func PossibleValuesForEventInputSchema() []string {
+	return []string{
+		string(EventInputSchemaCloudEventSchemaVOneZero),
+	}
+}
+
+func parseEventInputSchema(input string) (*EventInputSchema, error) {
+	vals := map[string]EventInputSchema{
+		"cloudeventschemav1_0": EventInputSchemaCloudEventSchemaVOneZero,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EventInputSchema(input)
+	return &out, nil
+}
+
+type PublisherType string
+
+const (
+	PublisherTypeCustom PublisherType = "Custom"
+)
+
+func PossibleValuesForPublisherType() []string {
+	return []string{
+		string(PublisherTypeCustom),
+	}
+}
+
+func parsePublisherType(input string) (*PublisherType, error) {
+	vals := map[string]PublisherType{
+		"custom": PublisherTypeCustom,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublisherType(input)
+	return &out, nil
+}
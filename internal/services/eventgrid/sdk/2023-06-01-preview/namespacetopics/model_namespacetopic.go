@@ -0,0 +1,8 @@
+package namespacetopics
+
+type NamespaceTopic struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *NamespaceTopicProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package namespacetopics
+
+type NamespaceTopicProperties struct {
+	EventRetentionInDays *int64            `json:"eventRetentionInDays,omitempty"`
+	InputSchema          *EventInputSchema `json:"inputSchema,omitempty"`
+	ProvisioningState    *string           `json:"provisioningState,omitempty"`
+	PublisherType        *PublisherType    `json:"publisherType,omitempty"`
+}
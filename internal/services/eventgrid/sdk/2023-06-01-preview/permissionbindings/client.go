@@ -0,0 +1,15 @@
+package permissionbindings
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PermissionBindingsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPermissionBindingsClientWithBaseURI(endpoint string) PermissionBindingsClient {
+	return PermissionBindingsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
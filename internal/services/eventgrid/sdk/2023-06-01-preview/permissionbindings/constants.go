@@ -0,0 +1,31 @@
+package permissionbindings
+
+import "strings"
+
+type PermissionType string
+
+const (
+	PermissionTypePublisher  PermissionType = "Publisher"
+	PermissionTypeSubscriber PermissionType = "Subscriber"
+)
+
+func PossibleValuesForPermissionType() []string {
+	return []string{
+		string(PermissionTypePublisher),
+		string(PermissionTypeSubscriber),
+	}
+}
+
+func parsePermissionType(input string) (*PermissionType, error) {
+	vals := map[string]PermissionType{
+		"publisher":  PermissionTypePublisher,
+		"subscriber": PermissionTypeSubscriber,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PermissionType(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package permissionbindings
+
+type PermissionBindingProperties struct {
+	ClientGroupName   *string         `json:"clientGroupName,omitempty"`
+	Description       *string         `json:"description,omitempty"`
+	Permission        *PermissionType `json:"permission,omitempty"`
+	ProvisioningState *string         `json:"provisioningState,omitempty"`
+	TopicSpaceName    *string         `json:"topicSpaceName,omitempty"`
+}
@@ -0,0 +1,15 @@
+package topicspaces
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TopicSpacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTopicSpacesClientWithBaseURI(endpoint string) TopicSpacesClient {
+	return TopicSpacesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package topicspaces
+
+type TopicSpace struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *TopicSpaceProperties `json:"properties,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package topicspaces
+
+type TopicSpaceProperties struct {
+	Description       *string   `json:"description,omitempty"`
+	ProvisioningState *string   `json:"provisioningState,omitempty"`
+	TopicTemplates    *[]string `json:"topicTemplates,omitempty"`
+}
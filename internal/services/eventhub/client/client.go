@@ -11,9 +11,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2018-01-01-preview/eventhubsclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2018-01-01-preview/networkrulesets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-11-01/schemaregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2022-01-01-preview/applicationgroup"
 )
 
 type Client struct {
+	ApplicationGroupClient                 *applicationgroup.ApplicationGroupClient
 	ClusterClient                          *eventhubsclusters.EventHubsClustersClient
 	ConsumerGroupClient                    *consumergroups.ConsumerGroupsClient
 	DisasterRecoveryConfigsClient          *disasterrecoveryconfigs.DisasterRecoveryConfigsClient
@@ -23,9 +26,13 @@ type Client struct {
 	NamespacesClient                       *namespaces.NamespacesClient
 	NamespaceAuthorizationRulesClient      *authorizationrulesnamespaces.AuthorizationRulesNamespacesClient
 	NetworkRuleSetsClient                  *networkrulesets.NetworkRuleSetsClient
+	SchemaRegistryClient                   *schemaregistry.SchemaRegistryClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	applicationGroupClient := applicationgroup.NewApplicationGroupClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&applicationGroupClient.Client, o.ResourceManagerAuthorizer)
+
 	clustersClient := eventhubsclusters.NewEventHubsClustersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&clustersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -53,7 +60,11 @@ func NewClient(o *common.ClientOptions) *Client {
 	networkRuleSetsClient := networkrulesets.NewNetworkRuleSetsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&networkRuleSetsClient.Client, o.ResourceManagerAuthorizer)
 
+	schemaRegistryClient := schemaregistry.NewSchemaRegistryClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&schemaRegistryClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
+		ApplicationGroupClient:                 &applicationGroupClient,
 		ClusterClient:                          &clustersClient,
 		ConsumerGroupClient:                    &consumerGroupsClient,
 		DisasterRecoveryConfigsClient:          &disasterRecoveryConfigsClient,
@@ -63,5 +74,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		NamespacesClient:                       &namespacesClient,
 		NamespaceAuthorizationRulesClient:      &namespaceAuthorizationRulesClient,
 		NetworkRuleSetsClient:                  &networkRuleSetsClient,
+		SchemaRegistryClient:                   &schemaRegistryClient,
 	}
 }
@@ -0,0 +1,296 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2022-01-01-preview/applicationgroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NamespaceApplicationGroupModel struct {
+	Name                             string                                 `tfschema:"name"`
+	NamespaceId                      string                                 `tfschema:"namespace_id"`
+	ClientApplicationGroupIdentifier string                                 `tfschema:"client_application_group_identifier"`
+	Enabled                          bool                                   `tfschema:"enabled"`
+	ThrottlingPolicies               []NamespaceApplicationGroupPolicyModel `tfschema:"throttling_policy"`
+}
+
+type NamespaceApplicationGroupPolicyModel struct {
+	Name               string `tfschema:"name"`
+	MetricId           string `tfschema:"metric_id"`
+	RateLimitThreshold int64  `tfschema:"rate_limit_threshold"`
+}
+
+var _ sdk.Resource = NamespaceApplicationGroupResource{}
+var _ sdk.ResourceWithUpdate = NamespaceApplicationGroupResource{}
+
+type NamespaceApplicationGroupResource struct{}
+
+func (r NamespaceApplicationGroupResource) ResourceType() string {
+	return "azurerm_eventhub_namespace_application_group"
+}
+
+func (r NamespaceApplicationGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9]([-._a-zA-Z0-9]{0,48}[a-zA-Z0-9])?$"),
+				"The application group name can contain only letters, numbers, periods (.), hyphens (-),and underscores (_), up to 50 characters, and it must begin and end with a letter or number.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"client_application_group_identifier": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^(SASKeyName|AADAppID)=.+$"),
+				"`client_application_group_identifier` must be in the format `SASKeyName={name}` or `AADAppID={application id}`",
+			),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"throttling_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"metric_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(applicationgroup.PossibleValuesForMetricId(), false),
+					},
+
+					"rate_limit_threshold": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceApplicationGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state NamespaceApplicationGroupModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+
+			namespaceId, err := namespaces.ParseNamespaceID(state.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationgroup.NewApplicationGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, state.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := applicationgroup.ApplicationGroup{
+				Properties: &applicationgroup.ApplicationGroupProperties{
+					ClientAppGroupIdentifier: state.ClientApplicationGroupIdentifier,
+					IsEnabled:                utils.Bool(state.Enabled),
+					Policies:                 expandEventHubNamespaceApplicationGroupPolicies(state.ThrottlingPolicies),
+				},
+			}
+
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+			id, err := applicationgroup.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state NamespaceApplicationGroupModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			parameters := *resp.Model
+			if metadata.ResourceData.HasChange("enabled") {
+				parameters.Properties.IsEnabled = utils.Bool(state.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("throttling_policy") {
+				parameters.Properties.Policies = expandEventHubNamespaceApplicationGroupPolicies(state.ThrottlingPolicies)
+			}
+
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+			id, err := applicationgroup.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NamespaceApplicationGroupModel{
+				Name:        id.ApplicationGroupName,
+				NamespaceId: namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.ClientApplicationGroupIdentifier = props.ClientAppGroupIdentifier
+
+				// Application Groups are enabled by default, so `isEnabled` may not be returned
+				state.Enabled = true
+				if props.IsEnabled != nil {
+					state.Enabled = *props.IsEnabled
+				}
+
+				state.ThrottlingPolicies = flattenEventHubNamespaceApplicationGroupPolicies(props.Policies)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+			id, err := applicationgroup.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) ModelObject() interface{} {
+	return &NamespaceApplicationGroupModel{}
+}
+
+func (r NamespaceApplicationGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationgroup.ValidateApplicationGroupID
+}
+
+func expandEventHubNamespaceApplicationGroupPolicies(input []NamespaceApplicationGroupPolicyModel) *[]applicationgroup.ApplicationGroupPolicy {
+	policies := make([]applicationgroup.ApplicationGroupPolicy, 0)
+	for _, v := range input {
+		policies = append(policies, applicationgroup.ThrottlingPolicy{
+			Name:               v.Name,
+			MetricId:           applicationgroup.MetricId(v.MetricId),
+			RateLimitThreshold: v.RateLimitThreshold,
+		})
+	}
+
+	return &policies
+}
+
+func flattenEventHubNamespaceApplicationGroupPolicies(input *[]applicationgroup.ApplicationGroupPolicy) []NamespaceApplicationGroupPolicyModel {
+	output := make([]NamespaceApplicationGroupPolicyModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		policy, ok := v.(applicationgroup.ThrottlingPolicy)
+		if !ok {
+			continue
+		}
+
+		output = append(output, NamespaceApplicationGroupPolicyModel{
+			Name:               policy.Name,
+			MetricId:           string(policy.MetricId),
+			RateLimitThreshold: policy.RateLimitThreshold,
+		})
+	}
+
+	return output
+}
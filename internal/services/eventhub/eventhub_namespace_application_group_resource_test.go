@@ -0,0 +1,205 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2022-01-01-preview/applicationgroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventHubNamespaceApplicationGroupResource struct{}
+
+func TestAccEventHubNamespaceApplicationGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("throttling_policy.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_aadApplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aadApplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventHubNamespaceApplicationGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := applicationgroup.ParseApplicationGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Eventhub.ApplicationGroupClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (EventHubNamespaceApplicationGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  send                = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r EventHubNamespaceApplicationGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "test" {
+  name                                = "acctestag-%d"
+  namespace_id                        = azurerm_eventhub_namespace.test.id
+  client_application_group_identifier = "SASKeyName=${azurerm_eventhub_namespace_authorization_rule.test.name}"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventHubNamespaceApplicationGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "import" {
+  name                                = azurerm_eventhub_namespace_application_group.test.name
+  namespace_id                        = azurerm_eventhub_namespace_application_group.test.namespace_id
+  client_application_group_identifier = azurerm_eventhub_namespace_application_group.test.client_application_group_identifier
+}
+`, r.basic(data))
+}
+
+func (r EventHubNamespaceApplicationGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "test" {
+  name                                = "acctestag-%d"
+  namespace_id                        = azurerm_eventhub_namespace.test.id
+  client_application_group_identifier = "SASKeyName=${azurerm_eventhub_namespace_authorization_rule.test.name}"
+  enabled                             = false
+
+  throttling_policy {
+    name                 = "incoming-messages"
+    metric_id            = "IncomingMessages"
+    rate_limit_threshold = 1000
+  }
+
+  throttling_policy {
+    name                 = "outgoing-bytes"
+    metric_id            = "OutgoingBytes"
+    rate_limit_threshold = 20000
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventHubNamespaceApplicationGroupResource) aadApplication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_eventhub_namespace_application_group" "test" {
+  name                                = "acctestag-%d"
+  namespace_id                        = azurerm_eventhub_namespace.test.id
+  client_application_group_identifier = "AADAppID=${data.azurerm_client_config.current.client_id}"
+
+  throttling_policy {
+    name                 = "incoming-bytes"
+    metric_id            = "IncomingBytes"
+    rate_limit_threshold = 10000
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,176 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-11-01/schemaregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespaceSchemaGroupModel struct {
+	Name                string `tfschema:"name"`
+	NamespaceId         string `tfschema:"namespace_id"`
+	SchemaCompatibility string `tfschema:"schema_compatibility"`
+	SchemaType          string `tfschema:"schema_type"`
+}
+
+var _ sdk.Resource = NamespaceSchemaGroupResource{}
+
+type NamespaceSchemaGroupResource struct{}
+
+func (r NamespaceSchemaGroupResource) ResourceType() string {
+	return "azurerm_eventhub_namespace_schema_group"
+}
+
+func (r NamespaceSchemaGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ValidateEventHubNamespaceSchemaGroupName(),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"schema_compatibility": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(schemaregistry.PossibleValuesForSchemaCompatibility(), false),
+		},
+
+		"schema_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(schemaregistry.PossibleValuesForSchemaType(), false),
+		},
+	}
+}
+
+func (r NamespaceSchemaGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceSchemaGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state NamespaceSchemaGroupModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Eventhub.SchemaRegistryClient
+
+			namespaceId, err := namespaces.ParseNamespaceID(state.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := schemaregistry.NewSchemaGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, state.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			schemaCompatibility := schemaregistry.SchemaCompatibility(state.SchemaCompatibility)
+			schemaType := schemaregistry.SchemaType(state.SchemaType)
+			parameters := schemaregistry.SchemaGroup{
+				Properties: &schemaregistry.SchemaGroupProperties{
+					SchemaCompatibility: &schemaCompatibility,
+					SchemaType:          &schemaType,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceSchemaGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.SchemaRegistryClient
+			id, err := schemaregistry.ParseSchemaGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NamespaceSchemaGroupModel{
+				Name:        id.SchemaGroupName,
+				NamespaceId: namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if v := model.Properties.SchemaCompatibility; v != nil {
+					state.SchemaCompatibility = string(*v)
+				}
+				if v := model.Properties.SchemaType; v != nil {
+					state.SchemaType = string(*v)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r NamespaceSchemaGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.SchemaRegistryClient
+			id, err := schemaregistry.ParseSchemaGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceSchemaGroupResource) ModelObject() interface{} {
+	return &NamespaceSchemaGroupModel{}
+}
+
+func (r NamespaceSchemaGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schemaregistry.ValidateSchemaGroupID
+}
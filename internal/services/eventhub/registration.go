@@ -53,5 +53,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ConsumerGroupResource{},
+		NamespaceApplicationGroupResource{},
+		NamespaceSchemaGroupResource{},
 	}
 }
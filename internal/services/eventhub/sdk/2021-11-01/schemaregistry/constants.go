@@ -0,0 +1,62 @@
+package schemaregistry
+
+import "strings"
+
+type SchemaCompatibility string
+
+const (
+	SchemaCompatibilityBackward SchemaCompatibility = "Backward"
+	SchemaCompatibilityForward  SchemaCompatibility = "Forward"
+	SchemaCompatibilityNone     SchemaCompatibility = "None"
+)
+
+func PossibleValuesForSchemaCompatibility() []string {
+	return []string{
+		string(SchemaCompatibilityBackward),
+		string(SchemaCompatibilityForward),
+		string(SchemaCompatibilityNone),
+	}
+}
+
+func parseSchemaCompatibility(input string) (*SchemaCompatibility, error) {
+	vals := map[string]SchemaCompatibility{
+		"backward": SchemaCompatibilityBackward,
+		"forward":  SchemaCompatibilityForward,
+		"none":     SchemaCompatibilityNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SchemaCompatibility(input)
+	return &out, nil
+}
+
+type SchemaType string
+
+const (
+	SchemaTypeAvro    SchemaType = "Avro"
+	SchemaTypeUnknown SchemaType = "Unknown"
+)
+
+func PossibleValuesForSchemaType() []string {
+	return []string{
+		string(SchemaTypeAvro),
+		string(SchemaTypeUnknown),
+	}
+}
+
+func parseSchemaType(input string) (*SchemaType, error) {
+	vals := map[string]SchemaType{
+		"avro":    SchemaTypeAvro,
+		"unknown": SchemaTypeUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SchemaType(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package schemaregistry
+
+type SchemaGroup struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SchemaGroupProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
@@ -0,0 +1,10 @@
+package schemaregistry
+
+type SchemaGroupProperties struct {
+	CreatedAtUtc        *string              `json:"createdAtUtc,omitempty"`
+	ETag                *string              `json:"eTag,omitempty"`
+	GroupProperties     *map[string]string   `json:"groupProperties,omitempty"`
+	SchemaCompatibility *SchemaCompatibility `json:"schemaCompatibility,omitempty"`
+	SchemaType          *SchemaType          `json:"schemaType,omitempty"`
+	UpdatedAtUtc        *string              `json:"updatedAtUtc,omitempty"`
+}
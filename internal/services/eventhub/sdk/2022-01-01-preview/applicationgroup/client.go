@@ -0,0 +1,15 @@
+package applicationgroup
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ApplicationGroupClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewApplicationGroupClientWithBaseURI(endpoint string) ApplicationGroupClient {
+	return ApplicationGroupClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
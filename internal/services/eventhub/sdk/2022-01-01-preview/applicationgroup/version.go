@@ -0,0 +1,9 @@
+package applicationgroup
+
+import "fmt"
+
+const defaultApiVersion = "2022-01-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/applicationgroup/%s", defaultApiVersion)
+}
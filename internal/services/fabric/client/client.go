@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/sdk/2023-11-01/fabriccapacities"
+)
+
+type Client struct {
+	FabricCapacitiesClient *fabriccapacities.FabricCapacitiesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	fabricCapacitiesClient := fabriccapacities.NewFabricCapacitiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fabricCapacitiesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		FabricCapacitiesClient: &fabricCapacitiesClient,
+	}
+}
@@ -0,0 +1,296 @@
+package fabric
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/sdk/2023-11-01/fabriccapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = FabricCapacityResource{}
+
+type FabricCapacityResource struct{}
+
+type FabricCapacityModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	Administrators    []string          `tfschema:"administrators"`
+	Paused            bool              `tfschema:"paused"`
+	SkuName           string            `tfschema:"sku_name"`
+	Tags              map[string]string `tfschema:"tags"`
+	State             string            `tfschema:"state"`
+}
+
+func (r FabricCapacityResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CapacityName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"administrators": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"F2",
+				"F4",
+				"F8",
+				"F16",
+				"F32",
+				"F64",
+				"F128",
+				"F256",
+				"F512",
+				"F1024",
+				"F2048",
+			}, false),
+		},
+
+		"paused": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r FabricCapacityResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r FabricCapacityResource) ModelObject() interface{} {
+	return &FabricCapacityModel{}
+}
+
+func (r FabricCapacityResource) ResourceType() string {
+	return "azurerm_fabric_capacity"
+}
+
+func (r FabricCapacityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fabriccapacities.ValidateCapacityID
+}
+
+func (r FabricCapacityResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.FabricCapacitiesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model FabricCapacityModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fabriccapacities.NewCapacityID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := fabriccapacities.FabricCapacity{
+				Location: location.Normalize(model.Location),
+				Properties: fabriccapacities.FabricCapacityProperties{
+					Administration: fabriccapacities.CapacityAdministration{
+						Members: model.Administrators,
+					},
+				},
+				Sku: fabriccapacities.RpSku{
+					Name: model.SkuName,
+					Tier: fabriccapacities.RpSkuTierFabric,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if model.Paused {
+				if err := client.SuspendThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("pausing %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FabricCapacityResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.FabricCapacitiesClient
+
+			id, err := fabriccapacities.ParseCapacityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := FabricCapacityModel{
+				Name:              id.CapacityName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Administrators = model.Properties.Administration.Members
+				state.SkuName = model.Sku.Name
+
+				if model.Properties.State != nil {
+					state.State = string(*model.Properties.State)
+					state.Paused = *model.Properties.State == fabriccapacities.ResourceStatePaused || *model.Properties.State == fabriccapacities.ResourceStateSuspended
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r FabricCapacityResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.FabricCapacitiesClient
+
+			id, err := fabriccapacities.ParseCapacityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model FabricCapacityModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := fabriccapacities.FabricCapacityUpdate{}
+			if metadata.ResourceData.HasChange("administrators") {
+				parameters.Properties = &fabriccapacities.FabricCapacityUpdateProperties{
+					Administration: &fabriccapacities.CapacityAdministration{
+						Members: model.Administrators,
+					},
+				}
+			}
+
+			if metadata.ResourceData.HasChange("sku_name") {
+				parameters.Sku = &fabriccapacities.RpSku{
+					Name: model.SkuName,
+					Tier: fabriccapacities.RpSkuTierFabric,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = &model.Tags
+			}
+
+			wasPaused, _ := metadata.ResourceData.GetChange("paused")
+
+			if metadata.ResourceData.HasChanges("administrators", "sku_name", "tags") {
+				// a paused Capacity can't be updated, so it needs resuming first
+				if wasPaused.(bool) {
+					if err := client.ResumeThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("resuming %s: %+v", *id, err)
+					}
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				if model.Paused {
+					if err := client.SuspendThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("pausing %s: %+v", *id, err)
+					}
+				}
+
+				return nil
+			}
+
+			if metadata.ResourceData.HasChange("paused") {
+				if model.Paused {
+					if err := client.SuspendThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("pausing %s: %+v", *id, err)
+					}
+				} else {
+					if err := client.ResumeThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("resuming %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FabricCapacityResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.FabricCapacitiesClient
+
+			id, err := fabriccapacities.ParseCapacityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
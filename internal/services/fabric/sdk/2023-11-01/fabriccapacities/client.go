@@ -0,0 +1,15 @@
+package fabriccapacities
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FabricCapacitiesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFabricCapacitiesClientWithBaseURI(endpoint string) FabricCapacitiesClient {
+	return FabricCapacitiesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
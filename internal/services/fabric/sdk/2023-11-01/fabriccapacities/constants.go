@@ -0,0 +1,126 @@
+package fabriccapacities
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+		string(ProvisioningStateCanceled),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+		"updating":     ProvisioningStateUpdating,
+		"canceled":     ProvisioningStateCanceled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type ResourceState string
+
+const (
+	ResourceStateActive       ResourceState = "Active"
+	ResourceStateDeleting     ResourceState = "Deleting"
+	ResourceStateFailed       ResourceState = "Failed"
+	ResourceStatePaused       ResourceState = "Paused"
+	ResourceStatePausing      ResourceState = "Pausing"
+	ResourceStatePreparing    ResourceState = "Preparing"
+	ResourceStateProvisioning ResourceState = "Provisioning"
+	ResourceStateResuming     ResourceState = "Resuming"
+	ResourceStateScaling      ResourceState = "Scaling"
+	ResourceStateSuspended    ResourceState = "Suspended"
+	ResourceStateSuspending   ResourceState = "Suspending"
+	ResourceStateUpdating     ResourceState = "Updating"
+)
+
+func PossibleValuesForResourceState() []string {
+	return []string{
+		string(ResourceStateActive),
+		string(ResourceStateDeleting),
+		string(ResourceStateFailed),
+		string(ResourceStatePaused),
+		string(ResourceStatePausing),
+		string(ResourceStatePreparing),
+		string(ResourceStateProvisioning),
+		string(ResourceStateResuming),
+		string(ResourceStateScaling),
+		string(ResourceStateSuspended),
+		string(ResourceStateSuspending),
+		string(ResourceStateUpdating),
+	}
+}
+
+func parseResourceState(input string) (*ResourceState, error) {
+	vals := map[string]ResourceState{
+		"active":       ResourceStateActive,
+		"deleting":     ResourceStateDeleting,
+		"failed":       ResourceStateFailed,
+		"paused":       ResourceStatePaused,
+		"pausing":      ResourceStatePausing,
+		"preparing":    ResourceStatePreparing,
+		"provisioning": ResourceStateProvisioning,
+		"resuming":     ResourceStateResuming,
+		"scaling":      ResourceStateScaling,
+		"suspended":    ResourceStateSuspended,
+		"suspending":   ResourceStateSuspending,
+		"updating":     ResourceStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceState(input)
+	return &out, nil
+}
+
+type RpSkuTier string
+
+const (
+	RpSkuTierFabric RpSkuTier = "Fabric"
+)
+
+func PossibleValuesForRpSkuTier() []string {
+	return []string{
+		string(RpSkuTierFabric),
+	}
+}
+
+func parseRpSkuTier(input string) (*RpSkuTier, error) {
+	vals := map[string]RpSkuTier{
+		"fabric": RpSkuTierFabric,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RpSkuTier(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package fabriccapacities
+
+type FabricCapacityProperties struct {
+	Administration    CapacityAdministration `json:"administration"`
+	ProvisioningState *ProvisioningState     `json:"provisioningState,omitempty"`
+	State             *ResourceState         `json:"state,omitempty"`
+}
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCapacityName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"capacity1", false},
+		{"1capacity", true},
+		{"Capacity", true},
+		{"capacity-1", true},
+		{"capacity_1", true},
+		{"a" + strings.Repeat("b", 62), false},
+		{"a" + strings.Repeat("b", 63), true},
+	}
+
+	for _, test := range testCases {
+		_, es := CapacityName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q to succeed", test.input)
+		}
+	}
+}
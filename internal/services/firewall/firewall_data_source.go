@@ -109,6 +109,11 @@ func FirewallDataSource() *pluginsdk.Resource {
 				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
 			},
 
+			"dns_proxy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"virtual_hub": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -187,6 +192,8 @@ func FirewallDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			return fmt.Errorf("setting `dns_servers`: %+v", err)
 		}
 
+		d.Set("dns_proxy_enabled", flattenFirewallDNSProxyEnabled(props.AdditionalProperties))
+
 		if policy := props.FirewallPolicy; policy != nil {
 			d.Set("firewall_policy_id", policy.ID)
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	logAnalytiscValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -291,8 +292,9 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"key_vault_secret_id": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 						},
 						"name": {
 							Type:     pluginsdk.TypeString,
@@ -403,6 +405,10 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
+	if err := validateFirewallPolicyPremiumFeatures(d); err != nil {
+		return err
+	}
+
 	props := network.FirewallPolicy{
 		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
 			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
@@ -565,6 +571,25 @@ func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+// validateFirewallPolicyPremiumFeatures ensures that IDPS and TLS Inspection, which are only available on the
+// Premium tier, aren't configured on a Standard Firewall Policy - since the API otherwise returns an opaque error
+func validateFirewallPolicyPremiumFeatures(d *pluginsdk.ResourceData) error {
+	isPremium := d.Get("sku").(string) == string(network.FirewallPolicySkuTierPremium)
+
+	for _, field := range []string{"intrusion_detection", "tls_certificate"} {
+		if len(d.Get(field).([]interface{})) > 0 && !isPremium {
+			return fmt.Errorf("`%s` can only be specified when `sku` is set to `%s`", field, string(network.FirewallPolicySkuTierPremium))
+		}
+	}
+
+	// the CA certificate used for TLS Inspection is retrieved from Key Vault using the User Assigned Identity
+	if len(d.Get("tls_certificate").([]interface{})) > 0 && len(d.Get("identity").([]interface{})) == 0 {
+		return fmt.Errorf("an `identity` block must be specified when `tls_certificate` is set")
+	}
+
+	return nil
+}
+
 func expandFirewallPolicyThreatIntelWhitelist(input []interface{}) *network.FirewallPolicyThreatIntelWhitelist {
 	if len(input) == 0 || input[0] == nil {
 		return nil
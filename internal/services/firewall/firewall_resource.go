@@ -3,6 +3,7 @@ package firewall
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -168,6 +169,13 @@ func resourceFirewall() *pluginsdk.Resource {
 				},
 			},
 
+			// the DNS Proxy is always enabled when `dns_servers` are specified
+			"dns_proxy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			"private_ip_ranges": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -314,7 +322,7 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.Sku.Tier = network.AzureFirewallSkuTier(skuTier)
 	}
 
-	if dnsServerSetting := expandFirewallDNSServers(d.Get("dns_servers").([]interface{})); dnsServerSetting != nil {
+	if dnsServerSetting := expandFirewallDNSServers(d.Get("dns_servers").([]interface{}), d.Get("dns_proxy_enabled").(bool)); dnsServerSetting != nil {
 		for k, v := range dnsServerSetting {
 			parameters.AdditionalProperties[k] = v
 		}
@@ -422,6 +430,8 @@ func resourceFirewallRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			return fmt.Errorf("setting `dns_servers`: %+v", err)
 		}
 
+		d.Set("dns_proxy_enabled", flattenFirewallDNSProxyEnabled(props.AdditionalProperties))
+
 		if err := d.Set("private_ip_ranges", flattenFirewallPrivateIpRange(props.AdditionalProperties)); err != nil {
 			return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
 		}
@@ -612,8 +622,8 @@ func flattenFirewallIPConfigurations(input *[]network.AzureFirewallIPConfigurati
 	return result
 }
 
-func expandFirewallDNSServers(input []interface{}) map[string]*string {
-	if len(input) == 0 {
+func expandFirewallDNSServers(input []interface{}, proxyEnabled bool) map[string]*string {
+	if len(input) == 0 && !proxyEnabled {
 		return nil
 	}
 
@@ -622,11 +632,18 @@ func expandFirewallDNSServers(input []interface{}) map[string]*string {
 		servers = append(servers, server.(string))
 	}
 
+	// the DNS Proxy is implicitly enabled when custom DNS Servers are specified
+	enabled := len(servers) > 0 || proxyEnabled
+
 	// Swagger issue asking finalize these properties: https://github.com/Azure/azure-rest-api-specs/issues/11278
-	return map[string]*string{
-		"Network.DNS.EnableProxy": utils.String("true"),
-		"Network.DNS.Servers":     utils.String(strings.Join(servers, ",")),
+	output := map[string]*string{
+		"Network.DNS.EnableProxy": utils.String(strconv.FormatBool(enabled)),
+	}
+	if len(servers) > 0 {
+		output["Network.DNS.Servers"] = utils.String(strings.Join(servers, ","))
 	}
+
+	return output
 }
 
 func flattenFirewallDNSServers(input map[string]*string) []interface{} {
@@ -634,22 +651,21 @@ func flattenFirewallDNSServers(input map[string]*string) []interface{} {
 		return nil
 	}
 
-	enabled := false
-	if enabledPtr := input["Network.DNS.EnableProxy"]; enabledPtr != nil {
-		enabled = *enabledPtr == "true"
-	}
-
-	if !enabled {
-		return nil
-	}
-
 	servers := []string{}
-	if serversPtr := input["Network.DNS.Servers"]; serversPtr != nil {
+	if serversPtr := input["Network.DNS.Servers"]; serversPtr != nil && *serversPtr != "" {
 		servers = strings.Split(*serversPtr, ",")
 	}
 	return utils.FlattenStringSlice(&servers)
 }
 
+func flattenFirewallDNSProxyEnabled(input map[string]*string) bool {
+	if enabledPtr := input["Network.DNS.EnableProxy"]; enabledPtr != nil {
+		return strings.EqualFold(*enabledPtr, "true")
+	}
+
+	return false
+}
+
 func expandFirewallPrivateIpRange(input []interface{}) map[string]*string {
 	if len(input) == 0 {
 		return nil
@@ -93,6 +93,8 @@ https://docs.microsoft.com/en-us/azure/hdinsight/hdinsight-component-versioning#
 
 			"metastores": SchemaHDInsightsExternalMetastores(),
 
+			"network": SchemaHDInsightsNetwork(),
+
 			"storage_account": SchemaHDInsightsStorageAccounts(),
 
 			"roles": {
@@ -155,6 +157,9 @@ func resourceHDInsightStormClusterCreate(d *pluginsdk.ResourceData, meta interfa
 		configurations[k] = v
 	}
 
+	networkPropertiesRaw := d.Get("network").([]interface{})
+	networkProperties := ExpandHDInsightsNetwork(networkPropertiesRaw)
+
 	storageAccountsRaw := d.Get("storage_account").([]interface{})
 	storageAccounts, identity, err := ExpandHDInsightsStorageAccounts(storageAccountsRaw, nil)
 	if err != nil {
@@ -190,6 +195,7 @@ func resourceHDInsightStormClusterCreate(d *pluginsdk.ResourceData, meta interfa
 			OsType:                 hdinsight.OSTypeLinux,
 			ClusterVersion:         utils.String(clusterVersion),
 			MinSupportedTLSVersion: utils.String(tls),
+			NetworkProperties:      networkProperties,
 			ClusterDefinition: &hdinsight.ClusterDefinition{
 				Kind:             utils.String("Storm"),
 				ComponentVersion: componentVersions,
@@ -297,6 +303,12 @@ func resourceHDInsightStormClusterRead(d *pluginsdk.ResourceData, meta interface
 			flattenHDInsightsMetastores(d, configurations.Configurations)
 		}
 
+		if props.NetworkProperties != nil {
+			if err := d.Set("network", FlattenHDInsightsNetwork(props.NetworkProperties)); err != nil {
+				return fmt.Errorf("flattening `network`: %+v", err)
+			}
+		}
+
 		stormRoles := hdInsightRoleDefinition{
 			HeadNodeDef:      hdInsightStormClusterHeadNodeDefinition,
 			WorkerNodeDef:    hdInsightStormClusterWorkerNodeDefinition,
@@ -112,9 +112,9 @@ func SchemaHDInsightsGateway() *pluginsdk.Schema {
 				},
 				// NOTE: these are Required since if these aren't present you get a `500 bad request`
 				"username": {
-					Type:     pluginsdk.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
 				},
 				"password": {
 					Type:      pluginsdk.TypeString,
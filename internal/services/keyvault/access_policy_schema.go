@@ -49,11 +49,14 @@ func keyPermissions() []string {
 		"Delete",
 		"Encrypt",
 		"Get",
+		"GetRotationPolicy",
 		"Import",
 		"List",
 		"Purge",
 		"Recover",
 		"Restore",
+		"Rotate",
+		"SetRotationPolicy",
 		"Sign",
 		"UnwrapKey",
 		"Update",
@@ -4,19 +4,34 @@ import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyrotationpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/roleassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/securitydomain"
 )
 
 type Client struct {
-	ManagedHsmClient *keyvault.ManagedHsmsClient
-	ManagementClient *keyvaultmgmt.BaseClient
-	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+	KeyRotationPoliciesClient       *keyrotationpolicies.KeyRotationPoliciesClient
+	ManagedHsmClient                *keyvault.ManagedHsmsClient
+	ManagedHsmRoleAssignmentsClient *roleassignments.RoleAssignmentsClient
+	ManagedHsmSecurityDomainClient  *securitydomain.SecurityDomainClient
+	ManagementClient                *keyvaultmgmt.BaseClient
+	VaultsClient                    *keyvault.VaultsClient
+	options                         *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	keyRotationPoliciesClient := keyrotationpolicies.NewKeyRotationPoliciesClient()
+	o.ConfigureClient(&keyRotationPoliciesClient.Client, o.KeyVaultAuthorizer)
+
 	managedHsmClient := keyvault.NewManagedHsmsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedHsmClient.Client, o.ResourceManagerAuthorizer)
 
+	managedHsmRoleAssignmentsClient := roleassignments.NewRoleAssignmentsClient()
+	o.ConfigureClient(&managedHsmRoleAssignmentsClient.Client, o.KeyVaultAuthorizer)
+
+	managedHsmSecurityDomainClient := securitydomain.NewSecurityDomainClient()
+	o.ConfigureClient(&managedHsmSecurityDomainClient.Client, o.KeyVaultAuthorizer)
+
 	managementClient := keyvaultmgmt.New()
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
@@ -24,10 +39,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ManagedHsmClient: &managedHsmClient,
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
-		options:          o,
+		KeyRotationPoliciesClient:       &keyRotationPoliciesClient,
+		ManagedHsmClient:                &managedHsmClient,
+		ManagedHsmRoleAssignmentsClient: &managedHsmRoleAssignmentsClient,
+		ManagedHsmSecurityDomainClient:  &managedHsmSecurityDomainClient,
+		ManagementClient:                &managementClient,
+		VaultsClient:                    &vaultsClient,
+		options:                         o,
 	}
 }
 
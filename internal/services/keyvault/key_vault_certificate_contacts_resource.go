@@ -0,0 +1,265 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKeyVaultCertificateContacts() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultCertificateContactsCreateOrUpdate,
+		Read:   resourceKeyVaultCertificateContactsRead,
+		Update: resourceKeyVaultCertificateContactsCreateOrUpdate,
+		Delete: resourceKeyVaultCertificateContactsDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parseKeyVaultCertificateContactsID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"key_vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VaultID,
+			},
+
+			"contact": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"email": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"phone": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKeyVaultCertificateContactsCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("retrieving base uri for %s: %+v", *keyVaultId, err)
+	}
+
+	// the Certificate Contacts are a singleton within the Key Vault
+	if d.IsNewResource() {
+		existing, err := client.GetCertificateContacts(ctx, *keyVaultBaseUri)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Certificate Contacts (Key Vault %q): %s", *keyVaultBaseUri, err)
+			}
+		}
+
+		if existing.ContactList != nil && len(*existing.ContactList) > 0 && existing.ID != nil {
+			return tf.ImportAsExistsError("azurerm_key_vault_certificate_contacts", *existing.ID)
+		}
+	}
+
+	parameters := keyvault.Contacts{
+		ContactList: expandKeyVaultCertificateContacts(d.Get("contact").(*pluginsdk.Set).List()),
+	}
+	resp, err := client.SetCertificateContacts(ctx, *keyVaultBaseUri, parameters)
+	if err != nil {
+		return fmt.Errorf("setting Certificate Contacts (Key Vault %q): %s", *keyVaultBaseUri, err)
+	}
+
+	if d.IsNewResource() {
+		if resp.ID == nil || *resp.ID == "" {
+			return fmt.Errorf("setting Certificate Contacts (Key Vault %q): `id` was nil", *keyVaultBaseUri)
+		}
+		d.SetId(*resp.ID)
+	}
+
+	return resourceKeyVaultCertificateContactsRead(d, meta)
+}
+
+func resourceKeyVaultCertificateContactsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s for Certificate Contacts exists: %v", *keyVaultId, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Certificate Contacts were not found since %s doesn't exist - removing from state", *keyVaultId)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetCertificateContacts(ctx, id.KeyVaultBaseUrl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate Contacts (Key Vault URI %q) do not exist - removing from state", id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Certificate Contacts (Key Vault %q): %+v", id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("key_vault_id", keyVaultId.ID())
+
+	if err := d.Set("contact", flattenKeyVaultCertificateContacts(resp.ContactList)); err != nil {
+		return fmt.Errorf("setting `contact`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceKeyVaultCertificateContactsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.DeleteCertificateContacts(ctx, id.KeyVaultBaseUrl); err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Certificate Contacts (Key Vault %q): %+v", id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// parseKeyVaultCertificateContactsID parses the ID of the Certificate Contacts within a Key Vault, for example
+// https://example-keyvault.vault.azure.net/certificates/contacts
+func parseKeyVaultCertificateContactsID(input string) (*parse.NestedItemId, error) {
+	id, err := parse.ParseOptionallyVersionedNestedItemID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.NestedItemType != "certificates" || id.Name != "contacts" || id.Version != "" {
+		return nil, fmt.Errorf("expected a Key Vault Certificate Contacts ID in the format `https://{keyVaultName}.vault.azure.net/certificates/contacts` but got %q", input)
+	}
+
+	return id, nil
+}
+
+func expandKeyVaultCertificateContacts(input []interface{}) *[]keyvault.Contact {
+	results := make([]keyvault.Contact, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		contact := keyvault.Contact{
+			EmailAddress: utils.String(v["email"].(string)),
+		}
+		if name := v["name"].(string); name != "" {
+			contact.Name = utils.String(name)
+		}
+		if phone := v["phone"].(string); phone != "" {
+			contact.Phone = utils.String(phone)
+		}
+		results = append(results, contact)
+	}
+
+	return &results
+}
+
+func flattenKeyVaultCertificateContacts(input *[]keyvault.Contact) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, contact := range *input {
+		email := ""
+		if contact.EmailAddress != nil {
+			email = *contact.EmailAddress
+		}
+
+		name := ""
+		if contact.Name != nil {
+			name = *contact.Name
+		}
+
+		phone := ""
+		if contact.Phone != nil {
+			phone = *contact.Phone
+		}
+
+		results = append(results, map[string]interface{}{
+			"email": email,
+			"name":  name,
+			"phone": phone,
+		})
+	}
+
+	return results
+}
@@ -186,7 +186,6 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 												"action_type": {
 													Type:     pluginsdk.TypeString,
 													Required: true,
-													ForceNew: true,
 													ValidateFunc: validation.StringInSlice([]string{
 														string(keyvault.AutoRenew),
 														string(keyvault.EmailContacts),
@@ -205,12 +204,10 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 												"days_before_expiry": {
 													Type:     pluginsdk.TypeInt,
 													Optional: true,
-													ForceNew: true,
 												},
 												"lifetime_percentage": {
 													Type:     pluginsdk.TypeInt,
 													Optional: true,
-													ForceNew: true,
 												},
 											},
 										},
@@ -385,6 +382,16 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"versionless_secret_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"certificate_data": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -518,21 +525,43 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 
 func resourceKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).KeyVault.ManagementClient
-	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id, err := parse.ParseNestedItemID(d.Id())
 	if err != nil {
 		return err
 	}
-	patch := keyvault.CertificateUpdateParameters{}
-	if t, ok := d.GetOk("tags"); ok {
-		patch.Tags = tags.Expand(t.(map[string]interface{}))
+
+	if d.HasChange("certificate_policy.0.lifetime_action") {
+		policy, err := expandKeyVaultCertificatePolicy(d)
+		if err != nil {
+			return fmt.Errorf("expanding certificate policy: %s", err)
+		}
+
+		// only the Lifetime Actions can be updated in-place, everything else forces a new resource
+		if policy != nil {
+			patch := keyvault.CertificatePolicy{
+				LifetimeActions: policy.LifetimeActions,
+			}
+			if _, err := client.UpdateCertificatePolicy(ctx, id.KeyVaultBaseUrl, id.Name, patch); err != nil {
+				return fmt.Errorf("updating the Lifetime Actions for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+		}
 	}
 
-	if _, err = client.UpdateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, patch); err != nil {
-		return err
+	if d.HasChange("tags") {
+		patch := keyvault.CertificateUpdateParameters{}
+		if t, ok := d.GetOk("tags"); ok {
+			patch.Tags = tags.Expand(t.(map[string]interface{}))
+		}
+
+		// the Certificate may have been renewed since it was created, so the latest version is updated
+		if _, err = client.UpdateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, d.Get("version").(string), patch); err != nil {
+			return err
+		}
 	}
+
 	return resourceKeyVaultCertificateRead(d, meta)
 }
 
@@ -617,10 +646,32 @@ func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("setting Key Vault Certificate Attributes: %+v", err)
 	}
 
+	// the Certificate may have been renewed (e.g. by an `AutoRenew` Lifetime Action), in which case the
+	// latest version is exposed rather than the one which was originally created
+	version := id.Version
+	if cert.ID != nil {
+		latestId, err := parse.ParseNestedItemID(*cert.ID)
+		if err != nil {
+			return err
+		}
+		version = latestId.Version
+	}
+
 	// Computed
-	d.Set("version", id.Version)
+	d.Set("version", version)
+	d.Set("versionless_id", id.VersionlessID())
 	d.Set("secret_id", cert.Sid)
 
+	versionlessSecretId := ""
+	if cert.Sid != nil {
+		secretId, err := parse.ParseNestedItemID(*cert.Sid)
+		if err != nil {
+			return err
+		}
+		versionlessSecretId = secretId.VersionlessID()
+	}
+	d.Set("versionless_secret_id", versionlessSecretId)
+
 	certificateData := ""
 	if contents := cert.Cer; contents != nil {
 		certificateData = strings.ToUpper(hex.EncodeToString(*contents))
@@ -734,8 +785,9 @@ func expandKeyVaultCertificatePolicy(d *pluginsdk.ResourceData) (*keyvault.Certi
 
 	issuers := policyRaw["issuer_parameters"].([]interface{})
 	issuer := issuers[0].(map[string]interface{})
+	issuerName := issuer["name"].(string)
 	policy.IssuerParameters = &keyvault.IssuerParameters{
-		Name: utils.String(issuer["name"].(string)),
+		Name: utils.String(issuerName),
 	}
 
 	properties := policyRaw["key_properties"].([]interface{})
@@ -783,8 +835,13 @@ func expandKeyVaultCertificatePolicy(d *pluginsdk.ResourceData) (*keyvault.Certi
 		if v, ok := action["action"]; ok {
 			as := v.([]interface{})
 			a := as[0].(map[string]interface{})
+			actionType := a["action_type"].(string)
+			// Certificates from an unknown issuer are renewed outside of Key Vault, so can't be renewed automatically
+			if actionType == string(keyvault.AutoRenew) && strings.EqualFold(issuerName, "Unknown") {
+				return nil, fmt.Errorf("the `AutoRenew` lifetime action can only be used with the `Self` issuer or an integrated issuer, but the issuer is %q", issuerName)
+			}
 			lifetimeAction.Action = &keyvault.Action{
-				ActionType: keyvault.ActionType(a["action_type"].(string)),
+				ActionType: keyvault.ActionType(actionType),
 			}
 		}
 
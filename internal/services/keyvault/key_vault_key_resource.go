@@ -11,14 +11,17 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/keyrotationpolicies"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -132,6 +135,66 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+										RequiredWith: []string{"rotation_policy.0.expire_after"},
+									},
+								},
+							},
+							AtLeastOneOf: []string{
+								"rotation_policy.0.automatic",
+								"rotation_policy.0.expire_after",
+							},
+						},
+
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+							AtLeastOneOf: []string{
+								"rotation_policy.0.automatic",
+								"rotation_policy.0.expire_after",
+							},
+						},
+
+						"notify_before_expiry": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+							RequiredWith: []string{"rotation_policy.0.expire_after"},
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -282,6 +345,13 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if v, ok := d.GetOk("rotation_policy"); ok {
+		policy := expandKeyVaultKeyRotationPolicy(v.([]interface{}))
+		if _, err := keyVaultsClient.KeyRotationPoliciesClient.UpdateKeyRotationPolicy(ctx, *keyVaultBaseUri, name, policy); err != nil {
+			return fmt.Errorf("setting the Rotation Policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
 	d.SetId(*read.Key.Kid)
 
 	return resourceKeyVaultKeyRead(d, meta)
@@ -349,6 +419,14 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.HasChange("rotation_policy") {
+		// removing the block resets the Rotation Policy, since a Key always has one
+		policy := expandKeyVaultKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))
+		if _, err := keyVaultsClient.KeyRotationPoliciesClient.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, policy); err != nil {
+			return fmt.Errorf("updating the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
@@ -486,6 +564,22 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	rotationPolicy, err := keyVaultsClient.KeyRotationPoliciesClient.GetKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		// the `GetRotationPolicy` permission is newer than the other Key permissions, so existing
+		// configurations may not have been granted it - in which case there's nothing to flatten,
+		// unless a `rotation_policy` is configured, since otherwise any changes would go unnoticed
+		resp := rotationPolicy.HttpResponse
+		forbidden := resp != nil && resp.StatusCode == http.StatusForbidden
+		notFound := resp != nil && resp.StatusCode == http.StatusNotFound
+		if (!forbidden && !notFound) || (forbidden && len(d.Get("rotation_policy").([]interface{})) > 0) {
+			return fmt.Errorf("retrieving the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+	if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(rotationPolicy.Model)); err != nil {
+		return fmt.Errorf("setting `rotation_policy`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -609,3 +703,108 @@ func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
 	}
 	return nil
 }
+
+func expandKeyVaultKeyRotationPolicy(input []interface{}) keyrotationpolicies.KeyRotationPolicy {
+	lifetimeActions := make([]keyrotationpolicies.LifetimeActions, 0)
+	policy := keyrotationpolicies.KeyRotationPolicy{
+		Attributes:      &keyrotationpolicies.KeyRotationPolicyAttributes{},
+		LifetimeActions: &lifetimeActions,
+	}
+	if len(input) == 0 || input[0] == nil {
+		return policy
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v := raw["expire_after"].(string); v != "" {
+		policy.Attributes.ExpiryTime = utils.String(v)
+	}
+
+	if v := raw["notify_before_expiry"].(string); v != "" {
+		action := keyrotationpolicies.KeyRotationPolicyActionNotify
+		lifetimeActions = append(lifetimeActions, keyrotationpolicies.LifetimeActions{
+			Action: &keyrotationpolicies.LifetimeActionsType{
+				Type: &action,
+			},
+			Trigger: &keyrotationpolicies.LifetimeActionsTrigger{
+				TimeBeforeExpiry: utils.String(v),
+			},
+		})
+	}
+
+	if automatic := raw["automatic"].([]interface{}); len(automatic) > 0 && automatic[0] != nil {
+		automaticRaw := automatic[0].(map[string]interface{})
+		trigger := keyrotationpolicies.LifetimeActionsTrigger{}
+		if v := automaticRaw["time_after_creation"].(string); v != "" {
+			trigger.TimeAfterCreate = utils.String(v)
+		}
+		if v := automaticRaw["time_before_expiry"].(string); v != "" {
+			trigger.TimeBeforeExpiry = utils.String(v)
+		}
+
+		action := keyrotationpolicies.KeyRotationPolicyActionRotate
+		lifetimeActions = append(lifetimeActions, keyrotationpolicies.LifetimeActions{
+			Action: &keyrotationpolicies.LifetimeActionsType{
+				Type: &action,
+			},
+			Trigger: &trigger,
+		})
+	}
+
+	return policy
+}
+
+func flattenKeyVaultKeyRotationPolicy(input *keyrotationpolicies.KeyRotationPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, item := range *input.LifetimeActions {
+			if item.Action == nil || item.Action.Type == nil || item.Trigger == nil {
+				continue
+			}
+
+			switch *item.Action.Type {
+			case keyrotationpolicies.KeyRotationPolicyActionNotify:
+				if item.Trigger.TimeBeforeExpiry != nil {
+					notifyBeforeExpiry = *item.Trigger.TimeBeforeExpiry
+				}
+
+			case keyrotationpolicies.KeyRotationPolicyActionRotate:
+				timeAfterCreation := ""
+				if item.Trigger.TimeAfterCreate != nil {
+					timeAfterCreation = *item.Trigger.TimeAfterCreate
+				}
+				timeBeforeExpiry := ""
+				if item.Trigger.TimeBeforeExpiry != nil {
+					timeBeforeExpiry = *item.Trigger.TimeBeforeExpiry
+				}
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": timeAfterCreation,
+					"time_before_expiry":  timeBeforeExpiry,
+				})
+			}
+		}
+	}
+
+	// every Key has a Rotation Policy, which by default only notifies ahead of an expiry which isn't set -
+	// so the policy is only considered configured when the Key expires or is automatically rotated
+	if expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"automatic":            automatic,
+			"expire_after":         expireAfter,
+			"notify_before_expiry": notifyBeforeExpiry,
+		},
+	}
+}
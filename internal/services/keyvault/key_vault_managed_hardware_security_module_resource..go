@@ -1,8 +1,15 @@
 package keyvault
 
 import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"math/big"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
@@ -11,7 +18,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.3/securitydomain"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -89,11 +98,38 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(7, 90),
 			},
 
+			"security_domain_key_vault_certificate_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 3,
+				MaxItems: 10,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.NestedItemIdWithOptionalVersion,
+				},
+				RequiredWith: []string{"security_domain_quorum"},
+			},
+
+			"security_domain_quorum": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(2, 10),
+				RequiredWith: []string{"security_domain_key_vault_certificate_ids"},
+			},
+
 			"hsm_uri": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"security_domain_encrypted_data": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			// https://github.com/Azure/azure-rest-api-specs/issues/13365
 			"tags": tags.ForceNewSchema(),
 		},
@@ -148,6 +184,23 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 	}
 
 	d.SetId(id.ID())
+
+	// the Managed HSM can only be used once it's been activated, which happens when the Security Domain is downloaded
+	if v, ok := d.GetOk("security_domain_key_vault_certificate_ids"); ok {
+		hsmUri, err := meta.(*clients.Client).KeyVault.BaseUriForManagedHSM(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving the URI for %s: %+v", id, err)
+		}
+
+		certificateIds := utils.ExpandStringSlice(v.([]interface{}))
+		quorum := d.Get("security_domain_quorum").(int)
+		encryptedData, err := downloadKeyVaultManagedHardwareSecurityModuleSecurityDomain(ctx, meta.(*clients.Client).KeyVault, *hsmUri, *certificateIds, quorum, d.Timeout(pluginsdk.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("activating %s: %+v", id, err)
+		}
+		d.Set("security_domain_encrypted_data", encryptedData)
+	}
+
 	return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
 }
 
@@ -219,3 +272,98 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.Resourc
 
 	return nil
 }
+
+func downloadKeyVaultManagedHardwareSecurityModuleSecurityDomain(ctx context.Context, client *client.Client, hsmUri string, certificateIds []string, quorum int, timeout time.Duration) (string, error) {
+	certificates := make([]securitydomain.SecurityDomainJsonWebKey, 0)
+	for _, certificateId := range certificateIds {
+		id, err := parse.ParseOptionallyVersionedNestedItemID(certificateId)
+		if err != nil {
+			return "", err
+		}
+
+		certificate, err := client.ManagementClient.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+		if err != nil {
+			return "", fmt.Errorf("retrieving Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		if certificate.Cer == nil {
+			return "", fmt.Errorf("retrieving Certificate %q (Key Vault %q): `cer` was nil", id.Name, id.KeyVaultBaseUrl)
+		}
+
+		jsonWebKey, err := securityDomainJsonWebKeyFromCertificate(certificateId, *certificate.Cer)
+		if err != nil {
+			return "", fmt.Errorf("building the Security Domain key for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		certificates = append(certificates, *jsonWebKey)
+	}
+
+	input := securitydomain.CertificateInfoObject{
+		Certificates: certificates,
+		Required:     utils.Int64(int64(quorum)),
+	}
+	resp, err := client.ManagedHsmSecurityDomainClient.Download(ctx, hsmUri, input)
+	if err != nil {
+		return "", fmt.Errorf("downloading the Security Domain: %+v", err)
+	}
+	if resp.Model == nil || resp.Model.Value == "" {
+		return "", fmt.Errorf("downloading the Security Domain: `value` was empty")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{string(securitydomain.OperationStatusInProgress)},
+		Target:  []string{string(securitydomain.OperationStatusSuccess)},
+		Refresh: func() (interface{}, string, error) {
+			pending, err := client.ManagedHsmSecurityDomainClient.DownloadPending(ctx, hsmUri)
+			if err != nil {
+				return nil, "", fmt.Errorf("polling the status of the Security Domain download: %+v", err)
+			}
+			if pending.Model == nil || pending.Model.Status == nil {
+				return nil, "", fmt.Errorf("polling the status of the Security Domain download: `status` was nil")
+			}
+
+			status := *pending.Model.Status
+			if status == securitydomain.OperationStatusFailed {
+				details := ""
+				if pending.Model.StatusDetails != nil {
+					details = *pending.Model.StatusDetails
+				}
+				return nil, "", fmt.Errorf("the Security Domain download failed: %s", details)
+			}
+
+			return pending, string(status), nil
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return "", fmt.Errorf("waiting for the Security Domain to be downloaded: %+v", err)
+	}
+
+	return resp.Model.Value, nil
+}
+
+func securityDomainJsonWebKeyFromCertificate(certificateId string, contents []byte) (*securitydomain.SecurityDomainJsonWebKey, error) {
+	certificate, err := x509.ParseCertificate(contents)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %+v", err)
+	}
+
+	publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("the Security Domain can only be encrypted using an RSA certificate")
+	}
+
+	sha1Thumbprint := sha1.Sum(contents)
+	sha256Thumbprint := sha256.Sum256(contents)
+	return &securitydomain.SecurityDomainJsonWebKey{
+		Alg:     "RSA-OAEP-256",
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		KeyOps:  []string{"verify", "encrypt", "wrapKey"},
+		Kid:     certificateId,
+		Kty:     "RSA",
+		N:       base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		Use:     utils.String("enc"),
+		X5c:     []string{base64.StdEncoding.EncodeToString(contents)},
+		X5t:     utils.String(base64.RawURLEncoding.EncodeToString(sha1Thumbprint[:])),
+		X5tS256: base64.RawURLEncoding.EncodeToString(sha256Thumbprint[:]),
+	}, nil
+}
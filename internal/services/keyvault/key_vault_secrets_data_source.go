@@ -6,9 +6,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -36,6 +38,31 @@ func dataSourceKeyVaultSecrets() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"secrets": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,6 +91,7 @@ func dataSourceKeyVaultSecretsRead(d *pluginsdk.ResourceData, meta interface{})
 	d.SetId(keyVaultId.ID())
 
 	var names []string
+	secrets := make([]interface{}, 0)
 
 	if secretList.Response().Value != nil {
 		for secretList.NotDone() {
@@ -73,6 +101,7 @@ func dataSourceKeyVaultSecretsRead(d *pluginsdk.ResourceData, meta interface{})
 					return err
 				}
 				names = append(names, *name)
+				secrets = append(secrets, flattenKeyVaultSecretsItem(*name, v))
 				err = secretList.NextWithContext(ctx)
 				if err != nil {
 					return fmt.Errorf("listing secrets on Azure KeyVault %q: %+v", *keyVaultId, err)
@@ -84,6 +113,10 @@ func dataSourceKeyVaultSecretsRead(d *pluginsdk.ResourceData, meta interface{})
 	d.Set("names", names)
 	d.Set("key_vault_id", keyVaultId.ID())
 
+	if err := d.Set("secrets", secrets); err != nil {
+		return fmt.Errorf("setting `secrets`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -99,3 +132,23 @@ func parseNameFromSecretUrl(input string) (*string, error) {
 	}
 	return &segments[2], nil
 }
+
+func flattenKeyVaultSecretsItem(name string, input keyvault.SecretItem) map[string]interface{} {
+	// the ID returned when listing is versionless
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	enabled := false
+	if input.Attributes != nil && input.Attributes.Enabled != nil {
+		enabled = *input.Attributes.Enabled
+	}
+
+	return map[string]interface{}{
+		"name":    name,
+		"id":      id,
+		"enabled": enabled,
+		"tags":    tags.Flatten(input.Tags),
+	}
+}
@@ -20,6 +20,8 @@ func TestAccDataSourceKeyVaultSecrets_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("names.#").HasValue("31"),
+				check.That(data.ResourceName).Key("secrets.#").HasValue("31"),
+				check.That(data.ResourceName).Key("secrets.0.enabled").HasValue("true"),
 			),
 		},
 	})
@@ -0,0 +1,73 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagedHSMRoleAssignmentId{}
+
+const managedHSMRoleAssignmentSegment = "/providers/Microsoft.Authorization/roleAssignments/"
+
+type ManagedHSMRoleAssignmentId struct {
+	ManagedHSMBaseUrl string
+	Scope             string
+	Name              string
+}
+
+func NewManagedHSMRoleAssignmentID(managedHsmBaseUrl, scope, name string) ManagedHSMRoleAssignmentId {
+	return ManagedHSMRoleAssignmentId{
+		ManagedHSMBaseUrl: managedHsmBaseUrl,
+		Scope:             scope,
+		Name:              name,
+	}
+}
+
+func (id ManagedHSMRoleAssignmentId) ID() string {
+	// example: https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000
+	return strings.TrimSuffix(id.ManagedHSMBaseUrl, "/") + strings.TrimSuffix(id.Scope, "/") + managedHSMRoleAssignmentSegment + id.Name
+}
+
+func (id ManagedHSMRoleAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Managed HSM %q", id.ManagedHSMBaseUrl),
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	return fmt.Sprintf("Managed HSM Role Assignment (%s)", strings.Join(components, " / "))
+}
+
+// ManagedHSMRoleAssignmentID parses a Managed HSM Role Assignment ID into a ManagedHSMRoleAssignmentId object
+func ManagedHSMRoleAssignmentID(input string) (*ManagedHSMRoleAssignmentId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Managed HSM Role Assignment ID %q: %+v", input, err)
+	}
+	if idURL.Host == "" {
+		return nil, fmt.Errorf("parsing Managed HSM Role Assignment ID %q: the URI of the Managed HSM was missing", input)
+	}
+
+	index := strings.LastIndex(idURL.Path, managedHSMRoleAssignmentSegment)
+	if index == -1 {
+		return nil, fmt.Errorf("parsing Managed HSM Role Assignment ID %q: expected the path to contain %q", input, managedHSMRoleAssignmentSegment)
+	}
+
+	name := idURL.Path[index+len(managedHSMRoleAssignmentSegment):]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("parsing Managed HSM Role Assignment ID %q: expected a single segment for the name but got %q", input, name)
+	}
+
+	scope := idURL.Path[:index]
+	if scope == "" {
+		scope = "/"
+	}
+
+	return &ManagedHSMRoleAssignmentId{
+		ManagedHSMBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Scope:             scope,
+		Name:              name,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagedHSMRoleAssignmentId{}
+
+func TestManagedHSMRoleAssignmentIDFormatter(t *testing.T) {
+	cases := []struct {
+		Input    ManagedHSMRoleAssignmentId
+		Expected string
+	}{
+		{
+			Input:    NewManagedHSMRoleAssignmentID("https://my-hsm.managedhsm.azure.net/", "/", "00000000-0000-0000-0000-000000000000"),
+			Expected: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input:    NewManagedHSMRoleAssignmentID("https://my-hsm.managedhsm.azure.net/", "/keys", "00000000-0000-0000-0000-000000000000"),
+			Expected: "https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, v := range cases {
+		if actual := v.Input.ID(); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestManagedHSMRoleAssignmentID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagedHSMRoleAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// not a uri
+			Input: "my-hsm/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+
+		{
+			// missing name
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/",
+			Error: true,
+		},
+
+		{
+			// missing role assignments segment
+			Input: "https://my-hsm.managedhsm.azure.net/keys/key1",
+			Error: true,
+		},
+
+		{
+			// root scope
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &ManagedHSMRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/",
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+
+		{
+			// keys scope
+			Input: "https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &ManagedHSMRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys",
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+
+		{
+			// key scope
+			Input: "https://my-hsm.managedhsm.azure.net/keys/key1/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &ManagedHSMRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys/key1",
+				Name:              "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ManagedHSMBaseUrl != v.Expected.ManagedHSMBaseUrl {
+			t.Fatalf("Expected %q but got %q for ManagedHSMBaseUrl", v.Expected.ManagedHSMBaseUrl, actual.ManagedHSMBaseUrl)
+		}
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
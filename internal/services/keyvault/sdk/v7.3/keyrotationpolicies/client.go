@@ -0,0 +1,15 @@
+package keyrotationpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+// KeyRotationPoliciesClient is a data plane client, as such the base uri is the
+// URI of the Key Vault which is specified on each request.
+type KeyRotationPoliciesClient struct {
+	Client autorest.Client
+}
+
+func NewKeyRotationPoliciesClient() KeyRotationPoliciesClient {
+	return KeyRotationPoliciesClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
@@ -0,0 +1,31 @@
+package keyrotationpolicies
+
+import "strings"
+
+type KeyRotationPolicyAction string
+
+const (
+	KeyRotationPolicyActionNotify KeyRotationPolicyAction = "Notify"
+	KeyRotationPolicyActionRotate KeyRotationPolicyAction = "Rotate"
+)
+
+func PossibleValuesForKeyRotationPolicyAction() []string {
+	return []string{
+		string(KeyRotationPolicyActionNotify),
+		string(KeyRotationPolicyActionRotate),
+	}
+}
+
+func parseKeyRotationPolicyAction(input string) (*KeyRotationPolicyAction, error) {
+	vals := map[string]KeyRotationPolicyAction{
+		"notify": KeyRotationPolicyActionNotify,
+		"rotate": KeyRotationPolicyActionRotate,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KeyRotationPolicyAction(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package keyrotationpolicies
+
+type KeyRotationPolicy struct {
+	Attributes      *KeyRotationPolicyAttributes `json:"attributes,omitempty"`
+	Id              *string                      `json:"id,omitempty"`
+	LifetimeActions *[]LifetimeActions           `json:"lifetimeActions,omitempty"`
+}
@@ -0,0 +1,6 @@
+package keyrotationpolicies
+
+type LifetimeActions struct {
+	Action  *LifetimeActionsType    `json:"action,omitempty"`
+	Trigger *LifetimeActionsTrigger `json:"trigger,omitempty"`
+}
@@ -0,0 +1,6 @@
+package keyrotationpolicies
+
+type LifetimeActionsTrigger struct {
+	TimeAfterCreate  *string `json:"timeAfterCreate,omitempty"`
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
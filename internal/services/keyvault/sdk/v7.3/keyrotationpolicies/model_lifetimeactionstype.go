@@ -0,0 +1,33 @@
+package keyrotationpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type LifetimeActionsType struct {
+	Type *KeyRotationPolicyAction `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &LifetimeActionsType{}
+
+func (o *LifetimeActionsType) UnmarshalJSON(bytes []byte) error {
+	type alias struct {
+		Type *string `json:"type,omitempty"`
+	}
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into LifetimeActionsType: %+v", err)
+	}
+
+	// the API returns the action type in lower case, regardless of what was sent
+	if decoded.Type != nil {
+		action, err := parseKeyRotationPolicyAction(*decoded.Type)
+		if err != nil {
+			return err
+		}
+		o.Type = action
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package roleassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+// RoleAssignmentsClient is a data plane client, as such the base uri is the
+// URI of the Managed HSM which is specified on each request.
+type RoleAssignmentsClient struct {
+	Client autorest.Client
+}
+
+func NewRoleAssignmentsClient() RoleAssignmentsClient {
+	return RoleAssignmentsClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
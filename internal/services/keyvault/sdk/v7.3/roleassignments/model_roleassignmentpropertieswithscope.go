@@ -0,0 +1,7 @@
+package roleassignments
+
+type RoleAssignmentPropertiesWithScope struct {
+	PrincipalId      *string `json:"principalId,omitempty"`
+	RoleDefinitionId *string `json:"roleDefinitionId,omitempty"`
+	Scope            *string `json:"scope,omitempty"`
+}
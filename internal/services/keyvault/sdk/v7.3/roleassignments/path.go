@@ -0,0 +1,13 @@
+package roleassignments
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pathForRoleAssignment returns the path to a Role Assignment within the specified scope
+// (e.g. `/` or `/keys`), relative to the URI of the Managed HSM.
+func pathForRoleAssignment(scope, roleAssignmentName string) string {
+	scope = strings.TrimSuffix(scope, "/")
+	return fmt.Sprintf("%s/providers/Microsoft.Authorization/roleAssignments/%s", scope, roleAssignmentName)
+}
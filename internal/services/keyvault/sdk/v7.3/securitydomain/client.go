@@ -0,0 +1,15 @@
+package securitydomain
+
+import "github.com/Azure/go-autorest/autorest"
+
+// SecurityDomainClient is a data plane client, as such the base uri is the
+// URI of the Managed HSM which is specified on each request.
+type SecurityDomainClient struct {
+	Client autorest.Client
+}
+
+func NewSecurityDomainClient() SecurityDomainClient {
+	return SecurityDomainClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
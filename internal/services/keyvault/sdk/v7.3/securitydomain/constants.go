@@ -0,0 +1,34 @@
+package securitydomain
+
+import "strings"
+
+type OperationStatus string
+
+const (
+	OperationStatusFailed     OperationStatus = "Failed"
+	OperationStatusInProgress OperationStatus = "InProgress"
+	OperationStatusSuccess    OperationStatus = "Success"
+)
+
+func PossibleValuesForOperationStatus() []string {
+	return []string{
+		string(OperationStatusFailed),
+		string(OperationStatusInProgress),
+		string(OperationStatusSuccess),
+	}
+}
+
+func parseOperationStatus(input string) (*OperationStatus, error) {
+	vals := map[string]OperationStatus{
+		"failed":     OperationStatusFailed,
+		"inprogress": OperationStatusInProgress,
+		"success":    OperationStatusSuccess,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperationStatus(input)
+	return &out, nil
+}
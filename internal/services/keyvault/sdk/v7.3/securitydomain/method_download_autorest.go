@@ -0,0 +1,69 @@
+package securitydomain
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DownloadResponse struct {
+	HttpResponse *http.Response
+	Model        *SecurityDomainObject
+}
+
+// Download ...
+func (c SecurityDomainClient) Download(ctx context.Context, managedHsmBaseUrl string, input CertificateInfoObject) (result DownloadResponse, err error) {
+	req, err := c.preparerForDownload(ctx, managedHsmBaseUrl, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securitydomain.SecurityDomainClient", "Download", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securitydomain.SecurityDomainClient", "Download", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDownload(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securitydomain.SecurityDomainClient", "Download", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDownload prepares the Download request.
+func (c SecurityDomainClient) preparerForDownload(ctx context.Context, managedHsmBaseUrl string, input CertificateInfoObject) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": managedHsmBaseUrl,
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPath("/securitydomain/download"),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDownload handles the response to the Download request. The method always
+// closes the http.Response Body.
+func (c SecurityDomainClient) responderForDownload(resp *http.Response) (result DownloadResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
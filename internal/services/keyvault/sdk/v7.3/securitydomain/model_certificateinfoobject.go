@@ -0,0 +1,6 @@
+package securitydomain
+
+type CertificateInfoObject struct {
+	Certificates []SecurityDomainJsonWebKey `json:"certificates"`
+	Required     *int64                     `json:"required,omitempty"`
+}
@@ -0,0 +1,14 @@
+package securitydomain
+
+type SecurityDomainJsonWebKey struct {
+	Alg     string   `json:"alg"`
+	E       string   `json:"e"`
+	KeyOps  []string `json:"key_ops"`
+	Kid     string   `json:"kid"`
+	Kty     string   `json:"kty"`
+	N       string   `json:"n"`
+	Use     *string  `json:"use,omitempty"`
+	X5c     []string `json:"x5c"`
+	X5t     *string  `json:"x5t,omitempty"`
+	X5tS256 string   `json:"x5t#S256"`
+}
@@ -0,0 +1,5 @@
+package securitydomain
+
+type SecurityDomainObject struct {
+	Value string `json:"value"`
+}
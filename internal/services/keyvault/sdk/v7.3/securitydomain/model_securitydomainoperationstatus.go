@@ -0,0 +1,35 @@
+package securitydomain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type SecurityDomainOperationStatus struct {
+	Status        *OperationStatus `json:"status,omitempty"`
+	StatusDetails *string          `json:"status_details,omitempty"`
+}
+
+var _ json.Unmarshaler = &SecurityDomainOperationStatus{}
+
+func (o *SecurityDomainOperationStatus) UnmarshalJSON(bytes []byte) error {
+	type alias struct {
+		Status        *string `json:"status,omitempty"`
+		StatusDetails *string `json:"status_details,omitempty"`
+	}
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into SecurityDomainOperationStatus: %+v", err)
+	}
+
+	o.StatusDetails = decoded.StatusDetails
+	if decoded.Status != nil {
+		status, err := parseOperationStatus(*decoded.Status)
+		if err != nil {
+			return err
+		}
+		o.Status = status
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package securitydomain
+
+import "fmt"
+
+const defaultApiVersion = "7.3"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/securitydomain/%s", defaultApiVersion)
+}
@@ -3,12 +3,14 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/kusto/mgmt/2021-01-01/kusto"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/2022-12-29/clusters"
 )
 
 type Client struct {
 	AttachedDatabaseConfigurationsClient *kusto.AttachedDatabaseConfigurationsClient
 	ClustersClient                       *kusto.ClustersClient
 	ClusterPrincipalAssignmentsClient    *kusto.ClusterPrincipalAssignmentsClient
+	ClusterSettingsClient                *clusters.ClustersClient
 	DatabasesClient                      *kusto.DatabasesClient
 	DataConnectionsClient                *kusto.DataConnectionsClient
 	DatabasePrincipalAssignmentsClient   *kusto.DatabasePrincipalAssignmentsClient
@@ -22,6 +24,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ClusterPrincipalAssignmentsClient := kusto.NewClusterPrincipalAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ClusterPrincipalAssignmentsClient.Client, o.ResourceManagerAuthorizer)
 
+	ClusterSettingsClient := clusters.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ClusterSettingsClient.Client, o.ResourceManagerAuthorizer)
+
 	DatabasesClient := kusto.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DatabasesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -40,6 +45,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		AttachedDatabaseConfigurationsClient: &AttachedDatabaseConfigurationsClient,
 		ClustersClient:                       &ClustersClient,
 		ClusterPrincipalAssignmentsClient:    &ClusterPrincipalAssignmentsClient,
+		ClusterSettingsClient:                &ClusterSettingsClient,
 		DatabasesClient:                      &DatabasesClient,
 		DataConnectionsClient:                &DataConnectionsClient,
 		DatabasePrincipalAssignmentsClient:   &DatabasePrincipalAssignmentsClient,
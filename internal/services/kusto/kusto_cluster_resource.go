@@ -1,6 +1,7 @@
 package kusto
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/2022-12-29/clusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -189,6 +191,78 @@ func resourceKustoCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"auto_stop_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"public_ip_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(clusters.PublicIPTypeIPv4),
+				ValidateFunc: validation.StringInSlice(clusters.PossibleValuesForPublicIPType(), false),
+			},
+
+			"outbound_network_access_restricted": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allowed_fqdns": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"allowed_ip_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						validation.IsCIDR,
+						validation.IsIPAddress,
+					),
+				},
+			},
+
+			"callout_policy": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"callout_type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(clusters.PossibleValuesForCalloutType(), false),
+						},
+
+						"callout_uri_regex": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"outbound_access": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(clusters.PossibleValuesForOutboundAccess(), false),
+						},
+					},
+				},
+			},
+
 			"engine": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -219,6 +293,7 @@ func resourceKustoCluster() *pluginsdk.Resource {
 
 func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Kusto.ClustersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -373,9 +448,130 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	// the network access and auto-stop settings aren't available in the API version used above, since updating
+	// these is a long-running operation we only do so when they've changed (or differ from the defaults on creation)
+	settingsClient := meta.(*clients.Client).Kusto.ClusterSettingsClient
+	settingsId := clusters.NewClusterID(subscriptionId, resourceGroup, name)
+
+	if kustoClusterSettingsHaveChanged(d) {
+		publicNetworkAccess := clusters.PublicNetworkAccessEnabled
+		if !d.Get("public_network_access_enabled").(bool) {
+			publicNetworkAccess = clusters.PublicNetworkAccessDisabled
+		}
+		restrictOutboundNetworkAccess := clusters.ClusterNetworkAccessFlagDisabled
+		if d.Get("outbound_network_access_restricted").(bool) {
+			restrictOutboundNetworkAccess = clusters.ClusterNetworkAccessFlagEnabled
+		}
+		publicIPType := clusters.PublicIPType(d.Get("public_ip_type").(string))
+
+		settings := clusters.ClusterUpdate{
+			Properties: &clusters.ClusterProperties{
+				AllowedFqdnList:               utils.ExpandStringSlice(d.Get("allowed_fqdns").([]interface{})),
+				AllowedIPRangeList:            utils.ExpandStringSlice(d.Get("allowed_ip_ranges").([]interface{})),
+				EnableAutoStop:                utils.Bool(d.Get("auto_stop_enabled").(bool)),
+				PublicIPType:                  &publicIPType,
+				PublicNetworkAccess:           &publicNetworkAccess,
+				RestrictOutboundNetworkAccess: &restrictOutboundNetworkAccess,
+			},
+		}
+		if err := settingsClient.UpdateThenPoll(ctx, settingsId, settings); err != nil {
+			return fmt.Errorf("updating network and auto-stop settings for %s: %+v", settingsId, err)
+		}
+	}
+
+	if d.HasChange("callout_policy") {
+		if err := updateKustoClusterCalloutPolicies(ctx, settingsClient, settingsId, d.Get("callout_policy").(*pluginsdk.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	return resourceKustoClusterRead(d, meta)
 }
 
+func kustoClusterSettingsHaveChanged(d *pluginsdk.ResourceData) bool {
+	if !d.IsNewResource() {
+		return d.HasChanges("allowed_fqdns", "allowed_ip_ranges", "auto_stop_enabled", "outbound_network_access_restricted", "public_ip_type", "public_network_access_enabled")
+	}
+
+	return len(d.Get("allowed_fqdns").([]interface{})) > 0 ||
+		len(d.Get("allowed_ip_ranges").([]interface{})) > 0 ||
+		!d.Get("auto_stop_enabled").(bool) ||
+		d.Get("outbound_network_access_restricted").(bool) ||
+		d.Get("public_ip_type").(string) != string(clusters.PublicIPTypeIPv4) ||
+		!d.Get("public_network_access_enabled").(bool)
+}
+
+func updateKustoClusterCalloutPolicies(ctx context.Context, client *clusters.ClustersClient, id clusters.ClusterId, input []interface{}) error {
+	existing, err := client.ListCalloutPolicies(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing callout policies for %s: %+v", id, err)
+	}
+
+	desired := expandKustoClusterCalloutPolicies(input)
+	desiredIds := make(map[string]clusters.CalloutPolicy)
+	for _, policy := range desired {
+		desiredIds[kustoClusterCalloutPolicyId(policy)] = policy
+	}
+
+	existingIds := make(map[string]clusters.CalloutPolicy)
+	if existing.Model != nil && existing.Model.Value != nil {
+		for _, policy := range *existing.Model.Value {
+			existingIds[kustoClusterCalloutPolicyId(policy)] = policy
+		}
+	}
+
+	for calloutId, policy := range existingIds {
+		// a policy is identified by its type and URI regex, so a change in access has to be removed and re-added
+		if newPolicy, ok := desiredIds[calloutId]; ok && strings.EqualFold(string(pointerToOutboundAccess(newPolicy.OutboundAccess)), string(pointerToOutboundAccess(policy.OutboundAccess))) {
+			delete(desiredIds, calloutId)
+			continue
+		}
+
+		if err := client.RemoveCalloutPolicyThenPoll(ctx, id, clusters.CalloutPolicyToRemove{CalloutId: utils.String(calloutId)}); err != nil {
+			return fmt.Errorf("removing callout policy %q from %s: %+v", calloutId, id, err)
+		}
+	}
+
+	if len(desiredIds) > 0 {
+		policies := make([]clusters.CalloutPolicy, 0)
+		for _, policy := range desiredIds {
+			policies = append(policies, policy)
+		}
+
+		if err := client.AddCalloutPoliciesThenPoll(ctx, id, clusters.CalloutPoliciesList{Value: &policies}); err != nil {
+			return fmt.Errorf("adding callout policies to %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// kustoClusterCalloutPolicyId returns the ID used by the API to identify a callout policy, which is
+// made up of the URI regex and the callout type
+func kustoClusterCalloutPolicyId(input clusters.CalloutPolicy) string {
+	if input.CalloutId != nil && *input.CalloutId != "" {
+		return *input.CalloutId
+	}
+
+	calloutType := ""
+	if input.CalloutType != nil {
+		calloutType = string(*input.CalloutType)
+	}
+	uriRegex := ""
+	if input.CalloutUriRegex != nil {
+		uriRegex = *input.CalloutUriRegex
+	}
+
+	return fmt.Sprintf("%s_%s", uriRegex, calloutType)
+}
+
+func pointerToOutboundAccess(input *clusters.OutboundAccess) clusters.OutboundAccess {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
+
 func resourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Kusto.ClustersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -434,6 +630,54 @@ func resourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("engine", clusterProperties.EngineType)
 	}
 
+	settingsClient := meta.(*clients.Client).Kusto.ClusterSettingsClient
+	settingsId := clusters.NewClusterID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	settings, err := settingsClient.Get(ctx, settingsId)
+	if err != nil {
+		return fmt.Errorf("retrieving network and auto-stop settings for %s: %+v", settingsId, err)
+	}
+
+	autoStopEnabled := true
+	publicNetworkAccessEnabled := true
+	outboundNetworkAccessRestricted := false
+	publicIPType := string(clusters.PublicIPTypeIPv4)
+	var allowedFqdns, allowedIPRanges []interface{}
+	if model := settings.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		if props.EnableAutoStop != nil {
+			autoStopEnabled = *props.EnableAutoStop
+		}
+		if props.PublicNetworkAccess != nil {
+			publicNetworkAccessEnabled = *props.PublicNetworkAccess == clusters.PublicNetworkAccessEnabled
+		}
+		if props.RestrictOutboundNetworkAccess != nil {
+			outboundNetworkAccessRestricted = *props.RestrictOutboundNetworkAccess == clusters.ClusterNetworkAccessFlagEnabled
+		}
+		if props.PublicIPType != nil {
+			publicIPType = string(*props.PublicIPType)
+		}
+		allowedFqdns = utils.FlattenStringSlice(props.AllowedFqdnList)
+		allowedIPRanges = utils.FlattenStringSlice(props.AllowedIPRangeList)
+	}
+	d.Set("auto_stop_enabled", autoStopEnabled)
+	d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+	d.Set("outbound_network_access_restricted", outboundNetworkAccessRestricted)
+	d.Set("public_ip_type", publicIPType)
+	d.Set("allowed_fqdns", allowedFqdns)
+	d.Set("allowed_ip_ranges", allowedIPRanges)
+
+	calloutPolicies, err := settingsClient.ListCalloutPolicies(ctx, settingsId)
+	if err != nil {
+		return fmt.Errorf("listing callout policies for %s: %+v", settingsId, err)
+	}
+	var policies *[]clusters.CalloutPolicy
+	if calloutPolicies.Model != nil {
+		policies = calloutPolicies.Model.Value
+	}
+	if err := d.Set("callout_policy", flattenKustoClusterCalloutPolicies(policies)); err != nil {
+		return fmt.Errorf("setting `callout_policy`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, clusterResponse.Tags)
 }
 
@@ -631,3 +875,47 @@ func diffLanguageExtensions(a, b []kusto.LanguageExtension) []kusto.LanguageExte
 
 	return diff
 }
+
+func expandKustoClusterCalloutPolicies(input []interface{}) []clusters.CalloutPolicy {
+	results := make([]clusters.CalloutPolicy, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		calloutType := clusters.CalloutType(v["callout_type"].(string))
+		outboundAccess := clusters.OutboundAccess(v["outbound_access"].(string))
+		results = append(results, clusters.CalloutPolicy{
+			CalloutType:     &calloutType,
+			CalloutUriRegex: utils.String(v["callout_uri_regex"].(string)),
+			OutboundAccess:  &outboundAccess,
+		})
+	}
+
+	return results
+}
+
+func flattenKustoClusterCalloutPolicies(input *[]clusters.CalloutPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		calloutType := ""
+		if item.CalloutType != nil {
+			calloutType = string(*item.CalloutType)
+		}
+
+		uriRegex := ""
+		if item.CalloutUriRegex != nil {
+			uriRegex = *item.CalloutUriRegex
+		}
+
+		results = append(results, map[string]interface{}{
+			"callout_type":      calloutType,
+			"callout_uri_regex": uriRegex,
+			"outbound_access":   string(pointerToOutboundAccess(item.OutboundAccess)),
+		})
+	}
+
+	return results
+}
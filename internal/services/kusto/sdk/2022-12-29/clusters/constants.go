@@ -0,0 +1,164 @@
+package clusters
+
+import "strings"
+
+type CalloutType string
+
+const (
+	CalloutTypeAzureDigitalTwins CalloutType = "azure_digital_twins"
+	CalloutTypeAzureOpenAI       CalloutType = "azure_openai"
+	CalloutTypeCosmosdb          CalloutType = "cosmosdb"
+	CalloutTypeExternalData      CalloutType = "external_data"
+	CalloutTypeGenevametrics     CalloutType = "genevametrics"
+	CalloutTypeKusto             CalloutType = "kusto"
+	CalloutTypeSandboxArtifacts  CalloutType = "sandbox_artifacts"
+	CalloutTypeSql               CalloutType = "sql"
+	CalloutTypeWebapi            CalloutType = "webapi"
+)
+
+func PossibleValuesForCalloutType() []string {
+	return []string{
+		string(CalloutTypeAzureDigitalTwins),
+		string(CalloutTypeAzureOpenAI),
+		string(CalloutTypeCosmosdb),
+		string(CalloutTypeExternalData),
+		string(CalloutTypeGenevametrics),
+		string(CalloutTypeKusto),
+		string(CalloutTypeSandboxArtifacts),
+		string(CalloutTypeSql),
+		string(CalloutTypeWebapi),
+	}
+}
+
+func parseCalloutType(input string) (*CalloutType, error) {
+	vals := map[string]CalloutType{
+		"azure_digital_twins": CalloutTypeAzureDigitalTwins,
+		"azure_openai":        CalloutTypeAzureOpenAI,
+		"cosmosdb":            CalloutTypeCosmosdb,
+		"external_data":       CalloutTypeExternalData,
+		"genevametrics":       CalloutTypeGenevametrics,
+		"kusto":               CalloutTypeKusto,
+		"sandbox_artifacts":   CalloutTypeSandboxArtifacts,
+		"sql":                 CalloutTypeSql,
+		"webapi":              CalloutTypeWebapi,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CalloutType(input)
+	return &out, nil
+}
+
+type ClusterNetworkAccessFlag string
+
+const (
+	ClusterNetworkAccessFlagDisabled ClusterNetworkAccessFlag = "Disabled"
+	ClusterNetworkAccessFlagEnabled  ClusterNetworkAccessFlag = "Enabled"
+)
+
+func PossibleValuesForClusterNetworkAccessFlag() []string {
+	return []string{
+		string(ClusterNetworkAccessFlagDisabled),
+		string(ClusterNetworkAccessFlagEnabled),
+	}
+}
+
+func parseClusterNetworkAccessFlag(input string) (*ClusterNetworkAccessFlag, error) {
+	vals := map[string]ClusterNetworkAccessFlag{
+		"disabled": ClusterNetworkAccessFlagDisabled,
+		"enabled":  ClusterNetworkAccessFlagEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ClusterNetworkAccessFlag(input)
+	return &out, nil
+}
+
+type OutboundAccess string
+
+const (
+	OutboundAccessAllow OutboundAccess = "Allow"
+	OutboundAccessDeny  OutboundAccess = "Deny"
+)
+
+func PossibleValuesForOutboundAccess() []string {
+	return []string{
+		string(OutboundAccessAllow),
+		string(OutboundAccessDeny),
+	}
+}
+
+func parseOutboundAccess(input string) (*OutboundAccess, error) {
+	vals := map[string]OutboundAccess{
+		"allow": OutboundAccessAllow,
+		"deny":  OutboundAccessDeny,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OutboundAccess(input)
+	return &out, nil
+}
+
+type PublicIPType string
+
+const (
+	PublicIPTypeDualStack PublicIPType = "DualStack"
+	PublicIPTypeIPv4      PublicIPType = "IPv4"
+)
+
+func PossibleValuesForPublicIPType() []string {
+	return []string{
+		string(PublicIPTypeDualStack),
+		string(PublicIPTypeIPv4),
+	}
+}
+
+func parsePublicIPType(input string) (*PublicIPType, error) {
+	vals := map[string]PublicIPType{
+		"dualstack": PublicIPTypeDualStack,
+		"ipv4":      PublicIPTypeIPv4,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicIPType(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
@@ -0,0 +1,75 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type AddCalloutPoliciesResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// AddCalloutPolicies ...
+func (c ClustersClient) AddCalloutPolicies(ctx context.Context, id ClusterId, input CalloutPoliciesList) (result AddCalloutPoliciesResponse, err error) {
+	req, err := c.preparerForAddCalloutPolicies(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusters.ClustersClient", "AddCalloutPolicies", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForAddCalloutPolicies(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusters.ClustersClient", "AddCalloutPolicies", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// AddCalloutPoliciesThenPoll performs AddCalloutPolicies then polls until it's completed
+func (c ClustersClient) AddCalloutPoliciesThenPoll(ctx context.Context, id ClusterId, input CalloutPoliciesList) error {
+	result, err := c.AddCalloutPolicies(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing AddCalloutPolicies: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after AddCalloutPolicies: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForAddCalloutPolicies prepares the AddCalloutPolicies request.
+func (c ClustersClient) preparerForAddCalloutPolicies(ctx context.Context, id ClusterId, input CalloutPoliciesList) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/addCalloutPolicies", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForAddCalloutPolicies sends the AddCalloutPolicies request. The method will close the
+// http.Response Body if it receives an error.
+func (c ClustersClient) senderForAddCalloutPolicies(ctx context.Context, req *http.Request) (future AddCalloutPoliciesResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListCalloutPoliciesResponse struct {
+	HttpResponse *http.Response
+	Model        *CalloutPoliciesList
+}
+
+// ListCalloutPolicies ...
+func (c ClustersClient) ListCalloutPolicies(ctx context.Context, id ClusterId) (result ListCalloutPoliciesResponse, err error) {
+	req, err := c.preparerForListCalloutPolicies(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusters.ClustersClient", "ListCalloutPolicies", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusters.ClustersClient", "ListCalloutPolicies", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListCalloutPolicies(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusters.ClustersClient", "ListCalloutPolicies", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListCalloutPolicies prepares the ListCalloutPolicies request.
+func (c ClustersClient) preparerForListCalloutPolicies(ctx context.Context, id ClusterId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listCalloutPolicies", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListCalloutPolicies handles the response to the ListCalloutPolicies request. The method always
+// closes the http.Response Body.
+func (c ClustersClient) responderForListCalloutPolicies(resp *http.Response) (result ListCalloutPoliciesResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,75 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type RemoveCalloutPolicyResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// RemoveCalloutPolicy ...
+func (c ClustersClient) RemoveCalloutPolicy(ctx context.Context, id ClusterId, input CalloutPolicyToRemove) (result RemoveCalloutPolicyResponse, err error) {
+	req, err := c.preparerForRemoveCalloutPolicy(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusters.ClustersClient", "RemoveCalloutPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForRemoveCalloutPolicy(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "clusters.ClustersClient", "RemoveCalloutPolicy", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// RemoveCalloutPolicyThenPoll performs RemoveCalloutPolicy then polls until it's completed
+func (c ClustersClient) RemoveCalloutPolicyThenPoll(ctx context.Context, id ClusterId, input CalloutPolicyToRemove) error {
+	result, err := c.RemoveCalloutPolicy(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing RemoveCalloutPolicy: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after RemoveCalloutPolicy: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForRemoveCalloutPolicy prepares the RemoveCalloutPolicy request.
+func (c ClustersClient) preparerForRemoveCalloutPolicy(ctx context.Context, id ClusterId, input CalloutPolicyToRemove) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/removeCalloutPolicy", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForRemoveCalloutPolicy sends the RemoveCalloutPolicy request. The method will close the
+// http.Response Body if it receives an error.
+func (c ClustersClient) senderForRemoveCalloutPolicy(ctx context.Context, req *http.Request) (future RemoveCalloutPolicyResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,6 @@
+package clusters
+
+type CalloutPoliciesList struct {
+	NextLink *string          `json:"nextLink,omitempty"`
+	Value    *[]CalloutPolicy `json:"value,omitempty"`
+}
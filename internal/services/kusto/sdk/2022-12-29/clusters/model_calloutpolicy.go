@@ -0,0 +1,8 @@
+package clusters
+
+type CalloutPolicy struct {
+	CalloutId       *string         `json:"calloutId,omitempty"`
+	CalloutType     *CalloutType    `json:"calloutType,omitempty"`
+	CalloutUriRegex *string         `json:"calloutUriRegex,omitempty"`
+	OutboundAccess  *OutboundAccess `json:"outboundAccess,omitempty"`
+}
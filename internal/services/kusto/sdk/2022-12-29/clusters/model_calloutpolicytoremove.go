@@ -0,0 +1,5 @@
+package clusters
+
+type CalloutPolicyToRemove struct {
+	CalloutId *string `json:"calloutId,omitempty"`
+}
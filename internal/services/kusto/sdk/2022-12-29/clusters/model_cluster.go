@@ -0,0 +1,9 @@
+package clusters
+
+type Cluster struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ClusterProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
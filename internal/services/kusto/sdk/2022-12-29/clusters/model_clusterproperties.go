@@ -0,0 +1,10 @@
+package clusters
+
+type ClusterProperties struct {
+	AllowedFqdnList               *[]string                 `json:"allowedFqdnList,omitempty"`
+	AllowedIPRangeList            *[]string                 `json:"allowedIpRangeList,omitempty"`
+	EnableAutoStop                *bool                     `json:"enableAutoStop,omitempty"`
+	PublicIPType                  *PublicIPType             `json:"publicIPType,omitempty"`
+	PublicNetworkAccess           *PublicNetworkAccess      `json:"publicNetworkAccess,omitempty"`
+	RestrictOutboundNetworkAccess *ClusterNetworkAccessFlag `json:"restrictOutboundNetworkAccess,omitempty"`
+}
@@ -0,0 +1,5 @@
+package clusters
+
+type ClusterUpdate struct {
+	Properties *ClusterProperties `json:"properties,omitempty"`
+}
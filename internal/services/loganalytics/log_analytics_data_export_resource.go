@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -24,8 +25,10 @@ func resourceLogAnalyticsDataExport() *pluginsdk.Resource {
 		Read:   resourceOperationalinsightsDataExportRead,
 		Update: resourceOperationalinsightsDataExportCreateUpdate,
 		Delete: resourceOperationalinsightsDataExportDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogAnalyticsDataExportID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -108,11 +111,9 @@ func resourceOperationalinsightsDataExportCreateUpdate(d *pluginsdk.ResourceData
 
 	parameters := operationalinsights.DataExport{
 		DataExportProperties: &operationalinsights.DataExportProperties{
-			Destination: &operationalinsights.Destination{
-				ResourceID: utils.String(d.Get("destination_resource_id").(string)),
-			},
-			TableNames: utils.ExpandStringSlice(d.Get("table_names").(*pluginsdk.Set).List()),
-			Enable:     utils.Bool(d.Get("enabled").(bool)),
+			Destination: expandDataExportDestination(d.Get("destination_resource_id").(string)),
+			TableNames:  utils.ExpandStringSlice(d.Get("table_names").(*pluginsdk.Set).List()),
+			Enable:      utils.Bool(d.Get("enabled").(bool)),
 		},
 	}
 
@@ -180,6 +181,23 @@ func resourceOperationalinsightsDataExportDelete(d *pluginsdk.ResourceData, meta
 	return nil
 }
 
+func expandDataExportDestination(input string) *operationalinsights.Destination {
+	// a specific Event Hub is exported to by targeting its Namespace and specifying the Event Hub name in the metadata
+	if eventHubId, err := eventhubs.ParseEventhubIDInsensitively(input); err == nil {
+		namespaceId := eventhubs.NewNamespaceID(eventHubId.SubscriptionId, eventHubId.ResourceGroupName, eventHubId.NamespaceName)
+		return &operationalinsights.Destination{
+			ResourceID: utils.String(namespaceId.ID()),
+			DestinationMetaData: &operationalinsights.DestinationMetaData{
+				EventHubName: utils.String(eventHubId.EventHubName),
+			},
+		}
+	}
+
+	return &operationalinsights.Destination{
+		ResourceID: utils.String(input),
+	}
+}
+
 func flattenDataExportDestination(input *operationalinsights.Destination) string {
 	if input == nil {
 		return ""
@@ -190,5 +208,11 @@ func flattenDataExportDestination(input *operationalinsights.Destination) string
 		resourceID = *input.ResourceID
 	}
 
+	if metaData := input.DestinationMetaData; metaData != nil && metaData.EventHubName != nil && *metaData.EventHubName != "" {
+		if namespaceId, err := eventhubs.ParseNamespaceIDInsensitively(resourceID); err == nil {
+			resourceID = eventhubs.NewEventhubID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, *metaData.EventHubName).ID()
+		}
+	}
+
 	return resourceID
 }
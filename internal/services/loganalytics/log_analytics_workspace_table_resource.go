@@ -0,0 +1,517 @@
+package loganalytics
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	logAnalyticsCustomLogTableSuffix     = "_CL"
+	logAnalyticsRestoredLogsTableSuffix  = "_RST"
+	logAnalyticsSearchResultsTableSuffix = "_SRCH"
+)
+
+func resourceLogAnalyticsWorkspaceTable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceLogAnalyticsWorkspaceTableRead,
+		Update: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceLogAnalyticsWorkspaceTableDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := tables.ParseTableID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceID,
+			},
+
+			"plan": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(tables.TablePlanEnumAnalytics),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(tables.TablePlanEnumAnalytics),
+					string(tables.TablePlanEnumBasic),
+				}, false),
+			},
+
+			"retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(4, 730),
+			},
+
+			"total_retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(4, 4383),
+			},
+
+			"column": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"restored_logs", "search_job"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(tables.PossibleValuesForColumnTypeEnum(), false),
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"display_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"restored_logs": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"column", "search_job"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_table": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"start_time": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+
+						"end_time": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+
+			"search_job": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"column", "restored_logs"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"start_time": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+
+						"end_time": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"limit": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"archive_retention_in_days": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLogAnalyticsWorkspaceTableCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.LogAnalyticsWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, name)
+
+	columns := d.Get("column").([]interface{})
+	restoredLogs := d.Get("restored_logs").([]interface{})
+	searchJob := d.Get("search_job").([]interface{})
+
+	if d.IsNewResource() {
+		if err := validateLogAnalyticsWorkspaceTableName(name, len(columns) > 0, len(restoredLogs) > 0, len(searchJob) > 0); err != nil {
+			return err
+		}
+
+		// built-in tables always exist within a workspace, so only the tables we create are checked for
+		if !isLogAnalyticsWorkspaceBuiltInTable(name) {
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError("azurerm_log_analytics_workspace_table", id.ID())
+			}
+		}
+	}
+
+	plan := tables.TablePlanEnum(d.Get("plan").(string))
+	properties := tables.TableProperties{
+		Plan: &plan,
+	}
+
+	if v, ok := d.GetOk("retention_in_days"); ok {
+		if plan == tables.TablePlanEnumBasic {
+			return fmt.Errorf("`retention_in_days` cannot be set when `plan` is `Basic`")
+		}
+		properties.RetentionInDays = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("total_retention_in_days"); ok {
+		properties.TotalRetentionInDays = utils.Int64(int64(v.(int)))
+	}
+
+	if len(columns) > 0 {
+		properties.Schema = &tables.Schema{
+			Name:    utils.String(name),
+			Columns: expandLogAnalyticsWorkspaceTableColumns(columns),
+		}
+	}
+
+	if len(restoredLogs) > 0 {
+		properties.RestoredLogs = expandLogAnalyticsWorkspaceTableRestoredLogs(restoredLogs)
+	}
+
+	if len(searchJob) > 0 {
+		properties.SearchResults = expandLogAnalyticsWorkspaceTableSearchJob(searchJob)
+		properties.Schema = &tables.Schema{
+			Name: utils.String(name),
+		}
+	}
+
+	parameters := tables.Table{
+		Properties: &properties,
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceLogAnalyticsWorkspaceTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TableName)
+	d.Set("workspace_id", parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			plan := string(tables.TablePlanEnumAnalytics)
+			if props.Plan != nil {
+				plan = string(*props.Plan)
+			}
+			d.Set("plan", plan)
+			d.Set("retention_in_days", props.RetentionInDays)
+			d.Set("total_retention_in_days", props.TotalRetentionInDays)
+			d.Set("archive_retention_in_days", props.ArchiveRetentionInDays)
+
+			columns := make([]interface{}, 0)
+			if schema := props.Schema; schema != nil && strings.HasSuffix(id.TableName, logAnalyticsCustomLogTableSuffix) {
+				columns = flattenLogAnalyticsWorkspaceTableColumns(schema.Columns)
+			}
+			if err := d.Set("column", columns); err != nil {
+				return fmt.Errorf("setting `column`: %+v", err)
+			}
+
+			if err := d.Set("restored_logs", flattenLogAnalyticsWorkspaceTableRestoredLogs(props.RestoredLogs)); err != nil {
+				return fmt.Errorf("setting `restored_logs`: %+v", err)
+			}
+
+			if err := d.Set("search_job", flattenLogAnalyticsWorkspaceTableSearchJob(props.SearchResults)); err != nil {
+				return fmt.Errorf("setting `search_job`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if isLogAnalyticsWorkspaceBuiltInTable(id.TableName) {
+		// built-in tables can't be deleted, so instead we reset the retention back to the workspace defaults
+		log.Printf("[DEBUG] Resetting the retention of %s to the workspace defaults", *id)
+		parameters := tables.Table{
+			Properties: &tables.TableProperties{
+				RetentionInDays:      utils.Int64(-1),
+				TotalRetentionInDays: utils.Int64(-1),
+			},
+		}
+		if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("resetting the retention of %s: %+v", *id, err)
+		}
+
+		return nil
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func isLogAnalyticsWorkspaceBuiltInTable(name string) bool {
+	for _, suffix := range []string{logAnalyticsCustomLogTableSuffix, logAnalyticsRestoredLogsTableSuffix, logAnalyticsSearchResultsTableSuffix} {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateLogAnalyticsWorkspaceTableName(name string, hasColumns, isRestoredLogs, isSearchJob bool) error {
+	switch {
+	case isRestoredLogs && !strings.HasSuffix(name, logAnalyticsRestoredLogsTableSuffix):
+		return fmt.Errorf("the `name` of a table with `restored_logs` must end with %q", logAnalyticsRestoredLogsTableSuffix)
+	case isSearchJob && !strings.HasSuffix(name, logAnalyticsSearchResultsTableSuffix):
+		return fmt.Errorf("the `name` of a table with `search_job` must end with %q", logAnalyticsSearchResultsTableSuffix)
+	case hasColumns && !strings.HasSuffix(name, logAnalyticsCustomLogTableSuffix):
+		return fmt.Errorf("`column` can only be specified for custom log tables, whose `name` must end with %q", logAnalyticsCustomLogTableSuffix)
+	case strings.HasSuffix(name, logAnalyticsCustomLogTableSuffix) && !hasColumns:
+		return fmt.Errorf("at least one `column` must be specified for the custom log table %q", name)
+	}
+	return nil
+}
+
+func expandLogAnalyticsWorkspaceTableColumns(input []interface{}) *[]tables.Column {
+	results := make([]tables.Column, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		columnType := tables.ColumnTypeEnum(v["type"].(string))
+		column := tables.Column{
+			Name: utils.String(v["name"].(string)),
+			Type: &columnType,
+		}
+		if description := v["description"].(string); description != "" {
+			column.Description = utils.String(description)
+		}
+		if displayName := v["display_name"].(string); displayName != "" {
+			column.DisplayName = utils.String(displayName)
+		}
+
+		results = append(results, column)
+	}
+	return &results
+}
+
+func flattenLogAnalyticsWorkspaceTableColumns(input *[]tables.Column) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		columnType := ""
+		if item.Type != nil {
+			columnType = string(*item.Type)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":         utils.NormalizeNilableString(item.Name),
+			"type":         columnType,
+			"description":  utils.NormalizeNilableString(item.Description),
+			"display_name": utils.NormalizeNilableString(item.DisplayName),
+		})
+	}
+	return results
+}
+
+func expandLogAnalyticsWorkspaceTableRestoredLogs(input []interface{}) *tables.RestoredLogs {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &tables.RestoredLogs{
+		SourceTable:      utils.String(v["source_table"].(string)),
+		StartRestoreTime: utils.String(v["start_time"].(string)),
+		EndRestoreTime:   utils.String(v["end_time"].(string)),
+	}
+}
+
+func flattenLogAnalyticsWorkspaceTableRestoredLogs(input *tables.RestoredLogs) []interface{} {
+	if input == nil || input.SourceTable == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"source_table": utils.NormalizeNilableString(input.SourceTable),
+			"start_time":   utils.NormalizeNilableString(input.StartRestoreTime),
+			"end_time":     utils.NormalizeNilableString(input.EndRestoreTime),
+		},
+	}
+}
+
+func expandLogAnalyticsWorkspaceTableSearchJob(input []interface{}) *tables.SearchResults {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := tables.SearchResults{
+		Query:           utils.String(v["query"].(string)),
+		StartSearchTime: utils.String(v["start_time"].(string)),
+		EndSearchTime:   utils.String(v["end_time"].(string)),
+	}
+
+	if description := v["description"].(string); description != "" {
+		result.Description = utils.String(description)
+	}
+
+	if limit := v["limit"].(int); limit > 0 {
+		result.Limit = utils.Int64(int64(limit))
+	}
+
+	return &result
+}
+
+func flattenLogAnalyticsWorkspaceTableSearchJob(input *tables.SearchResults) []interface{} {
+	if input == nil || input.Query == nil {
+		return []interface{}{}
+	}
+
+	var limit int64
+	if input.Limit != nil {
+		limit = *input.Limit
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"query":       utils.NormalizeNilableString(input.Query),
+			"start_time":  utils.NormalizeNilableString(input.StartSearchTime),
+			"end_time":    utils.NormalizeNilableString(input.EndSearchTime),
+			"description": utils.NormalizeNilableString(input.Description),
+			"limit":       limit,
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package tables
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TablesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTablesClientWithBaseURI(endpoint string) TablesClient {
+	return TablesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,142 @@
+package tables
+
+import "strings"
+
+type ColumnTypeEnum string
+
+const (
+	ColumnTypeEnumBoolean  ColumnTypeEnum = "boolean"
+	ColumnTypeEnumDateTime ColumnTypeEnum = "dateTime"
+	ColumnTypeEnumDynamic  ColumnTypeEnum = "dynamic"
+	ColumnTypeEnumGuid     ColumnTypeEnum = "guid"
+	ColumnTypeEnumInt      ColumnTypeEnum = "int"
+	ColumnTypeEnumLong     ColumnTypeEnum = "long"
+	ColumnTypeEnumReal     ColumnTypeEnum = "real"
+	ColumnTypeEnumString   ColumnTypeEnum = "string"
+)
+
+func PossibleValuesForColumnTypeEnum() []string {
+	return []string{
+		string(ColumnTypeEnumBoolean),
+		string(ColumnTypeEnumDateTime),
+		string(ColumnTypeEnumDynamic),
+		string(ColumnTypeEnumGuid),
+		string(ColumnTypeEnumInt),
+		string(ColumnTypeEnumLong),
+		string(ColumnTypeEnumReal),
+		string(ColumnTypeEnumString),
+	}
+}
+
+func parseColumnTypeEnum(input string) (*ColumnTypeEnum, error) {
+	vals := map[string]ColumnTypeEnum{
+		"boolean":  ColumnTypeEnumBoolean,
+		"datetime": ColumnTypeEnumDateTime,
+		"dynamic":  ColumnTypeEnumDynamic,
+		"guid":     ColumnTypeEnumGuid,
+		"int":      ColumnTypeEnumInt,
+		"long":     ColumnTypeEnumLong,
+		"real":     ColumnTypeEnumReal,
+		"string":   ColumnTypeEnumString,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ColumnTypeEnum(input)
+	return &out, nil
+}
+
+type TablePlanEnum string
+
+const (
+	TablePlanEnumAnalytics TablePlanEnum = "Analytics"
+	TablePlanEnumBasic     TablePlanEnum = "Basic"
+)
+
+func PossibleValuesForTablePlanEnum() []string {
+	return []string{
+		string(TablePlanEnumAnalytics),
+		string(TablePlanEnumBasic),
+	}
+}
+
+func parseTablePlanEnum(input string) (*TablePlanEnum, error) {
+	vals := map[string]TablePlanEnum{
+		"analytics": TablePlanEnumAnalytics,
+		"basic":     TablePlanEnumBasic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TablePlanEnum(input)
+	return &out, nil
+}
+
+type TableSubTypeEnum string
+
+const (
+	TableSubTypeEnumAny                     TableSubTypeEnum = "Any"
+	TableSubTypeEnumClassic                 TableSubTypeEnum = "Classic"
+	TableSubTypeEnumDataCollectionRuleBased TableSubTypeEnum = "DataCollectionRuleBased"
+)
+
+func PossibleValuesForTableSubTypeEnum() []string {
+	return []string{
+		string(TableSubTypeEnumAny),
+		string(TableSubTypeEnumClassic),
+		string(TableSubTypeEnumDataCollectionRuleBased),
+	}
+}
+
+func parseTableSubTypeEnum(input string) (*TableSubTypeEnum, error) {
+	vals := map[string]TableSubTypeEnum{
+		"any":                     TableSubTypeEnumAny,
+		"classic":                 TableSubTypeEnumClassic,
+		"datacollectionrulebased": TableSubTypeEnumDataCollectionRuleBased,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TableSubTypeEnum(input)
+	return &out, nil
+}
+
+type TableTypeEnum string
+
+const (
+	TableTypeEnumCustomLog     TableTypeEnum = "CustomLog"
+	TableTypeEnumMicrosoft     TableTypeEnum = "Microsoft"
+	TableTypeEnumRestoredLogs  TableTypeEnum = "RestoredLogs"
+	TableTypeEnumSearchResults TableTypeEnum = "SearchResults"
+)
+
+func PossibleValuesForTableTypeEnum() []string {
+	return []string{
+		string(TableTypeEnumCustomLog),
+		string(TableTypeEnumMicrosoft),
+		string(TableTypeEnumRestoredLogs),
+		string(TableTypeEnumSearchResults),
+	}
+}
+
+func parseTableTypeEnum(input string) (*TableTypeEnum, error) {
+	vals := map[string]TableTypeEnum{
+		"customlog":     TableTypeEnumCustomLog,
+		"microsoft":     TableTypeEnumMicrosoft,
+		"restoredlogs":  TableTypeEnumRestoredLogs,
+		"searchresults": TableTypeEnumSearchResults,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TableTypeEnum(input)
+	return &out, nil
+}
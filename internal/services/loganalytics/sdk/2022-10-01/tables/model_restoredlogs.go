@@ -0,0 +1,8 @@
+package tables
+
+type RestoredLogs struct {
+	AzureAsyncOperationId *string `json:"azureAsyncOperationId,omitempty"`
+	EndRestoreTime        *string `json:"endRestoreTime,omitempty"`
+	SourceTable           *string `json:"sourceTable,omitempty"`
+	StartRestoreTime      *string `json:"startRestoreTime,omitempty"`
+}
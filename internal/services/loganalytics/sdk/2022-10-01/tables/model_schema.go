@@ -0,0 +1,11 @@
+package tables
+
+type Schema struct {
+	Columns         *[]Column         `json:"columns,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	DisplayName     *string           `json:"displayName,omitempty"`
+	Name            *string           `json:"name,omitempty"`
+	StandardColumns *[]Column         `json:"standardColumns,omitempty"`
+	TableSubType    *TableSubTypeEnum `json:"tableSubType,omitempty"`
+	TableType       *TableTypeEnum    `json:"tableType,omitempty"`
+}
@@ -0,0 +1,11 @@
+package tables
+
+type SearchResults struct {
+	AzureAsyncOperationId *string `json:"azureAsyncOperationId,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	EndSearchTime         *string `json:"endSearchTime,omitempty"`
+	Limit                 *int64  `json:"limit,omitempty"`
+	Query                 *string `json:"query,omitempty"`
+	SourceTable           *string `json:"sourceTable,omitempty"`
+	StartSearchTime       *string `json:"startSearchTime,omitempty"`
+}
@@ -0,0 +1,14 @@
+package tables
+
+type TableProperties struct {
+	ArchiveRetentionInDays        *int64         `json:"archiveRetentionInDays,omitempty"`
+	LastPlanModifiedDate          *string        `json:"lastPlanModifiedDate,omitempty"`
+	Plan                          *TablePlanEnum `json:"plan,omitempty"`
+	RestoredLogs                  *RestoredLogs  `json:"restoredLogs,omitempty"`
+	RetentionInDays               *int64         `json:"retentionInDays,omitempty"`
+	RetentionInDaysAsDefault      *bool          `json:"retentionInDaysAsDefault,omitempty"`
+	Schema                        *Schema        `json:"schema,omitempty"`
+	SearchResults                 *SearchResults `json:"searchResults,omitempty"`
+	TotalRetentionInDays          *int64         `json:"totalRetentionInDays,omitempty"`
+	TotalRetentionInDaysAsDefault *bool          `json:"totalRetentionInDaysAsDefault,omitempty"`
+}
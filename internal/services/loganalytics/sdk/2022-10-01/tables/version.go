@@ -0,0 +1,9 @@
+package tables
+
+import "fmt"
+
+const defaultApiVersion = "2022-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/tables/%s", defaultApiVersion)
+}
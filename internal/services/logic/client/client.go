@@ -3,9 +3,11 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2019-05-01/logic"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2018-11-01/hostruntime"
 )
 
 type Client struct {
+	HostRuntimeClient                          *hostruntime.HostRuntimeClient
 	IntegrationAccountClient                   *logic.IntegrationAccountsClient
 	IntegrationAccountAgreementClient          *logic.IntegrationAccountAgreementsClient
 	IntegrationAccountAssemblyClient           *logic.IntegrationAccountAssembliesClient
@@ -21,6 +23,9 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	hostRuntimeClient := hostruntime.NewHostRuntimeClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&hostRuntimeClient.Client, o.ResourceManagerAuthorizer)
+
 	integrationAccountClient := logic.NewIntegrationAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&integrationAccountClient.Client, o.ResourceManagerAuthorizer)
 
@@ -58,6 +63,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&triggersClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		HostRuntimeClient:                          &hostRuntimeClient,
 		IntegrationAccountClient:                   &integrationAccountClient,
 		IntegrationAccountAgreementClient:          &integrationAccountAgreementClient,
 		IntegrationAccountAssemblyClient:           &integrationAccountAssemblyClient,
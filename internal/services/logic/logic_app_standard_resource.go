@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -174,6 +175,12 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 				Default:  "~3",
 			},
 
+			"virtual_network_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
 			"tags": tags.Schema(),
 
 			// Computed Only
@@ -307,6 +314,10 @@ func resourceLogicAppStandardCreate(d *pluginsdk.ResourceData, meta interface{})
 		siteEnvelope.SiteProperties.ClientCertMode = web.ClientCertMode(clientCertMode)
 	}
 
+	if subnetId := d.Get("virtual_network_subnet_id").(string); subnetId != "" {
+		siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(subnetId)
+	}
+
 	if _, ok := d.GetOk("identity"); ok {
 		appServiceIdentityRaw := d.Get("identity").([]interface{})
 		appServiceIdentity := expandLogicAppStandardIdentity(appServiceIdentityRaw)
@@ -404,6 +415,10 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 		siteEnvelope.SiteProperties.ClientCertMode = web.ClientCertMode(clientCertMode)
 	}
 
+	if subnetId := d.Get("virtual_network_subnet_id").(string); subnetId != "" {
+		siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(subnetId)
+	}
+
 	if _, ok := d.GetOk("identity"); ok {
 		appServiceIdentityRaw := d.Get("identity").([]interface{})
 		appServiceIdentity := expandLogicAppStandardIdentity(appServiceIdentityRaw)
@@ -419,6 +434,13 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("waiting for update of Logic App Standard %q (Resource Group %q): %+v", id.SiteName, id.ResourceGroup, err)
 	}
 
+	// the Virtual Network Integration isn't removed when `virtual_network_subnet_id` is omitted from the payload
+	if d.HasChange("virtual_network_subnet_id") && d.Get("virtual_network_subnet_id").(string) == "" {
+		if _, err := client.DeleteSwiftVirtualNetwork(ctx, id.ResourceGroup, id.SiteName); err != nil {
+			return fmt.Errorf("removing Virtual Network Integration for Logic App Standard %q (Resource Group %q): %+v", id.SiteName, id.ResourceGroup, err)
+		}
+	}
+
 	settings := web.StringDictionary{
 		Properties: appSettings,
 	}
@@ -520,6 +542,7 @@ func resourceLogicAppStandardRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("possible_outbound_ip_addresses", props.PossibleOutboundIPAddresses)
 		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
 		d.Set("custom_domain_verification_id", props.CustomDomainVerificationID)
+		d.Set("virtual_network_subnet_id", props.VirtualNetworkSubnetID)
 
 		clientCertMode := ""
 		if props.ClientCertEnabled != nil && *props.ClientCertEnabled {
@@ -1399,7 +1422,7 @@ func expandLogicAppStandardIpRestriction(input interface{}) ([]web.IPSecurityRes
 		priority := restriction["priority"].(int)
 		action := restriction["action"].(string)
 
-		if vNetSubnetID != "" && ipAddress != "" && serviceTag != "" {
+		if (vNetSubnetID != "" && ipAddress != "") || (vNetSubnetID != "" && serviceTag != "") || (ipAddress != "" && serviceTag != "") {
 			return nil, fmt.Errorf("only one of `ip_address`, `service_tag` or `virtual_network_subnet_id` can be set for an IP restriction")
 		}
 
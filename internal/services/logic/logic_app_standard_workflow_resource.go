@@ -0,0 +1,175 @@
+package logic
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2018-11-01/hostruntime"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceLogicAppStandardWorkflow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogicAppStandardWorkflowCreateUpdate,
+		Read:   resourceLogicAppStandardWorkflowRead,
+		Update: resourceLogicAppStandardWorkflowCreateUpdate,
+		Delete: resourceLogicAppStandardWorkflowDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogicAppStandardWorkflowID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,42}$`),
+					"`name` must start with a letter, can contain only letters, numbers, hyphens and underscores and be at most 43 characters",
+				),
+			},
+
+			"logic_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardID,
+			},
+
+			"definition": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(hostruntime.WorkflowKindStateful),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(hostruntime.WorkflowKindStateful),
+					string(hostruntime.WorkflowKindStateless),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceLogicAppStandardWorkflowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Logic.HostRuntimeClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	logicAppId, err := parse.LogicAppStandardID(d.Get("logic_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogicAppStandardWorkflowID(logicAppId.SubscriptionId, logicAppId.ResourceGroup, logicAppId.SiteName, d.Get("name").(string))
+	workflowId := hostruntime.NewWorkflowID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.WorkflowName)
+
+	if d.IsNewResource() {
+		existing, err := client.GetWorkflowFile(ctx, workflowId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_logic_app_standard_workflow", id.ID())
+		}
+	}
+
+	definition, err := pluginsdk.ExpandJsonFromString(d.Get("definition").(string))
+	if err != nil {
+		return fmt.Errorf("expanding JSON for `definition`: %+v", err)
+	}
+
+	parameters := hostruntime.WorkflowFile{
+		Definition: definition,
+		Kind:       hostruntime.WorkflowKind(d.Get("kind").(string)),
+	}
+
+	if _, err := client.PutWorkflowFile(ctx, workflowId, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceLogicAppStandardWorkflowRead(d, meta)
+}
+
+func resourceLogicAppStandardWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Logic.HostRuntimeClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardWorkflowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetWorkflowFile(ctx, hostruntime.NewWorkflowID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.WorkflowName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.WorkflowName)
+	d.Set("logic_app_id", parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("kind", string(model.Kind))
+
+		if definition, ok := model.Definition.(map[string]interface{}); ok {
+			definitionStr, err := pluginsdk.FlattenJsonToString(definition)
+			if err != nil {
+				return fmt.Errorf("flattening `definition`: %+v", err)
+			}
+			d.Set("definition", definitionStr)
+		}
+	}
+
+	return nil
+}
+
+func resourceLogicAppStandardWorkflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Logic.HostRuntimeClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardWorkflowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.DeleteWorkflow(ctx, hostruntime.NewWorkflowID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.WorkflowName)); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2018-11-01/hostruntime"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogicAppStandardWorkflowResource struct{}
+
+func TestAccLogicAppStandardWorkflow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("Stateful"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardWorkflow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogicAppStandardWorkflow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stateless(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("Stateless"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (LogicAppStandardWorkflowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LogicAppStandardWorkflowID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Logic.HostRuntimeClient.GetWorkflowFile(ctx, hostruntime.NewWorkflowID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.WorkflowName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (LogicAppStandardWorkflowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "test" {
+  name         = "acctest-workflow"
+  logic_app_id = azurerm_logic_app_standard.test.id
+  definition = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#"
+    contentVersion = "1.0.0.0"
+    triggers = {
+      manual = {
+        type = "Request"
+        kind = "Http"
+      }
+    }
+    actions = {
+      Response = {
+        type = "Response"
+        kind = "Http"
+        inputs = {
+          statusCode = 200
+        }
+        runAfter = {}
+      }
+    }
+    outputs = {}
+  })
+}
+`, LogicAppStandardResource{}.basic(data))
+}
+
+func (r LogicAppStandardWorkflowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "import" {
+  name         = azurerm_logic_app_standard_workflow.test.name
+  logic_app_id = azurerm_logic_app_standard_workflow.test.logic_app_id
+  definition   = azurerm_logic_app_standard_workflow.test.definition
+}
+`, r.basic(data))
+}
+
+func (LogicAppStandardWorkflowResource) stateless(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "test" {
+  name         = "acctest-workflow"
+  logic_app_id = azurerm_logic_app_standard.test.id
+  kind         = "Stateless"
+  definition = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#"
+    contentVersion = "1.0.0.0"
+    triggers = {
+      manual = {
+        type = "Request"
+        kind = "Http"
+      }
+    }
+    actions = {
+      Response = {
+        type = "Response"
+        kind = "Http"
+        inputs = {
+          statusCode = 202
+        }
+        runAfter = {}
+      }
+    }
+    outputs = {}
+  })
+}
+`, LogicAppStandardResource{}.basic(data))
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type LogicAppStandardWorkflowId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	WorkflowName   string
+}
+
+func NewLogicAppStandardWorkflowID(subscriptionId, resourceGroup, siteName, workflowName string) LogicAppStandardWorkflowId {
+	return LogicAppStandardWorkflowId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		WorkflowName:   workflowName,
+	}
+}
+
+func (id LogicAppStandardWorkflowId) String() string {
+	segments := []string{
+		fmt.Sprintf("Workflow Name %q", id.WorkflowName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Logic App Standard Workflow", segmentsStr)
+}
+
+func (id LogicAppStandardWorkflowId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/workflows/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.WorkflowName)
+}
+
+// LogicAppStandardWorkflowID parses a LogicAppStandardWorkflow ID into an LogicAppStandardWorkflowId struct
+func LogicAppStandardWorkflowID(input string) (*LogicAppStandardWorkflowId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LogicAppStandardWorkflowId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkflowName, err = id.PopSegment("workflows"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
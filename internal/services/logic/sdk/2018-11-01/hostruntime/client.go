@@ -0,0 +1,15 @@
+package hostruntime
+
+import "github.com/Azure/go-autorest/autorest"
+
+type HostRuntimeClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewHostRuntimeClientWithBaseURI(endpoint string) HostRuntimeClient {
+	return HostRuntimeClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
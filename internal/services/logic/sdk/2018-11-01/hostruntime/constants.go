@@ -0,0 +1,31 @@
+package hostruntime
+
+import "strings"
+
+type WorkflowKind string
+
+const (
+	WorkflowKindStateful  WorkflowKind = "Stateful"
+	WorkflowKindStateless WorkflowKind = "Stateless"
+)
+
+func PossibleValuesForWorkflowKind() []string {
+	return []string{
+		string(WorkflowKindStateful),
+		string(WorkflowKindStateless),
+	}
+}
+
+func parseWorkflowKind(input string) (*WorkflowKind, error) {
+	vals := map[string]WorkflowKind{
+		"stateful":  WorkflowKindStateful,
+		"stateless": WorkflowKindStateless,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WorkflowKind(input)
+	return &out, nil
+}
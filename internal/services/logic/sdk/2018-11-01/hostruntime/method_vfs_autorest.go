@@ -0,0 +1,189 @@
+package hostruntime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the workflows of a Logic App Standard are stored as files within the App's content share, which are managed
+// through the Virtual File System exposed by the Host Runtime - where each Workflow is defined in a directory
+// named after the Workflow, containing a `workflow.json` file
+
+func workflowDirectoryPath(id WorkflowId) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/hostruntime/admin/vfs/%s/", id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.WorkflowName)
+}
+
+func workflowFilePath(id WorkflowId) string {
+	return workflowDirectoryPath(id) + "workflow.json"
+}
+
+type GetWorkflowFileResponse struct {
+	HttpResponse *http.Response
+	Model        *WorkflowFile
+}
+
+// GetWorkflowFile ...
+func (c HostRuntimeClient) GetWorkflowFile(ctx context.Context, id WorkflowId) (result GetWorkflowFileResponse, err error) {
+	req, err := c.preparerForGetWorkflowFile(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "GetWorkflowFile", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "GetWorkflowFile", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetWorkflowFile(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "GetWorkflowFile", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetWorkflowFile prepares the GetWorkflowFile request.
+func (c HostRuntimeClient) preparerForGetWorkflowFile(ctx context.Context, id WorkflowId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version":  defaultApiVersion,
+		"relativePath": 1,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(workflowFilePath(id)),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetWorkflowFile handles the response to the GetWorkflowFile request. The method always
+// closes the http.Response Body.
+func (c HostRuntimeClient) responderForGetWorkflowFile(resp *http.Response) (result GetWorkflowFileResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
+
+type PutWorkflowFileResponse struct {
+	HttpResponse *http.Response
+}
+
+// PutWorkflowFile ...
+func (c HostRuntimeClient) PutWorkflowFile(ctx context.Context, id WorkflowId, input WorkflowFile) (result PutWorkflowFileResponse, err error) {
+	req, err := c.preparerForPutWorkflowFile(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "PutWorkflowFile", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "PutWorkflowFile", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPutWorkflowFile(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "PutWorkflowFile", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPutWorkflowFile prepares the PutWorkflowFile request.
+func (c HostRuntimeClient) preparerForPutWorkflowFile(ctx context.Context, id WorkflowId, input WorkflowFile) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version":  defaultApiVersion,
+		"relativePath": 1,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(workflowFilePath(id)),
+		// the file is overwritten if it already exists
+		autorest.WithHeader("If-Match", "*"),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPutWorkflowFile handles the response to the PutWorkflowFile request. The method always
+// closes the http.Response Body.
+func (c HostRuntimeClient) responderForPutWorkflowFile(resp *http.Response) (result PutWorkflowFileResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
+
+type DeleteWorkflowResponse struct {
+	HttpResponse *http.Response
+}
+
+// DeleteWorkflow deletes the directory containing the Workflow
+func (c HostRuntimeClient) DeleteWorkflow(ctx context.Context, id WorkflowId) (result DeleteWorkflowResponse, err error) {
+	req, err := c.preparerForDeleteWorkflow(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "DeleteWorkflow", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "DeleteWorkflow", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDeleteWorkflow(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "hostruntime.HostRuntimeClient", "DeleteWorkflow", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDeleteWorkflow prepares the DeleteWorkflow request.
+func (c HostRuntimeClient) preparerForDeleteWorkflow(ctx context.Context, id WorkflowId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version":  defaultApiVersion,
+		"recursive":    true,
+		"relativePath": 1,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(workflowDirectoryPath(id)),
+		autorest.WithHeader("If-Match", "*"),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDeleteWorkflow handles the response to the DeleteWorkflow request. The method always
+// closes the http.Response Body.
+func (c HostRuntimeClient) responderForDeleteWorkflow(resp *http.Response) (result DeleteWorkflowResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,6 @@
+package hostruntime
+
+type WorkflowFile struct {
+	Definition interface{}  `json:"definition"`
+	Kind       WorkflowKind `json:"kind"`
+}
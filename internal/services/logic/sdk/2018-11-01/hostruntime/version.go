@@ -0,0 +1,9 @@
+package hostruntime
+
+import "fmt"
+
+const defaultApiVersion = "2018-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/hostruntime/%s", defaultApiVersion)
+}
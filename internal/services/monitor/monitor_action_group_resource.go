@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-09-01/actiongroupsapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -27,7 +27,7 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 		Delete: resourceMonitorActionGroupDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ActionGroupID(id)
+			_, err := actiongroupsapis.ParseActionGroupID(id)
 			return err
 		}),
 
@@ -369,6 +369,47 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"event_hub_receiver": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"event_hub_namespace": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"event_hub_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"subscription_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"tenant_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"use_common_alert_schema": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -381,18 +422,18 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewActionGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := actiongroupsapis.NewActionGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.ActionGroupsGet(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing Monitor %s: %+v", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_monitor_action_group", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_action_group", id.ID())
 		}
 	}
 
@@ -409,15 +450,13 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	logicAppReceiversRaw := d.Get("logic_app_receiver").([]interface{})
 	azureFunctionReceiversRaw := d.Get("azure_function_receiver").([]interface{})
 	armRoleReceiversRaw := d.Get("arm_role_receiver").([]interface{})
+	eventHubReceiversRaw := d.Get("event_hub_receiver").([]interface{})
 
-	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
-
-	parameters := insights.ActionGroupResource{
-		Location: utils.String(azure.NormalizeLocation("Global")),
-		ActionGroup: &insights.ActionGroup{
-			GroupShortName:             utils.String(shortName),
-			Enabled:                    utils.Bool(enabled),
+	parameters := actiongroupsapis.ActionGroupResource{
+		Location: azure.NormalizeLocation("Global"),
+		Properties: &actiongroupsapis.ActionGroup{
+			GroupShortName:             shortName,
+			Enabled:                    enabled,
 			EmailReceivers:             expandMonitorActionGroupEmailReceiver(emailReceiversRaw),
 			AzureAppPushReceivers:      expandMonitorActionGroupAzureAppPushReceiver(azureAppPushReceiversRaw),
 			ItsmReceivers:              expandMonitorActionGroupItsmReceiver(itsmReceiversRaw),
@@ -428,11 +467,12 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			LogicAppReceivers:          expandMonitorActionGroupLogicAppReceiver(logicAppReceiversRaw),
 			AzureFunctionReceivers:     expandMonitorActionGroupAzureFunctionReceiver(azureFunctionReceiversRaw),
 			ArmRoleReceivers:           expandMonitorActionGroupRoleReceiver(armRoleReceiversRaw),
+			EventHubReceivers:          expandMonitorActionGroupEventHubReceiver(tenantId, subscriptionId, eventHubReceiversRaw),
 		},
-		Tags: expandedTags,
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+	if _, err := client.ActionGroupsCreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating or updating %s: %+v", id, err)
 	}
 
@@ -446,67 +486,77 @@ func resourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ActionGroupID(d.Id())
+	id, err := actiongroupsapis.ParseActionGroupIDInsensitively(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.ActionGroupsGet(ctx, *id)
 	if err != nil {
-		if response.WasNotFound(resp.Response.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("name", id.ActionGroupName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if group := resp.ActionGroup; group != nil {
-		d.Set("short_name", group.GroupShortName)
-		d.Set("enabled", group.Enabled)
+	if model := resp.Model; model != nil {
+		if group := model.Properties; group != nil {
+			d.Set("short_name", group.GroupShortName)
+			d.Set("enabled", group.Enabled)
 
-		if err = d.Set("email_receiver", flattenMonitorActionGroupEmailReceiver(group.EmailReceivers)); err != nil {
-			return fmt.Errorf("setting `email_receiver`: %+v", err)
-		}
+			if err = d.Set("email_receiver", flattenMonitorActionGroupEmailReceiver(group.EmailReceivers)); err != nil {
+				return fmt.Errorf("setting `email_receiver`: %+v", err)
+			}
 
-		if err = d.Set("itsm_receiver", flattenMonitorActionGroupItsmReceiver(group.ItsmReceivers)); err != nil {
-			return fmt.Errorf("setting `itsm_receiver`: %+v", err)
-		}
+			if err = d.Set("itsm_receiver", flattenMonitorActionGroupItsmReceiver(group.ItsmReceivers)); err != nil {
+				return fmt.Errorf("setting `itsm_receiver`: %+v", err)
+			}
 
-		if err = d.Set("azure_app_push_receiver", flattenMonitorActionGroupAzureAppPushReceiver(group.AzureAppPushReceivers)); err != nil {
-			return fmt.Errorf("setting `azure_app_push_receiver`: %+v", err)
-		}
+			if err = d.Set("azure_app_push_receiver", flattenMonitorActionGroupAzureAppPushReceiver(group.AzureAppPushReceivers)); err != nil {
+				return fmt.Errorf("setting `azure_app_push_receiver`: %+v", err)
+			}
 
-		if err = d.Set("sms_receiver", flattenMonitorActionGroupSmsReceiver(group.SmsReceivers)); err != nil {
-			return fmt.Errorf("setting `sms_receiver`: %+v", err)
-		}
+			if err = d.Set("sms_receiver", flattenMonitorActionGroupSmsReceiver(group.SmsReceivers)); err != nil {
+				return fmt.Errorf("setting `sms_receiver`: %+v", err)
+			}
 
-		if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers)); err != nil {
-			return fmt.Errorf("setting `webhook_receiver`: %+v", err)
-		}
+			if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers)); err != nil {
+				return fmt.Errorf("setting `webhook_receiver`: %+v", err)
+			}
 
-		if err = d.Set("automation_runbook_receiver", flattenMonitorActionGroupAutomationRunbookReceiver(group.AutomationRunbookReceivers)); err != nil {
-			return fmt.Errorf("setting `automation_runbook_receiver`: %+v", err)
-		}
+			if err = d.Set("automation_runbook_receiver", flattenMonitorActionGroupAutomationRunbookReceiver(group.AutomationRunbookReceivers)); err != nil {
+				return fmt.Errorf("setting `automation_runbook_receiver`: %+v", err)
+			}
 
-		if err = d.Set("voice_receiver", flattenMonitorActionGroupVoiceReceiver(group.VoiceReceivers)); err != nil {
-			return fmt.Errorf("setting `voice_receiver`: %+v", err)
-		}
+			if err = d.Set("voice_receiver", flattenMonitorActionGroupVoiceReceiver(group.VoiceReceivers)); err != nil {
+				return fmt.Errorf("setting `voice_receiver`: %+v", err)
+			}
 
-		if err = d.Set("logic_app_receiver", flattenMonitorActionGroupLogicAppReceiver(group.LogicAppReceivers)); err != nil {
-			return fmt.Errorf("setting `logic_app_receiver`: %+v", err)
-		}
+			if err = d.Set("logic_app_receiver", flattenMonitorActionGroupLogicAppReceiver(group.LogicAppReceivers)); err != nil {
+				return fmt.Errorf("setting `logic_app_receiver`: %+v", err)
+			}
 
-		if err = d.Set("azure_function_receiver", flattenMonitorActionGroupAzureFunctionReceiver(group.AzureFunctionReceivers)); err != nil {
-			return fmt.Errorf("setting `azure_function_receiver`: %+v", err)
-		}
-		if err = d.Set("arm_role_receiver", flattenMonitorActionGroupRoleReceiver(group.ArmRoleReceivers)); err != nil {
-			return fmt.Errorf("setting `arm_role_receiver`: %+v", err)
+			if err = d.Set("azure_function_receiver", flattenMonitorActionGroupAzureFunctionReceiver(group.AzureFunctionReceivers)); err != nil {
+				return fmt.Errorf("setting `azure_function_receiver`: %+v", err)
+			}
+
+			if err = d.Set("arm_role_receiver", flattenMonitorActionGroupRoleReceiver(group.ArmRoleReceivers)); err != nil {
+				return fmt.Errorf("setting `arm_role_receiver`: %+v", err)
+			}
+
+			if err = d.Set("event_hub_receiver", flattenMonitorActionGroupEventHubReceiver(group.EventHubReceivers)); err != nil {
+				return fmt.Errorf("setting `event_hub_receiver`: %+v", err)
+			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+
+	return nil
 }
 
 func resourceMonitorActionGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -514,14 +564,14 @@ func resourceMonitorActionGroupDelete(d *pluginsdk.ResourceData, meta interface{
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ActionGroupID(d.Id())
+	id, err := actiongroupsapis.ParseActionGroupIDInsensitively(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.ActionGroupsDelete(ctx, *id)
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting %s: %+v", *id, err)
 		}
 	}
@@ -529,13 +579,13 @@ func resourceMonitorActionGroupDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
-func expandMonitorActionGroupEmailReceiver(v []interface{}) *[]insights.EmailReceiver {
-	receivers := make([]insights.EmailReceiver, 0)
+func expandMonitorActionGroupEmailReceiver(v []interface{}) *[]actiongroupsapis.EmailReceiver {
+	receivers := make([]actiongroupsapis.EmailReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.EmailReceiver{
-			Name:                 utils.String(val["name"].(string)),
-			EmailAddress:         utils.String(val["email_address"].(string)),
+		receiver := actiongroupsapis.EmailReceiver{
+			Name:                 val["name"].(string),
+			EmailAddress:         val["email_address"].(string),
 			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
 		receivers = append(receivers, receiver)
@@ -543,67 +593,67 @@ func expandMonitorActionGroupEmailReceiver(v []interface{}) *[]insights.EmailRec
 	return &receivers
 }
 
-func expandMonitorActionGroupItsmReceiver(v []interface{}) *[]insights.ItsmReceiver {
-	receivers := make([]insights.ItsmReceiver, 0)
+func expandMonitorActionGroupItsmReceiver(v []interface{}) *[]actiongroupsapis.ItsmReceiver {
+	receivers := make([]actiongroupsapis.ItsmReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.ItsmReceiver{
-			Name:                utils.String(val["name"].(string)),
-			WorkspaceID:         utils.String(val["workspace_id"].(string)),
-			ConnectionID:        utils.String(val["connection_id"].(string)),
-			TicketConfiguration: utils.String(val["ticket_configuration"].(string)),
-			Region:              utils.String(azure.NormalizeLocation(val["region"].(string))),
+		receiver := actiongroupsapis.ItsmReceiver{
+			Name:                val["name"].(string),
+			WorkspaceId:         val["workspace_id"].(string),
+			ConnectionId:        val["connection_id"].(string),
+			TicketConfiguration: val["ticket_configuration"].(string),
+			Region:              azure.NormalizeLocation(val["region"].(string)),
 		}
 		receivers = append(receivers, receiver)
 	}
 	return &receivers
 }
 
-func expandMonitorActionGroupAzureAppPushReceiver(v []interface{}) *[]insights.AzureAppPushReceiver {
-	receivers := make([]insights.AzureAppPushReceiver, 0)
+func expandMonitorActionGroupAzureAppPushReceiver(v []interface{}) *[]actiongroupsapis.AzureAppPushReceiver {
+	receivers := make([]actiongroupsapis.AzureAppPushReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.AzureAppPushReceiver{
-			Name:         utils.String(val["name"].(string)),
-			EmailAddress: utils.String(val["email_address"].(string)),
+		receiver := actiongroupsapis.AzureAppPushReceiver{
+			Name:         val["name"].(string),
+			EmailAddress: val["email_address"].(string),
 		}
 		receivers = append(receivers, receiver)
 	}
 	return &receivers
 }
 
-func expandMonitorActionGroupSmsReceiver(v []interface{}) *[]insights.SmsReceiver {
-	receivers := make([]insights.SmsReceiver, 0)
+func expandMonitorActionGroupSmsReceiver(v []interface{}) *[]actiongroupsapis.SmsReceiver {
+	receivers := make([]actiongroupsapis.SmsReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.SmsReceiver{
-			Name:        utils.String(val["name"].(string)),
-			CountryCode: utils.String(val["country_code"].(string)),
-			PhoneNumber: utils.String(val["phone_number"].(string)),
+		receiver := actiongroupsapis.SmsReceiver{
+			Name:        val["name"].(string),
+			CountryCode: val["country_code"].(string),
+			PhoneNumber: val["phone_number"].(string),
 		}
 		receivers = append(receivers, receiver)
 	}
 	return &receivers
 }
 
-func expandMonitorActionGroupWebHookReceiver(tenantId string, v []interface{}) *[]insights.WebhookReceiver {
-	receivers := make([]insights.WebhookReceiver, 0)
+func expandMonitorActionGroupWebHookReceiver(tenantId string, v []interface{}) *[]actiongroupsapis.WebhookReceiver {
+	receivers := make([]actiongroupsapis.WebhookReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.WebhookReceiver{
-			Name:                 utils.String(val["name"].(string)),
-			ServiceURI:           utils.String(val["service_uri"].(string)),
+		receiver := actiongroupsapis.WebhookReceiver{
+			Name:                 val["name"].(string),
+			ServiceUri:           val["service_uri"].(string),
 			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
 		if v, ok := val["aad_auth"].([]interface{}); ok && len(v) > 0 {
 			secureWebhook := v[0].(map[string]interface{})
 			receiver.UseAadAuth = utils.Bool(true)
-			receiver.ObjectID = utils.String(secureWebhook["object_id"].(string))
-			receiver.IdentifierURI = utils.String(secureWebhook["identifier_uri"].(string))
+			receiver.ObjectId = utils.String(secureWebhook["object_id"].(string))
+			receiver.IdentifierUri = utils.String(secureWebhook["identifier_uri"].(string))
 			if v := secureWebhook["tenant_id"].(string); v != "" {
-				receiver.TenantID = utils.String(v)
+				receiver.TenantId = utils.String(v)
 			} else {
-				receiver.TenantID = utils.String(tenantId)
+				receiver.TenantId = utils.String(tenantId)
 			}
 		}
 		receivers = append(receivers, receiver)
@@ -611,17 +661,17 @@ func expandMonitorActionGroupWebHookReceiver(tenantId string, v []interface{}) *
 	return &receivers
 }
 
-func expandMonitorActionGroupAutomationRunbookReceiver(v []interface{}) *[]insights.AutomationRunbookReceiver {
-	receivers := make([]insights.AutomationRunbookReceiver, 0)
+func expandMonitorActionGroupAutomationRunbookReceiver(v []interface{}) *[]actiongroupsapis.AutomationRunbookReceiver {
+	receivers := make([]actiongroupsapis.AutomationRunbookReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.AutomationRunbookReceiver{
+		receiver := actiongroupsapis.AutomationRunbookReceiver{
 			Name:                 utils.String(val["name"].(string)),
-			AutomationAccountID:  utils.String(val["automation_account_id"].(string)),
-			RunbookName:          utils.String(val["runbook_name"].(string)),
-			WebhookResourceID:    utils.String(val["webhook_resource_id"].(string)),
-			IsGlobalRunbook:      utils.Bool(val["is_global_runbook"].(bool)),
-			ServiceURI:           utils.String(val["service_uri"].(string)),
+			AutomationAccountId:  val["automation_account_id"].(string),
+			RunbookName:          val["runbook_name"].(string),
+			WebhookResourceId:    val["webhook_resource_id"].(string),
+			IsGlobalRunbook:      val["is_global_runbook"].(bool),
+			ServiceUri:           utils.String(val["service_uri"].(string)),
 			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
 		receivers = append(receivers, receiver)
@@ -629,28 +679,28 @@ func expandMonitorActionGroupAutomationRunbookReceiver(v []interface{}) *[]insig
 	return &receivers
 }
 
-func expandMonitorActionGroupVoiceReceiver(v []interface{}) *[]insights.VoiceReceiver {
-	receivers := make([]insights.VoiceReceiver, 0)
+func expandMonitorActionGroupVoiceReceiver(v []interface{}) *[]actiongroupsapis.VoiceReceiver {
+	receivers := make([]actiongroupsapis.VoiceReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.VoiceReceiver{
-			Name:        utils.String(val["name"].(string)),
-			CountryCode: utils.String(val["country_code"].(string)),
-			PhoneNumber: utils.String(val["phone_number"].(string)),
+		receiver := actiongroupsapis.VoiceReceiver{
+			Name:        val["name"].(string),
+			CountryCode: val["country_code"].(string),
+			PhoneNumber: val["phone_number"].(string),
 		}
 		receivers = append(receivers, receiver)
 	}
 	return &receivers
 }
 
-func expandMonitorActionGroupLogicAppReceiver(v []interface{}) *[]insights.LogicAppReceiver {
-	receivers := make([]insights.LogicAppReceiver, 0)
+func expandMonitorActionGroupLogicAppReceiver(v []interface{}) *[]actiongroupsapis.LogicAppReceiver {
+	receivers := make([]actiongroupsapis.LogicAppReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.LogicAppReceiver{
-			Name:                 utils.String(val["name"].(string)),
-			ResourceID:           utils.String(val["resource_id"].(string)),
-			CallbackURL:          utils.String(val["callback_url"].(string)),
+		receiver := actiongroupsapis.LogicAppReceiver{
+			Name:                 val["name"].(string),
+			ResourceId:           val["resource_id"].(string),
+			CallbackUrl:          val["callback_url"].(string),
 			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
 		receivers = append(receivers, receiver)
@@ -658,15 +708,15 @@ func expandMonitorActionGroupLogicAppReceiver(v []interface{}) *[]insights.Logic
 	return &receivers
 }
 
-func expandMonitorActionGroupAzureFunctionReceiver(v []interface{}) *[]insights.AzureFunctionReceiver {
-	receivers := make([]insights.AzureFunctionReceiver, 0)
+func expandMonitorActionGroupAzureFunctionReceiver(v []interface{}) *[]actiongroupsapis.AzureFunctionReceiver {
+	receivers := make([]actiongroupsapis.AzureFunctionReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.AzureFunctionReceiver{
-			Name:                  utils.String(val["name"].(string)),
-			FunctionAppResourceID: utils.String(val["function_app_resource_id"].(string)),
-			FunctionName:          utils.String(val["function_name"].(string)),
-			HTTPTriggerURL:        utils.String(val["http_trigger_url"].(string)),
+		receiver := actiongroupsapis.AzureFunctionReceiver{
+			Name:                  val["name"].(string),
+			FunctionAppResourceId: val["function_app_resource_id"].(string),
+			FunctionName:          val["function_name"].(string),
+			HTTPTriggerURL:        val["http_trigger_url"].(string),
 			UseCommonAlertSchema:  utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
 		receivers = append(receivers, receiver)
@@ -674,31 +724,50 @@ func expandMonitorActionGroupAzureFunctionReceiver(v []interface{}) *[]insights.
 	return &receivers
 }
 
-func expandMonitorActionGroupRoleReceiver(v []interface{}) *[]insights.ArmRoleReceiver {
-	receivers := make([]insights.ArmRoleReceiver, 0)
+func expandMonitorActionGroupRoleReceiver(v []interface{}) *[]actiongroupsapis.ArmRoleReceiver {
+	receivers := make([]actiongroupsapis.ArmRoleReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
-		receiver := insights.ArmRoleReceiver{
-			Name:                 utils.String(val["name"].(string)),
-			RoleID:               utils.String(val["role_id"].(string)),
+		receiver := actiongroupsapis.ArmRoleReceiver{
+			Name:                 val["name"].(string),
+			RoleId:               val["role_id"].(string),
+			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupEventHubReceiver(tenantId string, subscriptionId string, v []interface{}) *[]actiongroupsapis.EventHubReceiver {
+	receivers := make([]actiongroupsapis.EventHubReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := actiongroupsapis.EventHubReceiver{
+			Name:                 val["name"].(string),
+			EventHubNameSpace:    val["event_hub_namespace"].(string),
+			EventHubName:         val["event_hub_name"].(string),
+			SubscriptionId:       subscriptionId,
+			TenantId:             utils.String(tenantId),
 			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
+		if v := val["subscription_id"].(string); v != "" {
+			receiver.SubscriptionId = v
+		}
+		if v := val["tenant_id"].(string); v != "" {
+			receiver.TenantId = utils.String(v)
+		}
 		receivers = append(receivers, receiver)
 	}
 	return &receivers
 }
 
-func flattenMonitorActionGroupEmailReceiver(receivers *[]insights.EmailReceiver) []interface{} {
+func flattenMonitorActionGroupEmailReceiver(receivers *[]actiongroupsapis.EmailReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
-			}
-			if receiver.EmailAddress != nil {
-				val["email_address"] = *receiver.EmailAddress
-			}
+			val["name"] = receiver.Name
+			val["email_address"] = receiver.EmailAddress
 			if receiver.UseCommonAlertSchema != nil {
 				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
 			}
@@ -708,63 +777,43 @@ func flattenMonitorActionGroupEmailReceiver(receivers *[]insights.EmailReceiver)
 	return result
 }
 
-func flattenMonitorActionGroupItsmReceiver(receivers *[]insights.ItsmReceiver) []interface{} {
+func flattenMonitorActionGroupItsmReceiver(receivers *[]actiongroupsapis.ItsmReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
-			}
-			if receiver.WorkspaceID != nil {
-				val["workspace_id"] = *receiver.WorkspaceID
-			}
-			if receiver.ConnectionID != nil {
-				val["connection_id"] = *receiver.ConnectionID
-			}
-			if receiver.TicketConfiguration != nil {
-				val["ticket_configuration"] = *receiver.TicketConfiguration
-			}
-			if receiver.Region != nil {
-				val["region"] = azure.NormalizeLocation(*receiver.Region)
-			}
+			val["name"] = receiver.Name
+			val["workspace_id"] = receiver.WorkspaceId
+			val["connection_id"] = receiver.ConnectionId
+			val["ticket_configuration"] = receiver.TicketConfiguration
+			val["region"] = azure.NormalizeLocation(receiver.Region)
 			result = append(result, val)
 		}
 	}
 	return result
 }
 
-func flattenMonitorActionGroupAzureAppPushReceiver(receivers *[]insights.AzureAppPushReceiver) []interface{} {
+func flattenMonitorActionGroupAzureAppPushReceiver(receivers *[]actiongroupsapis.AzureAppPushReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
-			}
-			if receiver.EmailAddress != nil {
-				val["email_address"] = *receiver.EmailAddress
-			}
+			val["name"] = receiver.Name
+			val["email_address"] = receiver.EmailAddress
 			result = append(result, val)
 		}
 	}
 	return result
 }
 
-func flattenMonitorActionGroupSmsReceiver(receivers *[]insights.SmsReceiver) []interface{} {
+func flattenMonitorActionGroupSmsReceiver(receivers *[]actiongroupsapis.SmsReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
-			}
-			if receiver.CountryCode != nil {
-				val["country_code"] = *receiver.CountryCode
-			}
-			if receiver.PhoneNumber != nil {
-				val["phone_number"] = *receiver.PhoneNumber
-			}
+			val["name"] = receiver.Name
+			val["country_code"] = receiver.CountryCode
+			val["phone_number"] = receiver.PhoneNumber
 
 			result = append(result, val)
 		}
@@ -772,25 +821,18 @@ func flattenMonitorActionGroupSmsReceiver(receivers *[]insights.SmsReceiver) []i
 	return result
 }
 
-func flattenMonitorActionGroupWebHookReceiver(receivers *[]insights.WebhookReceiver) []interface{} {
+func flattenMonitorActionGroupWebHookReceiver(receivers *[]actiongroupsapis.WebhookReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			var useCommonAlert bool
-			var name, serviceUri string
-			if receiver.Name != nil {
-				name = *receiver.Name
-			}
-			if receiver.ServiceURI != nil {
-				serviceUri = *receiver.ServiceURI
-			}
 			if receiver.UseCommonAlertSchema != nil {
 				useCommonAlert = *receiver.UseCommonAlertSchema
 			}
 
 			result = append(result, map[string]interface{}{
-				"name":                    name,
-				"service_uri":             serviceUri,
+				"name":                    receiver.Name,
+				"service_uri":             receiver.ServiceUri,
 				"use_common_alert_schema": useCommonAlert,
 				"aad_auth":                flattenMonitorActionGroupSecureWebHookReceiver(receiver),
 			})
@@ -799,20 +841,20 @@ func flattenMonitorActionGroupWebHookReceiver(receivers *[]insights.WebhookRecei
 	return result
 }
 
-func flattenMonitorActionGroupSecureWebHookReceiver(receiver insights.WebhookReceiver) []interface{} {
+func flattenMonitorActionGroupSecureWebHookReceiver(receiver actiongroupsapis.WebhookReceiver) []interface{} {
 	if receiver.UseAadAuth == nil || !*receiver.UseAadAuth {
 		return []interface{}{}
 	}
 
 	var objectId, identifierUri, tenantId string
 
-	if v := receiver.ObjectID; v != nil {
+	if v := receiver.ObjectId; v != nil {
 		objectId = *v
 	}
-	if v := receiver.IdentifierURI; v != nil {
+	if v := receiver.IdentifierUri; v != nil {
 		identifierUri = *v
 	}
-	if v := receiver.TenantID; v != nil {
+	if v := receiver.TenantId; v != nil {
 		tenantId = *v
 	}
 	return []interface{}{
@@ -824,7 +866,7 @@ func flattenMonitorActionGroupSecureWebHookReceiver(receiver insights.WebhookRec
 	}
 }
 
-func flattenMonitorActionGroupAutomationRunbookReceiver(receivers *[]insights.AutomationRunbookReceiver) []interface{} {
+func flattenMonitorActionGroupAutomationRunbookReceiver(receivers *[]actiongroupsapis.AutomationRunbookReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
@@ -832,20 +874,12 @@ func flattenMonitorActionGroupAutomationRunbookReceiver(receivers *[]insights.Au
 			if receiver.Name != nil {
 				val["name"] = *receiver.Name
 			}
-			if receiver.AutomationAccountID != nil {
-				val["automation_account_id"] = *receiver.AutomationAccountID
-			}
-			if receiver.RunbookName != nil {
-				val["runbook_name"] = *receiver.RunbookName
-			}
-			if receiver.WebhookResourceID != nil {
-				val["webhook_resource_id"] = *receiver.WebhookResourceID
-			}
-			if receiver.IsGlobalRunbook != nil {
-				val["is_global_runbook"] = *receiver.IsGlobalRunbook
-			}
-			if receiver.ServiceURI != nil {
-				val["service_uri"] = *receiver.ServiceURI
+			val["automation_account_id"] = receiver.AutomationAccountId
+			val["runbook_name"] = receiver.RunbookName
+			val["webhook_resource_id"] = receiver.WebhookResourceId
+			val["is_global_runbook"] = receiver.IsGlobalRunbook
+			if receiver.ServiceUri != nil {
+				val["service_uri"] = *receiver.ServiceUri
 			}
 			if receiver.UseCommonAlertSchema != nil {
 				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
@@ -856,40 +890,28 @@ func flattenMonitorActionGroupAutomationRunbookReceiver(receivers *[]insights.Au
 	return result
 }
 
-func flattenMonitorActionGroupVoiceReceiver(receivers *[]insights.VoiceReceiver) []interface{} {
+func flattenMonitorActionGroupVoiceReceiver(receivers *[]actiongroupsapis.VoiceReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
-			}
-			if receiver.CountryCode != nil {
-				val["country_code"] = *receiver.CountryCode
-			}
-			if receiver.PhoneNumber != nil {
-				val["phone_number"] = *receiver.PhoneNumber
-			}
+			val["name"] = receiver.Name
+			val["country_code"] = receiver.CountryCode
+			val["phone_number"] = receiver.PhoneNumber
 			result = append(result, val)
 		}
 	}
 	return result
 }
 
-func flattenMonitorActionGroupLogicAppReceiver(receivers *[]insights.LogicAppReceiver) []interface{} {
+func flattenMonitorActionGroupLogicAppReceiver(receivers *[]actiongroupsapis.LogicAppReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
-			}
-			if receiver.ResourceID != nil {
-				val["resource_id"] = *receiver.ResourceID
-			}
-			if receiver.CallbackURL != nil {
-				val["callback_url"] = *receiver.CallbackURL
-			}
+			val["name"] = receiver.Name
+			val["resource_id"] = receiver.ResourceId
+			val["callback_url"] = receiver.CallbackUrl
 			if receiver.UseCommonAlertSchema != nil {
 				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
 			}
@@ -899,23 +921,15 @@ func flattenMonitorActionGroupLogicAppReceiver(receivers *[]insights.LogicAppRec
 	return result
 }
 
-func flattenMonitorActionGroupAzureFunctionReceiver(receivers *[]insights.AzureFunctionReceiver) []interface{} {
+func flattenMonitorActionGroupAzureFunctionReceiver(receivers *[]actiongroupsapis.AzureFunctionReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
-			}
-			if receiver.FunctionAppResourceID != nil {
-				val["function_app_resource_id"] = *receiver.FunctionAppResourceID
-			}
-			if receiver.FunctionName != nil {
-				val["function_name"] = *receiver.FunctionName
-			}
-			if receiver.HTTPTriggerURL != nil {
-				val["http_trigger_url"] = *receiver.HTTPTriggerURL
-			}
+			val["name"] = receiver.Name
+			val["function_app_resource_id"] = receiver.FunctionAppResourceId
+			val["function_name"] = receiver.FunctionName
+			val["http_trigger_url"] = receiver.HTTPTriggerURL
 			if receiver.UseCommonAlertSchema != nil {
 				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
 			}
@@ -925,16 +939,33 @@ func flattenMonitorActionGroupAzureFunctionReceiver(receivers *[]insights.AzureF
 	return result
 }
 
-func flattenMonitorActionGroupRoleReceiver(receivers *[]insights.ArmRoleReceiver) []interface{} {
+func flattenMonitorActionGroupRoleReceiver(receivers *[]actiongroupsapis.ArmRoleReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
 		for _, receiver := range *receivers {
 			val := make(map[string]interface{})
-			if receiver.Name != nil {
-				val["name"] = *receiver.Name
+			val["name"] = receiver.Name
+			val["role_id"] = receiver.RoleId
+			if receiver.UseCommonAlertSchema != nil {
+				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
 			}
-			if receiver.RoleID != nil {
-				val["role_id"] = *receiver.RoleID
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupEventHubReceiver(receivers *[]actiongroupsapis.EventHubReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{})
+			val["name"] = receiver.Name
+			val["event_hub_namespace"] = receiver.EventHubNameSpace
+			val["event_hub_name"] = receiver.EventHubName
+			val["subscription_id"] = receiver.SubscriptionId
+			if receiver.TenantId != nil {
+				val["tenant_id"] = *receiver.TenantId
 			}
 			if receiver.UseCommonAlertSchema != nil {
 				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
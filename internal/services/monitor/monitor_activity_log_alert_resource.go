@@ -203,7 +203,65 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 									},
 								},
 							},
-							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.status", "criteria.0.sub_status", "criteria.0.recommendation_impact", "criteria.0.resource_provider", "criteria.0.resource_type", "criteria.0.operation_name", "criteria.0.caller", "criteria.0.operation_name"},
+							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.status", "criteria.0.sub_status", "criteria.0.recommendation_impact", "criteria.0.resource_provider", "criteria.0.resource_type", "criteria.0.operation_name", "criteria.0.caller", "criteria.0.operation_name", "criteria.0.resource_health"},
+						},
+						//lintignore:XS003
+						"resource_health": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"current": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Available",
+												"Degraded",
+												"Unavailable",
+												"Unknown",
+											},
+												false,
+											),
+										},
+										Set: pluginsdk.HashString,
+									},
+									"previous": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Available",
+												"Degraded",
+												"Unavailable",
+												"Unknown",
+											},
+												false,
+											),
+										},
+										Set: pluginsdk.HashString,
+									},
+									"reason": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"PlatformInitiated",
+												"UserInitiated",
+												"Unknown",
+											},
+												false,
+											),
+										},
+										Set: pluginsdk.HashString,
+									},
+								},
+							},
+							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.recommendation_type", "criteria.0.service_health"},
 						},
 					},
 				},
@@ -444,6 +502,10 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.AlertR
 		conditions = expandServiceHealth(serviceHealth, conditions)
 	}
 
+	if resourceHealth := v["resource_health"].([]interface{}); len(resourceHealth) > 0 {
+		conditions = expandResourceHealth(resourceHealth, conditions)
+	}
+
 	return &insights.AlertRuleAllOfCondition{
 		AllOf: &conditions,
 	}
@@ -489,6 +551,42 @@ func expandServiceHealth(serviceHealth []interface{}, conditions []insights.Aler
 	return conditions
 }
 
+func expandResourceHealth(resourceHealth []interface{}, conditions []insights.AlertRuleAnyOfOrLeafCondition) []insights.AlertRuleAnyOfOrLeafCondition {
+	for _, resourceItem := range resourceHealth {
+		if resourceItem == nil {
+			continue
+		}
+		vs := resourceItem.(map[string]interface{})
+
+		fields := []struct {
+			key   string
+			field string
+		}{
+			{"current", "properties.currentHealthStatus"},
+			{"previous", "properties.previousHealthStatus"},
+			{"reason", "properties.cause"},
+		}
+		for _, f := range fields {
+			values := vs[f.key].(*pluginsdk.Set).List()
+			if len(values) == 0 {
+				continue
+			}
+
+			ruleLeafCondition := make([]insights.AlertRuleLeafCondition, 0)
+			for _, value := range values {
+				ruleLeafCondition = append(ruleLeafCondition, insights.AlertRuleLeafCondition{
+					Field:  utils.String(f.field),
+					Equals: utils.String(value.(string)),
+				})
+			}
+			conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+				AnyOf: &ruleLeafCondition,
+			})
+		}
+	}
+	return conditions
+}
+
 func expandMonitorActivityLogAlertAction(input []interface{}) *insights.ActionList {
 	actions := make([]insights.ActionGroup, 0)
 	for _, item := range input {
@@ -548,6 +646,10 @@ func flattenMonitorActivityLogAlertCriteria(input *insights.AlertRuleAllOfCondit
 		flattenMonitorActivityLogAlertServiceHealth(input, result)
 	}
 
+	if result["category"] == "ResourceHealth" {
+		flattenMonitorActivityLogAlertResourceHealth(input, result)
+	}
+
 	return []interface{}{result}
 }
 
@@ -576,6 +678,35 @@ func flattenMonitorActivityLogAlertServiceHealth(input *insights.AlertRuleAllOfC
 	result["service_health"] = []interface{}{shResult}
 }
 
+func flattenMonitorActivityLogAlertResourceHealth(input *insights.AlertRuleAllOfCondition, result map[string]interface{}) {
+	rhResult := make(map[string]interface{})
+	for _, condition := range *input.AllOf {
+		if condition.Field != nil || condition.AnyOf == nil || len(*condition.AnyOf) == 0 {
+			continue
+		}
+
+		values := make([]string, 0)
+		var field string
+		for _, cond := range *condition.AnyOf {
+			if cond.Field != nil && cond.Equals != nil {
+				field = *cond.Field
+				values = append(values, *cond.Equals)
+			}
+		}
+
+		switch strings.ToLower(field) {
+		case "properties.currenthealthstatus":
+			rhResult["current"] = values
+		case "properties.previoushealthstatus":
+			rhResult["previous"] = values
+		case "properties.cause":
+			rhResult["reason"] = values
+		}
+	}
+
+	result["resource_health"] = []interface{}{rhResult}
+}
+
 func flattenMonitorActivityLogAlertAction(input *insights.ActionList) (result []interface{}) {
 	result = make([]interface{}, 0)
 	if input == nil || input.ActionGroups == nil {
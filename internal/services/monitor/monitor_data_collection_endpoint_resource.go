@@ -0,0 +1,223 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorDataCollectionEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionEndpointCreateUpdate,
+		Read:   resourceMonitorDataCollectionEndpointRead,
+		Update: resourceMonitorDataCollectionEndpointCreateUpdate,
+		Delete: resourceMonitorDataCollectionEndpointDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := datacollectionendpoints.ParseDataCollectionEndpointID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datacollectionendpoints.KnownDataCollectionEndpointResourceKindLinux),
+					string(datacollectionendpoints.KnownDataCollectionEndpointResourceKindWindows),
+				}, false),
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"configuration_access_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"immutable_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"logs_ingestion_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorDataCollectionEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := datacollectionendpoints.NewDataCollectionEndpointID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_endpoint", id.ID())
+		}
+	}
+
+	publicNetworkAccess := datacollectionendpoints.KnownPublicNetworkAccessOptionsDisabled
+	if d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = datacollectionendpoints.KnownPublicNetworkAccessOptionsEnabled
+	}
+
+	parameters := datacollectionendpoints.DataCollectionEndpointResource{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &datacollectionendpoints.DataCollectionEndpoint{
+			NetworkAcls: &datacollectionendpoints.NetworkRuleSet{
+				PublicNetworkAccess: &publicNetworkAccess,
+			},
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kind"); ok {
+		kind := datacollectionendpoints.KnownDataCollectionEndpointResourceKind(v.(string))
+		parameters.Kind = &kind
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionEndpointRead(d, meta)
+}
+
+func resourceMonitorDataCollectionEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionendpoints.ParseDataCollectionEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataCollectionEndpointName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", azure.NormalizeLocation(model.Location))
+
+		kind := ""
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			d.Set("immutable_id", props.ImmutableId)
+
+			configurationAccessEndpoint := ""
+			if props.ConfigurationAccess != nil && props.ConfigurationAccess.Endpoint != nil {
+				configurationAccessEndpoint = *props.ConfigurationAccess.Endpoint
+			}
+			d.Set("configuration_access_endpoint", configurationAccessEndpoint)
+
+			logsIngestionEndpoint := ""
+			if props.LogsIngestion != nil && props.LogsIngestion.Endpoint != nil {
+				logsIngestionEndpoint = *props.LogsIngestion.Endpoint
+			}
+			d.Set("logs_ingestion_endpoint", logsIngestionEndpoint)
+
+			publicNetworkAccessEnabled := true
+			if props.NetworkAcls != nil && props.NetworkAcls.PublicNetworkAccess != nil {
+				publicNetworkAccessEnabled = *props.NetworkAcls.PublicNetworkAccess == datacollectionendpoints.KnownPublicNetworkAccessOptionsEnabled
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionendpoints.ParseDataCollectionEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
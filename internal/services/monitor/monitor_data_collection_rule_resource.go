@@ -0,0 +1,1252 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the API accepts these values for `recordStartTimestampFormat` but doesn't expose them as an enum
+var dataCollectionRuleLogFileTimestampFormats = []string{
+	"ISO 8601",
+	"YYYY-MM-DD HH:MM:SS",
+	"M/D/YYYY HH:MM:SS AM/PM",
+	"Mon DD, YYYY HH:MM:SS",
+	"yyMMdd HH:mm:ss",
+	"ddMMyy HH:mm:ss",
+	"MMM d hh:mm:ss",
+	"dd/MMM/yyyy:HH:mm:ss zzz",
+	"yyyy-MM-ddTHH:mm:ssK",
+}
+
+func resourceMonitorDataCollectionRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleRead,
+		Update: resourceMonitorDataCollectionRuleCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := datacollectionrules.ParseDataCollectionRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"data_flow": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"streams": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"output_stream": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"transform_kql": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"destinations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"azure_monitor_metrics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							AtLeastOneOf: []string{
+								"destinations.0.azure_monitor_metrics",
+								"destinations.0.event_hub",
+								"destinations.0.log_analytics",
+								"destinations.0.monitor_account",
+								"destinations.0.storage_blob",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+
+						"event_hub": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"destinations.0.azure_monitor_metrics",
+								"destinations.0.event_hub",
+								"destinations.0.log_analytics",
+								"destinations.0.monitor_account",
+								"destinations.0.storage_blob",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"event_hub_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: eventhubs.ValidateEventhubID,
+									},
+								},
+							},
+						},
+
+						"log_analytics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"destinations.0.azure_monitor_metrics",
+								"destinations.0.event_hub",
+								"destinations.0.log_analytics",
+								"destinations.0.monitor_account",
+								"destinations.0.storage_blob",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"workspace_resource_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+									},
+								},
+							},
+						},
+
+						"monitor_account": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"destinations.0.azure_monitor_metrics",
+								"destinations.0.event_hub",
+								"destinations.0.log_analytics",
+								"destinations.0.monitor_account",
+								"destinations.0.storage_blob",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"monitor_account_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azuremonitorworkspaces.ValidateAccountID,
+									},
+								},
+							},
+						},
+
+						"storage_blob": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"destinations.0.azure_monitor_metrics",
+								"destinations.0.event_hub",
+								"destinations.0.log_analytics",
+								"destinations.0.monitor_account",
+								"destinations.0.storage_blob",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"container_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: storageValidate.StorageContainerName,
+									},
+
+									"storage_account_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: storageValidate.StorageAccountID,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"data_collection_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: datacollectionendpoints.ValidateDataCollectionEndpointID,
+			},
+
+			"data_sources": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"extension": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"extension_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"extension_json": {
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringIsJSON,
+										DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+									},
+
+									"input_data_sources": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"iis_log": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"log_directories": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"log_file": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"file_patterns": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"format": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(datacollectionrules.KnownLogFilesDataSourceFormatJson),
+											string(datacollectionrules.KnownLogFilesDataSourceFormatText),
+										}, false),
+									},
+
+									"settings": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"text": {
+													Type:     pluginsdk.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"record_start_timestamp_format": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(dataCollectionRuleLogFileTimestampFormats, false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"performance_counter": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"counter_specifiers": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"sampling_frequency_in_seconds": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1800),
+									},
+								},
+							},
+						},
+
+						"prometheus_forwarder": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"label_include_filter": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"label": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"value": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"syslog": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"facility_names": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"log_levels": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"windows_event_log": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": dataCollectionRuleStreamsSchema(),
+
+									"x_path_queries": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datacollectionrules.KnownDataCollectionRuleResourceKindLinux),
+					string(datacollectionrules.KnownDataCollectionRuleResourceKindWindows),
+				}, false),
+			},
+
+			"stream_declaration": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"stream_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^Custom-.+`), "`stream_name` must start with `Custom-`"),
+						},
+
+						"column": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"type": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(datacollectionrules.PossibleValuesForKnownColumnDefinitionType(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"immutable_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func dataCollectionRuleStreamsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := datacollectionrules.NewDataCollectionRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule", id.ID())
+		}
+	}
+
+	dataSources, err := expandDataCollectionRuleDataSources(d.Get("data_sources").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `data_sources`: %+v", err)
+	}
+
+	parameters := datacollectionrules.DataCollectionRuleResource{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &datacollectionrules.DataCollectionRule{
+			DataFlows:          expandDataCollectionRuleDataFlows(d.Get("data_flow").([]interface{})),
+			DataSources:        dataSources,
+			Destinations:       expandDataCollectionRuleDestinations(d.Get("destinations").([]interface{})),
+			StreamDeclarations: expandDataCollectionRuleStreamDeclarations(d.Get("stream_declaration").(*pluginsdk.Set).List()),
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("data_collection_endpoint_id"); ok {
+		parameters.Properties.DataCollectionEndpointId = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kind"); ok {
+		kind := datacollectionrules.KnownDataCollectionRuleResourceKind(v.(string))
+		parameters.Kind = &kind
+	}
+
+	if _, err := client.Create(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorDataCollectionRuleRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionrules.ParseDataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataCollectionRuleName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", azure.NormalizeLocation(model.Location))
+
+		kind := ""
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			d.Set("immutable_id", props.ImmutableId)
+
+			dataCollectionEndpointId := ""
+			if props.DataCollectionEndpointId != nil {
+				endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointIDInsensitively(*props.DataCollectionEndpointId)
+				if err != nil {
+					return err
+				}
+				dataCollectionEndpointId = endpointId.ID()
+			}
+			d.Set("data_collection_endpoint_id", dataCollectionEndpointId)
+
+			if err := d.Set("data_flow", flattenDataCollectionRuleDataFlows(props.DataFlows)); err != nil {
+				return fmt.Errorf("setting `data_flow`: %+v", err)
+			}
+
+			dataSources, err := flattenDataCollectionRuleDataSources(props.DataSources)
+			if err != nil {
+				return fmt.Errorf("flattening `data_sources`: %+v", err)
+			}
+			if err := d.Set("data_sources", dataSources); err != nil {
+				return fmt.Errorf("setting `data_sources`: %+v", err)
+			}
+
+			destinations, err := flattenDataCollectionRuleDestinations(props.Destinations)
+			if err != nil {
+				return fmt.Errorf("flattening `destinations`: %+v", err)
+			}
+			if err := d.Set("destinations", destinations); err != nil {
+				return fmt.Errorf("setting `destinations`: %+v", err)
+			}
+
+			if err := d.Set("stream_declaration", flattenDataCollectionRuleStreamDeclarations(props.StreamDeclarations)); err != nil {
+				return fmt.Errorf("setting `stream_declaration`: %+v", err)
+			}
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datacollectionrules.ParseDataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandDataCollectionRuleDataFlows(input []interface{}) *[]datacollectionrules.DataFlow {
+	results := make([]datacollectionrules.DataFlow, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		dataFlow := datacollectionrules.DataFlow{
+			Destinations: utils.ExpandStringSlice(v["destinations"].([]interface{})),
+			Streams:      utils.ExpandStringSlice(v["streams"].([]interface{})),
+		}
+
+		if outputStream := v["output_stream"].(string); outputStream != "" {
+			dataFlow.OutputStream = utils.String(outputStream)
+		}
+
+		if transformKql := v["transform_kql"].(string); transformKql != "" {
+			dataFlow.TransformKql = utils.String(transformKql)
+		}
+
+		results = append(results, dataFlow)
+	}
+
+	return &results
+}
+
+func flattenDataCollectionRuleDataFlows(input *[]datacollectionrules.DataFlow) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		outputStream := ""
+		if item.OutputStream != nil {
+			outputStream = *item.OutputStream
+		}
+
+		transformKql := ""
+		if item.TransformKql != nil {
+			transformKql = *item.TransformKql
+		}
+
+		results = append(results, map[string]interface{}{
+			"destinations":  utils.FlattenStringSlice(item.Destinations),
+			"output_stream": outputStream,
+			"streams":       utils.FlattenStringSlice(item.Streams),
+			"transform_kql": transformKql,
+		})
+	}
+
+	return results
+}
+
+func expandDataCollectionRuleDestinations(input []interface{}) *datacollectionrules.DestinationsSpec {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := datacollectionrules.DestinationsSpec{}
+
+	if metrics := v["azure_monitor_metrics"].([]interface{}); len(metrics) > 0 && metrics[0] != nil {
+		result.AzureMonitorMetrics = &datacollectionrules.AzureMonitorMetricsDestination{
+			Name: utils.String(metrics[0].(map[string]interface{})["name"].(string)),
+		}
+	}
+
+	eventHubs := make([]datacollectionrules.EventHubDestination, 0)
+	for _, item := range v["event_hub"].([]interface{}) {
+		eventHub := item.(map[string]interface{})
+		eventHubs = append(eventHubs, datacollectionrules.EventHubDestination{
+			EventHubResourceId: utils.String(eventHub["event_hub_id"].(string)),
+			Name:               utils.String(eventHub["name"].(string)),
+		})
+	}
+	result.EventHubs = &eventHubs
+
+	logAnalytics := make([]datacollectionrules.LogAnalyticsDestination, 0)
+	for _, item := range v["log_analytics"].([]interface{}) {
+		workspace := item.(map[string]interface{})
+		logAnalytics = append(logAnalytics, datacollectionrules.LogAnalyticsDestination{
+			Name:                utils.String(workspace["name"].(string)),
+			WorkspaceResourceId: utils.String(workspace["workspace_resource_id"].(string)),
+		})
+	}
+	result.LogAnalytics = &logAnalytics
+
+	monitorAccounts := make([]datacollectionrules.MonitoringAccountDestination, 0)
+	for _, item := range v["monitor_account"].([]interface{}) {
+		monitorAccount := item.(map[string]interface{})
+		monitorAccounts = append(monitorAccounts, datacollectionrules.MonitoringAccountDestination{
+			AccountResourceId: utils.String(monitorAccount["monitor_account_id"].(string)),
+			Name:              utils.String(monitorAccount["name"].(string)),
+		})
+	}
+	result.MonitoringAccounts = &monitorAccounts
+
+	storageBlobs := make([]datacollectionrules.StorageBlobDestination, 0)
+	for _, item := range v["storage_blob"].([]interface{}) {
+		storageBlob := item.(map[string]interface{})
+		storageBlobs = append(storageBlobs, datacollectionrules.StorageBlobDestination{
+			ContainerName:            utils.String(storageBlob["container_name"].(string)),
+			Name:                     utils.String(storageBlob["name"].(string)),
+			StorageAccountResourceId: utils.String(storageBlob["storage_account_id"].(string)),
+		})
+	}
+	result.StorageBlobsDirect = &storageBlobs
+
+	return &result
+}
+
+func flattenDataCollectionRuleDestinations(input *datacollectionrules.DestinationsSpec) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	metrics := make([]interface{}, 0)
+	if input.AzureMonitorMetrics != nil {
+		name := ""
+		if input.AzureMonitorMetrics.Name != nil {
+			name = *input.AzureMonitorMetrics.Name
+		}
+		metrics = append(metrics, map[string]interface{}{
+			"name": name,
+		})
+	}
+
+	eventHubs := make([]interface{}, 0)
+	if input.EventHubs != nil {
+		for _, item := range *input.EventHubs {
+			eventHubs = append(eventHubs, map[string]interface{}{
+				"event_hub_id": utils.NormalizeNilableString(item.EventHubResourceId),
+				"name":         utils.NormalizeNilableString(item.Name),
+			})
+		}
+	}
+
+	logAnalytics := make([]interface{}, 0)
+	if input.LogAnalytics != nil {
+		for _, item := range *input.LogAnalytics {
+			logAnalytics = append(logAnalytics, map[string]interface{}{
+				"name":                  utils.NormalizeNilableString(item.Name),
+				"workspace_resource_id": utils.NormalizeNilableString(item.WorkspaceResourceId),
+			})
+		}
+	}
+
+	monitorAccounts := make([]interface{}, 0)
+	if input.MonitoringAccounts != nil {
+		for _, item := range *input.MonitoringAccounts {
+			monitorAccountId := ""
+			if item.AccountResourceId != nil {
+				accountId, err := azuremonitorworkspaces.ParseAccountIDInsensitively(*item.AccountResourceId)
+				if err != nil {
+					return nil, err
+				}
+				monitorAccountId = accountId.ID()
+			}
+
+			monitorAccounts = append(monitorAccounts, map[string]interface{}{
+				"monitor_account_id": monitorAccountId,
+				"name":               utils.NormalizeNilableString(item.Name),
+			})
+		}
+	}
+
+	storageBlobs := make([]interface{}, 0)
+	if input.StorageBlobsDirect != nil {
+		for _, item := range *input.StorageBlobsDirect {
+			storageBlobs = append(storageBlobs, map[string]interface{}{
+				"container_name":     utils.NormalizeNilableString(item.ContainerName),
+				"name":               utils.NormalizeNilableString(item.Name),
+				"storage_account_id": utils.NormalizeNilableString(item.StorageAccountResourceId),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"azure_monitor_metrics": metrics,
+			"event_hub":             eventHubs,
+			"log_analytics":         logAnalytics,
+			"monitor_account":       monitorAccounts,
+			"storage_blob":          storageBlobs,
+		},
+	}, nil
+}
+
+func expandDataCollectionRuleDataSources(input []interface{}) (*datacollectionrules.DataSourcesSpec, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := datacollectionrules.DataSourcesSpec{}
+
+	extensions := make([]datacollectionrules.ExtensionDataSource, 0)
+	for _, item := range v["extension"].([]interface{}) {
+		extension := item.(map[string]interface{})
+		dataSource := datacollectionrules.ExtensionDataSource{
+			ExtensionName:    extension["extension_name"].(string),
+			InputDataSources: utils.ExpandStringSlice(extension["input_data_sources"].([]interface{})),
+			Name:             utils.String(extension["name"].(string)),
+			Streams:          utils.ExpandStringSlice(extension["streams"].([]interface{})),
+		}
+
+		if extensionJson := extension["extension_json"].(string); extensionJson != "" {
+			var settings interface{}
+			if err := json.Unmarshal([]byte(extensionJson), &settings); err != nil {
+				return nil, fmt.Errorf("unmarshalling `extension_json`: %+v", err)
+			}
+			dataSource.ExtensionSettings = &settings
+		}
+
+		extensions = append(extensions, dataSource)
+	}
+	result.Extensions = &extensions
+
+	iisLogs := make([]datacollectionrules.IisLogsDataSource, 0)
+	for _, item := range v["iis_log"].([]interface{}) {
+		iisLog := item.(map[string]interface{})
+		iisLogs = append(iisLogs, datacollectionrules.IisLogsDataSource{
+			LogDirectories: utils.ExpandStringSlice(iisLog["log_directories"].([]interface{})),
+			Name:           utils.String(iisLog["name"].(string)),
+			Streams:        *utils.ExpandStringSlice(iisLog["streams"].([]interface{})),
+		})
+	}
+	result.IisLogs = &iisLogs
+
+	logFiles := make([]datacollectionrules.LogFilesDataSource, 0)
+	for _, item := range v["log_file"].([]interface{}) {
+		logFile := item.(map[string]interface{})
+		dataSource := datacollectionrules.LogFilesDataSource{
+			FilePatterns: *utils.ExpandStringSlice(logFile["file_patterns"].([]interface{})),
+			Format:       datacollectionrules.KnownLogFilesDataSourceFormat(logFile["format"].(string)),
+			Name:         utils.String(logFile["name"].(string)),
+			Streams:      *utils.ExpandStringSlice(logFile["streams"].([]interface{})),
+		}
+
+		if settings := logFile["settings"].([]interface{}); len(settings) > 0 && settings[0] != nil {
+			dataSource.Settings = &datacollectionrules.LogFilesDataSourceSettings{}
+			if text := settings[0].(map[string]interface{})["text"].([]interface{}); len(text) > 0 && text[0] != nil {
+				dataSource.Settings.Text = &datacollectionrules.LogFileSettingsText{
+					RecordStartTimestampFormat: text[0].(map[string]interface{})["record_start_timestamp_format"].(string),
+				}
+			}
+		}
+
+		logFiles = append(logFiles, dataSource)
+	}
+	result.LogFiles = &logFiles
+
+	performanceCounters := make([]datacollectionrules.PerfCounterDataSource, 0)
+	for _, item := range v["performance_counter"].([]interface{}) {
+		counter := item.(map[string]interface{})
+		performanceCounters = append(performanceCounters, datacollectionrules.PerfCounterDataSource{
+			CounterSpecifiers:          utils.ExpandStringSlice(counter["counter_specifiers"].([]interface{})),
+			Name:                       utils.String(counter["name"].(string)),
+			SamplingFrequencyInSeconds: utils.Int64(int64(counter["sampling_frequency_in_seconds"].(int))),
+			Streams:                    utils.ExpandStringSlice(counter["streams"].([]interface{})),
+		})
+	}
+	result.PerformanceCounters = &performanceCounters
+
+	prometheusForwarders := make([]datacollectionrules.PrometheusForwarderDataSource, 0)
+	for _, item := range v["prometheus_forwarder"].([]interface{}) {
+		forwarder := item.(map[string]interface{})
+		dataSource := datacollectionrules.PrometheusForwarderDataSource{
+			Name:    utils.String(forwarder["name"].(string)),
+			Streams: utils.ExpandStringSlice(forwarder["streams"].([]interface{})),
+		}
+
+		if filters := forwarder["label_include_filter"].(*pluginsdk.Set).List(); len(filters) > 0 {
+			labels := make(map[string]string)
+			for _, f := range filters {
+				filter := f.(map[string]interface{})
+				labels[filter["label"].(string)] = filter["value"].(string)
+			}
+			dataSource.LabelIncludeFilter = &labels
+		}
+
+		prometheusForwarders = append(prometheusForwarders, dataSource)
+	}
+	result.PrometheusForwarder = &prometheusForwarders
+
+	syslogs := make([]datacollectionrules.SyslogDataSource, 0)
+	for _, item := range v["syslog"].([]interface{}) {
+		syslog := item.(map[string]interface{})
+		syslogs = append(syslogs, datacollectionrules.SyslogDataSource{
+			FacilityNames: utils.ExpandStringSlice(syslog["facility_names"].([]interface{})),
+			LogLevels:     utils.ExpandStringSlice(syslog["log_levels"].([]interface{})),
+			Name:          utils.String(syslog["name"].(string)),
+			Streams:       utils.ExpandStringSlice(syslog["streams"].([]interface{})),
+		})
+	}
+	result.Syslog = &syslogs
+
+	windowsEventLogs := make([]datacollectionrules.WindowsEventLogDataSource, 0)
+	for _, item := range v["windows_event_log"].([]interface{}) {
+		eventLog := item.(map[string]interface{})
+		windowsEventLogs = append(windowsEventLogs, datacollectionrules.WindowsEventLogDataSource{
+			Name:         utils.String(eventLog["name"].(string)),
+			Streams:      utils.ExpandStringSlice(eventLog["streams"].([]interface{})),
+			XPathQueries: utils.ExpandStringSlice(eventLog["x_path_queries"].([]interface{})),
+		})
+	}
+	result.WindowsEventLogs = &windowsEventLogs
+
+	return &result, nil
+}
+
+func flattenDataCollectionRuleDataSources(input *datacollectionrules.DataSourcesSpec) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	extensions := make([]interface{}, 0)
+	if input.Extensions != nil {
+		for _, item := range *input.Extensions {
+			extensionJson := ""
+			if item.ExtensionSettings != nil {
+				settings, err := json.Marshal(*item.ExtensionSettings)
+				if err != nil {
+					return nil, fmt.Errorf("marshalling the settings for extension %q: %+v", item.ExtensionName, err)
+				}
+				extensionJson = string(settings)
+			}
+
+			extensions = append(extensions, map[string]interface{}{
+				"extension_json":     extensionJson,
+				"extension_name":     item.ExtensionName,
+				"input_data_sources": utils.FlattenStringSlice(item.InputDataSources),
+				"name":               utils.NormalizeNilableString(item.Name),
+				"streams":            utils.FlattenStringSlice(item.Streams),
+			})
+		}
+	}
+
+	iisLogs := make([]interface{}, 0)
+	if input.IisLogs != nil {
+		for _, item := range *input.IisLogs {
+			iisLogs = append(iisLogs, map[string]interface{}{
+				"log_directories": utils.FlattenStringSlice(item.LogDirectories),
+				"name":            utils.NormalizeNilableString(item.Name),
+				"streams":         utils.FlattenStringSlice(&item.Streams),
+			})
+		}
+	}
+
+	logFiles := make([]interface{}, 0)
+	if input.LogFiles != nil {
+		for _, item := range *input.LogFiles {
+			settings := make([]interface{}, 0)
+			if item.Settings != nil && item.Settings.Text != nil {
+				settings = append(settings, map[string]interface{}{
+					"text": []interface{}{
+						map[string]interface{}{
+							"record_start_timestamp_format": item.Settings.Text.RecordStartTimestampFormat,
+						},
+					},
+				})
+			}
+
+			logFiles = append(logFiles, map[string]interface{}{
+				"file_patterns": utils.FlattenStringSlice(&item.FilePatterns),
+				"format":        string(item.Format),
+				"name":          utils.NormalizeNilableString(item.Name),
+				"settings":      settings,
+				"streams":       utils.FlattenStringSlice(&item.Streams),
+			})
+		}
+	}
+
+	performanceCounters := make([]interface{}, 0)
+	if input.PerformanceCounters != nil {
+		for _, item := range *input.PerformanceCounters {
+			samplingFrequency := 0
+			if item.SamplingFrequencyInSeconds != nil {
+				samplingFrequency = int(*item.SamplingFrequencyInSeconds)
+			}
+
+			performanceCounters = append(performanceCounters, map[string]interface{}{
+				"counter_specifiers":            utils.FlattenStringSlice(item.CounterSpecifiers),
+				"name":                          utils.NormalizeNilableString(item.Name),
+				"sampling_frequency_in_seconds": samplingFrequency,
+				"streams":                       utils.FlattenStringSlice(item.Streams),
+			})
+		}
+	}
+
+	prometheusForwarders := make([]interface{}, 0)
+	if input.PrometheusForwarder != nil {
+		for _, item := range *input.PrometheusForwarder {
+			filters := make([]interface{}, 0)
+			if item.LabelIncludeFilter != nil {
+				for label, value := range *item.LabelIncludeFilter {
+					filters = append(filters, map[string]interface{}{
+						"label": label,
+						"value": value,
+					})
+				}
+			}
+
+			prometheusForwarders = append(prometheusForwarders, map[string]interface{}{
+				"label_include_filter": filters,
+				"name":                 utils.NormalizeNilableString(item.Name),
+				"streams":              utils.FlattenStringSlice(item.Streams),
+			})
+		}
+	}
+
+	syslogs := make([]interface{}, 0)
+	if input.Syslog != nil {
+		for _, item := range *input.Syslog {
+			syslogs = append(syslogs, map[string]interface{}{
+				"facility_names": utils.FlattenStringSlice(item.FacilityNames),
+				"log_levels":     utils.FlattenStringSlice(item.LogLevels),
+				"name":           utils.NormalizeNilableString(item.Name),
+				"streams":        utils.FlattenStringSlice(item.Streams),
+			})
+		}
+	}
+
+	windowsEventLogs := make([]interface{}, 0)
+	if input.WindowsEventLogs != nil {
+		for _, item := range *input.WindowsEventLogs {
+			windowsEventLogs = append(windowsEventLogs, map[string]interface{}{
+				"name":           utils.NormalizeNilableString(item.Name),
+				"streams":        utils.FlattenStringSlice(item.Streams),
+				"x_path_queries": utils.FlattenStringSlice(item.XPathQueries),
+			})
+		}
+	}
+
+	if len(extensions) == 0 && len(iisLogs) == 0 && len(logFiles) == 0 && len(performanceCounters) == 0 && len(prometheusForwarders) == 0 && len(syslogs) == 0 && len(windowsEventLogs) == 0 {
+		return []interface{}{}, nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"extension":            extensions,
+			"iis_log":              iisLogs,
+			"log_file":             logFiles,
+			"performance_counter":  performanceCounters,
+			"prometheus_forwarder": prometheusForwarders,
+			"syslog":               syslogs,
+			"windows_event_log":    windowsEventLogs,
+		},
+	}, nil
+}
+
+func expandDataCollectionRuleStreamDeclarations(input []interface{}) *map[string]datacollectionrules.StreamDeclaration {
+	results := make(map[string]datacollectionrules.StreamDeclaration)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		columns := make([]datacollectionrules.ColumnDefinition, 0)
+		for _, c := range v["column"].([]interface{}) {
+			column := c.(map[string]interface{})
+			columnType := datacollectionrules.KnownColumnDefinitionType(column["type"].(string))
+			columns = append(columns, datacollectionrules.ColumnDefinition{
+				Name: utils.String(column["name"].(string)),
+				Type: &columnType,
+			})
+		}
+
+		results[v["stream_name"].(string)] = datacollectionrules.StreamDeclaration{
+			Columns: &columns,
+		}
+	}
+
+	return &results
+}
+
+func flattenDataCollectionRuleStreamDeclarations(input *map[string]datacollectionrules.StreamDeclaration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for name, declaration := range *input {
+		columns := make([]interface{}, 0)
+		if declaration.Columns != nil {
+			for _, column := range *declaration.Columns {
+				columnType := ""
+				if column.Type != nil {
+					columnType = string(*column.Type)
+				}
+
+				columns = append(columns, map[string]interface{}{
+					"name": utils.NormalizeNilableString(column.Name),
+					"type": columnType,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"column":      columns,
+			"stream_name": name,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,664 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-03-15-preview/scheduledqueryrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type scheduledQueryRulesAlertV2IdentityType = identity.SystemAssignedUserAssigned
+
+func resourceMonitorScheduledQueryRulesAlertV2() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorScheduledQueryRulesAlertV2CreateUpdate,
+		Read:   resourceMonitorScheduledQueryRulesAlertV2Read,
+		Update: resourceMonitorScheduledQueryRulesAlertV2CreateUpdate,
+		Delete: resourceMonitorScheduledQueryRulesAlertV2Delete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := scheduledqueryrules.ParseScheduledQueryRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("<>*%&:\\?+/"),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"criteria": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"operator": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scheduledqueryrules.ConditionOperatorEquals),
+								string(scheduledqueryrules.ConditionOperatorGreaterThan),
+								string(scheduledqueryrules.ConditionOperatorGreaterThanOrEqual),
+								string(scheduledqueryrules.ConditionOperatorLessThan),
+								string(scheduledqueryrules.ConditionOperatorLessThanOrEqual),
+							}, false),
+						},
+
+						"query": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"threshold": {
+							Type:     pluginsdk.TypeFloat,
+							Required: true,
+						},
+
+						"time_aggregation_method": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scheduledqueryrules.TimeAggregationAverage),
+								string(scheduledqueryrules.TimeAggregationCount),
+								string(scheduledqueryrules.TimeAggregationMaximum),
+								string(scheduledqueryrules.TimeAggregationMinimum),
+								string(scheduledqueryrules.TimeAggregationTotal),
+							}, false),
+						},
+
+						"dimension": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(scheduledqueryrules.DimensionOperatorExclude),
+											string(scheduledqueryrules.DimensionOperatorInclude),
+										}, false),
+									},
+
+									"values": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"failing_periods": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"minimum_failing_periods_to_trigger_alert": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 6),
+									},
+
+									"number_of_evaluation_periods": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 6),
+									},
+								},
+							},
+						},
+
+						"metric_measure_column": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"resource_id_column": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"evaluation_frequency": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M", "PT5M", "PT10M", "PT15M", "PT30M", "PT45M",
+					"PT1H", "PT2H", "PT3H", "PT4H", "PT5H", "PT6H",
+					"P1D",
+				}, false),
+			},
+
+			"scopes": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"severity": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"window_size": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M", "PT5M", "PT10M", "PT15M", "PT30M", "PT45M",
+					"PT1H", "PT2H", "PT3H", "PT4H", "PT5H", "PT6H",
+					"P1D", "P2D",
+				}, false),
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"action_groups": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+
+						"custom_properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"auto_mitigation_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"mute_actions_after_alert_duration"},
+			},
+
+			"workspace_alerts_storage_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"identity": scheduledQueryRulesAlertV2IdentityType{}.Schema(),
+
+			"mute_actions_after_alert_duration": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT5M", "PT10M", "PT15M", "PT30M", "PT45M",
+					"PT1H", "PT2H", "PT3H", "PT4H", "PT5H", "PT6H",
+					"P1D", "P2D",
+				}, false),
+				ConflictsWith: []string{"auto_mitigation_enabled"},
+			},
+
+			"query_time_range_override": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT5M", "PT10M", "PT15M", "PT20M", "PT30M", "PT45M",
+					"PT1H", "PT2H", "PT3H", "PT4H", "PT5H", "PT6H",
+					"P1D", "P2D",
+				}, false),
+			},
+
+			"skip_query_validation": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"target_resource_types": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"created_with_api_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"is_a_legacy_log_analytics_rule": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"is_workspace_alerts_storage_configured": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorScheduledQueryRulesAlertV2CreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesV2Client
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := scheduledqueryrules.NewScheduledQueryRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_scheduled_query_rules_alert_v2", id.ID())
+		}
+	}
+
+	ruleIdentity, err := expandScheduledQueryRulesAlertV2Identity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	kind := scheduledqueryrules.KindLogAlert
+	parameters := scheduledqueryrules.ScheduledQueryRuleResource{
+		Identity: ruleIdentity,
+		Kind:     &kind,
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: scheduledqueryrules.ScheduledQueryRuleProperties{
+			Actions:                               expandScheduledQueryRulesAlertV2Actions(d.Get("action").([]interface{})),
+			AutoMitigate:                          utils.Bool(d.Get("auto_mitigation_enabled").(bool)),
+			CheckWorkspaceAlertsStorageConfigured: utils.Bool(d.Get("workspace_alerts_storage_enabled").(bool)),
+			Criteria:                              expandScheduledQueryRulesAlertV2Criteria(d.Get("criteria").([]interface{})),
+			Enabled:                               utils.Bool(d.Get("enabled").(bool)),
+			EvaluationFrequency:                   utils.String(d.Get("evaluation_frequency").(string)),
+			Scopes:                                utils.ExpandStringSlice(d.Get("scopes").([]interface{})),
+			Severity:                              utils.Int64(int64(d.Get("severity").(int))),
+			SkipQueryValidation:                   utils.Bool(d.Get("skip_query_validation").(bool)),
+			TargetResourceTypes:                   utils.ExpandStringSlice(d.Get("target_resource_types").([]interface{})),
+			WindowSize:                            utils.String(d.Get("window_size").(string)),
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		parameters.Properties.DisplayName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mute_actions_after_alert_duration"); ok {
+		parameters.Properties.MuteActionsDuration = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("query_time_range_override"); ok {
+		parameters.Properties.OverrideQueryTimeRange = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorScheduledQueryRulesAlertV2Read(d, meta)
+}
+
+func resourceMonitorScheduledQueryRulesAlertV2Read(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesV2Client
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", azure.NormalizeLocation(model.Location))
+
+		if err := d.Set("identity", flattenScheduledQueryRulesAlertV2Identity(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		props := model.Properties
+		if err := d.Set("action", flattenScheduledQueryRulesAlertV2Actions(props.Actions)); err != nil {
+			return fmt.Errorf("setting `action`: %+v", err)
+		}
+
+		autoMitigate := false
+		if props.AutoMitigate != nil {
+			autoMitigate = *props.AutoMitigate
+		}
+		d.Set("auto_mitigation_enabled", autoMitigate)
+
+		workspaceAlertsStorageEnabled := false
+		if props.CheckWorkspaceAlertsStorageConfigured != nil {
+			workspaceAlertsStorageEnabled = *props.CheckWorkspaceAlertsStorageConfigured
+		}
+		d.Set("workspace_alerts_storage_enabled", workspaceAlertsStorageEnabled)
+
+		if err := d.Set("criteria", flattenScheduledQueryRulesAlertV2Criteria(props.Criteria)); err != nil {
+			return fmt.Errorf("setting `criteria`: %+v", err)
+		}
+
+		enabled := true
+		if props.Enabled != nil {
+			enabled = *props.Enabled
+		}
+		d.Set("enabled", enabled)
+
+		var severity int64
+		if props.Severity != nil {
+			severity = *props.Severity
+		}
+		d.Set("severity", severity)
+
+		skipQueryValidation := false
+		if props.SkipQueryValidation != nil {
+			skipQueryValidation = *props.SkipQueryValidation
+		}
+		d.Set("skip_query_validation", skipQueryValidation)
+
+		d.Set("created_with_api_version", props.CreatedWithApiVersion)
+		d.Set("description", props.Description)
+		d.Set("display_name", props.DisplayName)
+		d.Set("evaluation_frequency", props.EvaluationFrequency)
+		d.Set("is_a_legacy_log_analytics_rule", props.IsLegacyLogAnalyticsRule)
+		d.Set("is_workspace_alerts_storage_configured", props.IsWorkspaceAlertsStorageConfigured)
+		d.Set("mute_actions_after_alert_duration", props.MuteActionsDuration)
+		d.Set("query_time_range_override", props.OverrideQueryTimeRange)
+		d.Set("window_size", props.WindowSize)
+
+		if err := d.Set("scopes", utils.FlattenStringSlice(props.Scopes)); err != nil {
+			return fmt.Errorf("setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("target_resource_types", utils.FlattenStringSlice(props.TargetResourceTypes)); err != nil {
+			return fmt.Errorf("setting `target_resource_types`: %+v", err)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceMonitorScheduledQueryRulesAlertV2Delete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesV2Client
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandScheduledQueryRulesAlertV2Identity(input []interface{}) (*identity.SystemUserAssignedIdentityMap, error) {
+	expanded, err := scheduledQueryRulesAlertV2IdentityType{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := identity.SystemUserAssignedIdentityMap{}
+	result.FromExpandedConfig(*expanded)
+	return &result, nil
+}
+
+func flattenScheduledQueryRulesAlertV2Identity(input *identity.SystemUserAssignedIdentityMap) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	config := input.ToExpandedConfig()
+	return scheduledQueryRulesAlertV2IdentityType{}.Flatten(&config)
+}
+
+func expandScheduledQueryRulesAlertV2Actions(input []interface{}) *scheduledqueryrules.Actions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &scheduledqueryrules.Actions{
+		ActionGroups:     utils.ExpandStringSlice(v["action_groups"].([]interface{})),
+		CustomProperties: tagsHelper.Expand(v["custom_properties"].(map[string]interface{})),
+	}
+}
+
+func flattenScheduledQueryRulesAlertV2Actions(input *scheduledqueryrules.Actions) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"action_groups":     utils.FlattenStringSlice(input.ActionGroups),
+			"custom_properties": tagsHelper.Flatten(input.CustomProperties),
+		},
+	}
+}
+
+func expandScheduledQueryRulesAlertV2Criteria(input []interface{}) *scheduledqueryrules.ScheduledQueryRuleCriteria {
+	conditions := make([]scheduledqueryrules.Condition, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		operator := scheduledqueryrules.ConditionOperator(v["operator"].(string))
+		timeAggregation := scheduledqueryrules.TimeAggregation(v["time_aggregation_method"].(string))
+		condition := scheduledqueryrules.Condition{
+			Dimensions:      expandScheduledQueryRulesAlertV2Dimensions(v["dimension"].([]interface{})),
+			Operator:        &operator,
+			Query:           utils.String(v["query"].(string)),
+			Threshold:       utils.Float(v["threshold"].(float64)),
+			TimeAggregation: &timeAggregation,
+		}
+
+		if failingPeriods := v["failing_periods"].([]interface{}); len(failingPeriods) > 0 && failingPeriods[0] != nil {
+			periods := failingPeriods[0].(map[string]interface{})
+			condition.FailingPeriods = &scheduledqueryrules.ConditionFailingPeriods{
+				MinFailingPeriodsToAlert:  utils.Int64(int64(periods["minimum_failing_periods_to_trigger_alert"].(int))),
+				NumberOfEvaluationPeriods: utils.Int64(int64(periods["number_of_evaluation_periods"].(int))),
+			}
+		}
+
+		if metricMeasureColumn := v["metric_measure_column"].(string); metricMeasureColumn != "" {
+			condition.MetricMeasureColumn = utils.String(metricMeasureColumn)
+		}
+
+		if resourceIdColumn := v["resource_id_column"].(string); resourceIdColumn != "" {
+			condition.ResourceIdColumn = utils.String(resourceIdColumn)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return &scheduledqueryrules.ScheduledQueryRuleCriteria{
+		AllOf: &conditions,
+	}
+}
+
+func flattenScheduledQueryRulesAlertV2Criteria(input *scheduledqueryrules.ScheduledQueryRuleCriteria) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.AllOf == nil {
+		return results
+	}
+
+	for _, condition := range *input.AllOf {
+		operator := ""
+		if condition.Operator != nil {
+			operator = string(*condition.Operator)
+		}
+
+		threshold := 0.0
+		if condition.Threshold != nil {
+			threshold = *condition.Threshold
+		}
+
+		timeAggregation := ""
+		if condition.TimeAggregation != nil {
+			timeAggregation = string(*condition.TimeAggregation)
+		}
+
+		failingPeriods := make([]interface{}, 0)
+		if periods := condition.FailingPeriods; periods != nil {
+			var minFailingPeriods, numberOfEvaluationPeriods int64
+			if periods.MinFailingPeriodsToAlert != nil {
+				minFailingPeriods = *periods.MinFailingPeriodsToAlert
+			}
+			if periods.NumberOfEvaluationPeriods != nil {
+				numberOfEvaluationPeriods = *periods.NumberOfEvaluationPeriods
+			}
+			failingPeriods = append(failingPeriods, map[string]interface{}{
+				"minimum_failing_periods_to_trigger_alert": minFailingPeriods,
+				"number_of_evaluation_periods":             numberOfEvaluationPeriods,
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"dimension":               flattenScheduledQueryRulesAlertV2Dimensions(condition.Dimensions),
+			"failing_periods":         failingPeriods,
+			"metric_measure_column":   utils.NormalizeNilableString(condition.MetricMeasureColumn),
+			"operator":                operator,
+			"query":                   utils.NormalizeNilableString(condition.Query),
+			"resource_id_column":      utils.NormalizeNilableString(condition.ResourceIdColumn),
+			"threshold":               threshold,
+			"time_aggregation_method": timeAggregation,
+		})
+	}
+
+	return results
+}
+
+func expandScheduledQueryRulesAlertV2Dimensions(input []interface{}) *[]scheduledqueryrules.Dimension {
+	results := make([]scheduledqueryrules.Dimension, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, scheduledqueryrules.Dimension{
+			Name:     v["name"].(string),
+			Operator: scheduledqueryrules.DimensionOperator(v["operator"].(string)),
+			Values:   *utils.ExpandStringSlice(v["values"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func flattenScheduledQueryRulesAlertV2Dimensions(input *[]scheduledqueryrules.Dimension) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"name":     item.Name,
+			"operator": string(item.Operator),
+			"values":   utils.FlattenStringSlice(&item.Values),
+		})
+	}
+
+	return results
+}
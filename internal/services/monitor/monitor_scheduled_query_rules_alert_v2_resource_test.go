@@ -0,0 +1,272 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-03-15-preview/scheduledqueryrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorScheduledQueryRulesAlertV2Resource struct{}
+
+func TestAccMonitorScheduledQueryRulesAlertV2_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorScheduledQueryRulesAlertV2_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorScheduledQueryRulesAlertV2_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("created_with_api_version").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorScheduledQueryRulesAlertV2_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.ScheduledQueryRulesV2Client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sqr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestAppInsights-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                = "acctest-sqr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  evaluation_frequency = "PT10M"
+  window_size          = "PT10M"
+  scopes               = [azurerm_application_insights.test.id]
+  severity             = 4
+
+  criteria {
+    query                   = <<-QUERY
+      requests
+        | summarize CountByCountry=count() by client_CountryOrRegion
+      QUERY
+    time_aggregation_method = "Count"
+    threshold               = 5.0
+    operator                = "Equals"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "import" {
+  name                = azurerm_monitor_scheduled_query_rules_alert_v2.test.name
+  resource_group_name = azurerm_monitor_scheduled_query_rules_alert_v2.test.resource_group_name
+  location            = azurerm_monitor_scheduled_query_rules_alert_v2.test.location
+
+  evaluation_frequency = azurerm_monitor_scheduled_query_rules_alert_v2.test.evaluation_frequency
+  window_size          = azurerm_monitor_scheduled_query_rules_alert_v2.test.window_size
+  scopes               = azurerm_monitor_scheduled_query_rules_alert_v2.test.scopes
+  severity             = azurerm_monitor_scheduled_query_rules_alert_v2.test.severity
+
+  criteria {
+    query                   = <<-QUERY
+      requests
+        | summarize CountByCountry=count() by client_CountryOrRegion
+      QUERY
+    time_aggregation_method = "Count"
+    threshold               = 5.0
+    operator                = "Equals"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_application_insights.test.id
+  role_definition_name = "Reader"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                = "acctest-sqr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  evaluation_frequency = "PT5M"
+  window_size          = "PT10M"
+  scopes               = [azurerm_application_insights.test.id]
+  severity             = 3
+
+  criteria {
+    query                   = <<-QUERY
+      requests
+        | summarize CountByCountry=count() by client_CountryOrRegion
+      QUERY
+    time_aggregation_method = "Maximum"
+    threshold               = 17.5
+    operator                = "LessThan"
+
+    resource_id_column    = "client_CountryOrRegion"
+    metric_measure_column = "CountByCountry"
+
+    dimension {
+      name     = "client_CountryOrRegion"
+      operator = "Exclude"
+      values   = ["123"]
+    }
+
+    failing_periods {
+      minimum_failing_periods_to_trigger_alert = 1
+      number_of_evaluation_periods             = 1
+    }
+  }
+
+  auto_mitigation_enabled          = true
+  workspace_alerts_storage_enabled = false
+  description                      = "acceptance test description"
+  display_name                     = "acctest-sqr-%[2]d"
+  enabled                          = true
+  query_time_range_override        = "PT1H"
+  skip_query_validation            = true
+
+  action {
+    action_groups = [azurerm_monitor_action_group.test.id]
+    custom_properties = {
+      key  = "value"
+      key2 = "value2"
+    }
+  }
+
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+
+  tags = {
+    key  = "value"
+    key2 = "value2"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
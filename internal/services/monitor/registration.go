@@ -46,5 +46,11 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_monitor_scheduled_query_rules_alert": resourceMonitorScheduledQueryRulesAlert(),
 		"azurerm_monitor_scheduled_query_rules_log":   resourceMonitorScheduledQueryRulesLog(),
 		"azurerm_monitor_smart_detector_alert_rule":   resourceMonitorSmartDetectorAlertRule(),
+
+		"azurerm_monitor_data_collection_endpoint":         resourceMonitorDataCollectionEndpoint(),
+		"azurerm_monitor_data_collection_rule":             resourceMonitorDataCollectionRule(),
+		"azurerm_monitor_data_collection_rule_association": resourceMonitorDataCollectionRuleAssociation(),
+		"azurerm_monitor_scheduled_query_rules_alert_v2":   resourceMonitorScheduledQueryRulesAlertV2(),
+		"azurerm_monitor_workspace":                        resourceMonitorWorkspace(),
 	}
 }
@@ -0,0 +1,7 @@
+package actiongroupsapis
+
+type ArmRoleReceiver struct {
+	Name                 string `json:"name"`
+	RoleId               string `json:"roleId"`
+	UseCommonAlertSchema *bool  `json:"useCommonAlertSchema,omitempty"`
+}
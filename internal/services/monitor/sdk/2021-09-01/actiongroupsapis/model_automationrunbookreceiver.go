@@ -0,0 +1,11 @@
+package actiongroupsapis
+
+type AutomationRunbookReceiver struct {
+	AutomationAccountId  string  `json:"automationAccountId"`
+	IsGlobalRunbook      bool    `json:"isGlobalRunbook"`
+	Name                 *string `json:"name,omitempty"`
+	RunbookName          string  `json:"runbookName"`
+	ServiceUri           *string `json:"serviceUri,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+	WebhookResourceId    string  `json:"webhookResourceId"`
+}
@@ -0,0 +1,10 @@
+package actiongroupsapis
+
+type EventHubReceiver struct {
+	EventHubName         string  `json:"eventHubName"`
+	EventHubNameSpace    string  `json:"eventHubNameSpace"`
+	Name                 string  `json:"name"`
+	SubscriptionId       string  `json:"subscriptionId"`
+	TenantId             *string `json:"tenantId,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+}
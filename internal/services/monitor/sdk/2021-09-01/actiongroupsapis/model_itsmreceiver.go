@@ -0,0 +1,9 @@
+package actiongroupsapis
+
+type ItsmReceiver struct {
+	ConnectionId        string `json:"connectionId"`
+	Name                string `json:"name"`
+	Region              string `json:"region"`
+	TicketConfiguration string `json:"ticketConfiguration"`
+	WorkspaceId         string `json:"workspaceId"`
+}
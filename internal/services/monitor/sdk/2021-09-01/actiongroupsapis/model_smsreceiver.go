@@ -0,0 +1,8 @@
+package actiongroupsapis
+
+type SmsReceiver struct {
+	CountryCode string          `json:"countryCode"`
+	Name        string          `json:"name"`
+	PhoneNumber string          `json:"phoneNumber"`
+	Status      *ReceiverStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,9 @@
+package actiongroupsapis
+
+import "fmt"
+
+const defaultApiVersion = "2021-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/actiongroupsapis/%s", defaultApiVersion)
+}
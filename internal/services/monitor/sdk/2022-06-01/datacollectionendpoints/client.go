@@ -0,0 +1,15 @@
+package datacollectionendpoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DataCollectionEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDataCollectionEndpointsClientWithBaseURI(endpoint string) DataCollectionEndpointsClient {
+	return DataCollectionEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
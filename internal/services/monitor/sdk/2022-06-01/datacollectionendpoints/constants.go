@@ -0,0 +1,102 @@
+package datacollectionendpoints
+
+import "strings"
+
+type KnownDataCollectionEndpointResourceKind string
+
+const (
+	KnownDataCollectionEndpointResourceKindLinux   KnownDataCollectionEndpointResourceKind = "Linux"
+	KnownDataCollectionEndpointResourceKindWindows KnownDataCollectionEndpointResourceKind = "Windows"
+)
+
+func PossibleValuesForKnownDataCollectionEndpointResourceKind() []string {
+	return []string{
+		string(KnownDataCollectionEndpointResourceKindLinux),
+		string(KnownDataCollectionEndpointResourceKindWindows),
+	}
+}
+
+func parseKnownDataCollectionEndpointResourceKind(input string) (*KnownDataCollectionEndpointResourceKind, error) {
+	vals := map[string]KnownDataCollectionEndpointResourceKind{
+		"linux":   KnownDataCollectionEndpointResourceKindLinux,
+		"windows": KnownDataCollectionEndpointResourceKindWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownDataCollectionEndpointResourceKind(input)
+	return &out, nil
+}
+
+type KnownProvisioningState string
+
+const (
+	KnownProvisioningStateCanceled  KnownProvisioningState = "Canceled"
+	KnownProvisioningStateCreating  KnownProvisioningState = "Creating"
+	KnownProvisioningStateDeleting  KnownProvisioningState = "Deleting"
+	KnownProvisioningStateFailed    KnownProvisioningState = "Failed"
+	KnownProvisioningStateSucceeded KnownProvisioningState = "Succeeded"
+	KnownProvisioningStateUpdating  KnownProvisioningState = "Updating"
+)
+
+func PossibleValuesForKnownProvisioningState() []string {
+	return []string{
+		string(KnownProvisioningStateCanceled),
+		string(KnownProvisioningStateCreating),
+		string(KnownProvisioningStateDeleting),
+		string(KnownProvisioningStateFailed),
+		string(KnownProvisioningStateSucceeded),
+		string(KnownProvisioningStateUpdating),
+	}
+}
+
+func parseKnownProvisioningState(input string) (*KnownProvisioningState, error) {
+	vals := map[string]KnownProvisioningState{
+		"canceled":  KnownProvisioningStateCanceled,
+		"creating":  KnownProvisioningStateCreating,
+		"deleting":  KnownProvisioningStateDeleting,
+		"failed":    KnownProvisioningStateFailed,
+		"succeeded": KnownProvisioningStateSucceeded,
+		"updating":  KnownProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownProvisioningState(input)
+	return &out, nil
+}
+
+type KnownPublicNetworkAccessOptions string
+
+const (
+	KnownPublicNetworkAccessOptionsDisabled           KnownPublicNetworkAccessOptions = "Disabled"
+	KnownPublicNetworkAccessOptionsEnabled            KnownPublicNetworkAccessOptions = "Enabled"
+	KnownPublicNetworkAccessOptionsSecuredByPerimeter KnownPublicNetworkAccessOptions = "SecuredByPerimeter"
+)
+
+func PossibleValuesForKnownPublicNetworkAccessOptions() []string {
+	return []string{
+		string(KnownPublicNetworkAccessOptionsDisabled),
+		string(KnownPublicNetworkAccessOptionsEnabled),
+		string(KnownPublicNetworkAccessOptionsSecuredByPerimeter),
+	}
+}
+
+func parseKnownPublicNetworkAccessOptions(input string) (*KnownPublicNetworkAccessOptions, error) {
+	vals := map[string]KnownPublicNetworkAccessOptions{
+		"disabled":           KnownPublicNetworkAccessOptionsDisabled,
+		"enabled":            KnownPublicNetworkAccessOptionsEnabled,
+		"securedbyperimeter": KnownPublicNetworkAccessOptionsSecuredByPerimeter,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownPublicNetworkAccessOptions(input)
+	return &out, nil
+}
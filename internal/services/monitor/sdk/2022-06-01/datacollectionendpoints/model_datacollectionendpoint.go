@@ -0,0 +1,10 @@
+package datacollectionendpoints
+
+type DataCollectionEndpoint struct {
+	ConfigurationAccess *ConfigurationAccessEndpointSpec `json:"configurationAccess,omitempty"`
+	Description         *string                          `json:"description,omitempty"`
+	ImmutableId         *string                          `json:"immutableId,omitempty"`
+	LogsIngestion       *LogsIngestionEndpointSpec       `json:"logsIngestion,omitempty"`
+	NetworkAcls         *NetworkRuleSet                  `json:"networkAcls,omitempty"`
+	ProvisioningState   *KnownProvisioningState          `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,12 @@
+package datacollectionendpoints
+
+type DataCollectionEndpointResource struct {
+	Etag       *string                                  `json:"etag,omitempty"`
+	Id         *string                                  `json:"id,omitempty"`
+	Kind       *KnownDataCollectionEndpointResourceKind `json:"kind,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *DataCollectionEndpoint                  `json:"properties,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
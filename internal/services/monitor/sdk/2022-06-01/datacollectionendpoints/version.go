@@ -0,0 +1,9 @@
+package datacollectionendpoints
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/datacollectionendpoints/%s", defaultApiVersion)
+}
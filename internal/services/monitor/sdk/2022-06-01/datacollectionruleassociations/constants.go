@@ -0,0 +1,43 @@
+package datacollectionruleassociations
+
+import "strings"
+
+type KnownProvisioningState string
+
+const (
+	KnownProvisioningStateCanceled  KnownProvisioningState = "Canceled"
+	KnownProvisioningStateCreating  KnownProvisioningState = "Creating"
+	KnownProvisioningStateDeleting  KnownProvisioningState = "Deleting"
+	KnownProvisioningStateFailed    KnownProvisioningState = "Failed"
+	KnownProvisioningStateSucceeded KnownProvisioningState = "Succeeded"
+	KnownProvisioningStateUpdating  KnownProvisioningState = "Updating"
+)
+
+func PossibleValuesForKnownProvisioningState() []string {
+	return []string{
+		string(KnownProvisioningStateCanceled),
+		string(KnownProvisioningStateCreating),
+		string(KnownProvisioningStateDeleting),
+		string(KnownProvisioningStateFailed),
+		string(KnownProvisioningStateSucceeded),
+		string(KnownProvisioningStateUpdating),
+	}
+}
+
+func parseKnownProvisioningState(input string) (*KnownProvisioningState, error) {
+	vals := map[string]KnownProvisioningState{
+		"canceled":  KnownProvisioningStateCanceled,
+		"creating":  KnownProvisioningStateCreating,
+		"deleting":  KnownProvisioningStateDeleting,
+		"failed":    KnownProvisioningStateFailed,
+		"succeeded": KnownProvisioningStateSucceeded,
+		"updating":  KnownProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package datacollectionruleassociations
+
+type DataCollectionRuleAssociationProxyOnlyResource struct {
+	Etag       *string                        `json:"etag,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *DataCollectionRuleAssociation `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
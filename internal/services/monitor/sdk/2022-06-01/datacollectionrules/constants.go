@@ -0,0 +1,142 @@
+package datacollectionrules
+
+import "strings"
+
+type KnownColumnDefinitionType string
+
+const (
+	KnownColumnDefinitionTypeBoolean  KnownColumnDefinitionType = "boolean"
+	KnownColumnDefinitionTypeDatetime KnownColumnDefinitionType = "datetime"
+	KnownColumnDefinitionTypeDynamic  KnownColumnDefinitionType = "dynamic"
+	KnownColumnDefinitionTypeInt      KnownColumnDefinitionType = "int"
+	KnownColumnDefinitionTypeLong     KnownColumnDefinitionType = "long"
+	KnownColumnDefinitionTypeReal     KnownColumnDefinitionType = "real"
+	KnownColumnDefinitionTypeString   KnownColumnDefinitionType = "string"
+)
+
+func PossibleValuesForKnownColumnDefinitionType() []string {
+	return []string{
+		string(KnownColumnDefinitionTypeBoolean),
+		string(KnownColumnDefinitionTypeDatetime),
+		string(KnownColumnDefinitionTypeDynamic),
+		string(KnownColumnDefinitionTypeInt),
+		string(KnownColumnDefinitionTypeLong),
+		string(KnownColumnDefinitionTypeReal),
+		string(KnownColumnDefinitionTypeString),
+	}
+}
+
+func parseKnownColumnDefinitionType(input string) (*KnownColumnDefinitionType, error) {
+	vals := map[string]KnownColumnDefinitionType{
+		"boolean":  KnownColumnDefinitionTypeBoolean,
+		"datetime": KnownColumnDefinitionTypeDatetime,
+		"dynamic":  KnownColumnDefinitionTypeDynamic,
+		"int":      KnownColumnDefinitionTypeInt,
+		"long":     KnownColumnDefinitionTypeLong,
+		"real":     KnownColumnDefinitionTypeReal,
+		"string":   KnownColumnDefinitionTypeString,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownColumnDefinitionType(input)
+	return &out, nil
+}
+
+type KnownDataCollectionRuleResourceKind string
+
+const (
+	KnownDataCollectionRuleResourceKindLinux   KnownDataCollectionRuleResourceKind = "Linux"
+	KnownDataCollectionRuleResourceKindWindows KnownDataCollectionRuleResourceKind = "Windows"
+)
+
+func PossibleValuesForKnownDataCollectionRuleResourceKind() []string {
+	return []string{
+		string(KnownDataCollectionRuleResourceKindLinux),
+		string(KnownDataCollectionRuleResourceKindWindows),
+	}
+}
+
+func parseKnownDataCollectionRuleResourceKind(input string) (*KnownDataCollectionRuleResourceKind, error) {
+	vals := map[string]KnownDataCollectionRuleResourceKind{
+		"linux":   KnownDataCollectionRuleResourceKindLinux,
+		"windows": KnownDataCollectionRuleResourceKindWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownDataCollectionRuleResourceKind(input)
+	return &out, nil
+}
+
+type KnownLogFilesDataSourceFormat string
+
+const (
+	KnownLogFilesDataSourceFormatJson KnownLogFilesDataSourceFormat = "json"
+	KnownLogFilesDataSourceFormatText KnownLogFilesDataSourceFormat = "text"
+)
+
+func PossibleValuesForKnownLogFilesDataSourceFormat() []string {
+	return []string{
+		string(KnownLogFilesDataSourceFormatJson),
+		string(KnownLogFilesDataSourceFormatText),
+	}
+}
+
+func parseKnownLogFilesDataSourceFormat(input string) (*KnownLogFilesDataSourceFormat, error) {
+	vals := map[string]KnownLogFilesDataSourceFormat{
+		"json": KnownLogFilesDataSourceFormatJson,
+		"text": KnownLogFilesDataSourceFormatText,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownLogFilesDataSourceFormat(input)
+	return &out, nil
+}
+
+type KnownProvisioningState string
+
+const (
+	KnownProvisioningStateCanceled  KnownProvisioningState = "Canceled"
+	KnownProvisioningStateCreating  KnownProvisioningState = "Creating"
+	KnownProvisioningStateDeleting  KnownProvisioningState = "Deleting"
+	KnownProvisioningStateFailed    KnownProvisioningState = "Failed"
+	KnownProvisioningStateSucceeded KnownProvisioningState = "Succeeded"
+	KnownProvisioningStateUpdating  KnownProvisioningState = "Updating"
+)
+
+func PossibleValuesForKnownProvisioningState() []string {
+	return []string{
+		string(KnownProvisioningStateCanceled),
+		string(KnownProvisioningStateCreating),
+		string(KnownProvisioningStateDeleting),
+		string(KnownProvisioningStateFailed),
+		string(KnownProvisioningStateSucceeded),
+		string(KnownProvisioningStateUpdating),
+	}
+}
+
+func parseKnownProvisioningState(input string) (*KnownProvisioningState, error) {
+	vals := map[string]KnownProvisioningState{
+		"canceled":  KnownProvisioningStateCanceled,
+		"creating":  KnownProvisioningStateCreating,
+		"deleting":  KnownProvisioningStateDeleting,
+		"failed":    KnownProvisioningStateFailed,
+		"succeeded": KnownProvisioningStateSucceeded,
+		"updating":  KnownProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,5 @@
+package datacollectionrules
+
+type AzureMonitorMetricsDestination struct {
+	Name *string `json:"name,omitempty"`
+}
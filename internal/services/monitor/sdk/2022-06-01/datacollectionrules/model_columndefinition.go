@@ -0,0 +1,6 @@
+package datacollectionrules
+
+type ColumnDefinition struct {
+	Name *string                    `json:"name,omitempty"`
+	Type *KnownColumnDefinitionType `json:"type,omitempty"`
+}
@@ -0,0 +1,12 @@
+package datacollectionrules
+
+type DataCollectionRule struct {
+	DataCollectionEndpointId *string                       `json:"dataCollectionEndpointId,omitempty"`
+	DataFlows                *[]DataFlow                   `json:"dataFlows,omitempty"`
+	DataSources              *DataSourcesSpec              `json:"dataSources,omitempty"`
+	Description              *string                       `json:"description,omitempty"`
+	Destinations             *DestinationsSpec             `json:"destinations,omitempty"`
+	ImmutableId              *string                       `json:"immutableId,omitempty"`
+	ProvisioningState        *KnownProvisioningState       `json:"provisioningState,omitempty"`
+	StreamDeclarations       *map[string]StreamDeclaration `json:"streamDeclarations,omitempty"`
+}
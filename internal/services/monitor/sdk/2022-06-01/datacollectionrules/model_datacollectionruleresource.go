@@ -0,0 +1,12 @@
+package datacollectionrules
+
+type DataCollectionRuleResource struct {
+	Etag       *string                              `json:"etag,omitempty"`
+	Id         *string                              `json:"id,omitempty"`
+	Kind       *KnownDataCollectionRuleResourceKind `json:"kind,omitempty"`
+	Location   string                               `json:"location"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *DataCollectionRule                  `json:"properties,omitempty"`
+	Tags       *map[string]string                   `json:"tags,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
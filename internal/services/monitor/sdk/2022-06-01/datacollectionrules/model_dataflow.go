@@ -0,0 +1,8 @@
+package datacollectionrules
+
+type DataFlow struct {
+	Destinations *[]string `json:"destinations,omitempty"`
+	OutputStream *string   `json:"outputStream,omitempty"`
+	Streams      *[]string `json:"streams,omitempty"`
+	TransformKql *string   `json:"transformKql,omitempty"`
+}
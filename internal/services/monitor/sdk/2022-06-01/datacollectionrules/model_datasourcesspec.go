@@ -0,0 +1,11 @@
+package datacollectionrules
+
+type DataSourcesSpec struct {
+	Extensions          *[]ExtensionDataSource           `json:"extensions,omitempty"`
+	IisLogs             *[]IisLogsDataSource             `json:"iisLogs,omitempty"`
+	LogFiles            *[]LogFilesDataSource            `json:"logFiles,omitempty"`
+	PerformanceCounters *[]PerfCounterDataSource         `json:"performanceCounters,omitempty"`
+	PrometheusForwarder *[]PrometheusForwarderDataSource `json:"prometheusForwarder,omitempty"`
+	Syslog              *[]SyslogDataSource              `json:"syslog,omitempty"`
+	WindowsEventLogs    *[]WindowsEventLogDataSource     `json:"windowsEventLogs,omitempty"`
+}
@@ -0,0 +1,9 @@
+package datacollectionrules
+
+type DestinationsSpec struct {
+	AzureMonitorMetrics *AzureMonitorMetricsDestination `json:"azureMonitorMetrics,omitempty"`
+	EventHubs           *[]EventHubDestination          `json:"eventHubs,omitempty"`
+	LogAnalytics        *[]LogAnalyticsDestination      `json:"logAnalytics,omitempty"`
+	MonitoringAccounts  *[]MonitoringAccountDestination `json:"monitoringAccounts,omitempty"`
+	StorageBlobsDirect  *[]StorageBlobDestination       `json:"storageBlobsDirect,omitempty"`
+}
@@ -0,0 +1,6 @@
+package datacollectionrules
+
+type EventHubDestination struct {
+	EventHubResourceId *string `json:"eventHubResourceId,omitempty"`
+	Name               *string `json:"name,omitempty"`
+}
@@ -0,0 +1,9 @@
+package datacollectionrules
+
+type ExtensionDataSource struct {
+	ExtensionName     string       `json:"extensionName"`
+	ExtensionSettings *interface{} `json:"extensionSettings,omitempty"`
+	InputDataSources  *[]string    `json:"inputDataSources,omitempty"`
+	Name              *string      `json:"name,omitempty"`
+	Streams           *[]string    `json:"streams,omitempty"`
+}
@@ -0,0 +1,7 @@
+package datacollectionrules
+
+type IisLogsDataSource struct {
+	LogDirectories *[]string `json:"logDirectories,omitempty"`
+	Name           *string   `json:"name,omitempty"`
+	Streams        []string  `json:"streams"`
+}
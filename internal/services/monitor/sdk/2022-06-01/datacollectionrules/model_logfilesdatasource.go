@@ -0,0 +1,9 @@
+package datacollectionrules
+
+type LogFilesDataSource struct {
+	FilePatterns []string                      `json:"filePatterns"`
+	Format       KnownLogFilesDataSourceFormat `json:"format"`
+	Name         *string                       `json:"name,omitempty"`
+	Settings     *LogFilesDataSourceSettings   `json:"settings,omitempty"`
+	Streams      []string                      `json:"streams"`
+}
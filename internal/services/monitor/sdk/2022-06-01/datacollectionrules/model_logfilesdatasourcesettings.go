@@ -0,0 +1,5 @@
+package datacollectionrules
+
+type LogFilesDataSourceSettings struct {
+	Text *LogFileSettingsText `json:"text,omitempty"`
+}
@@ -0,0 +1,5 @@
+package datacollectionrules
+
+type LogFileSettingsText struct {
+	RecordStartTimestampFormat string `json:"recordStartTimestampFormat"`
+}
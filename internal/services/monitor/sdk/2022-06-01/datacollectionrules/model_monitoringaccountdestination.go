@@ -0,0 +1,7 @@
+package datacollectionrules
+
+type MonitoringAccountDestination struct {
+	AccountId         *string `json:"accountId,omitempty"`
+	AccountResourceId *string `json:"accountResourceId,omitempty"`
+	Name              *string `json:"name,omitempty"`
+}
@@ -0,0 +1,7 @@
+package datacollectionrules
+
+type StorageBlobDestination struct {
+	ContainerName            *string `json:"containerName,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+	StorageAccountResourceId *string `json:"storageAccountResourceId,omitempty"`
+}
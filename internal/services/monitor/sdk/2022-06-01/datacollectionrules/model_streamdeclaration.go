@@ -0,0 +1,5 @@
+package datacollectionrules
+
+type StreamDeclaration struct {
+	Columns *[]ColumnDefinition `json:"columns,omitempty"`
+}
@@ -0,0 +1,8 @@
+package datacollectionrules
+
+type SyslogDataSource struct {
+	FacilityNames *[]string `json:"facilityNames,omitempty"`
+	LogLevels     *[]string `json:"logLevels,omitempty"`
+	Name          *string   `json:"name,omitempty"`
+	Streams       *[]string `json:"streams,omitempty"`
+}
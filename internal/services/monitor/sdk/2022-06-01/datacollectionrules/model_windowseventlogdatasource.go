@@ -0,0 +1,7 @@
+package datacollectionrules
+
+type WindowsEventLogDataSource struct {
+	Name         *string   `json:"name,omitempty"`
+	Streams      *[]string `json:"streams,omitempty"`
+	XPathQueries *[]string `json:"xPathQueries,omitempty"`
+}
@@ -0,0 +1,65 @@
+package autoscalesettings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AutoscaleSettingsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *AutoscaleSettingResource
+}
+
+// AutoscaleSettingsCreateOrUpdate ...
+func (c AutoScaleSettingsClient) AutoscaleSettingsCreateOrUpdate(ctx context.Context, id AutoscaleSettingId, input AutoscaleSettingResource) (result AutoscaleSettingsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForAutoscaleSettingsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAutoscaleSettingsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAutoscaleSettingsCreateOrUpdate prepares the AutoscaleSettingsCreateOrUpdate request.
+func (c AutoScaleSettingsClient) preparerForAutoscaleSettingsCreateOrUpdate(ctx context.Context, id AutoscaleSettingId, input AutoscaleSettingResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAutoscaleSettingsCreateOrUpdate handles the response to the AutoscaleSettingsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c AutoScaleSettingsClient) responderForAutoscaleSettingsCreateOrUpdate(resp *http.Response) (result AutoscaleSettingsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
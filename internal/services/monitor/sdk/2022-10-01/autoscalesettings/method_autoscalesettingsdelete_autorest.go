@@ -0,0 +1,62 @@
+package autoscalesettings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AutoscaleSettingsDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// AutoscaleSettingsDelete ...
+func (c AutoScaleSettingsClient) AutoscaleSettingsDelete(ctx context.Context, id AutoscaleSettingId) (result AutoscaleSettingsDeleteResponse, err error) {
+	req, err := c.preparerForAutoscaleSettingsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAutoscaleSettingsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAutoscaleSettingsDelete prepares the AutoscaleSettingsDelete request.
+func (c AutoScaleSettingsClient) preparerForAutoscaleSettingsDelete(ctx context.Context, id AutoscaleSettingId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAutoscaleSettingsDelete handles the response to the AutoscaleSettingsDelete request. The method always
+// closes the http.Response Body.
+func (c AutoScaleSettingsClient) responderForAutoscaleSettingsDelete(resp *http.Response) (result AutoscaleSettingsDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
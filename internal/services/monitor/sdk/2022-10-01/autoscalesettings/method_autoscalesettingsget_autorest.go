@@ -0,0 +1,64 @@
+package autoscalesettings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AutoscaleSettingsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *AutoscaleSettingResource
+}
+
+// AutoscaleSettingsGet ...
+func (c AutoScaleSettingsClient) AutoscaleSettingsGet(ctx context.Context, id AutoscaleSettingId) (result AutoscaleSettingsGetResponse, err error) {
+	req, err := c.preparerForAutoscaleSettingsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAutoscaleSettingsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoscalesettings.AutoScaleSettingsClient", "AutoscaleSettingsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAutoscaleSettingsGet prepares the AutoscaleSettingsGet request.
+func (c AutoScaleSettingsClient) preparerForAutoscaleSettingsGet(ctx context.Context, id AutoscaleSettingId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAutoscaleSettingsGet handles the response to the AutoscaleSettingsGet request. The method always
+// closes the http.Response Body.
+func (c AutoScaleSettingsClient) responderForAutoscaleSettingsGet(resp *http.Response) (result AutoscaleSettingsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
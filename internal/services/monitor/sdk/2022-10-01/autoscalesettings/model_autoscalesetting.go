@@ -0,0 +1,11 @@
+package autoscalesettings
+
+type AutoscaleSetting struct {
+	Enabled                   *bool                      `json:"enabled,omitempty"`
+	Name                      *string                    `json:"name,omitempty"`
+	Notifications             *[]AutoscaleNotification   `json:"notifications,omitempty"`
+	PredictiveAutoscalePolicy *PredictiveAutoscalePolicy `json:"predictiveAutoscalePolicy,omitempty"`
+	Profiles                  []AutoscaleProfile         `json:"profiles"`
+	TargetResourceLocation    *string                    `json:"targetResourceLocation,omitempty"`
+	TargetResourceUri         *string                    `json:"targetResourceUri,omitempty"`
+}
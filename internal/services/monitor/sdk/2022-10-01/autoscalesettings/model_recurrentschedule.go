@@ -0,0 +1,8 @@
+package autoscalesettings
+
+type RecurrentSchedule struct {
+	Days     []string `json:"days"`
+	Hours    []int64  `json:"hours"`
+	Minutes  []int64  `json:"minutes"`
+	TimeZone string   `json:"timeZone"`
+}
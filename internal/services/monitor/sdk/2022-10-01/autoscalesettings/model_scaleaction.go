@@ -0,0 +1,8 @@
+package autoscalesettings
+
+type ScaleAction struct {
+	Cooldown  string         `json:"cooldown"`
+	Direction ScaleDirection `json:"direction"`
+	Type      ScaleType      `json:"type"`
+	Value     *string        `json:"value,omitempty"`
+}
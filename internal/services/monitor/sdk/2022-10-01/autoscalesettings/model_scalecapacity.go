@@ -0,0 +1,7 @@
+package autoscalesettings
+
+type ScaleCapacity struct {
+	Default string `json:"default"`
+	Maximum string `json:"maximum"`
+	Minimum string `json:"minimum"`
+}
@@ -0,0 +1,15 @@
+package scheduledqueryrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ScheduledQueryRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewScheduledQueryRulesClientWithBaseURI(endpoint string) ScheduledQueryRulesClient {
+	return ScheduledQueryRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
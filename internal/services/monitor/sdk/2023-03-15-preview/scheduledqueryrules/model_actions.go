@@ -0,0 +1,7 @@
+package scheduledqueryrules
+
+type Actions struct {
+	ActionGroups     *[]string          `json:"actionGroups,omitempty"`
+	ActionProperties *map[string]string `json:"actionProperties,omitempty"`
+	CustomProperties *map[string]string `json:"customProperties,omitempty"`
+}
@@ -0,0 +1,6 @@
+package scheduledqueryrules
+
+type ConditionFailingPeriods struct {
+	MinFailingPeriodsToAlert  *int64 `json:"minFailingPeriodsToAlert,omitempty"`
+	NumberOfEvaluationPeriods *int64 `json:"numberOfEvaluationPeriods,omitempty"`
+}
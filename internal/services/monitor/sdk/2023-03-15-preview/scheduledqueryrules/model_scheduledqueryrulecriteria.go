@@ -0,0 +1,5 @@
+package scheduledqueryrules
+
+type ScheduledQueryRuleCriteria struct {
+	AllOf *[]Condition `json:"allOf,omitempty"`
+}
@@ -0,0 +1,22 @@
+package scheduledqueryrules
+
+type ScheduledQueryRuleProperties struct {
+	Actions                               *Actions                    `json:"actions,omitempty"`
+	AutoMitigate                          *bool                       `json:"autoMitigate,omitempty"`
+	CheckWorkspaceAlertsStorageConfigured *bool                       `json:"checkWorkspaceAlertsStorageConfigured,omitempty"`
+	CreatedWithApiVersion                 *string                     `json:"createdWithApiVersion,omitempty"`
+	Criteria                              *ScheduledQueryRuleCriteria `json:"criteria,omitempty"`
+	Description                           *string                     `json:"description,omitempty"`
+	DisplayName                           *string                     `json:"displayName,omitempty"`
+	Enabled                               *bool                       `json:"enabled,omitempty"`
+	EvaluationFrequency                   *string                     `json:"evaluationFrequency,omitempty"`
+	IsLegacyLogAnalyticsRule              *bool                       `json:"isLegacyLogAnalyticsRule,omitempty"`
+	IsWorkspaceAlertsStorageConfigured    *bool                       `json:"isWorkspaceAlertsStorageConfigured,omitempty"`
+	MuteActionsDuration                   *string                     `json:"muteActionsDuration,omitempty"`
+	OverrideQueryTimeRange                *string                     `json:"overrideQueryTimeRange,omitempty"`
+	Scopes                                *[]string                   `json:"scopes,omitempty"`
+	Severity                              *int64                      `json:"severity,omitempty"`
+	SkipQueryValidation                   *bool                       `json:"skipQueryValidation,omitempty"`
+	TargetResourceTypes                   *[]string                   `json:"targetResourceTypes,omitempty"`
+	WindowSize                            *string                     `json:"windowSize,omitempty"`
+}
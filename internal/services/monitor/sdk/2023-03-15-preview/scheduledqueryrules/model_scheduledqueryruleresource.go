@@ -0,0 +1,17 @@
+package scheduledqueryrules
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type ScheduledQueryRuleResource struct {
+	Etag       *string                                 `json:"etag,omitempty"`
+	Id         *string                                 `json:"id,omitempty"`
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Kind       *Kind                                   `json:"kind,omitempty"`
+	Location   string                                  `json:"location"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties ScheduledQueryRuleProperties            `json:"properties"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+	Type       *string                                 `json:"type,omitempty"`
+}
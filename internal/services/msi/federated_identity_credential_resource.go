@@ -0,0 +1,209 @@
+package msi
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2018-11-30/managedidentity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2023-01-31/federatedidentitycredentials"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmFederatedIdentityCredential() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmFederatedIdentityCredentialCreate,
+		Read:   resourceArmFederatedIdentityCredentialRead,
+		Update: resourceArmFederatedIdentityCredentialUpdate,
+		Delete: resourceArmFederatedIdentityCredentialDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 120),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"parent_id": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(input interface{}, key string) (warnings []string, errors []error) {
+					v, ok := input.(string)
+					if !ok {
+						errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+						return
+					}
+
+					if _, err := managedidentity.ParseUserAssignedIdentitiesID(v); err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			"audience": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"issuer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"subject": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceArmFederatedIdentityCredentialCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Federated Identity Credential creation.")
+
+	parentId, err := managedidentity.ParseUserAssignedIdentitiesID(d.Get("parent_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup := d.Get("resource_group_name").(string); resourceGroup != parentId.ResourceGroup {
+		return fmt.Errorf("`resource_group_name` (%q) must match the Resource Group of the `parent_id` (%q)", resourceGroup, parentId.ResourceGroup)
+	}
+
+	id := federatedidentitycredentials.NewFederatedIdentityCredentialID(parentId.SubscriptionId, parentId.ResourceGroup, parentId.UserAssignedIdentityName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_federated_identity_credential", id.ID())
+	}
+
+	payload := federatedidentitycredentials.FederatedIdentityCredential{
+		Properties: expandFederatedIdentityCredentialProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceArmFederatedIdentityCredentialRead(d, meta)
+}
+
+func resourceArmFederatedIdentityCredentialUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := federatedidentitycredentials.FederatedIdentityCredential{
+		Properties: expandFederatedIdentityCredentialProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceArmFederatedIdentityCredentialRead(d, meta)
+}
+
+func resourceArmFederatedIdentityCredentialRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.FederatedIdentityCredentialName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("parent_id", managedidentity.NewUserAssignedIdentitiesID(id.SubscriptionId, id.ResourceGroup, id.UserAssignedIdentityName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("audience", utils.FlattenStringSlice(&props.Audiences))
+			d.Set("issuer", props.Issuer)
+			d.Set("subject", props.Subject)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFederatedIdentityCredentialDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFederatedIdentityCredentialProperties(d *pluginsdk.ResourceData) *federatedidentitycredentials.FederatedIdentityCredentialProperties {
+	return &federatedidentitycredentials.FederatedIdentityCredentialProperties{
+		Audiences: *utils.ExpandStringSlice(d.Get("audience").([]interface{})),
+		Issuer:    d.Get("issuer").(string),
+		Subject:   d.Get("subject").(string),
+	}
+}
@@ -0,0 +1,127 @@
+package federatedidentitycredentials
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FederatedIdentityCredentialId struct {
+	SubscriptionId                  string
+	ResourceGroup                   string
+	UserAssignedIdentityName        string
+	FederatedIdentityCredentialName string
+}
+
+func NewFederatedIdentityCredentialID(subscriptionId, resourceGroup, userAssignedIdentityName, federatedIdentityCredentialName string) FederatedIdentityCredentialId {
+	return FederatedIdentityCredentialId{
+		SubscriptionId:                  subscriptionId,
+		ResourceGroup:                   resourceGroup,
+		UserAssignedIdentityName:        userAssignedIdentityName,
+		FederatedIdentityCredentialName: federatedIdentityCredentialName,
+	}
+}
+
+func (id FederatedIdentityCredentialId) String() string {
+	segments := []string{
+		fmt.Sprintf("Federated Identity Credential Name %q", id.FederatedIdentityCredentialName),
+		fmt.Sprintf("User Assigned Identity Name %q", id.UserAssignedIdentityName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Federated Identity Credential", segmentsStr)
+}
+
+func (id FederatedIdentityCredentialId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s/federatedIdentityCredentials/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.UserAssignedIdentityName, id.FederatedIdentityCredentialName)
+}
+
+// ParseFederatedIdentityCredentialID parses a FederatedIdentityCredential ID into an FederatedIdentityCredentialId struct
+func ParseFederatedIdentityCredentialID(input string) (*FederatedIdentityCredentialId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FederatedIdentityCredentialId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.UserAssignedIdentityName, err = id.PopSegment("userAssignedIdentities"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.FederatedIdentityCredentialName, err = id.PopSegment("federatedIdentityCredentials"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseFederatedIdentityCredentialIDInsensitively parses an FederatedIdentityCredential ID into an FederatedIdentityCredentialId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseFederatedIdentityCredentialID method should be used instead for validation etc.
+func ParseFederatedIdentityCredentialIDInsensitively(input string) (*FederatedIdentityCredentialId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FederatedIdentityCredentialId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'userAssignedIdentities' segment
+	userAssignedIdentitiesKey := "userAssignedIdentities"
+	for key := range id.Path {
+		if strings.EqualFold(key, userAssignedIdentitiesKey) {
+			userAssignedIdentitiesKey = key
+			break
+		}
+	}
+	if resourceId.UserAssignedIdentityName, err = id.PopSegment(userAssignedIdentitiesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'federatedIdentityCredentials' segment
+	federatedIdentityCredentialsKey := "federatedIdentityCredentials"
+	for key := range id.Path {
+		if strings.EqualFold(key, federatedIdentityCredentialsKey) {
+			federatedIdentityCredentialsKey = key
+			break
+		}
+	}
+	if resourceId.FederatedIdentityCredentialName, err = id.PopSegment(federatedIdentityCredentialsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,262 @@
+package federatedidentitycredentials
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = FederatedIdentityCredentialId{}
+
+func TestFederatedIdentityCredentialIDFormatter(t *testing.T) {
+	actual := NewFederatedIdentityCredentialID("{subscriptionId}", "{resourceGroupName}", "{userAssignedIdentityName}", "{federatedIdentityCredentialName}").ID()
+	expected := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{userAssignedIdentityName}/federatedIdentityCredentials/{federatedIdentityCredentialName}"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseFederatedIdentityCredentialID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FederatedIdentityCredentialId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing UserAssignedIdentityName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for UserAssignedIdentityName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/",
+			Error: true,
+		},
+
+		{
+			// missing FederatedIdentityCredentialName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{userAssignedIdentityName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for FederatedIdentityCredentialName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{userAssignedIdentityName}/federatedIdentityCredentials/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{userAssignedIdentityName}/federatedIdentityCredentials/{federatedIdentityCredentialName}",
+			Expected: &FederatedIdentityCredentialId{
+				SubscriptionId:                  "{subscriptionId}",
+				ResourceGroup:                   "{resourceGroupName}",
+				UserAssignedIdentityName:        "{userAssignedIdentityName}",
+				FederatedIdentityCredentialName: "{federatedIdentityCredentialName}",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/{SUBSCRIPTIONID}/RESOURCEGROUPS/{RESOURCEGROUPNAME}/PROVIDERS/MICROSOFT.MANAGEDIDENTITY/USERASSIGNEDIDENTITIES/{USERASSIGNEDIDENTITYNAME}/FEDERATEDIDENTITYCREDENTIALS/{FEDERATEDIDENTITYCREDENTIALNAME}",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseFederatedIdentityCredentialID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.UserAssignedIdentityName != v.Expected.UserAssignedIdentityName {
+			t.Fatalf("Expected %q but got %q for UserAssignedIdentityName", v.Expected.UserAssignedIdentityName, actual.UserAssignedIdentityName)
+		}
+		if actual.FederatedIdentityCredentialName != v.Expected.FederatedIdentityCredentialName {
+			t.Fatalf("Expected %q but got %q for FederatedIdentityCredentialName", v.Expected.FederatedIdentityCredentialName, actual.FederatedIdentityCredentialName)
+		}
+	}
+}
+
+func TestParseFederatedIdentityCredentialIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FederatedIdentityCredentialId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing UserAssignedIdentityName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for UserAssignedIdentityName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/",
+			Error: true,
+		},
+
+		{
+			// missing FederatedIdentityCredentialName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{userAssignedIdentityName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for FederatedIdentityCredentialName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{userAssignedIdentityName}/federatedIdentityCredentials/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{userAssignedIdentityName}/federatedIdentityCredentials/{federatedIdentityCredentialName}",
+			Expected: &FederatedIdentityCredentialId{
+				SubscriptionId:                  "{subscriptionId}",
+				ResourceGroup:                   "{resourceGroupName}",
+				UserAssignedIdentityName:        "{userAssignedIdentityName}",
+				FederatedIdentityCredentialName: "{federatedIdentityCredentialName}",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userassignedidentities/{userAssignedIdentityName}/federatedidentitycredentials/{federatedIdentityCredentialName}",
+			Expected: &FederatedIdentityCredentialId{
+				SubscriptionId:                  "{subscriptionId}",
+				ResourceGroup:                   "{resourceGroupName}",
+				UserAssignedIdentityName:        "{userAssignedIdentityName}",
+				FederatedIdentityCredentialName: "{federatedIdentityCredentialName}",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/USERASSIGNEDIDENTITIES/{userAssignedIdentityName}/FEDERATEDIDENTITYCREDENTIALS/{federatedIdentityCredentialName}",
+			Expected: &FederatedIdentityCredentialId{
+				SubscriptionId:                  "{subscriptionId}",
+				ResourceGroup:                   "{resourceGroupName}",
+				UserAssignedIdentityName:        "{userAssignedIdentityName}",
+				FederatedIdentityCredentialName: "{federatedIdentityCredentialName}",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/uSeRaSsIgNeDiDeNtItIeS/{userAssignedIdentityName}/fEdErAtEdIdEnTiTyCrEdEnTiAlS/{federatedIdentityCredentialName}",
+			Expected: &FederatedIdentityCredentialId{
+				SubscriptionId:                  "{subscriptionId}",
+				ResourceGroup:                   "{resourceGroupName}",
+				UserAssignedIdentityName:        "{userAssignedIdentityName}",
+				FederatedIdentityCredentialName: "{federatedIdentityCredentialName}",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseFederatedIdentityCredentialIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.UserAssignedIdentityName != v.Expected.UserAssignedIdentityName {
+			t.Fatalf("Expected %q but got %q for UserAssignedIdentityName", v.Expected.UserAssignedIdentityName, actual.UserAssignedIdentityName)
+		}
+		if actual.FederatedIdentityCredentialName != v.Expected.FederatedIdentityCredentialName {
+			t.Fatalf("Expected %q but got %q for FederatedIdentityCredentialName", v.Expected.FederatedIdentityCredentialName, actual.FederatedIdentityCredentialName)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package federatedidentitycredentials
+
+type FederatedIdentityCredentialProperties struct {
+	Audiences []string `json:"audiences"`
+	Issuer    string   `json:"issuer"`
+	Subject   string   `json:"subject"`
+}
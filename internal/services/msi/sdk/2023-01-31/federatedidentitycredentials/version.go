@@ -0,0 +1,9 @@
+package federatedidentitycredentials
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-31"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/federatedidentitycredentials/%s", defaultApiVersion)
+}
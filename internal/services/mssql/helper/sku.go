@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"regexp"
 	"strings"
 )
 
@@ -24,3 +25,50 @@ func CompareDatabaseSkuServiceTiers(sku1, sku2 string) bool {
 
 	return index1 > 0 && index2 > 0 && index1 > index2
 }
+
+// IsServerlessDatabaseSku returns true if the SKU is a vCore serverless SKU, e.g. "GP_S_Gen5_2"
+func IsServerlessDatabaseSku(skuName string) bool {
+	return strings.Contains(strings.ToUpper(skuName), "_S_")
+}
+
+// zoneRedundantSkuTiers are the service tiers which support zone redundancy
+var zoneRedundantSkuTiers = []string{
+	"BC",
+	"GP",
+	"HS",
+	"PREMIUM",
+}
+
+// premiumDtuSkuRegex matches the Premium (DTU) Database SKUs, e.g. "P1" or "P15"
+var premiumDtuSkuRegex = regexp.MustCompile(`^P[0-9]+$`)
+
+// SkuSupportsZoneRedundancy returns true if the service tier of the SKU supports zone redundancy. The Basic and
+// Standard (DTU) tiers and Data Warehouse SKUs are unsupported; this works for both Database and Elastic Pool SKUs.
+func SkuSupportsZoneRedundancy(skuName string) bool {
+	tier := skuServiceTier(skuName)
+	for _, v := range zoneRedundantSkuTiers {
+		if tier == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// skuServiceTier returns the upper-cased service tier of a Database or Elastic Pool SKU, e.g. "GP" for "GP_Gen5_2"
+// or "PREMIUM" for both "P1" and "PremiumPool"
+func skuServiceTier(skuName string) string {
+	name := strings.ToUpper(skuName)
+
+	// vCore SKUs are named `{tier}_{family}_{capacity}` for Databases and `{tier}_{family}` for Elastic Pools
+	if strings.Contains(name, "_") {
+		return strings.SplitN(name, "_", 2)[0]
+	}
+
+	if premiumDtuSkuRegex.MatchString(name) {
+		return "PREMIUM"
+	}
+
+	// DTU Elastic Pool SKUs are named `{tier}Pool`
+	return strings.TrimSuffix(name, "POOL")
+}
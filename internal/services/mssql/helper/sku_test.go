@@ -0,0 +1,45 @@
+package helper
+
+import "testing"
+
+func TestIsServerlessDatabaseSku(t *testing.T) {
+	cases := map[string]bool{
+		"GP_S_Gen5_2":  true,
+		"gp_s_gen5_40": true,
+		"GP_Gen5_2":    false,
+		"BC_Gen5_2":    false,
+		"HS_Gen5_2":    false,
+		"S0":           false,
+		"Basic":        false,
+	}
+
+	for input, expected := range cases {
+		if actual := IsServerlessDatabaseSku(input); actual != expected {
+			t.Fatalf("expected IsServerlessDatabaseSku(%q) to be %t but got %t", input, expected, actual)
+		}
+	}
+}
+
+func TestSkuSupportsZoneRedundancy(t *testing.T) {
+	cases := map[string]bool{
+		"GP_S_Gen5_2":  true,
+		"GP_Gen5_2":    true,
+		"BC_Gen5_2":    true,
+		"HS_Gen5_2":    true,
+		"P1":           true,
+		"PremiumPool":  true,
+		"Basic":        false,
+		"BasicPool":    false,
+		"S0":           false,
+		"StandardPool": false,
+		"DW100c":       false,
+		"PRS1":         false,
+		"ElasticPool":  false,
+	}
+
+	for input, expected := range cases {
+		if actual := SkuSupportsZoneRedundancy(input); actual != expected {
+			t.Fatalf("expected SkuSupportsZoneRedundancy(%q) to be %t but got %t", input, expected, actual)
+		}
+	}
+}
@@ -35,6 +35,11 @@ func dataSourceMsSqlDatabase() *pluginsdk.Resource {
 				ValidateFunc: validate.ServerID,
 			},
 
+			"auto_pause_delay_in_minutes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"collation": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -55,6 +60,16 @@ func dataSourceMsSqlDatabase() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"ledger_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"min_capacity": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+
 			"read_replica_count": {
 				Type:     pluginsdk.TypeInt,
 				Computed: true,
@@ -111,12 +126,15 @@ func dataSourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) er
 	d.Set("server_id", mssqlServerId)
 
 	if props := resp.DatabaseProperties; props != nil {
+		d.Set("auto_pause_delay_in_minutes", props.AutoPauseDelay)
 		d.Set("collation", props.Collation)
 		d.Set("elastic_pool_id", props.ElasticPoolID)
 		d.Set("license_type", props.LicenseType)
+		d.Set("ledger_enabled", props.IsLedgerOn)
 		if props.MaxSizeBytes != nil {
 			d.Set("max_size_gb", int32((*props.MaxSizeBytes)/int64(1073741824)))
 		}
+		d.Set("min_capacity", props.MinCapacity)
 		d.Set("read_replica_count", props.HighAvailabilityReplicaCount)
 		if props.ReadScale == sql.DatabaseReadScaleEnabled {
 			d.Set("read_scale", true)
@@ -44,6 +44,21 @@ func TestAccDataSourceMsSqlDatabase_complete(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceMsSqlDatabase_serverless(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_database", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: MsSqlDatabaseDataSource{}.serverless(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("auto_pause_delay_in_minutes").HasValue("70"),
+				check.That(data.ResourceName).Key("min_capacity").HasValue("0.75"),
+				check.That(data.ResourceName).Key("sku_name").HasValue("GP_S_Gen5_2"),
+			),
+		},
+	})
+}
+
 func (MsSqlDatabaseDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -67,3 +82,14 @@ data "azurerm_mssql_database" "test" {
 
 `, MsSqlDatabaseResource{}.complete(data))
 }
+
+func (MsSqlDatabaseDataSource) serverless(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_mssql_database" "test" {
+  name      = azurerm_mssql_database.test.name
+  server_id = azurerm_mssql_database.test.server_id
+}
+`, MsSqlDatabaseResource{}.gpServerless(data))
+}
@@ -305,6 +305,13 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"ledger_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 
@@ -313,6 +320,29 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				// "hyperscale can not change to other sku
 				return strings.HasPrefix(old.(string), "HS") && !strings.HasPrefix(new.(string), "HS")
 			}),
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				skuName := diff.Get("sku_name").(string)
+				if skuName == "" {
+					return nil
+				}
+
+				if !helper.IsServerlessDatabaseSku(skuName) {
+					if diff.HasChange("auto_pause_delay_in_minutes") && diff.Get("auto_pause_delay_in_minutes").(int) != 0 {
+						return fmt.Errorf("`auto_pause_delay_in_minutes` can only be specified for a serverless SKU, got %q", skuName)
+					}
+					if diff.HasChange("min_capacity") && diff.Get("min_capacity").(float64) != 0 {
+						return fmt.Errorf("`min_capacity` can only be specified for a serverless SKU, got %q", skuName)
+					}
+				}
+
+				// databases within an Elastic Pool inherit the zone redundancy of the pool, which is validated by the pool
+				inElasticPool := diff.Get("elastic_pool_id").(string) != "" || strings.EqualFold(skuName, "ElasticPool")
+				if !inElasticPool && diff.Get("zone_redundant").(bool) && !helper.SkuSupportsZoneRedundancy(skuName) {
+					return fmt.Errorf("`zone_redundant` isn't supported for the SKU %q", skuName)
+				}
+
+				return nil
+			}),
 		),
 	}
 }
@@ -470,11 +500,9 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		Name:     &name,
 		Location: &location,
 		DatabaseProperties: &sql.DatabaseProperties{
-			AutoPauseDelay:                   utils.Int32(int32(d.Get("auto_pause_delay_in_minutes").(int))),
 			Collation:                        utils.String(d.Get("collation").(string)),
 			ElasticPoolID:                    utils.String(d.Get("elastic_pool_id").(string)),
 			LicenseType:                      sql.DatabaseLicenseType(d.Get("license_type").(string)),
-			MinCapacity:                      utils.Float(d.Get("min_capacity").(float64)),
 			HighAvailabilityReplicaCount:     utils.Int32(int32(d.Get("read_replica_count").(int))),
 			SampleName:                       sql.SampleName(d.Get("sample_name").(string)),
 			RequestedBackupStorageRedundancy: expandMsSqlBackupStorageRedundancy(d.Get("storage_account_type").(string)),
@@ -519,6 +547,11 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	}
 	params.DatabaseProperties.ReadScale = readScale
 
+	// ledger can only be enabled when the database is created, and is inherited by any secondaries
+	if d.Get("ledger_enabled").(bool) {
+		params.DatabaseProperties.IsLedgerOn = utils.Bool(true)
+	}
+
 	if v, ok := d.GetOk("restore_point_in_time"); ok {
 		if cm, ok := d.GetOk("create_mode"); ok && cm.(string) != string(sql.CreateModePointInTimeRestore) {
 			return fmt.Errorf("'restore_point_in_time' is supported only for create_mode %s", string(sql.CreateModePointInTimeRestore))
@@ -537,6 +570,12 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	// the auto-pause delay and minimum capacity are only applicable to serverless databases
+	if helper.IsServerlessDatabaseSku(d.Get("sku_name").(string)) {
+		params.DatabaseProperties.AutoPauseDelay = utils.Int32(int32(d.Get("auto_pause_delay_in_minutes").(int)))
+		params.DatabaseProperties.MinCapacity = utils.Float(d.Get("min_capacity").(float64))
+	}
+
 	if v, ok := d.GetOk("creation_source_database_id"); ok {
 		params.DatabaseProperties.SourceDatabaseID = utils.String(v.(string))
 	}
@@ -680,6 +719,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		d.Set("collation", props.Collation)
 		d.Set("elastic_pool_id", props.ElasticPoolID)
 		d.Set("license_type", props.LicenseType)
+		d.Set("ledger_enabled", props.IsLedgerOn)
 		if props.MaxSizeBytes != nil {
 			d.Set("max_size_gb", int32((*props.MaxSizeBytes)/int64(1073741824)))
 		}
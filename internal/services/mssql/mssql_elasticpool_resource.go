@@ -178,6 +178,10 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 				return err
 			}
 
+			if skuName := diff.Get("sku.0.name").(string); diff.Get("zone_redundant").(bool) && !helper.SkuSupportsZoneRedundancy(skuName) {
+				return fmt.Errorf("`zone_redundant` isn't supported for the SKU %q", skuName)
+			}
+
 			return nil
 		}),
 	}
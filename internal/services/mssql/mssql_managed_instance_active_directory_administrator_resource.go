@@ -0,0 +1,274 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorModel struct {
+	ManagedInstanceId         string `tfschema:"managed_instance_id"`
+	LoginUsername             string `tfschema:"login_username"`
+	ObjectId                  string `tfschema:"object_id"`
+	TenantId                  string `tfschema:"tenant_id"`
+	AzureADAuthenticationOnly bool   `tfschema:"azuread_authentication_only"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorResource struct{}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_active_directory_administrator"
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceActiveDirectoryAdministratorModel{}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceAzureActiveDirectoryAdministratorID
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"login_username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"azuread_authentication_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+
+			var model MsSqlManagedInstanceActiveDirectoryAdministratorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewManagedInstanceAzureActiveDirectoryAdministratorID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, string(sql.AdministratorTypeActiveDirectory))
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if existing.ID != nil && *existing.ID != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := r.createOrUpdate(ctx, metadata, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceActiveDirectoryAdministratorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := r.createOrUpdate(ctx, metadata, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MsSqlManagedInstanceActiveDirectoryAdministratorModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			if props := existing.ManagedInstanceAdministratorProperties; props != nil {
+				model.LoginUsername = utils.NormalizeNilableString(props.Login)
+				if props.Sid != nil {
+					model.ObjectId = props.Sid.String()
+				}
+				if props.TenantID != nil {
+					model.TenantId = props.TenantID.String()
+				}
+			}
+
+			aadOnly, err := aadOnlyClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(aadOnly.Response) {
+					return fmt.Errorf("retrieving Azure AD Only Authentication for %s: %+v", id, err)
+				}
+			}
+			if props := aadOnly.ManagedInstanceAzureADOnlyAuthProperties; props != nil && props.AzureADOnlyAuthentication != nil {
+				model.AzureADAuthenticationOnly = *props.AzureADOnlyAuthentication
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+			aadOnlyClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+			id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// Azure AD Only Authentication has to be disabled before the administrator can be removed
+			aadOnlyFuture, err := aadOnlyClient.Delete(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				return fmt.Errorf("disabling Azure AD Only Authentication for %s: %+v", id, err)
+			}
+			if err = aadOnlyFuture.WaitForCompletionRef(ctx, aadOnlyClient.Client); err != nil {
+				return fmt.Errorf("waiting for Azure AD Only Authentication to be disabled for %s: %+v", id, err)
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedInstanceAzureActiveDirectoryAdministratorId, model MsSqlManagedInstanceActiveDirectoryAdministratorModel) error {
+	client := metadata.Client.MSSQL.ManagedInstanceAdministratorsClient
+	aadOnlyClient := metadata.Client.MSSQL.ManagedInstanceAzureADOnlyAuthenticationsClient
+
+	sid, err := uuid.FromString(model.ObjectId)
+	if err != nil {
+		return fmt.Errorf("parsing `object_id`: %+v", err)
+	}
+
+	tenantId, err := uuid.FromString(model.TenantId)
+	if err != nil {
+		return fmt.Errorf("parsing `tenant_id`: %+v", err)
+	}
+
+	parameters := sql.ManagedInstanceAdministrator{
+		ManagedInstanceAdministratorProperties: &sql.ManagedInstanceAdministratorProperties{
+			AdministratorType: utils.String(string(sql.AdministratorTypeActiveDirectory)),
+			Login:             utils.String(model.LoginUsername),
+			Sid:               &sid,
+			TenantID:          &tenantId,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, parameters)
+	if err != nil {
+		return err
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	aadOnlyParameters := sql.ManagedInstanceAzureADOnlyAuthentication{
+		ManagedInstanceAzureADOnlyAuthProperties: &sql.ManagedInstanceAzureADOnlyAuthProperties{
+			AzureADOnlyAuthentication: utils.Bool(model.AzureADAuthenticationOnly),
+		},
+	}
+
+	aadOnlyFuture, err := aadOnlyClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, aadOnlyParameters)
+	if err != nil {
+		return fmt.Errorf("setting Azure AD Only Authentication: %+v", err)
+	}
+	if err = aadOnlyFuture.WaitForCompletionRef(ctx, aadOnlyClient.Client); err != nil {
+		return fmt.Errorf("waiting for Azure AD Only Authentication to be set: %+v", err)
+	}
+
+	return nil
+}
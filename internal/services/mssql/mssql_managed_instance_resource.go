@@ -0,0 +1,572 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceModel struct {
+	Name                         string                              `tfschema:"name"`
+	ResourceGroupName            string                              `tfschema:"resource_group_name"`
+	Location                     string                              `tfschema:"location"`
+	SkuName                      string                              `tfschema:"sku_name"`
+	AdministratorLogin           string                              `tfschema:"administrator_login"`
+	AdministratorLoginPassword   string                              `tfschema:"administrator_login_password"`
+	VCores                       int32                               `tfschema:"vcores"`
+	StorageSizeInGb              int32                               `tfschema:"storage_size_in_gb"`
+	LicenseType                  string                              `tfschema:"license_type"`
+	SubnetId                     string                              `tfschema:"subnet_id"`
+	Collation                    string                              `tfschema:"collation"`
+	DnsZonePartnerId             string                              `tfschema:"dns_zone_partner_id"`
+	Identity                     []MsSqlManagedInstanceIdentityModel `tfschema:"identity"`
+	MaintenanceConfigurationName string                              `tfschema:"maintenance_configuration_name"`
+	MinimumTlsVersion            string                              `tfschema:"minimum_tls_version"`
+	ProxyOverride                string                              `tfschema:"proxy_override"`
+	PublicDataEndpointEnabled    bool                                `tfschema:"public_data_endpoint_enabled"`
+	StorageAccountType           string                              `tfschema:"storage_account_type"`
+	TimezoneId                   string                              `tfschema:"timezone_id"`
+	ZoneRedundantEnabled         bool                                `tfschema:"zone_redundant_enabled"`
+	Tags                         map[string]string                   `tfschema:"tags"`
+
+	DnsZone string `tfschema:"dns_zone"`
+	Fqdn    string `tfschema:"fqdn"`
+}
+
+type MsSqlManagedInstanceIdentityModel struct {
+	Type        string `tfschema:"type"`
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceResource{}
+
+type MsSqlManagedInstanceResource struct{}
+
+func (r MsSqlManagedInstanceResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance"
+}
+
+func (r MsSqlManagedInstanceResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceModel{}
+}
+
+func (r MsSqlManagedInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceID
+}
+
+func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ValidateMsSqlServerName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": azure.SchemaLocation(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"GP_Gen4",
+				"GP_Gen5",
+				"GP_Gen8IM",
+				"GP_Gen8IH",
+				"BC_Gen4",
+				"BC_Gen5",
+				"BC_Gen8IM",
+				"BC_Gen8IH",
+			}, false),
+		},
+
+		"administrator_login": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"administrator_login_password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"vcores": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				4,
+				8,
+				16,
+				24,
+				32,
+				40,
+				48,
+				56,
+				64,
+				80,
+				96,
+				128,
+			}),
+		},
+
+		"storage_size_in_gb": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(32, 16384),
+		},
+
+		"license_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(sql.ManagedInstanceLicenseTypeBasePrice),
+				string(sql.ManagedInstanceLicenseTypeLicenseIncluded),
+			}, false),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"collation": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "SQL_Latin1_General_CP1_CI_AS",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_zone_partner_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(sql.IdentityTypeSystemAssigned),
+						}, false),
+					},
+
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"maintenance_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "SQL_Default",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"minimum_tls_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "1.2",
+			ValidateFunc: validation.StringInSlice([]string{
+				"1.0",
+				"1.1",
+				"1.2",
+			}, false),
+		},
+
+		"proxy_override": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(sql.ManagedInstanceProxyOverrideDefault),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(sql.ManagedInstanceProxyOverrideDefault),
+				string(sql.ManagedInstanceProxyOverrideProxy),
+				string(sql.ManagedInstanceProxyOverrideRedirect),
+			}, false),
+		},
+
+		"public_data_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"storage_account_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(sql.StorageAccountTypeGRS),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(sql.StorageAccountTypeGRS),
+				string(sql.StorageAccountTypeLRS),
+				string(sql.StorageAccountTypeZRS),
+			}, false),
+		},
+
+		"timezone_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "UTC",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"zone_redundant_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// provisioning a new Managed Instance within a Subnet can take several hours
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MsSqlManagedInstanceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewManagedInstanceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if existing.ID != nil && *existing.ID != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sku, err := r.expandSkuName(model.SkuName)
+			if err != nil {
+				return err
+			}
+
+			parameters := sql.ManagedInstance{
+				Location: utils.String(azure.NormalizeLocation(model.Location)),
+				Identity: r.expandIdentity(model.Identity),
+				Sku:      sku,
+				ManagedInstanceProperties: &sql.ManagedInstanceProperties{
+					AdministratorLogin:         utils.String(model.AdministratorLogin),
+					AdministratorLoginPassword: utils.String(model.AdministratorLoginPassword),
+					Collation:                  utils.String(model.Collation),
+					LicenseType:                sql.ManagedInstanceLicenseType(model.LicenseType),
+					MaintenanceConfigurationID: utils.String(r.maintenanceConfigurationId(subscriptionId, model.MaintenanceConfigurationName)),
+					MinimalTLSVersion:          utils.String(model.MinimumTlsVersion),
+					ProxyOverride:              sql.ManagedInstanceProxyOverride(model.ProxyOverride),
+					PublicDataEndpointEnabled:  utils.Bool(model.PublicDataEndpointEnabled),
+					StorageAccountType:         sql.StorageAccountType(model.StorageAccountType),
+					StorageSizeInGB:            utils.Int32(model.StorageSizeInGb),
+					SubnetID:                   utils.String(model.SubnetId),
+					TimezoneID:                 utils.String(model.TimezoneId),
+					VCores:                     utils.Int32(model.VCores),
+					ZoneRedundant:              utils.Bool(model.ZoneRedundantEnabled),
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+
+			if model.DnsZonePartnerId != "" {
+				parameters.ManagedInstanceProperties.DNSZonePartner = utils.String(model.DnsZonePartnerId)
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := parse.ManagedInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sku, err := r.expandSkuName(model.SkuName)
+			if err != nil {
+				return err
+			}
+
+			parameters := sql.ManagedInstanceUpdate{
+				Identity: r.expandIdentity(model.Identity),
+				Sku:      sku,
+				ManagedInstanceProperties: &sql.ManagedInstanceProperties{
+					LicenseType:                sql.ManagedInstanceLicenseType(model.LicenseType),
+					MaintenanceConfigurationID: utils.String(r.maintenanceConfigurationId(subscriptionId, model.MaintenanceConfigurationName)),
+					MinimalTLSVersion:          utils.String(model.MinimumTlsVersion),
+					ProxyOverride:              sql.ManagedInstanceProxyOverride(model.ProxyOverride),
+					PublicDataEndpointEnabled:  utils.Bool(model.PublicDataEndpointEnabled),
+					StorageSizeInGB:            utils.Int32(model.StorageSizeInGb),
+					VCores:                     utils.Int32(model.VCores),
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+
+			if metadata.ResourceData.HasChange("administrator_login_password") {
+				parameters.ManagedInstanceProperties.AdministratorLoginPassword = utils.String(model.AdministratorLoginPassword)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+
+			id, err := parse.ManagedInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the password isn't returned by the API, so pull it from the existing state
+			var state MsSqlManagedInstanceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := MsSqlManagedInstanceModel{
+				Name:                       id.Name,
+				ResourceGroupName:          id.ResourceGroup,
+				Location:                   location.NormalizeNilable(existing.Location),
+				AdministratorLoginPassword: state.AdministratorLoginPassword,
+				Identity:                   r.flattenIdentity(existing.Identity),
+				Tags:                       tags.ToTypedObject(existing.Tags),
+			}
+
+			if sku := existing.Sku; sku != nil && sku.Name != nil {
+				model.SkuName = *sku.Name
+			}
+
+			if props := existing.ManagedInstanceProperties; props != nil {
+				model.AdministratorLogin = utils.NormalizeNilableString(props.AdministratorLogin)
+				model.Collation = utils.NormalizeNilableString(props.Collation)
+				model.DnsZone = utils.NormalizeNilableString(props.DNSZone)
+				model.Fqdn = utils.NormalizeNilableString(props.FullyQualifiedDomainName)
+				model.LicenseType = string(props.LicenseType)
+				model.MinimumTlsVersion = utils.NormalizeNilableString(props.MinimalTLSVersion)
+				model.ProxyOverride = string(props.ProxyOverride)
+				model.StorageAccountType = string(props.StorageAccountType)
+				model.TimezoneId = utils.NormalizeNilableString(props.TimezoneID)
+
+				// the DNS Zone Partner isn't returned by the API, so pull it from the existing state
+				model.DnsZonePartnerId = state.DnsZonePartnerId
+
+				if props.MaintenanceConfigurationID != nil {
+					maintenanceConfigurationId := *props.MaintenanceConfigurationID
+					model.MaintenanceConfigurationName = maintenanceConfigurationId[strings.LastIndex(maintenanceConfigurationId, "/")+1:]
+				}
+				if props.PublicDataEndpointEnabled != nil {
+					model.PublicDataEndpointEnabled = *props.PublicDataEndpointEnabled
+				}
+				if props.StorageSizeInGB != nil {
+					model.StorageSizeInGb = *props.StorageSizeInGB
+				}
+				if props.SubnetID != nil {
+					subnetId, err := networkParse.SubnetIDInsensitively(*props.SubnetID)
+					if err != nil {
+						return err
+					}
+					model.SubnetId = subnetId.ID()
+				}
+				if props.VCores != nil {
+					model.VCores = *props.VCores
+				}
+				if props.ZoneRedundant != nil {
+					model.ZoneRedundantEnabled = *props.ZoneRedundant
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstancesClient
+
+			id, err := parse.ManagedInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceResource) expandSkuName(skuName string) (*sql.Sku, error) {
+	parts := strings.Split(skuName, "_")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("sku_name (%s) has the wrong number of parts (%d) after splitting on _", skuName, len(parts))
+	}
+
+	var tier string
+	switch parts[0] {
+	case "GP":
+		tier = "GeneralPurpose"
+	case "BC":
+		tier = "BusinessCritical"
+	default:
+		return nil, fmt.Errorf("sku_name %s has unknown sku tier %s", skuName, parts[0])
+	}
+
+	return &sql.Sku{
+		Name:   utils.String(skuName),
+		Tier:   utils.String(tier),
+		Family: utils.String(parts[1]),
+	}, nil
+}
+
+func (r MsSqlManagedInstanceResource) maintenanceConfigurationId(subscriptionId, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Maintenance/publicMaintenanceConfigurations/%s", subscriptionId, name)
+}
+
+func (r MsSqlManagedInstanceResource) expandIdentity(input []MsSqlManagedInstanceIdentityModel) *sql.ResourceIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &sql.ResourceIdentity{
+		Type: sql.IdentityType(input[0].Type),
+	}
+}
+
+func (r MsSqlManagedInstanceResource) flattenIdentity(input *sql.ResourceIdentity) []MsSqlManagedInstanceIdentityModel {
+	if input == nil || input.Type == "" || input.Type == sql.IdentityTypeNone {
+		return []MsSqlManagedInstanceIdentityModel{}
+	}
+
+	model := MsSqlManagedInstanceIdentityModel{
+		Type: string(input.Type),
+	}
+	if input.PrincipalID != nil {
+		model.PrincipalId = input.PrincipalID.String()
+	}
+	if input.TenantID != nil {
+		model.TenantId = input.TenantID.String()
+	}
+
+	return []MsSqlManagedInstanceIdentityModel{model}
+}
@@ -0,0 +1,286 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceVulnerabilityAssessmentModel struct {
+	ManagedInstanceId       string                `tfschema:"managed_instance_id"`
+	StorageContainerPath    string                `tfschema:"storage_container_path"`
+	StorageAccountAccessKey string                `tfschema:"storage_account_access_key"`
+	StorageContainerSasKey  string                `tfschema:"storage_container_sas_key"`
+	RecurringScans          []RecurringScansModel `tfschema:"recurring_scans"`
+}
+
+type RecurringScansModel struct {
+	Enabled                 bool     `tfschema:"enabled"`
+	EmailSubscriptionAdmins bool     `tfschema:"email_subscription_admins"`
+	Emails                  []string `tfschema:"emails"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceVulnerabilityAssessmentResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceVulnerabilityAssessmentResource{}
+
+type MsSqlManagedInstanceVulnerabilityAssessmentResource struct{}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_vulnerability_assessment"
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceVulnerabilityAssessmentModel{}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceVulnerabilityAssessmentID
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"storage_container_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_account_access_key": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"storage_container_sas_key"},
+		},
+
+		"storage_container_sas_key": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"storage_account_access_key"},
+		},
+
+		"recurring_scans": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"email_subscription_admins": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"emails": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			var model MsSqlManagedInstanceVulnerabilityAssessmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewManagedInstanceVulnerabilityAssessmentID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, "default")
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			// the Vulnerability Assessment always exists, so check whether it's been configured
+			if props := existing.ManagedInstanceVulnerabilityAssessmentProperties; props != nil && props.StorageContainerPath != nil && *props.StorageContainerPath != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, r.expand(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			id, err := parse.ManagedInstanceVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceVulnerabilityAssessmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, r.expand(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			id, err := parse.ManagedInstanceVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the access keys aren't returned by the API, so pull them from the existing state
+			var state MsSqlManagedInstanceVulnerabilityAssessmentModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := MsSqlManagedInstanceVulnerabilityAssessmentModel{
+				ManagedInstanceId:       parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+				StorageAccountAccessKey: state.StorageAccountAccessKey,
+				StorageContainerSasKey:  state.StorageContainerSasKey,
+			}
+
+			if props := existing.ManagedInstanceVulnerabilityAssessmentProperties; props != nil {
+				model.StorageContainerPath = utils.NormalizeNilableString(props.StorageContainerPath)
+
+				if scans := props.RecurringScans; scans != nil {
+					recurringScans := RecurringScansModel{
+						Emails: []string{},
+					}
+					if scans.IsEnabled != nil {
+						recurringScans.Enabled = *scans.IsEnabled
+					}
+					if scans.EmailSubscriptionAdmins != nil {
+						recurringScans.EmailSubscriptionAdmins = *scans.EmailSubscriptionAdmins
+					}
+					if scans.Emails != nil {
+						recurringScans.Emails = *scans.Emails
+					}
+					model.RecurringScans = []RecurringScansModel{recurringScans}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceVulnerabilityAssessmentsClient
+
+			id, err := parse.ManagedInstanceVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.ManagedInstanceName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceVulnerabilityAssessmentResource) expand(model MsSqlManagedInstanceVulnerabilityAssessmentModel) sql.ManagedInstanceVulnerabilityAssessment {
+	props := sql.ManagedInstanceVulnerabilityAssessmentProperties{
+		StorageContainerPath: utils.String(model.StorageContainerPath),
+	}
+
+	if model.StorageAccountAccessKey != "" {
+		props.StorageAccountAccessKey = utils.String(model.StorageAccountAccessKey)
+	}
+
+	if model.StorageContainerSasKey != "" {
+		props.StorageContainerSasKey = utils.String(model.StorageContainerSasKey)
+	}
+
+	if len(model.RecurringScans) > 0 {
+		scans := model.RecurringScans[0]
+		emails := scans.Emails
+		if emails == nil {
+			emails = []string{}
+		}
+		props.RecurringScans = &sql.VulnerabilityAssessmentRecurringScansProperties{
+			IsEnabled:               utils.Bool(scans.Enabled),
+			EmailSubscriptionAdmins: utils.Bool(scans.EmailSubscriptionAdmins),
+			Emails:                  &emails,
+		}
+	}
+
+	return sql.ManagedInstanceVulnerabilityAssessment{
+		ManagedInstanceVulnerabilityAssessmentProperties: &props,
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
@@ -173,6 +174,17 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				},
 			},
 
+			// the key can also be managed using the `azurerm_mssql_server_transparent_data_encryption` resource
+			"transparent_data_encryption_key_vault_key_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: keyVaultValidate.NestedItemId,
+				RequiredWith: []string{
+					"primary_user_assigned_identity_id",
+				},
+			},
+
 			"minimum_tls_version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -268,6 +280,11 @@ func resourceMsSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		props.PrimaryUserAssignedIdentityID = utils.String(primaryUserAssignedIdentityID.(string))
 	}
 
+	// the Key Vault Key is used for TDE from the moment the server is created, using the primary user assigned identity
+	if keyVaultKeyId, ok := d.GetOk("transparent_data_encryption_key_vault_key_id"); ok {
+		props.KeyID = utils.String(keyVaultKeyId.(string))
+	}
+
 	if v := d.Get("public_network_access_enabled"); !v.(bool) {
 		props.ServerProperties.PublicNetworkAccess = sql.ServerNetworkAccessFlagDisabled
 	}
@@ -427,6 +444,7 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 			primaryUserAssignedIdentityID = parsedPrimaryUserAssignedIdentityID.ID()
 		}
 		d.Set("primary_user_assigned_identity_id", primaryUserAssignedIdentityID)
+		d.Set("transparent_data_encryption_key_vault_key_id", props.KeyID)
 		if props.Administrators != nil {
 			d.Set("azuread_administrator", flatternMsSqlServerAdministrators(*props.Administrators))
 		}
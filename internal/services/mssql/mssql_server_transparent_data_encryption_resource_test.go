@@ -30,6 +30,37 @@ func TestAccMsSqlServerTransparentDataEncryption_keyVault(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlServerTransparentDataEncryption_autoRotate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_transparent_data_encryption", "test")
+	r := MsSqlServerTransparentDataEncryptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoRotate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_rotation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.keyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_rotation_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMsSqlServerTransparentDataEncryption_systemManaged(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_server_transparent_data_encryption", "test")
 	r := MsSqlServerTransparentDataEncryptionResource{}
@@ -93,6 +124,31 @@ func (r MsSqlServerTransparentDataEncryptionResource) keyVault(data acceptance.T
 		`
 %s
 
+resource "azurerm_mssql_server_transparent_data_encryption" "test" {
+  server_id        = azurerm_mssql_server.test.id
+  key_vault_key_id = azurerm_key_vault_key.generated.id
+}
+`, r.keyVaultTemplate(data))
+}
+
+func (r MsSqlServerTransparentDataEncryptionResource) autoRotate(data acceptance.TestData) string {
+	return fmt.Sprintf(
+		`
+%s
+
+resource "azurerm_mssql_server_transparent_data_encryption" "test" {
+  server_id             = azurerm_mssql_server.test.id
+  key_vault_key_id      = azurerm_key_vault_key.generated.id
+  auto_rotation_enabled = true
+}
+`, r.keyVaultTemplate(data))
+}
+
+func (r MsSqlServerTransparentDataEncryptionResource) keyVaultTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(
+		`
+%s
+
 resource "azurerm_key_vault" "test" {
 	name                        = "acctestsqlserver%[2]s"
 	location                    = azurerm_resource_group.test.location
@@ -142,11 +198,6 @@ resource "azurerm_key_vault" "test" {
 	  azurerm_key_vault.test,
 	]
   }
-
-  resource "azurerm_mssql_server_transparent_data_encryption" "test" {
-	server_id = azurerm_mssql_server.test.id
-	key_vault_key_id = azurerm_key_vault_key.generated.id
-  }
 `, r.server(data), data.RandomStringOfLength(5))
 }
 
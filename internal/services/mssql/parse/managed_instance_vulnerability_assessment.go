@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ManagedInstanceVulnerabilityAssessmentId struct {
+	SubscriptionId              string
+	ResourceGroup               string
+	ManagedInstanceName         string
+	VulnerabilityAssessmentName string
+}
+
+func NewManagedInstanceVulnerabilityAssessmentID(subscriptionId, resourceGroup, managedInstanceName, vulnerabilityAssessmentName string) ManagedInstanceVulnerabilityAssessmentId {
+	return ManagedInstanceVulnerabilityAssessmentId{
+		SubscriptionId:              subscriptionId,
+		ResourceGroup:               resourceGroup,
+		ManagedInstanceName:         managedInstanceName,
+		VulnerabilityAssessmentName: vulnerabilityAssessmentName,
+	}
+}
+
+func (id ManagedInstanceVulnerabilityAssessmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Vulnerability Assessment Name %q", id.VulnerabilityAssessmentName),
+		fmt.Sprintf("Managed Instance Name %q", id.ManagedInstanceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed Instance Vulnerability Assessment", segmentsStr)
+}
+
+func (id ManagedInstanceVulnerabilityAssessmentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/managedInstances/%s/vulnerabilityAssessments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.VulnerabilityAssessmentName)
+}
+
+// ManagedInstanceVulnerabilityAssessmentID parses a ManagedInstanceVulnerabilityAssessment ID into an ManagedInstanceVulnerabilityAssessmentId struct
+func ManagedInstanceVulnerabilityAssessmentID(input string) (*ManagedInstanceVulnerabilityAssessmentId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagedInstanceVulnerabilityAssessmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedInstanceName, err = id.PopSegment("managedInstances"); err != nil {
+		return nil, err
+	}
+	if resourceId.VulnerabilityAssessmentName, err = id.PopSegment("vulnerabilityAssessments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,8 @@
+package startstopmanagedinstanceschedules
+
+type ScheduleItem struct {
+	StartDay  DayOfWeek `json:"startDay"`
+	StartTime string    `json:"startTime"`
+	StopDay   DayOfWeek `json:"stopDay"`
+	StopTime  string    `json:"stopTime"`
+}
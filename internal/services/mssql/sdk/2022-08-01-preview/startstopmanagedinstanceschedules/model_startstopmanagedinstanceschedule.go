@@ -0,0 +1,8 @@
+package startstopmanagedinstanceschedules
+
+type StartStopManagedInstanceSchedule struct {
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *StartStopManagedInstanceScheduleProperties `json:"properties,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
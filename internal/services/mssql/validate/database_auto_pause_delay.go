@@ -10,7 +10,7 @@ func DatabaseAutoPauseDelay(i interface{}, k string) (warnings []string, errors
 	}
 	min := 60
 	max := 10080
-	if (v < min || v > max) && v%10 != 0 && v != -1 {
+	if v != -1 && (v < min || v > max || v%10 != 0) {
 		errors = append(errors, fmt.Errorf("expected %s to be in the range (%d - %d) and divisible by 10 or -1, got %d", k, min, max, v))
 		return warnings, errors
 	}
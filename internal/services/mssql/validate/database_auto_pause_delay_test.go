@@ -4,23 +4,31 @@ import "testing"
 
 func TestDatabaseAutoPauseDelay(t *testing.T) {
 	testCases := []struct {
-		input       string
+		input       interface{}
 		shouldError bool
 	}{
-		{"-1", false},
-		{"-2", true},
-		{"30", true},
-		{"60", false},
-		{"65", true},
-		{"360", false},
-		{"19900", true},
+		{"60", true},
+		{-1, false},
+		{-2, true},
+		{0, true},
+		{30, true},
+		{60, false},
+		{65, true},
+		{360, false},
+		{10080, false},
+		{10090, true},
+		{19900, true},
 	}
 
 	for _, test := range testCases {
 		_, es := DatabaseAutoPauseDelay(test.input, "name")
 
 		if test.shouldError && len(es) == 0 {
-			t.Fatalf("Expected validating name %q to fail", test.input)
+			t.Fatalf("Expected validating %v to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating %v to succeed but got %+v", test.input, es)
 		}
 	}
 }
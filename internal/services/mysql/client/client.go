@@ -4,20 +4,25 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2021-05-01/mysqlflexibleservers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2021-12-01-preview/azureadadministrators"
+	flexibleServers "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2021-12-01-preview/servers"
 )
 
 type Client struct {
-	ConfigurationsClient               *mysql.ConfigurationsClient
-	DatabasesClient                    *mysql.DatabasesClient
-	FirewallRulesClient                *mysql.FirewallRulesClient
-	FlexibleServerConfigurationsClient *mysqlflexibleservers.ConfigurationsClient
-	FlexibleServerClient               *mysqlflexibleservers.ServersClient
-	FlexibleServerFirewallRulesClient  *mysqlflexibleservers.FirewallRulesClient
-	ServersClient                      *mysql.ServersClient
-	ServerKeysClient                   *mysql.ServerKeysClient
-	ServerSecurityAlertPoliciesClient  *mysql.ServerSecurityAlertPoliciesClient
-	VirtualNetworkRulesClient          *mysql.VirtualNetworkRulesClient
-	ServerAdministratorsClient         *mysql.ServerAdministratorsClient
+	ConfigurationsClient                  *mysql.ConfigurationsClient
+	DatabasesClient                       *mysql.DatabasesClient
+	FirewallRulesClient                   *mysql.FirewallRulesClient
+	FlexibleDatabasesClient               *mysqlflexibleservers.DatabasesClient
+	FlexibleServerAADAdministratorsClient *azureadadministrators.AzureADAdministratorsClient
+	FlexibleServerConfigurationsClient    *mysqlflexibleservers.ConfigurationsClient
+	FlexibleServerClient                  *mysqlflexibleservers.ServersClient
+	FlexibleServerFirewallRulesClient     *mysqlflexibleservers.FirewallRulesClient
+	FlexibleServersPreviewClient          *flexibleServers.ServersClient
+	ServersClient                         *mysql.ServersClient
+	ServerKeysClient                      *mysql.ServerKeysClient
+	ServerSecurityAlertPoliciesClient     *mysql.ServerSecurityAlertPoliciesClient
+	VirtualNetworkRulesClient             *mysql.VirtualNetworkRulesClient
+	ServerAdministratorsClient            *mysql.ServerAdministratorsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -30,6 +35,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	FirewallRulesClient := mysql.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FirewallRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleDatabasesClient := mysqlflexibleservers.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&flexibleDatabasesClient.Client, o.ResourceManagerAuthorizer)
+
 	flexibleServerClient := mysqlflexibleservers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerClient.Client, o.ResourceManagerAuthorizer)
 
@@ -39,6 +47,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerConfigurationsClient := mysqlflexibleservers.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerAADAdministratorsClient := azureadadministrators.NewAzureADAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerAADAdministratorsClient.Client, o.ResourceManagerAuthorizer)
+
+	flexibleServersPreviewClient := flexibleServers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServersPreviewClient.Client, o.ResourceManagerAuthorizer)
+
 	ServersClient := mysql.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ServersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -55,16 +69,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&serverAdministratorsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ConfigurationsClient:               &ConfigurationsClient,
-		DatabasesClient:                    &DatabasesClient,
-		FirewallRulesClient:                &FirewallRulesClient,
-		FlexibleServerClient:               &flexibleServerClient,
-		FlexibleServerFirewallRulesClient:  &flexibleServerFirewallRulesClient,
-		FlexibleServerConfigurationsClient: &flexibleServerConfigurationsClient,
-		ServersClient:                      &ServersClient,
-		ServerKeysClient:                   &ServerKeysClient,
-		ServerSecurityAlertPoliciesClient:  &serverSecurityAlertPoliciesClient,
-		VirtualNetworkRulesClient:          &VirtualNetworkRulesClient,
-		ServerAdministratorsClient:         &serverAdministratorsClient,
+		ConfigurationsClient:                  &ConfigurationsClient,
+		DatabasesClient:                       &DatabasesClient,
+		FirewallRulesClient:                   &FirewallRulesClient,
+		FlexibleDatabasesClient:               &flexibleDatabasesClient,
+		FlexibleServerAADAdministratorsClient: &flexibleServerAADAdministratorsClient,
+		FlexibleServerClient:                  &flexibleServerClient,
+		FlexibleServersPreviewClient:          &flexibleServersPreviewClient,
+		FlexibleServerFirewallRulesClient:     &flexibleServerFirewallRulesClient,
+		FlexibleServerConfigurationsClient:    &flexibleServerConfigurationsClient,
+		ServersClient:                         &ServersClient,
+		ServerKeysClient:                      &ServerKeysClient,
+		ServerSecurityAlertPoliciesClient:     &serverSecurityAlertPoliciesClient,
+		VirtualNetworkRulesClient:             &VirtualNetworkRulesClient,
+		ServerAdministratorsClient:            &serverAdministratorsClient,
 	}
 }
@@ -0,0 +1,43 @@
+package mysql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MySqlFlexibleServerDataSource struct {
+}
+
+func TestAccDataSourceMySqlFlexibleServer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mysql_flexible_server", "test")
+	r := MySqlFlexibleServerDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("administrator_login").Exists(),
+				check.That(data.ResourceName).Key("sku_name").Exists(),
+				check.That(data.ResourceName).Key("storage.#").HasValue("1"),
+				check.That(data.ResourceName).Key("version").Exists(),
+				check.That(data.ResourceName).Key("backup_retention_days").Exists(),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+				check.That(data.ResourceName).Key("public_network_access_enabled").Exists(),
+			),
+		},
+	})
+}
+
+func (MySqlFlexibleServerDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mysql_flexible_server" "test" {
+  name                = azurerm_mysql_flexible_server.test.name
+  resource_group_name = azurerm_mysql_flexible_server.test.resource_group_name
+}
+`, MySqlFlexibleServerResource{}.basic(data))
+}
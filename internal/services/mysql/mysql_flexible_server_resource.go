@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,8 +12,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	flexibleServers "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2021-12-01-preview/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
@@ -23,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+type mysqlFlexibleServerIdentity = identity.UserAssigned
+
 const (
 	ServerMaintenanceWindowEnabled  = "Enabled"
 	ServerMaintenanceWindowDisabled = "Disabled"
@@ -81,6 +88,40 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(7, 35),
 			},
 
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+
+						"primary_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: msiValidate.UserAssignedIdentityID,
+						},
+
+						"geo_backup_key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+
+						"geo_backup_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: msiValidate.UserAssignedIdentityID,
+						},
+					},
+				},
+				RequiredWith: []string{"identity"},
+			},
+
 			"create_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -136,6 +177,8 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 				},
 			},
 
+			"identity": mysqlFlexibleServerIdentity{}.Schema(),
+
 			"maintenance_window": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -396,6 +439,15 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	// `identity` and `customer_managed_key` are only available in a newer API version, so they're set in a separate update
+	_, hasIdentity := d.GetOk("identity")
+	_, hasCustomerManagedKey := d.GetOk("customer_managed_key")
+	if hasIdentity || hasCustomerManagedKey {
+		if err := updateMysqlFlexibleServerDataEncryption(ctx, d, meta, id); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceMysqlFlexibleServerRead(d, meta)
@@ -464,6 +516,30 @@ func resourceMysqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interface{}
 
 	d.Set("sku_name", sku)
 
+	previewClient := meta.(*clients.Client).MySQL.FlexibleServersPreviewClient
+	previewResp, err := previewClient.Get(ctx, flexibleServers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving identity and data encryption for %s: %+v", *id, err)
+	}
+
+	if model := previewResp.Model; model != nil {
+		identity, err := flattenFlexibleServerIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", identity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		var dataEncryption *flexibleServers.DataEncryption
+		if props := model.Properties; props != nil {
+			dataEncryption = props.DataEncryption
+		}
+		if err := d.Set("customer_managed_key", flattenFlexibleServerDataEncryption(dataEncryption)); err != nil {
+			return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+		}
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -626,6 +702,12 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the update of the Mysql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	if d.HasChanges("identity", "customer_managed_key") {
+		if err := updateMysqlFlexibleServerDataEncryption(ctx, d, meta, *id); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("storage") && !d.Get("storage.0.auto_grow_enabled").(bool) {
 		parameters := mysqlflexibleservers.ServerForUpdate{
 			ServerPropertiesForUpdate: &mysqlflexibleservers.ServerPropertiesForUpdate{
@@ -667,6 +749,28 @@ func resourceMysqlFlexibleServerDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+func updateMysqlFlexibleServerDataEncryption(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.FlexibleServerId) error {
+	client := meta.(*clients.Client).MySQL.FlexibleServersPreviewClient
+
+	identity, err := expandFlexibleServerIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	parameters := flexibleServers.ServerForUpdate{
+		Identity: identity,
+		Properties: &flexibleServers.ServerPropertiesForUpdate{
+			DataEncryption: expandFlexibleServerDataEncryption(d.Get("customer_managed_key").([]interface{})),
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, flexibleServers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name), parameters); err != nil {
+		return fmt.Errorf("updating `identity` and `customer_managed_key` for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func expandArmServerNetwork(d *pluginsdk.ResourceData) *mysqlflexibleservers.Network {
 	network := mysqlflexibleservers.Network{}
 
@@ -880,3 +984,65 @@ func flattenFlexibleServerHighAvailability(ha *mysqlflexibleservers.HighAvailabi
 		},
 	}
 }
+
+func expandFlexibleServerIdentity(input []interface{}) (*identity.UserAssignedIdentityMap, error) {
+	expanded, err := mysqlFlexibleServerIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := identity.UserAssignedIdentityMap{}
+	result.FromExpandedConfig(*expanded)
+	return &result, nil
+}
+
+func flattenFlexibleServerIdentity(input *identity.UserAssignedIdentityMap) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	config := input.ToExpandedConfig()
+	return mysqlFlexibleServerIdentity{}.Flatten(&config), nil
+}
+
+func expandFlexibleServerDataEncryption(input []interface{}) *flexibleServers.DataEncryption {
+	if len(input) == 0 || input[0] == nil {
+		encryptionType := flexibleServers.DataEncryptionTypeSystemManaged
+		return &flexibleServers.DataEncryption{
+			Type: &encryptionType,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	encryptionType := flexibleServers.DataEncryptionTypeAzureKeyVault
+	dataEncryption := flexibleServers.DataEncryption{
+		Type:                          &encryptionType,
+		PrimaryKeyURI:                 utils.String(v["key_vault_key_id"].(string)),
+		PrimaryUserAssignedIdentityId: utils.String(v["primary_user_assigned_identity_id"].(string)),
+	}
+
+	if geoBackupKeyId := v["geo_backup_key_vault_key_id"].(string); geoBackupKeyId != "" {
+		dataEncryption.GeoBackupKeyURI = utils.String(geoBackupKeyId)
+	}
+
+	if geoBackupIdentityId := v["geo_backup_user_assigned_identity_id"].(string); geoBackupIdentityId != "" {
+		dataEncryption.GeoBackupUserAssignedIdentityId = utils.String(geoBackupIdentityId)
+	}
+
+	return &dataEncryption
+}
+
+func flattenFlexibleServerDataEncryption(input *flexibleServers.DataEncryption) []interface{} {
+	if input == nil || input.Type == nil || *input.Type != flexibleServers.DataEncryptionTypeAzureKeyVault {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":                     utils.NormalizeNilableString(input.PrimaryKeyURI),
+			"primary_user_assigned_identity_id":    utils.NormalizeNilableString(input.PrimaryUserAssignedIdentityId),
+			"geo_backup_key_vault_key_id":          utils.NormalizeNilableString(input.GeoBackupKeyURI),
+			"geo_backup_user_assigned_identity_id": utils.NormalizeNilableString(input.GeoBackupUserAssignedIdentityId),
+		},
+	}
+}
@@ -21,22 +21,25 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_mysql_server": dataSourceMySqlServer(),
+		"azurerm_mysql_flexible_server": dataSourceMysqlFlexibleServer(),
+		"azurerm_mysql_server":          dataSourceMySqlServer(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_mysql_configuration":                  resourceMySQLConfiguration(),
-		"azurerm_mysql_database":                       resourceMySqlDatabase(),
-		"azurerm_mysql_firewall_rule":                  resourceMySqlFirewallRule(),
-		"azurerm_mysql_flexible_server":                resourceMysqlFlexibleServer(),
-		"azurerm_mysql_flexible_server_configuration":  resourceMySQLFlexibleServerConfiguration(),
-		"azurerm_mysql_flexible_server_firewall_rule":  resourceMySqlFlexibleServerFirewallRule(),
-		"azurerm_mysql_server":                         resourceMySqlServer(),
-		"azurerm_mysql_server_key":                     resourceMySQLServerKey(),
-		"azurerm_mysql_virtual_network_rule":           resourceMySQLVirtualNetworkRule(),
-		"azurerm_mysql_active_directory_administrator": resourceMySQLAdministrator(),
+		"azurerm_mysql_configuration":                                  resourceMySQLConfiguration(),
+		"azurerm_mysql_database":                                       resourceMySqlDatabase(),
+		"azurerm_mysql_firewall_rule":                                  resourceMySqlFirewallRule(),
+		"azurerm_mysql_flexible_database":                              resourceMySqlFlexibleDatabase(),
+		"azurerm_mysql_flexible_server":                                resourceMysqlFlexibleServer(),
+		"azurerm_mysql_flexible_server_active_directory_administrator": resourceMySQLFlexibleServerAdministrator(),
+		"azurerm_mysql_flexible_server_configuration":                  resourceMySQLFlexibleServerConfiguration(),
+		"azurerm_mysql_flexible_server_firewall_rule":                  resourceMySqlFlexibleServerFirewallRule(),
+		"azurerm_mysql_server":                                         resourceMySqlServer(),
+		"azurerm_mysql_server_key":                                     resourceMySQLServerKey(),
+		"azurerm_mysql_virtual_network_rule":                           resourceMySQLVirtualNetworkRule(),
+		"azurerm_mysql_active_directory_administrator":                 resourceMySQLAdministrator(),
 	}
 }
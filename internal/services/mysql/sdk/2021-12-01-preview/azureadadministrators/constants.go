@@ -0,0 +1,28 @@
+package azureadadministrators
+
+import "strings"
+
+type AdministratorType string
+
+const (
+	AdministratorTypeActiveDirectory AdministratorType = "ActiveDirectory"
+)
+
+func PossibleValuesForAdministratorType() []string {
+	return []string{
+		string(AdministratorTypeActiveDirectory),
+	}
+}
+
+func parseAdministratorType(input string) (*AdministratorType, error) {
+	vals := map[string]AdministratorType{
+		"activedirectory": AdministratorTypeActiveDirectory,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AdministratorType(input)
+	return &out, nil
+}
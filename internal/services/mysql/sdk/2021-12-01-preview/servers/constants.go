@@ -0,0 +1,31 @@
+package servers
+
+import "strings"
+
+type DataEncryptionType string
+
+const (
+	DataEncryptionTypeAzureKeyVault DataEncryptionType = "AzureKeyVault"
+	DataEncryptionTypeSystemManaged DataEncryptionType = "SystemManaged"
+)
+
+func PossibleValuesForDataEncryptionType() []string {
+	return []string{
+		string(DataEncryptionTypeAzureKeyVault),
+		string(DataEncryptionTypeSystemManaged),
+	}
+}
+
+func parseDataEncryptionType(input string) (*DataEncryptionType, error) {
+	vals := map[string]DataEncryptionType{
+		"azurekeyvault": DataEncryptionTypeAzureKeyVault,
+		"systemmanaged": DataEncryptionTypeSystemManaged,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataEncryptionType(input)
+	return &out, nil
+}
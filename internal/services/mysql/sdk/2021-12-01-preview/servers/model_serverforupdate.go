@@ -0,0 +1,10 @@
+package servers
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type ServerForUpdate struct {
+	Identity   *identity.UserAssignedIdentityMap `json:"identity,omitempty"`
+	Properties *ServerPropertiesForUpdate        `json:"properties,omitempty"`
+}
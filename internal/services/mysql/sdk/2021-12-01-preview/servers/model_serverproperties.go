@@ -0,0 +1,5 @@
+package servers
+
+type ServerProperties struct {
+	DataEncryption *DataEncryption `json:"dataEncryption,omitempty"`
+}
@@ -370,6 +370,78 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							}, true),
 						},
 
+						"private_link_configuration_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_link_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"private_link_configuration": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ip_configuration": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"subnet_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: networkValidate.SubnetID,
+									},
+
+									"private_ip_address_allocation": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.IPAllocationMethodDynamic),
+											string(network.IPAllocationMethodStatic),
+										}, false),
+									},
+
+									"primary": {
+										Type:     pluginsdk.TypeBool,
+										Required: true,
+									},
+
+									"private_ip_address": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IsIPv4Address,
+									},
+								},
+							},
+						},
+
 						"id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -1515,10 +1587,11 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
 			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, id.ID()),
 			EnableHTTP2:                   utils.Bool(enablehttp2),
-			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
+			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d, id.ID()),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
 			HTTPListeners:                 httpListeners,
+			PrivateLinkConfigurations:     expandApplicationGatewayPrivateLinkConfigurations(d.Get("private_link_configuration").(*pluginsdk.Set).List()),
 			Probes:                        expandApplicationGatewayProbes(d),
 			RequestRoutingRules:           requestRoutingRules,
 			RedirectConfigurations:        redirectConfigurations,
@@ -1694,6 +1767,10 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `frontend_port`: %+v", setErr)
 		}
 
+		if setErr := d.Set("private_link_configuration", flattenApplicationGatewayPrivateLinkConfigurations(props.PrivateLinkConfigurations)); setErr != nil {
+			return fmt.Errorf("setting `private_link_configuration`: %+v", setErr)
+		}
+
 		if setErr := d.Set("frontend_ip_configuration", flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)); setErr != nil {
 			return fmt.Errorf("setting `frontend_ip_configuration`: %+v", setErr)
 		}
@@ -2649,7 +2726,7 @@ func flattenApplicationGatewayFrontendPorts(input *[]network.ApplicationGatewayF
 	return results
 }
 
-func expandApplicationGatewayFrontendIPConfigurations(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayFrontendIPConfiguration {
+func expandApplicationGatewayFrontendIPConfigurations(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayFrontendIPConfiguration {
 	vs := d.Get("frontend_ip_configuration").([]interface{})
 	results := make([]network.ApplicationGatewayFrontendIPConfiguration, 0)
 
@@ -2678,6 +2755,13 @@ func expandApplicationGatewayFrontendIPConfigurations(d *pluginsdk.ResourceData)
 			}
 		}
 
+		if val := v["private_link_configuration_name"].(string); val != "" {
+			privateLinkConfigurationID := fmt.Sprintf("%s/privateLinkConfigurations/%s", gatewayID, val)
+			properties.PrivateLinkConfiguration = &network.SubResource{
+				ID: utils.String(privateLinkConfigurationID),
+			}
+		}
+
 		name := v["name"].(string)
 		output := network.ApplicationGatewayFrontendIPConfiguration{
 			Name: utils.String(name),
@@ -2720,7 +2804,106 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
 				output["public_ip_address_id"] = *props.PublicIPAddress.ID
 			}
+
+			if props.PrivateLinkConfiguration != nil && props.PrivateLinkConfiguration.ID != nil {
+				configurationID := *props.PrivateLinkConfiguration.ID
+				output["private_link_configuration_id"] = configurationID
+				// the name is the last segment of the ID, e.g. `{gatewayID}/privateLinkConfigurations/{name}`
+				if parts := strings.Split(configurationID, "/"); len(parts) > 0 {
+					output["private_link_configuration_name"] = parts[len(parts)-1]
+				}
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayPrivateLinkConfigurations(input []interface{}) *[]network.ApplicationGatewayPrivateLinkConfiguration {
+	results := make([]network.ApplicationGatewayPrivateLinkConfiguration, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		ipConfigurations := make([]network.ApplicationGatewayPrivateLinkIPConfiguration, 0)
+		for _, rawIPConfig := range v["ip_configuration"].([]interface{}) {
+			c := rawIPConfig.(map[string]interface{})
+
+			ipConfiguration := network.ApplicationGatewayPrivateLinkIPConfiguration{
+				Name: utils.String(c["name"].(string)),
+				ApplicationGatewayPrivateLinkIPConfigurationProperties: &network.ApplicationGatewayPrivateLinkIPConfigurationProperties{
+					Primary:                   utils.Bool(c["primary"].(bool)),
+					PrivateIPAllocationMethod: network.IPAllocationMethod(c["private_ip_address_allocation"].(string)),
+					Subnet: &network.SubResource{
+						ID: utils.String(c["subnet_id"].(string)),
+					},
+				},
+			}
+
+			if privateIPAddress := c["private_ip_address"].(string); privateIPAddress != "" {
+				ipConfiguration.ApplicationGatewayPrivateLinkIPConfigurationProperties.PrivateIPAddress = utils.String(privateIPAddress)
+			}
+
+			ipConfigurations = append(ipConfigurations, ipConfiguration)
+		}
+
+		results = append(results, network.ApplicationGatewayPrivateLinkConfiguration{
+			Name: utils.String(v["name"].(string)),
+			ApplicationGatewayPrivateLinkConfigurationProperties: &network.ApplicationGatewayPrivateLinkConfigurationProperties{
+				IPConfigurations: &ipConfigurations,
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayPrivateLinkConfigurations(input *[]network.ApplicationGatewayPrivateLinkConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := make(map[string]interface{})
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		ipConfigurations := make([]interface{}, 0)
+		if props := config.ApplicationGatewayPrivateLinkConfigurationProperties; props != nil && props.IPConfigurations != nil {
+			for _, ipConfig := range *props.IPConfigurations {
+				ipConfigOutput := make(map[string]interface{})
+				if ipConfig.Name != nil {
+					ipConfigOutput["name"] = *ipConfig.Name
+				}
+
+				if ipProps := ipConfig.ApplicationGatewayPrivateLinkIPConfigurationProperties; ipProps != nil {
+					ipConfigOutput["private_ip_address_allocation"] = string(ipProps.PrivateIPAllocationMethod)
+
+					if ipProps.Primary != nil {
+						ipConfigOutput["primary"] = *ipProps.Primary
+					}
+
+					if ipProps.PrivateIPAddress != nil {
+						ipConfigOutput["private_ip_address"] = *ipProps.PrivateIPAddress
+					}
+
+					if ipProps.Subnet != nil && ipProps.Subnet.ID != nil {
+						ipConfigOutput["subnet_id"] = *ipProps.Subnet.ID
+					}
+				}
+
+				ipConfigurations = append(ipConfigurations, ipConfigOutput)
+			}
 		}
+		output["ip_configuration"] = ipConfigurations
 
 		results = append(results, output)
 	}
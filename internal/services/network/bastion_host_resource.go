@@ -1,19 +1,21 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/bastionhosts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -26,7 +28,7 @@ func resourceBastionHost() *pluginsdk.Resource {
 		Delete: resourceBastionHostDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.BastionHostID(id)
+			_, err := bastionhosts.ParseBastionHostID(id)
 			return err
 		}),
 
@@ -78,6 +80,59 @@ func resourceBastionHost() *pluginsdk.Resource {
 				},
 			},
 
+			"copy_paste_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"file_copy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ip_connect_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"kerberos_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"scale_units": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(2, 50),
+				Default:      2,
+			},
+
+			"shareable_link_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(bastionhosts.BastionHostSkuNameBasic),
+					string(bastionhosts.BastionHostSkuNameStandard),
+				}, false),
+				Default: string(bastionhosts.BastionHostSkuNameBasic),
+			},
+
+			"tunneling_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"dns_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -85,57 +140,66 @@ func resourceBastionHost() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// downgrading from the Standard SKU to the Basic SKU isn't supported by the API
+			pluginsdk.ForceNewIfChange("sku", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(bastionhosts.BastionHostSkuNameStandard) && new.(string) == string(bastionhosts.BastionHostSkuNameBasic)
+			}),
+			pluginsdk.CustomizeDiffShim(bastionHostCustomizeDiff),
+		),
 	}
 }
 
 func resourceBastionHostCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.BastionHostsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Println("[INFO] preparing arguments for Azure Bastion Host creation.")
 
-	resourceGroup := d.Get("resource_group_name").(string)
-	name := d.Get("name").(string)
+	id := bastionhosts.NewBastionHostID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	t := d.Get("tags").(map[string]interface{})
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing Bastion Host %q (Resource Group %q): %s", name, resourceGroup, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_bastion_host", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_bastion_host", id.ID())
 		}
 	}
 
-	parameters := network.BastionHost{
-		Location: &location,
-		BastionHostPropertiesFormat: &network.BastionHostPropertiesFormat{
-			IPConfigurations: expandBastionHostIPConfiguration(d.Get("ip_configuration").([]interface{})),
+	sku := bastionhosts.BastionHostSkuName(d.Get("sku").(string))
+
+	parameters := bastionhosts.BastionHost{
+		Location: utils.String(location),
+		Properties: &bastionhosts.BastionHostPropertiesFormat{
+			DisableCopyPaste:    utils.Bool(!d.Get("copy_paste_enabled").(bool)),
+			EnableFileCopy:      utils.Bool(d.Get("file_copy_enabled").(bool)),
+			EnableIPConnect:     utils.Bool(d.Get("ip_connect_enabled").(bool)),
+			EnableKerberos:      utils.Bool(d.Get("kerberos_enabled").(bool)),
+			EnableShareableLink: utils.Bool(d.Get("shareable_link_enabled").(bool)),
+			EnableTunneling:     utils.Bool(d.Get("tunneling_enabled").(bool)),
+			IPConfigurations:    expandBastionHostIPConfiguration(d.Get("ip_configuration").([]interface{})),
+			ScaleUnits:          utils.Int64(int64(d.Get("scale_units").(int))),
 		},
-		Tags: tags.Expand(t),
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating/updating Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+		Sku: &bastionhosts.Sku{
+			Name: &sku,
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	read, err := client.Get(ctx, resourceGroup, name)
-	if err != nil {
-		return fmt.Errorf("retrieving Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	d.SetId(*read.ID)
+	d.SetId(id.ID())
 
 	return resourceBastionHostRead(d, meta)
 }
@@ -145,14 +209,14 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.BastionHostID(d.Id())
+	id, err := bastionhosts.ParseBastionHostID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
 			return nil
@@ -160,24 +224,51 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("name", id.BastionHostName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
 
-	if props := resp.BastionHostPropertiesFormat; props != nil {
-		d.Set("dns_name", props.DNSName)
+		sku := string(bastionhosts.BastionHostSkuNameBasic)
+		if model.Sku != nil && model.Sku.Name != nil {
+			sku = string(*model.Sku.Name)
+		}
+		d.Set("sku", sku)
+
+		if props := model.Properties; props != nil {
+			d.Set("dns_name", props.DnsName)
 
-		if ipConfigs := props.IPConfigurations; ipConfigs != nil {
-			if err := d.Set("ip_configuration", flattenBastionHostIPConfiguration(ipConfigs)); err != nil {
-				return fmt.Errorf("flattening `ip_configuration`: %+v", err)
+			copyPasteEnabled := true
+			if props.DisableCopyPaste != nil {
+				copyPasteEnabled = !*props.DisableCopyPaste
+			}
+			d.Set("copy_paste_enabled", copyPasteEnabled)
+			d.Set("file_copy_enabled", utils.NormaliseNilableBool(props.EnableFileCopy))
+			d.Set("ip_connect_enabled", utils.NormaliseNilableBool(props.EnableIPConnect))
+			d.Set("kerberos_enabled", utils.NormaliseNilableBool(props.EnableKerberos))
+			d.Set("shareable_link_enabled", utils.NormaliseNilableBool(props.EnableShareableLink))
+			d.Set("tunneling_enabled", utils.NormaliseNilableBool(props.EnableTunneling))
+
+			scaleUnits := 2
+			if props.ScaleUnits != nil {
+				scaleUnits = int(*props.ScaleUnits)
+			}
+			d.Set("scale_units", scaleUnits)
+
+			if ipConfigs := props.IPConfigurations; ipConfigs != nil {
+				if err := d.Set("ip_configuration", flattenBastionHostIPConfiguration(ipConfigs)); err != nil {
+					return fmt.Errorf("flattening `ip_configuration`: %+v", err)
+				}
 			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceBastionHostDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -185,18 +276,18 @@ func resourceBastionHostDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.BastionHostID(d.Id())
+	id, err := bastionhosts.ParseBastionHostID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Delete(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
+	if err := future.Poller.PollUntilDone(); err != nil {
+		if !response.WasNotFound(future.Poller.HttpResponse) {
 			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
 		}
 	}
@@ -204,7 +295,39 @@ func resourceBastionHostDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]network.BastionHostIPConfiguration) {
+func bastionHostCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Get("sku").(string) == string(bastionhosts.BastionHostSkuNameStandard) {
+		return nil
+	}
+
+	if d.Get("scale_units").(int) != 2 {
+		return fmt.Errorf("`scale_units` only can be changed when `sku` is `Standard`. `scale_units` is always `2` when `sku` is `Basic`")
+	}
+
+	if !d.Get("copy_paste_enabled").(bool) {
+		return fmt.Errorf("`copy_paste_enabled` can only be disabled when `sku` is `Standard`")
+	}
+
+	if d.Get("file_copy_enabled").(bool) {
+		return fmt.Errorf("`file_copy_enabled` is only supported when `sku` is `Standard`")
+	}
+
+	if d.Get("ip_connect_enabled").(bool) {
+		return fmt.Errorf("`ip_connect_enabled` is only supported when `sku` is `Standard`")
+	}
+
+	if d.Get("shareable_link_enabled").(bool) {
+		return fmt.Errorf("`shareable_link_enabled` is only supported when `sku` is `Standard`")
+	}
+
+	if d.Get("tunneling_enabled").(bool) {
+		return fmt.Errorf("`tunneling_enabled` is only supported when `sku` is `Standard`")
+	}
+
+	return nil
+}
+
+func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]bastionhosts.BastionHostIPConfiguration) {
 	if len(input) == 0 {
 		return nil
 	}
@@ -214,22 +337,22 @@ func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]network
 	subID := property["subnet_id"].(string)
 	pipID := property["public_ip_address_id"].(string)
 
-	return &[]network.BastionHostIPConfiguration{
+	return &[]bastionhosts.BastionHostIPConfiguration{
 		{
 			Name: &ipConfName,
-			BastionHostIPConfigurationPropertiesFormat: &network.BastionHostIPConfigurationPropertiesFormat{
-				Subnet: &network.SubResource{
-					ID: &subID,
+			Properties: &bastionhosts.BastionHostIPConfigurationPropertiesFormat{
+				Subnet: bastionhosts.SubResource{
+					Id: &subID,
 				},
-				PublicIPAddress: &network.SubResource{
-					ID: &pipID,
+				PublicIPAddress: bastionhosts.SubResource{
+					Id: &pipID,
 				},
 			},
 		},
 	}
 }
 
-func flattenBastionHostIPConfiguration(ipConfigs *[]network.BastionHostIPConfiguration) []interface{} {
+func flattenBastionHostIPConfiguration(ipConfigs *[]bastionhosts.BastionHostIPConfiguration) []interface{} {
 	result := make([]interface{}, 0)
 	if ipConfigs == nil {
 		return result
@@ -242,13 +365,13 @@ func flattenBastionHostIPConfiguration(ipConfigs *[]network.BastionHostIPConfigu
 			ipConfig["name"] = *config.Name
 		}
 
-		if props := config.BastionHostIPConfigurationPropertiesFormat; props != nil {
-			if subnet := props.Subnet; subnet != nil {
-				ipConfig["subnet_id"] = *subnet.ID
+		if props := config.Properties; props != nil {
+			if subnetId := props.Subnet.Id; subnetId != nil {
+				ipConfig["subnet_id"] = *subnetId
 			}
 
-			if pip := props.PublicIPAddress; pip != nil {
-				ipConfig["public_ip_address_id"] = *pip.ID
+			if pipId := props.PublicIPAddress.Id; pipId != nil {
+				ipConfig["public_ip_address_id"] = *pipId
 			}
 		}
 
@@ -3,62 +3,85 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/privateendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routingintents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/staticmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/bastionhosts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/webapplicationfirewallpolicies"
 )
 
 type Client struct {
-	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
-	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
-	BastionHostsClient                     *network.BastionHostsClient
-	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
-	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
-	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
-	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
-	ExpressRouteCircuitConnectionClient    *network.ExpressRouteCircuitConnectionsClient
-	ExpressRouteConnectionsClient          *network.ExpressRouteConnectionsClient
-	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
-	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
-	ExpressRoutePortsClient                *network.ExpressRoutePortsClient
-	FlowLogsClient                         *network.FlowLogsClient
-	HubRouteTableClient                    *network.HubRouteTablesClient
-	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
-	InterfacesClient                       *network.InterfacesClient
-	IPGroupsClient                         *network.IPGroupsClient
-	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
-	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
-	ProfileClient                          *network.ProfilesClient
-	PacketCapturesClient                   *network.PacketCapturesClient
-	PrivateEndpointClient                  *network.PrivateEndpointsClient
-	PublicIPsClient                        *network.PublicIPAddressesClient
-	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
-	RoutesClient                           *network.RoutesClient
-	RouteFiltersClient                     *network.RouteFiltersClient
-	RouteTablesClient                      *network.RouteTablesClient
-	SecurityGroupClient                    *network.SecurityGroupsClient
-	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
-	SecurityRuleClient                     *network.SecurityRulesClient
-	ServiceEndpointPoliciesClient          *network.ServiceEndpointPoliciesClient
-	ServiceEndpointPolicyDefinitionsClient *network.ServiceEndpointPolicyDefinitionsClient
-	ServiceTagsClient                      *network.ServiceTagsClient
-	SubnetsClient                          *network.SubnetsClient
-	NatGatewayClient                       *network.NatGatewaysClient
-	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
-	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
-	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
-	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
-	VnetClient                             *network.VirtualNetworksClient
-	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
-	VirtualWanClient                       *network.VirtualWansClient
-	VirtualHubClient                       *network.VirtualHubsClient
-	VpnConnectionsClient                   *network.VpnConnectionsClient
-	VpnGatewaysClient                      *network.VpnGatewaysClient
-	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
-	VpnSitesClient                         *network.VpnSitesClient
-	WatcherClient                          *network.WatchersClient
-	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
-	PrivateDnsZoneGroupClient              *network.PrivateDNSZoneGroupsClient
-	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
-	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
-	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+	ApplicationGatewaysClient                       *network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient                 *network.ApplicationSecurityGroupsClient
+	BastionHostsClient                              *bastionhosts.BastionHostsClient
+	ConnectionMonitorsClient                        *network.ConnectionMonitorsClient
+	DDOSProtectionPlansClient                       *network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient                         *network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitsClient                      *network.ExpressRouteCircuitsClient
+	ExpressRouteCircuitConnectionClient             *network.ExpressRouteCircuitConnectionsClient
+	ExpressRouteConnectionsClient                   *network.ExpressRouteConnectionsClient
+	ExpressRouteGatewaysClient                      *network.ExpressRouteGatewaysClient
+	ExpressRoutePeeringsClient                      *network.ExpressRouteCircuitPeeringsClient
+	ExpressRoutePortsClient                         *network.ExpressRoutePortsClient
+	FlowLogsClient                                  *network.FlowLogsClient
+	HubRouteTableClient                             *network.HubRouteTablesClient
+	HubVirtualNetworkConnectionClient               *network.HubVirtualNetworkConnectionsClient
+	InterfacesClient                                *network.InterfacesClient
+	IPGroupsClient                                  *network.IPGroupsClient
+	LocalNetworkGatewaysClient                      *network.LocalNetworkGatewaysClient
+	NetworkManagerAdminRuleCollectionsClient        *adminrulecollections.AdminRuleCollectionsClient
+	NetworkManagerAdminRulesClient                  *adminrules.AdminRulesClient
+	NetworkManagerConnectivityConfigurationsClient  *connectivityconfigurations.ConnectivityConfigurationsClient
+	NetworkManagerNetworkGroupsClient               *networkgroups.NetworkGroupsClient
+	NetworkManagersClient                           *networkmanagers.NetworkManagersClient
+	NetworkManagerSecurityAdminConfigurationsClient *securityadminconfigurations.SecurityAdminConfigurationsClient
+	NetworkManagerStaticMembersClient               *staticmembers.StaticMembersClient
+	PointToSiteVpnGatewaysClient                    *network.P2sVpnGatewaysClient
+	ProfileClient                                   *network.ProfilesClient
+	PacketCapturesClient                            *network.PacketCapturesClient
+	PrivateEndpointClient                           *privateendpoints.PrivateEndpointsClient
+	PublicIPsClient                                 *network.PublicIPAddressesClient
+	PublicIPPrefixesClient                          *network.PublicIPPrefixesClient
+	RoutesClient                                    *network.RoutesClient
+	RouteFiltersClient                              *network.RouteFiltersClient
+	RouteMapsClient                                 *routemaps.RouteMapsClient
+	RouteTablesClient                               *network.RouteTablesClient
+	RoutingIntentsClient                            *routingintents.RoutingIntentsClient
+	SecurityGroupClient                             *network.SecurityGroupsClient
+	SecurityPartnerProviderClient                   *network.SecurityPartnerProvidersClient
+	SecurityRuleClient                              *network.SecurityRulesClient
+	ServiceEndpointPoliciesClient                   *network.ServiceEndpointPoliciesClient
+	ServiceEndpointPolicyDefinitionsClient          *network.ServiceEndpointPolicyDefinitionsClient
+	ServiceTagsClient                               *network.ServiceTagsClient
+	SubnetsClient                                   *network.SubnetsClient
+	NatGatewayClient                                *network.NatGatewaysClient
+	VirtualHubBgpConnectionClient                   *network.VirtualHubBgpConnectionClient
+	VirtualHubIPClient                              *network.VirtualHubIPConfigurationClient
+	VnetGatewayConnectionsClient                    *network.VirtualNetworkGatewayConnectionsClient
+	VnetGatewayClient                               *network.VirtualNetworkGatewaysClient
+	VnetGatewayNatRuleClient                        *network.VirtualNetworkGatewayNatRulesClient
+	VnetClient                                      *network.VirtualNetworksClient
+	VnetPeeringsClient                              *network.VirtualNetworkPeeringsClient
+	VirtualWanClient                                *network.VirtualWansClient
+	VirtualHubClient                                *network.VirtualHubsClient
+	VpnConnectionsClient                            *network.VpnConnectionsClient
+	VpnGatewaysClient                               *network.VpnGatewaysClient
+	VpnGatewayNatRuleClient                         *network.NatRulesClient
+	VpnServerConfigurationsClient                   *network.VpnServerConfigurationsClient
+	VpnSitesClient                                  *network.VpnSitesClient
+	WatcherClient                                   *network.WatchersClient
+	WebApplicationFirewallPoliciesClient            *webapplicationfirewallpolicies.WebApplicationFirewallPoliciesClient
+	PrivateDnsZoneGroupClient                       *network.PrivateDNSZoneGroupsClient
+	PrivateLinkServiceClient                        *network.PrivateLinkServicesClient
+	ServiceAssociationLinkClient                    *network.ServiceAssociationLinksClient
+	ResourceNavigationLinkClient                    *network.ResourceNavigationLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -68,7 +91,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	ApplicationSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ApplicationSecurityGroupsClient.Client, o.ResourceManagerAuthorizer)
 
-	BastionHostsClient := network.NewBastionHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	BastionHostsClient := bastionhosts.NewBastionHostsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&BastionHostsClient.Client, o.ResourceManagerAuthorizer)
 
 	ConnectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -131,7 +154,28 @@ func NewClient(o *common.ClientOptions) *Client {
 	PacketCapturesClient := network.NewPacketCapturesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PacketCapturesClient.Client, o.ResourceManagerAuthorizer)
 
-	PrivateEndpointClient := network.NewPrivateEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	NetworkManagerAdminRuleCollectionsClient := adminrulecollections.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkManagerAdminRulesClient := adminrules.NewAdminRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerAdminRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkManagerConnectivityConfigurationsClient := connectivityconfigurations.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerConnectivityConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkManagerNetworkGroupsClient := networkgroups.NewNetworkGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerNetworkGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkManagersClient := networkmanagers.NewNetworkManagersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagersClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkManagerSecurityAdminConfigurationsClient := securityadminconfigurations.NewSecurityAdminConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerSecurityAdminConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkManagerStaticMembersClient := staticmembers.NewStaticMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerStaticMembersClient.Client, o.ResourceManagerAuthorizer)
+
+	PrivateEndpointClient := privateendpoints.NewPrivateEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&PrivateEndpointClient.Client, o.ResourceManagerAuthorizer)
 
 	VnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -155,9 +199,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteFiltersClient := network.NewRouteFiltersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteFiltersClient.Client, o.ResourceManagerAuthorizer)
 
+	RouteMapsClient := routemaps.NewRouteMapsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RouteMapsClient.Client, o.ResourceManagerAuthorizer)
+
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
+	RoutingIntentsClient := routingintents.NewRoutingIntentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RoutingIntentsClient.Client, o.ResourceManagerAuthorizer)
+
 	SecurityGroupClient := network.NewSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SecurityGroupClient.Client, o.ResourceManagerAuthorizer)
 
@@ -188,6 +238,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
 
+	VnetGatewayNatRuleClient := network.NewVirtualNetworkGatewayNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VnetGatewayNatRuleClient.Client, o.ResourceManagerAuthorizer)
+
 	NatGatewayClient := network.NewNatGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatGatewayClient.Client, o.ResourceManagerAuthorizer)
 
@@ -203,6 +256,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	vpnGatewayNatRuleClient := network.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vpnGatewayNatRuleClient.Client, o.ResourceManagerAuthorizer)
+
 	vpnConnectionsClient := network.NewVpnConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vpnConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -212,7 +268,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	WatcherClient := network.NewWatchersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&WatcherClient.Client, o.ResourceManagerAuthorizer)
 
-	WebApplicationFirewallPoliciesClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	WebApplicationFirewallPoliciesClient := webapplicationfirewallpolicies.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&WebApplicationFirewallPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
 	ServiceAssociationLinkClient := network.NewServiceAssociationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -222,58 +278,69 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
-		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
-		BastionHostsClient:                     &BastionHostsClient,
-		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
-		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
-		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
-		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
-		ExpressRouteCircuitConnectionClient:    &ExpressRouteCircuitConnectionClient,
-		ExpressRouteConnectionsClient:          &ExpressRouteConnectionsClient,
-		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
-		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
-		ExpressRoutePortsClient:                &ExpressRoutePortsClient,
-		FlowLogsClient:                         &FlowLogsClient,
-		HubRouteTableClient:                    &HubRouteTableClient,
-		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
-		InterfacesClient:                       &InterfacesClient,
-		IPGroupsClient:                         &IpGroupsClient,
-		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
-		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
-		ProfileClient:                          &ProfileClient,
-		PacketCapturesClient:                   &PacketCapturesClient,
-		PrivateEndpointClient:                  &PrivateEndpointClient,
-		PublicIPsClient:                        &PublicIPsClient,
-		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
-		RoutesClient:                           &RoutesClient,
-		RouteFiltersClient:                     &RouteFiltersClient,
-		RouteTablesClient:                      &RouteTablesClient,
-		SecurityGroupClient:                    &SecurityGroupClient,
-		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
-		SecurityRuleClient:                     &SecurityRuleClient,
-		ServiceEndpointPoliciesClient:          &ServiceEndpointPoliciesClient,
-		ServiceEndpointPolicyDefinitionsClient: &ServiceEndpointPolicyDefinitionsClient,
-		ServiceTagsClient:                      &ServiceTagsClient,
-		SubnetsClient:                          &SubnetsClient,
-		NatGatewayClient:                       &NatGatewayClient,
-		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
-		VirtualHubIPClient:                     &VirtualHubIPClient,
-		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
-		VnetGatewayClient:                      &VnetGatewayClient,
-		VnetClient:                             &VnetClient,
-		VnetPeeringsClient:                     &VnetPeeringsClient,
-		VirtualWanClient:                       &VirtualWanClient,
-		VirtualHubClient:                       &VirtualHubClient,
-		VpnConnectionsClient:                   &vpnConnectionsClient,
-		VpnGatewaysClient:                      &vpnGatewaysClient,
-		VpnServerConfigurationsClient:          &vpnServerConfigurationsClient,
-		VpnSitesClient:                         &vpnSitesClient,
-		WatcherClient:                          &WatcherClient,
-		WebApplicationFirewallPoliciesClient:   &WebApplicationFirewallPoliciesClient,
-		PrivateDnsZoneGroupClient:              &PrivateDnsZoneGroupClient,
-		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
-		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
-		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+		ApplicationGatewaysClient:                       &ApplicationGatewaysClient,
+		ApplicationSecurityGroupsClient:                 &ApplicationSecurityGroupsClient,
+		BastionHostsClient:                              &BastionHostsClient,
+		ConnectionMonitorsClient:                        &ConnectionMonitorsClient,
+		DDOSProtectionPlansClient:                       &DDOSProtectionPlansClient,
+		ExpressRouteAuthsClient:                         &ExpressRouteAuthsClient,
+		ExpressRouteCircuitsClient:                      &ExpressRouteCircuitsClient,
+		ExpressRouteCircuitConnectionClient:             &ExpressRouteCircuitConnectionClient,
+		ExpressRouteConnectionsClient:                   &ExpressRouteConnectionsClient,
+		ExpressRouteGatewaysClient:                      &ExpressRouteGatewaysClient,
+		ExpressRoutePeeringsClient:                      &ExpressRoutePeeringsClient,
+		ExpressRoutePortsClient:                         &ExpressRoutePortsClient,
+		FlowLogsClient:                                  &FlowLogsClient,
+		HubRouteTableClient:                             &HubRouteTableClient,
+		HubVirtualNetworkConnectionClient:               &HubVirtualNetworkConnectionClient,
+		InterfacesClient:                                &InterfacesClient,
+		IPGroupsClient:                                  &IpGroupsClient,
+		LocalNetworkGatewaysClient:                      &LocalNetworkGatewaysClient,
+		NetworkManagerAdminRuleCollectionsClient:        &NetworkManagerAdminRuleCollectionsClient,
+		NetworkManagerAdminRulesClient:                  &NetworkManagerAdminRulesClient,
+		NetworkManagerConnectivityConfigurationsClient:  &NetworkManagerConnectivityConfigurationsClient,
+		NetworkManagerNetworkGroupsClient:               &NetworkManagerNetworkGroupsClient,
+		NetworkManagersClient:                           &NetworkManagersClient,
+		NetworkManagerSecurityAdminConfigurationsClient: &NetworkManagerSecurityAdminConfigurationsClient,
+		NetworkManagerStaticMembersClient:               &NetworkManagerStaticMembersClient,
+		PointToSiteVpnGatewaysClient:                    &pointToSiteVpnGatewaysClient,
+		ProfileClient:                                   &ProfileClient,
+		PacketCapturesClient:                            &PacketCapturesClient,
+		PrivateEndpointClient:                           &PrivateEndpointClient,
+		PublicIPsClient:                                 &PublicIPsClient,
+		PublicIPPrefixesClient:                          &PublicIPPrefixesClient,
+		RoutesClient:                                    &RoutesClient,
+		RouteFiltersClient:                              &RouteFiltersClient,
+		RouteMapsClient:                                 &RouteMapsClient,
+		RouteTablesClient:                               &RouteTablesClient,
+		RoutingIntentsClient:                            &RoutingIntentsClient,
+		SecurityGroupClient:                             &SecurityGroupClient,
+		SecurityPartnerProviderClient:                   &SecurityPartnerProviderClient,
+		SecurityRuleClient:                              &SecurityRuleClient,
+		ServiceEndpointPoliciesClient:                   &ServiceEndpointPoliciesClient,
+		ServiceEndpointPolicyDefinitionsClient:          &ServiceEndpointPolicyDefinitionsClient,
+		ServiceTagsClient:                               &ServiceTagsClient,
+		SubnetsClient:                                   &SubnetsClient,
+		NatGatewayClient:                                &NatGatewayClient,
+		VirtualHubBgpConnectionClient:                   &VirtualHubBgpConnectionClient,
+		VirtualHubIPClient:                              &VirtualHubIPClient,
+		VnetGatewayConnectionsClient:                    &VnetGatewayConnectionsClient,
+		VnetGatewayClient:                               &VnetGatewayClient,
+		VnetGatewayNatRuleClient:                        &VnetGatewayNatRuleClient,
+		VnetClient:                                      &VnetClient,
+		VnetPeeringsClient:                              &VnetPeeringsClient,
+		VirtualWanClient:                                &VirtualWanClient,
+		VirtualHubClient:                                &VirtualHubClient,
+		VpnConnectionsClient:                            &vpnConnectionsClient,
+		VpnGatewaysClient:                               &vpnGatewaysClient,
+		VpnGatewayNatRuleClient:                         &vpnGatewayNatRuleClient,
+		VpnServerConfigurationsClient:                   &vpnServerConfigurationsClient,
+		VpnSitesClient:                                  &vpnSitesClient,
+		WatcherClient:                                   &WatcherClient,
+		WebApplicationFirewallPoliciesClient:            &WebApplicationFirewallPoliciesClient,
+		PrivateDnsZoneGroupClient:                       &PrivateDnsZoneGroupClient,
+		PrivateLinkServiceClient:                        &PrivateLinkServiceClient,
+		ServiceAssociationLinkClient:                    &ServiceAssociationLinkClient,
+		ResourceNavigationLinkClient:                    &ResourceNavigationLinkClient,
 	}
 }
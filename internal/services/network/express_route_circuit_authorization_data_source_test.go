@@ -0,0 +1,38 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExpressRouteCircuitAuthorizationDataSource struct{}
+
+func testAccDataSourceExpressRouteCircuitAuthorization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_authorization", "test")
+	r := ExpressRouteCircuitAuthorizationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("authorization_key").Exists(),
+				check.That(data.ResourceName).Key("authorization_use_status").HasValue("Available"),
+			),
+		},
+	})
+}
+
+func (ExpressRouteCircuitAuthorizationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_authorization" "test" {
+  name                       = azurerm_express_route_circuit_authorization.test.name
+  express_route_circuit_name = azurerm_express_route_circuit_authorization.test.express_route_circuit_name
+  resource_group_name        = azurerm_express_route_circuit_authorization.test.resource_group_name
+}
+`, ExpressRouteCircuitAuthorizationResource{}.basicConfig(data))
+}
@@ -52,6 +52,7 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"basic":          testAccExpressRouteCircuitAuthorization_basic,
 			"multiple":       testAccExpressRouteCircuitAuthorization_multiple,
 			"requiresImport": testAccExpressRouteCircuitAuthorization_requiresImport,
+			"data_basic":     testAccDataSourceExpressRouteCircuitAuthorization_basic,
 		},
 	}
 
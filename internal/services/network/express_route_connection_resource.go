@@ -67,6 +67,12 @@ func resourceExpressRouteConnection() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"express_route_gateway_bypass_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"routing": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -157,9 +163,10 @@ func resourceExpressRouteConnectionCreate(d *pluginsdk.ResourceData, meta interf
 			ExpressRouteCircuitPeering: &network.ExpressRouteCircuitPeeringID{
 				ID: utils.String(d.Get("express_route_circuit_peering_id").(string)),
 			},
-			EnableInternetSecurity: utils.Bool(d.Get("enable_internet_security").(bool)),
-			RoutingConfiguration:   expandExpressRouteConnectionRouting(d.Get("routing").([]interface{})),
-			RoutingWeight:          utils.Int32(int32(d.Get("routing_weight").(int))),
+			EnableInternetSecurity:    utils.Bool(d.Get("enable_internet_security").(bool)),
+			ExpressRouteGatewayBypass: utils.Bool(d.Get("express_route_gateway_bypass_enabled").(bool)),
+			RoutingConfiguration:      expandExpressRouteConnectionRouting(d.Get("routing").([]interface{})),
+			RoutingWeight:             utils.Int32(int32(d.Get("routing_weight").(int))),
 		},
 	}
 
@@ -207,6 +214,7 @@ func resourceExpressRouteConnectionRead(d *pluginsdk.ResourceData, meta interfac
 		d.Set("routing_weight", props.RoutingWeight)
 		d.Set("authorization_key", props.AuthorizationKey)
 		d.Set("enable_internet_security", props.EnableInternetSecurity)
+		d.Set("express_route_gateway_bypass_enabled", props.ExpressRouteGatewayBypass)
 
 		circuitPeeringID := ""
 		if v := props.ExpressRouteCircuitPeering; v != nil {
@@ -246,9 +254,10 @@ func resourceExpressRouteConnectionUpdate(d *pluginsdk.ResourceData, meta interf
 			ExpressRouteCircuitPeering: &network.ExpressRouteCircuitPeeringID{
 				ID: utils.String(d.Get("express_route_circuit_peering_id").(string)),
 			},
-			EnableInternetSecurity: utils.Bool(d.Get("enable_internet_security").(bool)),
-			RoutingConfiguration:   expandExpressRouteConnectionRouting(d.Get("routing").([]interface{})),
-			RoutingWeight:          utils.Int32(int32(d.Get("routing_weight").(int))),
+			EnableInternetSecurity:    utils.Bool(d.Get("enable_internet_security").(bool)),
+			ExpressRouteGatewayBypass: utils.Bool(d.Get("express_route_gateway_bypass_enabled").(bool)),
+			RoutingConfiguration:      expandExpressRouteConnectionRouting(d.Get("routing").([]interface{})),
+			RoutingWeight:             utils.Int32(int32(d.Get("routing_weight").(int))),
 		},
 	}
 
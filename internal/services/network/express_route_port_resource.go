@@ -39,21 +39,19 @@ var expressRoutePortSchema = &pluginsdk.Schema{
 			"macsec_cipher": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-
-				// TODO: The following hardcode can be replaced by SDK types once following is merged:
-				// 	https://github.com/Azure/azure-rest-api-specs/pull/12329
-				Default: "GcmAes128",
-				// Default: string(network.GcmAes128),
-
-				// TODO: The following hardcode can be replaced by SDK types once following is merged:
-				// 	https://github.com/Azure/azure-rest-api-specs/pull/12329
+				Default:  string(network.ExpressRouteLinkMacSecCipherGcmAes128),
 				ValidateFunc: validation.StringInSlice([]string{
-					"GcmAes128",
-					"GcmAes256",
-					// string(network.GcmAes128),
-					// string(network.GcmAes256),
+					string(network.ExpressRouteLinkMacSecCipherGcmAes128),
+					string(network.ExpressRouteLinkMacSecCipherGcmAes256),
+					string(network.ExpressRouteLinkMacSecCipherGcmAesXpn128),
+					string(network.ExpressRouteLinkMacSecCipherGcmAesXpn256),
 				}, false),
 			},
+			"macsec_sci_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"macsec_ckn_keyvault_secret_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -412,13 +410,19 @@ func expandExpressRoutePortLink(idx int, input []interface{}) *network.ExpressRo
 		adminState = network.ExpressRouteLinkAdminStateEnabled
 	}
 
+	sciState := network.ExpressRouteLinkMacSecSciStateDisabled
+	if b["macsec_sci_enabled"].(bool) {
+		sciState = network.ExpressRouteLinkMacSecSciStateEnabled
+	}
+
 	link := network.ExpressRouteLink{
 		// The link name is fixed
 		Name: utils.String(fmt.Sprintf("link%d", idx)),
 		ExpressRouteLinkPropertiesFormat: &network.ExpressRouteLinkPropertiesFormat{
 			AdminState: adminState,
 			MacSecConfig: &network.ExpressRouteLinkMacSecConfig{
-				Cipher: network.ExpressRouteLinkMacSecCipher(b["macsec_cipher"].(string)),
+				Cipher:   network.ExpressRouteLinkMacSecCipher(b["macsec_cipher"].(string)),
+				SciState: sciState,
 			},
 		},
 	}
@@ -460,6 +464,7 @@ func flattenExpressRoutePortLink(link network.ExpressRouteLink) []interface{} {
 		cknSecretId   string
 		cakSecretId   string
 		cipher        string
+		sciEnabled    bool
 	)
 
 	if prop := link.ExpressRouteLinkPropertiesFormat; prop != nil {
@@ -485,6 +490,7 @@ func flattenExpressRoutePortLink(link network.ExpressRouteLink) []interface{} {
 				cakSecretId = *cfg.CakSecretIdentifier
 			}
 			cipher = string(cfg.Cipher)
+			sciEnabled = cfg.SciState == network.ExpressRouteLinkMacSecSciStateEnabled
 		}
 	}
 
@@ -500,6 +506,7 @@ func flattenExpressRoutePortLink(link network.ExpressRouteLink) []interface{} {
 			"macsec_ckn_keyvault_secret_id": cknSecretId,
 			"macsec_cak_keyvault_secret_id": cakSecretId,
 			"macsec_cipher":                 cipher,
+			"macsec_sci_enabled":            sciEnabled,
 		},
 	}
 }
@@ -0,0 +1,308 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerAdminRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerAdminRuleCreateUpdate,
+		Read:   resourceNetworkManagerAdminRuleRead,
+		Update: resourceNetworkManagerAdminRuleCreateUpdate,
+		Delete: resourceNetworkManagerAdminRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := adminrules.ParseRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"admin_rule_collection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: adminrulecollections.ValidateRuleCollectionID,
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(adminrules.SecurityConfigurationRuleAccessAllow),
+					string(adminrules.SecurityConfigurationRuleAccessAlwaysAllow),
+					string(adminrules.SecurityConfigurationRuleAccessDeny),
+				}, false),
+			},
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(adminrules.SecurityConfigurationRuleDirectionInbound),
+					string(adminrules.SecurityConfigurationRuleDirectionOutbound),
+				}, false),
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4096),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(adminrules.SecurityConfigurationRuleProtocolAh),
+					string(adminrules.SecurityConfigurationRuleProtocolAny),
+					string(adminrules.SecurityConfigurationRuleProtocolEsp),
+					string(adminrules.SecurityConfigurationRuleProtocolIcmp),
+					string(adminrules.SecurityConfigurationRuleProtocolTcp),
+					string(adminrules.SecurityConfigurationRuleProtocolUdp),
+				}, false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"destination": networkManagerAdminRuleAddressPrefixSchema(),
+
+			"destination_port_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"source": networkManagerAdminRuleAddressPrefixSchema(),
+
+			"source_port_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerAdminRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerAdminRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	collectionId, err := adminrulecollections.ParseRuleCollectionID(d.Get("admin_rule_collection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := adminrules.NewRuleID(collectionId.SubscriptionId, collectionId.ResourceGroupName, collectionId.NetworkManagerName, collectionId.SecurityAdminConfigurationName, collectionId.RuleCollectionName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_admin_rule", id.ID())
+		}
+	}
+
+	parameters := adminrules.AdminRule{
+		Kind: adminrules.AdminRuleKindCustom,
+		Name: utils.String(id.RuleName),
+		Properties: &adminrules.AdminPropertiesFormat{
+			Access:                adminrules.SecurityConfigurationRuleAccess(d.Get("action").(string)),
+			DestinationPortRanges: utils.ExpandStringSlice(d.Get("destination_port_ranges").([]interface{})),
+			Destinations:          expandNetworkManagerAdminRuleAddressPrefixItems(d.Get("destination").([]interface{})),
+			Direction:             adminrules.SecurityConfigurationRuleDirection(d.Get("direction").(string)),
+			Priority:              int64(d.Get("priority").(int)),
+			Protocol:              adminrules.SecurityConfigurationRuleProtocol(d.Get("protocol").(string)),
+			SourcePortRanges:      utils.ExpandStringSlice(d.Get("source_port_ranges").([]interface{})),
+			Sources:               expandNetworkManagerAdminRuleAddressPrefixItems(d.Get("source").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerAdminRuleRead(d, meta)
+}
+
+func resourceNetworkManagerAdminRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerAdminRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := adminrules.ParseRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("admin_rule_collection_id", adminrulecollections.NewRuleCollectionID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("action", string(props.Access))
+			d.Set("description", props.Description)
+			d.Set("direction", string(props.Direction))
+			d.Set("priority", props.Priority)
+			d.Set("protocol", string(props.Protocol))
+
+			if err := d.Set("destination", flattenNetworkManagerAdminRuleAddressPrefixItems(props.Destinations)); err != nil {
+				return fmt.Errorf("setting `destination`: %+v", err)
+			}
+
+			if err := d.Set("destination_port_ranges", utils.FlattenStringSlice(props.DestinationPortRanges)); err != nil {
+				return fmt.Errorf("setting `destination_port_ranges`: %+v", err)
+			}
+
+			if err := d.Set("source", flattenNetworkManagerAdminRuleAddressPrefixItems(props.Sources)); err != nil {
+				return fmt.Errorf("setting `source`: %+v", err)
+			}
+
+			if err := d.Set("source_port_ranges", utils.FlattenStringSlice(props.SourcePortRanges)); err != nil {
+				return fmt.Errorf("setting `source_port_ranges`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerAdminRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerAdminRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := adminrules.ParseRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func networkManagerAdminRuleAddressPrefixSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(adminrules.AddressPrefixTypeIPPrefix),
+						string(adminrules.AddressPrefixTypeServiceTag),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkManagerAdminRuleAddressPrefixItems(input []interface{}) *[]adminrules.AddressPrefixItem {
+	results := make([]adminrules.AddressPrefixItem, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		prefixType := adminrules.AddressPrefixType(v["address_prefix_type"].(string))
+		results = append(results, adminrules.AddressPrefixItem{
+			AddressPrefix:     utils.String(v["address_prefix"].(string)),
+			AddressPrefixType: &prefixType,
+		})
+	}
+
+	return &results
+}
+
+func flattenNetworkManagerAdminRuleAddressPrefixItems(input *[]adminrules.AddressPrefixItem) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		addressPrefix := ""
+		if item.AddressPrefix != nil {
+			addressPrefix = *item.AddressPrefix
+		}
+
+		addressPrefixType := ""
+		if item.AddressPrefixType != nil {
+			addressPrefixType = string(*item.AddressPrefixType)
+		}
+
+		results = append(results, map[string]interface{}{
+			"address_prefix":      addressPrefix,
+			"address_prefix_type": addressPrefixType,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,350 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerConnectivityConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerConnectivityConfigurationCreateUpdate,
+		Read:   resourceNetworkManagerConnectivityConfigurationRead,
+		Update: resourceNetworkManagerConnectivityConfigurationCreateUpdate,
+		Delete: resourceNetworkManagerConnectivityConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := connectivityconfigurations.ParseConnectivityConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+			},
+
+			"connectivity_topology": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(connectivityconfigurations.ConnectivityTopologyHubAndSpoke),
+					string(connectivityconfigurations.ConnectivityTopologyMesh),
+				}, false),
+			},
+
+			"applies_to_group": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"group_connectivity": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(connectivityconfigurations.GroupConnectivityDirectlyConnected),
+								string(connectivityconfigurations.GroupConnectivityNone),
+							}, false),
+						},
+
+						"network_group_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: networkgroups.ValidateNetworkGroupID,
+						},
+
+						"global_mesh_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"use_hub_gateway": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"delete_existing_peering_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"global_mesh_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"hub": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"resource_type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerConnectivityConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerConnectivityConfigurationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkManagerId, err := networkmanagers.ParseNetworkManagerID(d.Get("network_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := connectivityconfigurations.NewConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_manager_connectivity_configuration", id.ID())
+		}
+	}
+
+	topology := connectivityconfigurations.ConnectivityTopology(d.Get("connectivity_topology").(string))
+	hubs := expandNetworkManagerConnectivityConfigurationHubs(d.Get("hub").([]interface{}))
+	if topology == connectivityconfigurations.ConnectivityTopologyHubAndSpoke && len(*hubs) == 0 {
+		return fmt.Errorf("a `hub` block must be specified when `connectivity_topology` is `%s`", string(connectivityconfigurations.ConnectivityTopologyHubAndSpoke))
+	}
+
+	deleteExistingPeering := connectivityconfigurations.DeleteExistingPeeringFalse
+	if d.Get("delete_existing_peering_enabled").(bool) {
+		deleteExistingPeering = connectivityconfigurations.DeleteExistingPeeringTrue
+	}
+
+	isGlobal := connectivityconfigurations.IsGlobalFalse
+	if d.Get("global_mesh_enabled").(bool) {
+		isGlobal = connectivityconfigurations.IsGlobalTrue
+	}
+
+	parameters := connectivityconfigurations.ConnectivityConfiguration{
+		Name: utils.String(id.ConnectivityConfigurationName),
+		Properties: &connectivityconfigurations.ConnectivityConfigurationProperties{
+			AppliesToGroups:       expandNetworkManagerConnectivityGroupItems(d.Get("applies_to_group").([]interface{})),
+			ConnectivityTopology:  topology,
+			DeleteExistingPeering: &deleteExistingPeering,
+			Hubs:                  hubs,
+			IsGlobal:              &isGlobal,
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerConnectivityConfigurationRead(d, meta)
+}
+
+func resourceNetworkManagerConnectivityConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerConnectivityConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := connectivityconfigurations.ParseConnectivityConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ConnectivityConfigurationName)
+	d.Set("network_manager_id", networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("connectivity_topology", string(props.ConnectivityTopology))
+			d.Set("description", props.Description)
+			d.Set("delete_existing_peering_enabled", props.DeleteExistingPeering != nil && *props.DeleteExistingPeering == connectivityconfigurations.DeleteExistingPeeringTrue)
+			d.Set("global_mesh_enabled", props.IsGlobal != nil && *props.IsGlobal == connectivityconfigurations.IsGlobalTrue)
+
+			if err := d.Set("applies_to_group", flattenNetworkManagerConnectivityGroupItems(props.AppliesToGroups)); err != nil {
+				return fmt.Errorf("setting `applies_to_group`: %+v", err)
+			}
+
+			if err := d.Set("hub", flattenNetworkManagerConnectivityConfigurationHubs(props.Hubs)); err != nil {
+				return fmt.Errorf("setting `hub`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerConnectivityConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerConnectivityConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := connectivityconfigurations.ParseConnectivityConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerConnectivityGroupItems(input []interface{}) []connectivityconfigurations.ConnectivityGroupItem {
+	results := make([]connectivityconfigurations.ConnectivityGroupItem, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		isGlobal := connectivityconfigurations.IsGlobalFalse
+		if v["global_mesh_enabled"].(bool) {
+			isGlobal = connectivityconfigurations.IsGlobalTrue
+		}
+
+		useHubGateway := connectivityconfigurations.UseHubGatewayFalse
+		if v["use_hub_gateway"].(bool) {
+			useHubGateway = connectivityconfigurations.UseHubGatewayTrue
+		}
+
+		results = append(results, connectivityconfigurations.ConnectivityGroupItem{
+			GroupConnectivity: connectivityconfigurations.GroupConnectivity(v["group_connectivity"].(string)),
+			IsGlobal:          &isGlobal,
+			NetworkGroupId:    v["network_group_id"].(string),
+			UseHubGateway:     &useHubGateway,
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkManagerConnectivityGroupItems(input []connectivityconfigurations.ConnectivityGroupItem) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		results = append(results, map[string]interface{}{
+			"group_connectivity":  string(item.GroupConnectivity),
+			"global_mesh_enabled": item.IsGlobal != nil && *item.IsGlobal == connectivityconfigurations.IsGlobalTrue,
+			"network_group_id":    item.NetworkGroupId,
+			"use_hub_gateway":     item.UseHubGateway != nil && *item.UseHubGateway == connectivityconfigurations.UseHubGatewayTrue,
+		})
+	}
+
+	return results
+}
+
+func expandNetworkManagerConnectivityConfigurationHubs(input []interface{}) *[]connectivityconfigurations.Hub {
+	results := make([]connectivityconfigurations.Hub, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		results = append(results, connectivityconfigurations.Hub{
+			ResourceId:   utils.String(v["resource_id"].(string)),
+			ResourceType: utils.String(v["resource_type"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenNetworkManagerConnectivityConfigurationHubs(input *[]connectivityconfigurations.Hub) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		resourceId := ""
+		if item.ResourceId != nil {
+			resourceId = *item.ResourceId
+		}
+
+		resourceType := ""
+		if item.ResourceType != nil {
+			resourceType = *item.ResourceType
+		}
+
+		results = append(results, map[string]interface{}{
+			"resource_id":   resourceId,
+			"resource_type": resourceType,
+		})
+	}
+
+	return results
+}
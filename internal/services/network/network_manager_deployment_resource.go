@@ -0,0 +1,268 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerDeploymentCreate,
+		Read:   resourceNetworkManagerDeploymentRead,
+		Update: resourceNetworkManagerDeploymentUpdate,
+		Delete: resourceNetworkManagerDeploymentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkManagerDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(1 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"scope_access": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(networkmanagers.ConfigurationTypeConnectivity),
+					string(networkmanagers.ConfigurationTypeSecurityAdmin),
+				}, false),
+			},
+
+			"configuration_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerDeploymentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkManagerId, err := networkmanagers.ParseNetworkManagerID(d.Get("network_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkManagerDeploymentID(*networkManagerId, azure.NormalizeLocation(d.Get("location").(string)), d.Get("scope_access").(string))
+
+	locks.ByName(id.NetworkManager.NetworkManagerName, networkManagerResourceName)
+	defer locks.UnlockByName(id.NetworkManager.NetworkManagerName, networkManagerResourceName)
+
+	existing, err := networkManagerDeploymentStatus(ctx, client, id)
+	if err != nil {
+		return fmt.Errorf("checking for existing %s: %+v", id, err)
+	}
+	if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+		return tf.ImportAsExistsError("azurerm_network_manager_deployment", id.ID())
+	}
+
+	if err := networkManagerDeploymentCommit(ctx, client, id, d.Get("configuration_ids").([]interface{})); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerDeploymentRead(d, meta)
+}
+
+func resourceNetworkManagerDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	status, err := networkManagerDeploymentStatus(ctx, client, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if status == nil || status.ConfigurationIds == nil || len(*status.ConfigurationIds) == 0 {
+		log.Printf("[INFO] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_manager_id", id.NetworkManager.ID())
+	d.Set("location", azure.NormalizeLocation(id.Location))
+	d.Set("scope_access", id.ScopeAccess)
+
+	if err := d.Set("configuration_ids", utils.FlattenStringSlice(status.ConfigurationIds)); err != nil {
+		return fmt.Errorf("setting `configuration_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerDeploymentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NetworkManager.NetworkManagerName, networkManagerResourceName)
+	defer locks.UnlockByName(id.NetworkManager.NetworkManagerName, networkManagerResourceName)
+
+	if err := networkManagerDeploymentCommit(ctx, client, *id, d.Get("configuration_ids").([]interface{})); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceNetworkManagerDeploymentRead(d, meta)
+}
+
+func resourceNetworkManagerDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkManagerDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NetworkManager.NetworkManagerName, networkManagerResourceName)
+	defer locks.UnlockByName(id.NetworkManager.NetworkManagerName, networkManagerResourceName)
+
+	// committing an empty list of configurations removes the deployment from the region
+	if err := networkManagerDeploymentCommit(ctx, client, *id, []interface{}{}); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentCommit(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId, configurationIds []interface{}) error {
+	input := networkmanagers.NetworkManagerCommit{
+		CommitType:       networkmanagers.ConfigurationType(id.ScopeAccess),
+		ConfigurationIds: utils.ExpandStringSlice(configurationIds),
+		TargetLocations:  []string{id.Location},
+	}
+
+	if err := client.NetworkManagerCommitsPostThenPoll(ctx, id.NetworkManager, input); err != nil {
+		return fmt.Errorf("committing configurations: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{string(networkmanagers.DeploymentStatusDeploying), string(networkmanagers.DeploymentStatusNotStarted)},
+		Target:                    []string{string(networkmanagers.DeploymentStatusDeployed)},
+		Refresh:                   networkManagerDeploymentStateRefreshFunc(ctx, client, id),
+		PollInterval:              15 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentStatus(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) (*networkmanagers.NetworkManagerDeploymentStatus, error) {
+	input := networkmanagers.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagers.ConfigurationType{networkmanagers.ConfigurationType(id.ScopeAccess)},
+		Regions:         &[]string{id.Location},
+	}
+
+	resp, err := client.NetworkManagerDeploymentStatusList(ctx, id.NetworkManager, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, nil
+	}
+
+	for _, item := range *resp.Model.Value {
+		if item.Region == nil || item.DeploymentType == nil {
+			continue
+		}
+
+		if azure.NormalizeLocation(*item.Region) == azure.NormalizeLocation(id.Location) && strings.EqualFold(string(*item.DeploymentType), id.ScopeAccess) {
+			status := item
+			return &status, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func networkManagerDeploymentStateRefreshFunc(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := networkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if status == nil || status.DeploymentStatus == nil {
+			return "", string(networkmanagers.DeploymentStatusNotStarted), nil
+		}
+
+		if *status.DeploymentStatus == networkmanagers.DeploymentStatusFailed {
+			message := ""
+			if status.ErrorMessage != nil {
+				message = *status.ErrorMessage
+			}
+			return nil, "", fmt.Errorf("deployment of %s failed: %s", id, message)
+		}
+
+		return status, string(*status.DeploymentStatus), nil
+	}
+}
@@ -0,0 +1,352 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	mgValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+	subscriptionValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const networkManagerResourceName = "azurerm_network_manager"
+
+func resourceNetworkManager() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerCreate,
+		Read:   resourceNetworkManagerRead,
+		Update: resourceNetworkManagerUpdate,
+		Delete: resourceNetworkManagerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := networkmanagers.ParseNetworkManagerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"scope": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"management_group_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: mgValidate.ManagementGroupID,
+							},
+							AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+						},
+
+						"subscription_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: subscriptionValidate.SubscriptionID,
+							},
+							AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+						},
+					},
+				},
+			},
+
+			"scope_accesses": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(networkmanagers.ConfigurationTypeConnectivity),
+						string(networkmanagers.ConfigurationTypeSecurityAdmin),
+					}, false),
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cross_tenant_scopes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"management_groups": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"subscriptions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkManagerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := networkmanagers.NewNetworkManagerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError(networkManagerResourceName, id.ID())
+	}
+
+	parameters := networkmanagers.NetworkManager{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Name:     utils.String(id.NetworkManagerName),
+		Properties: &networkmanagers.NetworkManagerProperties{
+			NetworkManagerScopeAccesses: expandNetworkManagerScopeAccesses(d.Get("scope_accesses").([]interface{})),
+			NetworkManagerScopes:        expandNetworkManagerScope(d.Get("scope").([]interface{})),
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkManagerRead(d, meta)
+}
+
+func resourceNetworkManagerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkmanagers.ParseNetworkManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.NetworkManagerName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+
+			if err := d.Set("scope", flattenNetworkManagerScope(props.NetworkManagerScopes)); err != nil {
+				return fmt.Errorf("setting `scope`: %+v", err)
+			}
+
+			if err := d.Set("scope_accesses", flattenNetworkManagerScopeAccesses(props.NetworkManagerScopeAccesses)); err != nil {
+				return fmt.Errorf("setting `scope_accesses`: %+v", err)
+			}
+
+			if err := d.Set("cross_tenant_scopes", flattenNetworkManagerCrossTenantScopes(props.NetworkManagerScopes.CrossTenantScopes)); err != nil {
+				return fmt.Errorf("setting `cross_tenant_scopes`: %+v", err)
+			}
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkmanagers.ParseNetworkManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	if d.HasChange("description") {
+		existing.Model.Properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("scope") {
+		existing.Model.Properties.NetworkManagerScopes = expandNetworkManagerScope(d.Get("scope").([]interface{}))
+	}
+
+	if d.HasChange("scope_accesses") {
+		existing.Model.Properties.NetworkManagerScopeAccesses = expandNetworkManagerScopeAccesses(d.Get("scope_accesses").([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		existing.Model.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, *existing.Model); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceNetworkManagerRead(d, meta)
+}
+
+func resourceNetworkManagerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkmanagers.ParseNetworkManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkManagerScope(input []interface{}) networkmanagers.NetworkManagerPropertiesNetworkManagerScopes {
+	if len(input) == 0 || input[0] == nil {
+		return networkmanagers.NetworkManagerPropertiesNetworkManagerScopes{}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return networkmanagers.NetworkManagerPropertiesNetworkManagerScopes{
+		ManagementGroups: utils.ExpandStringSlice(v["management_group_ids"].([]interface{})),
+		Subscriptions:    utils.ExpandStringSlice(v["subscription_ids"].([]interface{})),
+	}
+}
+
+func flattenNetworkManagerScope(input networkmanagers.NetworkManagerPropertiesNetworkManagerScopes) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"management_group_ids": utils.FlattenStringSlice(input.ManagementGroups),
+			"subscription_ids":     utils.FlattenStringSlice(input.Subscriptions),
+		},
+	}
+}
+
+func flattenNetworkManagerCrossTenantScopes(input *[]networkmanagers.CrossTenantScopes) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		tenantId := ""
+		if item.TenantId != nil {
+			tenantId = *item.TenantId
+		}
+
+		results = append(results, map[string]interface{}{
+			"management_groups": utils.FlattenStringSlice(item.ManagementGroups),
+			"subscriptions":     utils.FlattenStringSlice(item.Subscriptions),
+			"tenant_id":         tenantId,
+		})
+	}
+
+	return results
+}
+
+func expandNetworkManagerScopeAccesses(input []interface{}) []networkmanagers.ConfigurationType {
+	results := make([]networkmanagers.ConfigurationType, 0)
+	for _, item := range input {
+		results = append(results, networkmanagers.ConfigurationType(item.(string)))
+	}
+
+	return results
+}
+
+func flattenNetworkManagerScopeAccesses(input []networkmanagers.ConfigurationType) []interface{} {
+	results := make([]interface{}, 0)
+	for _, item := range input {
+		results = append(results, string(item))
+	}
+
+	return results
+}
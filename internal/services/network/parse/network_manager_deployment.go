@@ -0,0 +1,56 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagers"
+)
+
+type NetworkManagerDeploymentId struct {
+	NetworkManager networkmanagers.NetworkManagerId
+	Location       string
+	ScopeAccess    string
+}
+
+func NewNetworkManagerDeploymentID(networkManagerId networkmanagers.NetworkManagerId, location, scopeAccess string) NetworkManagerDeploymentId {
+	return NetworkManagerDeploymentId{
+		NetworkManager: networkManagerId,
+		Location:       location,
+		ScopeAccess:    scopeAccess,
+	}
+}
+
+func (id NetworkManagerDeploymentId) String() string {
+	return fmt.Sprintf("Network Manager Deployment (Network Manager %q / Resource Group %q / Location %q / Scope Access %q)", id.NetworkManager.NetworkManagerName, id.NetworkManager.ResourceGroupName, id.Location, id.ScopeAccess)
+}
+
+func (id NetworkManagerDeploymentId) ID() string {
+	return fmt.Sprintf("%s|%s|%s", id.NetworkManager.ID(), id.Location, id.ScopeAccess)
+}
+
+func NetworkManagerDeploymentID(input string) (*NetworkManagerDeploymentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("Expected an ID in the format `{networkManagerID}|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	networkManagerId, err := networkmanagers.ParseNetworkManagerID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Manager ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("expected a location in %q but got an empty value", input)
+	}
+
+	if segments[2] == "" {
+		return nil, fmt.Errorf("expected a scope access in %q but got an empty value", input)
+	}
+
+	return &NetworkManagerDeploymentId{
+		NetworkManager: *networkManagerId,
+		Location:       segments[1],
+		ScopeAccess:    segments[2],
+	}, nil
+}
@@ -0,0 +1,91 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestNetworkManagerDeploymentID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *NetworkManagerDeploymentId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Three Segments Invalid ID",
+			Input: "hello|westeurope|Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Scope Access",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1|westeurope",
+			Error: true,
+		},
+		{
+			Name:  "Empty Location",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1||Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager Deployment ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1|westeurope|Connectivity",
+			Error: false,
+			Expect: &NetworkManagerDeploymentId{
+				Location:    "westeurope",
+				ScopeAccess: "Connectivity",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NetworkManagerDeploymentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.NetworkManager.NetworkManagerName != "manager1" {
+			t.Fatalf("Expected %q but got %q for Network Manager Name", "manager1", actual.NetworkManager.NetworkManagerName)
+		}
+
+		if actual.NetworkManager.ResourceGroupName != "group1" {
+			t.Fatalf("Expected %q but got %q for Resource Group", "group1", actual.NetworkManager.ResourceGroupName)
+		}
+
+		if actual.Location != v.Expect.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expect.Location, actual.Location)
+		}
+
+		if actual.ScopeAccess != v.Expect.ScopeAccess {
+			t.Fatalf("Expected %q but got %q for Scope Access", v.Expect.ScopeAccess, actual.ScopeAccess)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
@@ -6,13 +6,14 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/privateendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourcePrivateEndpointConnection() *pluginsdk.Resource {
@@ -80,6 +81,7 @@ func dataSourcePrivateEndpointConnection() *pluginsdk.Resource {
 
 func dataSourcePrivateEndpointConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.PrivateEndpointClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	nicsClient := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -87,32 +89,36 @@ func dataSourcePrivateEndpointConnectionRead(d *pluginsdk.ResourceData, meta int
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	resp, err := client.Get(ctx, resourceGroup, name, "")
+	id := privateendpoints.NewPrivateEndpointID(subscriptionId, resourceGroup, name)
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("Private Endpoint %q was not found in Resource Group %q", name, resourceGroup)
 		}
 		return fmt.Errorf("reading Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
-	if resp.ID == nil || *resp.ID == "" {
-		return fmt.Errorf("API returns a nil/empty id on Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
 
-	d.SetId(*resp.ID)
-	d.Set("name", resp.Name)
+	d.SetId(id.ID())
+	d.Set("name", name)
 	d.Set("resource_group_name", resourceGroup)
-	if location := resp.Location; location != nil {
+
+	model := resp.Model
+	if model == nil {
+		return fmt.Errorf("reading Private Endpoint %q (Resource Group %q): `model` was nil", name, resourceGroup)
+	}
+
+	if location := model.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	if props := resp.PrivateEndpointProperties; props != nil {
+	if props := model.Properties; props != nil {
 		networkInterfaceId := ""
 		privateIpAddress := ""
 
 		if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 {
 			nic := (*nics)[0]
-			if nic.ID != nil && *nic.ID != "" {
-				networkInterfaceId = *nic.ID
+			if nic.Id != nil && *nic.Id != "" {
+				networkInterfaceId = *nic.Id
 				privateIpAddress = getPrivateIpAddress(ctx, nicsClient, networkInterfaceId)
 			}
 		}
@@ -173,7 +179,7 @@ func getPrivateIpAddress(ctx context.Context, client *network.InterfacesClient,
 	return privateIpAddress
 }
 
-func dataSourceFlattenPrivateEndpointServiceConnection(serviceConnections *[]network.PrivateLinkServiceConnection, manualServiceConnections *[]network.PrivateLinkServiceConnection, privateIpAddress string) []interface{} {
+func dataSourceFlattenPrivateEndpointServiceConnection(serviceConnections *[]privateendpoints.PrivateLinkServiceConnection, manualServiceConnections *[]privateendpoints.PrivateLinkServiceConnection, privateIpAddress string) []interface{} {
 	results := make([]interface{}, 0)
 	if serviceConnections == nil && manualServiceConnections == nil {
 		return results
@@ -187,7 +193,7 @@ func dataSourceFlattenPrivateEndpointServiceConnection(serviceConnections *[]net
 			if v := item.Name; v != nil {
 				result["name"] = *v
 			}
-			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+			if props := item.Properties; props != nil {
 				if v := props.PrivateLinkServiceConnectionState; v != nil {
 					if s := v.Status; s != nil {
 						result["status"] = *s
@@ -210,7 +216,7 @@ func dataSourceFlattenPrivateEndpointServiceConnection(serviceConnections *[]net
 			if v := item.Name; v != nil {
 				result["name"] = *v
 			}
-			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+			if props := item.Properties; props != nil {
 				if v := props.PrivateLinkServiceConnectionState; v != nil {
 					if s := v.Status; s != nil {
 						result["status"] = *s
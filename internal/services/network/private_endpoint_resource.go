@@ -11,6 +11,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -18,6 +19,7 @@ import (
 	mariaDBParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mariadb/parse"
 	mysqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/privateendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	postgresqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
 	privateDnsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
@@ -163,6 +165,50 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			"application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.ApplicationSecurityGroupID,
+				},
+			},
+
+			"ip_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"private_ip_address": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"subresource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.PrivateLinkSubResourceName,
+						},
+						"member_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"custom_dns_configs": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -253,14 +299,15 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("validating the configuration for the Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	endpointId := privateendpoints.NewPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	existing, err := client.Get(ctx, endpointId)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return fmt.Errorf("checking for presence of existing Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 		}
 	}
 
-	if existing.PrivateEndpointProperties != nil {
+	if !response.WasNotFound(existing.HttpResponse) {
 		return tf.ImportAsExistsError("azurerm_private_endpoint", id.ID())
 	}
 
@@ -269,29 +316,27 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
 	subnetId := d.Get("subnet_id").(string)
 
-	parameters := network.PrivateEndpoint{
+	parameters := privateendpoints.PrivateEndpoint{
 		Location: utils.String(location),
-		PrivateEndpointProperties: &network.PrivateEndpointProperties{
+		Properties: &privateendpoints.PrivateEndpointProperties{
+			ApplicationSecurityGroups:           expandPrivateEndpointApplicationSecurityGroups(d.Get("application_security_group_ids").(*pluginsdk.Set).List()),
+			IPConfigurations:                    expandPrivateEndpointIPConfigurations(d.Get("ip_configuration").([]interface{})),
 			PrivateLinkServiceConnections:       expandPrivateLinkEndpointServiceConnection(privateServiceConnections, false),
 			ManualPrivateLinkServiceConnections: expandPrivateLinkEndpointServiceConnection(privateServiceConnections, true),
-			Subnet: &network.Subnet{
-				ID: utils.String(subnetId),
+			Subnet: &privateendpoints.Subnet{
+				Id: utils.String(subnetId),
 			},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, endpointId, parameters); err != nil {
 		if strings.EqualFold(err.Error(), "is missing required parameter 'group Id'") {
 			return fmt.Errorf("creating Private Endpoint %q (Resource Group %q) due to missing 'group Id', ensure that the 'subresource_names' type is populated: %+v", id.Name, id.ResourceGroup, err)
 		} else {
 			return fmt.Errorf("creating Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 		}
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
 
 	d.SetId(id.ID())
 
@@ -329,29 +374,28 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 	subnetId := d.Get("subnet_id").(string)
 
 	// TODO: in future it'd be nice to support conditional updates here, but one problem at a time
-	parameters := network.PrivateEndpoint{
+	parameters := privateendpoints.PrivateEndpoint{
 		Location: utils.String(location),
-		PrivateEndpointProperties: &network.PrivateEndpointProperties{
+		Properties: &privateendpoints.PrivateEndpointProperties{
+			ApplicationSecurityGroups:           expandPrivateEndpointApplicationSecurityGroups(d.Get("application_security_group_ids").(*pluginsdk.Set).List()),
+			IPConfigurations:                    expandPrivateEndpointIPConfigurations(d.Get("ip_configuration").([]interface{})),
 			PrivateLinkServiceConnections:       expandPrivateLinkEndpointServiceConnection(privateServiceConnections, false),
 			ManualPrivateLinkServiceConnections: expandPrivateLinkEndpointServiceConnection(privateServiceConnections, true),
-			Subnet: &network.Subnet{
-				ID: utils.String(subnetId),
+			Subnet: &privateendpoints.Subnet{
+				Id: utils.String(subnetId),
 			},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
-	if err != nil {
+	endpointId := privateendpoints.NewPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	if err := client.CreateOrUpdateThenPoll(ctx, endpointId, parameters); err != nil {
 		if strings.EqualFold(err.Error(), "is missing required parameter 'group Id'") {
 			return fmt.Errorf("updating Private Endpoint %q (Resource Group %q) due to missing 'group Id', ensure that the 'subresource_names' type is populated: %+v", id.Name, id.ResourceGroup, err)
 		} else {
 			return fmt.Errorf("updating Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 		}
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
 
 	// 1 Private Endpoint can have 1 Private DNS Zone Group - so to update we need to Delete & Recreate
 	if d.HasChange("private_dns_zone_group") {
@@ -413,9 +457,9 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, privateendpoints.NewPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Private Endpoint %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -430,10 +474,20 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if props := resp.PrivateEndpointProperties; props != nil {
-		if err := d.Set("custom_dns_configs", flattenCustomDnsConfigs(props.CustomDNSConfigs)); err != nil {
+	model := resp.Model
+	if model == nil {
+		return fmt.Errorf("retrieving Private Endpoint %q (Resource Group %q): `model` was nil", id.Name, id.ResourceGroup)
+	}
+
+	d.Set("location", location.NormalizeNilable(model.Location))
+
+	if props := model.Properties; props != nil {
+		if err := d.Set("application_security_group_ids", flattenPrivateEndpointApplicationSecurityGroups(props.ApplicationSecurityGroups)); err != nil {
+			return fmt.Errorf("setting `application_security_group_ids`: %+v", err)
+		}
+
+		if err := d.Set("custom_dns_configs", flattenCustomDnsConfigs(props.CustomDnsConfigs)); err != nil {
 			return fmt.Errorf("setting `custom_dns_configs`: %+v", err)
 		}
 
@@ -441,12 +495,16 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 		privateIpAddress := ""
 		if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 {
 			nic := (*nics)[0]
-			if nic.ID != nil && *nic.ID != "" {
-				networkInterfaceId = *nic.ID
+			if nic.Id != nil && *nic.Id != "" {
+				networkInterfaceId = *nic.Id
 				privateIpAddress = getPrivateIpAddress(ctx, nicsClient, networkInterfaceId)
 			}
 		}
 
+		if err := d.Set("ip_configuration", flattenPrivateEndpointIPConfigurations(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("setting `ip_configuration`: %+v", err)
+		}
+
 		networkInterface := getNetworkInterface(networkInterfaceId)
 		if err := d.Set("network_interface", networkInterface); err != nil {
 			return fmt.Errorf("setting `network_interface`: %+v", err)
@@ -458,8 +516,8 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 
 		subnetId := ""
-		if props.Subnet != nil && props.Subnet.ID != nil {
-			subnetId = *props.Subnet.ID
+		if props.Subnet != nil && props.Subnet.Id != nil {
+			subnetId = *props.Subnet.Id
 		}
 		d.Set("subnet_id", subnetId)
 	}
@@ -489,7 +547,7 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("setting `private_dns_zone_group`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 }
 
 func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -510,13 +568,13 @@ func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Deleted the Private DNS Zone Group associated with Private Endpoint %q / Resource Group %q.", id.Name, id.ResourceGroup)
 
 	log.Printf("[DEBUG] Deleting the Private Endpoint %q / Resource Group %q..", id.Name, id.ResourceGroup)
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Delete(ctx, privateendpoints.NewPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
 		return fmt.Errorf("deleting Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
+	if err := future.Poller.PollUntilDone(); err != nil {
+		if !response.WasNotFound(future.Poller.HttpResponse) {
 			return fmt.Errorf("waiting for deletion of Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 		}
 	}
@@ -525,8 +583,8 @@ func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
-func expandPrivateLinkEndpointServiceConnection(input []interface{}, parseManual bool) *[]network.PrivateLinkServiceConnection {
-	results := make([]network.PrivateLinkServiceConnection, 0)
+func expandPrivateLinkEndpointServiceConnection(input []interface{}, parseManual bool) *[]privateendpoints.PrivateLinkServiceConnection {
+	results := make([]privateendpoints.PrivateLinkServiceConnection, 0)
 
 	for _, item := range input {
 		v := item.(map[string]interface{})
@@ -540,16 +598,16 @@ func expandPrivateLinkEndpointServiceConnection(input []interface{}, parseManual
 		name := v["name"].(string)
 
 		if isManual == parseManual {
-			result := network.PrivateLinkServiceConnection{
+			result := privateendpoints.PrivateLinkServiceConnection{
 				Name: utils.String(name),
-				PrivateLinkServiceConnectionProperties: &network.PrivateLinkServiceConnectionProperties{
+				Properties: &privateendpoints.PrivateLinkServiceConnectionProperties{
 					GroupIds:             utils.ExpandStringSlice(subresourceNames),
-					PrivateLinkServiceID: utils.String(privateConnectonResourceId),
+					PrivateLinkServiceId: utils.String(privateConnectonResourceId),
 				},
 			}
 
 			if requestMessage != "" {
-				result.PrivateLinkServiceConnectionProperties.RequestMessage = utils.String(requestMessage)
+				result.Properties.RequestMessage = utils.String(requestMessage)
 			}
 
 			results = append(results, result)
@@ -559,7 +617,7 @@ func expandPrivateLinkEndpointServiceConnection(input []interface{}, parseManual
 	return &results
 }
 
-func flattenCustomDnsConfigs(customDnsConfigs *[]network.CustomDNSConfigPropertiesFormat) []interface{} {
+func flattenCustomDnsConfigs(customDnsConfigs *[]privateendpoints.CustomDnsConfigPropertiesFormat) []interface{} {
 	results := make([]interface{}, 0)
 	if customDnsConfigs == nil {
 		return results
@@ -575,7 +633,7 @@ func flattenCustomDnsConfigs(customDnsConfigs *[]network.CustomDNSConfigProperti
 	return results
 }
 
-func flattenPrivateLinkEndpointServiceConnection(serviceConnections *[]network.PrivateLinkServiceConnection, manualServiceConnections *[]network.PrivateLinkServiceConnection, privateIPAddress string) []interface{} {
+func flattenPrivateLinkEndpointServiceConnection(serviceConnections *[]privateendpoints.PrivateLinkServiceConnection, manualServiceConnections *[]privateendpoints.PrivateLinkServiceConnection, privateIPAddress string) []interface{} {
 	results := make([]interface{}, 0)
 	if serviceConnections == nil && manualServiceConnections == nil {
 		return results
@@ -591,12 +649,12 @@ func flattenPrivateLinkEndpointServiceConnection(serviceConnections *[]network.P
 			privateConnectionId := ""
 			subResourceNames := make([]interface{}, 0)
 
-			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+			if props := item.Properties; props != nil {
 				if v := props.GroupIds; v != nil {
 					subResourceNames = utils.FlattenStringSlice(v)
 				}
-				if props.PrivateLinkServiceID != nil {
-					privateConnectionId = *props.PrivateLinkServiceID
+				if props.PrivateLinkServiceId != nil {
+					privateConnectionId = *props.PrivateLinkServiceId
 				}
 			}
 			attrs := map[string]interface{}{
@@ -648,12 +706,12 @@ func flattenPrivateLinkEndpointServiceConnection(serviceConnections *[]network.P
 			requestMessage := ""
 			subResourceNames := make([]interface{}, 0)
 
-			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+			if props := item.Properties; props != nil {
 				if v := props.GroupIds; v != nil {
 					subResourceNames = utils.FlattenStringSlice(v)
 				}
-				if props.PrivateLinkServiceID != nil {
-					privateConnectionId = *props.PrivateLinkServiceID
+				if props.PrivateLinkServiceId != nil {
+					privateConnectionId = *props.PrivateLinkServiceId
 				}
 				if props.RequestMessage != nil {
 					requestMessage = *props.RequestMessage
@@ -697,6 +755,102 @@ func flattenPrivateLinkEndpointServiceConnection(serviceConnections *[]network.P
 	return results
 }
 
+func expandPrivateEndpointApplicationSecurityGroups(input []interface{}) *[]privateendpoints.ApplicationSecurityGroup {
+	results := make([]privateendpoints.ApplicationSecurityGroup, 0)
+
+	for _, item := range input {
+		results = append(results, privateendpoints.ApplicationSecurityGroup{
+			Id: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenPrivateEndpointApplicationSecurityGroups(input *[]privateendpoints.ApplicationSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Id == nil {
+			continue
+		}
+
+		if id, err := parse.ApplicationSecurityGroupID(*item.Id); err == nil {
+			results = append(results, id.ID())
+		}
+	}
+
+	return results
+}
+
+func expandPrivateEndpointIPConfigurations(input []interface{}) *[]privateendpoints.PrivateEndpointIPConfiguration {
+	results := make([]privateendpoints.PrivateEndpointIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		result := privateendpoints.PrivateEndpointIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			Properties: &privateendpoints.PrivateEndpointIPConfigurationProperties{
+				GroupId:          utils.String(v["subresource_name"].(string)),
+				PrivateIPAddress: utils.String(v["private_ip_address"].(string)),
+			},
+		}
+
+		// the member name defaults to the sub-resource name for services which only expose a single member
+		memberName := v["member_name"].(string)
+		if memberName == "" {
+			memberName = v["subresource_name"].(string)
+		}
+		result.Properties.MemberName = utils.String(memberName)
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenPrivateEndpointIPConfigurations(input *[]privateendpoints.PrivateEndpointIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateIpAddress := ""
+		subresourceName := ""
+		memberName := ""
+		if props := item.Properties; props != nil {
+			if props.PrivateIPAddress != nil {
+				privateIpAddress = *props.PrivateIPAddress
+			}
+			if props.GroupId != nil {
+				subresourceName = *props.GroupId
+			}
+			if props.MemberName != nil {
+				memberName = *props.MemberName
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"private_ip_address": privateIpAddress,
+			"subresource_name":   subresourceName,
+			"member_name":        memberName,
+		})
+	}
+
+	return results
+}
+
 func createPrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId, inputRaw []interface{}) error {
 	if len(inputRaw) != 1 {
 		return fmt.Errorf("expected a single Private DNS Zone Groups but got %d", len(inputRaw))
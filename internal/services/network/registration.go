@@ -24,6 +24,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
 		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
 		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
+		"azurerm_express_route_circuit_authorization":       dataSourceExpressRouteCircuitAuthorization(),
 		"azurerm_ip_group":                                  dataSourceIpGroup(),
 		"azurerm_nat_gateway":                               dataSourceNatGateway(),
 		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
@@ -79,6 +80,15 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_interface_nat_rule_association":                                 resourceNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_interface_security_group_association":                           resourceNetworkInterfaceSecurityGroupAssociation(),
 
+		"azurerm_network_manager":                              resourceNetworkManager(),
+		"azurerm_network_manager_admin_rule":                   resourceNetworkManagerAdminRule(),
+		"azurerm_network_manager_admin_rule_collection":        resourceNetworkManagerAdminRuleCollection(),
+		"azurerm_network_manager_connectivity_configuration":   resourceNetworkManagerConnectivityConfiguration(),
+		"azurerm_network_manager_deployment":                   resourceNetworkManagerDeployment(),
+		"azurerm_network_manager_network_group":                resourceNetworkManagerNetworkGroup(),
+		"azurerm_network_manager_security_admin_configuration": resourceNetworkManagerSecurityAdminConfiguration(),
+		"azurerm_network_manager_static_member":                resourceNetworkManagerStaticMember(),
+
 		"azurerm_network_packet_capture":                    resourceNetworkPacketCapture(),
 		"azurerm_network_profile":                           resourceNetworkProfile(),
 		"azurerm_packet_capture":                            resourcePacketCapture(),
@@ -92,6 +102,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_watcher_flow_log":                  resourceNetworkWatcherFlowLog(),
 		"azurerm_network_watcher":                           resourceNetworkWatcher(),
 		"azurerm_route_filter":                              resourceRouteFilter(),
+		"azurerm_route_map":                                 resourceRouteMap(),
+		"azurerm_route_server":                              resourceRouteServer(),
+		"azurerm_route_server_bgp_connection":               resourceRouteServerBgpConnection(),
 		"azurerm_route_table":                               resourceRouteTable(),
 		"azurerm_route":                                     resourceRoute(),
 		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
@@ -102,6 +115,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_subnet":                                    resourceSubnet(),
 		"azurerm_virtual_hub":                               resourceVirtualHub(),
 		"azurerm_virtual_hub_bgp_connection":                resourceVirtualHubBgpConnection(),
+		"azurerm_virtual_hub_routing_intent":                resourceVirtualHubRoutingIntent(),
 		"azurerm_virtual_hub_connection":                    resourceVirtualHubConnection(),
 		"azurerm_virtual_hub_ip":                            resourceVirtualHubIP(),
 		"azurerm_virtual_hub_route_table":                   resourceVirtualHubRouteTable(),
@@ -109,11 +123,13 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_nat_rule":          resourceVirtualNetworkGatewayNatRule(),
 		"azurerm_virtual_network_peering":                   resourceVirtualNetworkPeering(),
 		"azurerm_virtual_network":                           resourceVirtualNetwork(),
 		"azurerm_virtual_wan":                               resourceVirtualWan(),
 		"azurerm_vpn_gateway":                               resourceVPNGateway(),
 		"azurerm_vpn_gateway_connection":                    resourceVPNGatewayConnection(),
+		"azurerm_vpn_gateway_nat_rule":                      resourceVPNGatewayNatRule(),
 		"azurerm_vpn_server_configuration":                  resourceVPNServerConfiguration(),
 		"azurerm_vpn_site":                                  resourceVpnSite(),
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
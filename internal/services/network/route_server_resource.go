@@ -0,0 +1,332 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Route Server is a Virtual Hub with a single IP Configuration, which can't be renamed
+const routeServerIpConfigurationName = "ipconfig1"
+
+func resourceRouteServer() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRouteServerCreate,
+		Read:   resourceRouteServerRead,
+		Update: resourceRouteServerUpdate,
+		Delete: resourceRouteServerDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RouteServerID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"sku": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Standard",
+				}, false),
+			},
+
+			"public_ip_address_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PublicIpAddressID,
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SubnetID,
+			},
+
+			"branch_to_branch_traffic_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"routing_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_router_asn": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"virtual_router_ips": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceRouteServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ipClient := meta.(*clients.Client).Network.VirtualHubIPClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if _, ok := ctx.Deadline(); !ok {
+		return fmt.Errorf("deadline is not properly set for Route Server")
+	}
+
+	id := parse.NewRouteServerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_route_server", id.ID())
+	}
+
+	parameters := network.VirtualHub{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualHubProperties: &network.VirtualHubProperties{
+			Sku:                        utils.String(d.Get("sku").(string)),
+			AllowBranchToBranchTraffic: utils.Bool(d.Get("branch_to_branch_traffic_enabled").(bool)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	ipConfiguration := network.HubIPConfiguration{
+		Name: utils.String(routeServerIpConfigurationName),
+		HubIPConfigurationPropertiesFormat: &network.HubIPConfigurationPropertiesFormat{
+			PublicIPAddress: &network.PublicIPAddress{
+				ID: utils.String(d.Get("public_ip_address_id").(string)),
+			},
+			Subnet: &network.Subnet{
+				ID: utils.String(d.Get("subnet_id").(string)),
+			},
+		},
+	}
+
+	ipFuture, err := ipClient.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, routeServerIpConfigurationName, ipConfiguration)
+	if err != nil {
+		return fmt.Errorf("creating IP Configuration for %s: %+v", id, err)
+	}
+
+	if err := ipFuture.WaitForCompletionRef(ctx, ipClient.Client); err != nil {
+		return fmt.Errorf("waiting for creation of IP Configuration for %s: %+v", id, err)
+	}
+
+	// the routing state is still "Provisioning" once the IP Configuration has been created,
+	// BGP Connections can only be added once it's "Provisioned"
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Provisioning"},
+		Target:                    []string{"Provisioned", "Failed", "None"},
+		Refresh:                   virtualHubCreateRefreshFunc(ctx, client, id.ResourceGroup, id.VirtualHubName),
+		PollInterval:              15 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the routing state of %s to be provisioned: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceRouteServerRead(d, meta)
+}
+
+func resourceRouteServerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ipClient := meta.(*clients.Client).Network.VirtualHubIPClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VirtualHubName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.VirtualHubProperties; props != nil {
+		d.Set("sku", props.Sku)
+		d.Set("branch_to_branch_traffic_enabled", props.AllowBranchToBranchTraffic)
+		d.Set("routing_state", string(props.RoutingState))
+
+		virtualRouterAsn := 0
+		if props.VirtualRouterAsn != nil {
+			virtualRouterAsn = int(*props.VirtualRouterAsn)
+		}
+		d.Set("virtual_router_asn", virtualRouterAsn)
+
+		if err := d.Set("virtual_router_ips", utils.FlattenStringSlice(props.VirtualRouterIps)); err != nil {
+			return fmt.Errorf("setting `virtual_router_ips`: %+v", err)
+		}
+	}
+
+	ipResp, err := ipClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, routeServerIpConfigurationName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(ipResp.Response) {
+			return fmt.Errorf("retrieving IP Configuration for %s: %+v", *id, err)
+		}
+	}
+
+	if props := ipResp.HubIPConfigurationPropertiesFormat; props != nil {
+		if props.PublicIPAddress != nil {
+			d.Set("public_ip_address_id", props.PublicIPAddress.ID)
+		}
+
+		if props.Subnet != nil {
+			d.Set("subnet_id", props.Subnet.ID)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceRouteServerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if existing.VirtualHubProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	if d.HasChange("branch_to_branch_traffic_enabled") {
+		existing.VirtualHubProperties.AllowBranchToBranchTraffic = utils.Bool(d.Get("branch_to_branch_traffic_enabled").(bool))
+	}
+
+	if d.HasChange("tags") {
+		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, existing)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	}
+
+	return resourceRouteServerRead(d, meta)
+}
+
+func resourceRouteServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ipClient := meta.(*clients.Client).Network.VirtualHubIPClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	// the IP Configuration has to be removed prior to deleting the Route Server
+	ipFuture, err := ipClient.Delete(ctx, id.ResourceGroup, id.VirtualHubName, routeServerIpConfigurationName)
+	if err != nil {
+		return fmt.Errorf("deleting IP Configuration for %s: %+v", *id, err)
+	}
+
+	if err := ipFuture.WaitForCompletionRef(ctx, ipClient.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of IP Configuration for %s: %+v", *id, err)
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
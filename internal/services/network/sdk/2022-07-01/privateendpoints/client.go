@@ -0,0 +1,15 @@
+package privateendpoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrivateEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrivateEndpointsClientWithBaseURI(endpoint string) PrivateEndpointsClient {
+	return PrivateEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,5 @@
+package privateendpoints
+
+type ApplicationSecurityGroup struct {
+	Id *string `json:"id,omitempty"`
+}
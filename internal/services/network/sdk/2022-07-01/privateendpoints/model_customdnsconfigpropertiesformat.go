@@ -0,0 +1,6 @@
+package privateendpoints
+
+type CustomDnsConfigPropertiesFormat struct {
+	Fqdn        *string   `json:"fqdn,omitempty"`
+	IPAddresses *[]string `json:"ipAddresses,omitempty"`
+}
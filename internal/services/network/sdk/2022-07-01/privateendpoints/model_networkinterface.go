@@ -0,0 +1,5 @@
+package privateendpoints
+
+type NetworkInterface struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,8 @@
+package privateendpoints
+
+type PrivateEndpointIPConfiguration struct {
+	Etag       *string                                   `json:"etag,omitempty"`
+	Name       *string                                   `json:"name,omitempty"`
+	Properties *PrivateEndpointIPConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                                   `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package privateendpoints
+
+type PrivateEndpointIPConfigurationProperties struct {
+	GroupId          *string `json:"groupId,omitempty"`
+	MemberName       *string `json:"memberName,omitempty"`
+	PrivateIPAddress *string `json:"privateIPAddress,omitempty"`
+}
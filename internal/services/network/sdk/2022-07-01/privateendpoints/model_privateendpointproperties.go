@@ -0,0 +1,13 @@
+package privateendpoints
+
+type PrivateEndpointProperties struct {
+	ApplicationSecurityGroups           *[]ApplicationSecurityGroup        `json:"applicationSecurityGroups,omitempty"`
+	CustomDnsConfigs                    *[]CustomDnsConfigPropertiesFormat `json:"customDnsConfigs,omitempty"`
+	CustomNetworkInterfaceName          *string                            `json:"customNetworkInterfaceName,omitempty"`
+	IPConfigurations                    *[]PrivateEndpointIPConfiguration  `json:"ipConfigurations,omitempty"`
+	ManualPrivateLinkServiceConnections *[]PrivateLinkServiceConnection    `json:"manualPrivateLinkServiceConnections,omitempty"`
+	NetworkInterfaces                   *[]NetworkInterface                `json:"networkInterfaces,omitempty"`
+	PrivateLinkServiceConnections       *[]PrivateLinkServiceConnection    `json:"privateLinkServiceConnections,omitempty"`
+	ProvisioningState                   *ProvisioningState                 `json:"provisioningState,omitempty"`
+	Subnet                              *Subnet                            `json:"subnet,omitempty"`
+}
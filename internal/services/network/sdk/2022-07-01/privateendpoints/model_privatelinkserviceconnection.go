@@ -0,0 +1,9 @@
+package privateendpoints
+
+type PrivateLinkServiceConnection struct {
+	Etag       *string                                 `json:"etag,omitempty"`
+	Id         *string                                 `json:"id,omitempty"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties *PrivateLinkServiceConnectionProperties `json:"properties,omitempty"`
+	Type       *string                                 `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package privateendpoints
+
+type PrivateLinkServiceConnectionProperties struct {
+	GroupIds                          *[]string                          `json:"groupIds,omitempty"`
+	PrivateLinkServiceConnectionState *PrivateLinkServiceConnectionState `json:"privateLinkServiceConnectionState,omitempty"`
+	PrivateLinkServiceId              *string                            `json:"privateLinkServiceId,omitempty"`
+	ProvisioningState                 *ProvisioningState                 `json:"provisioningState,omitempty"`
+	RequestMessage                    *string                            `json:"requestMessage,omitempty"`
+}
@@ -0,0 +1,5 @@
+package privateendpoints
+
+type Subnet struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,9 @@
+package privateendpoints
+
+import "fmt"
+
+const defaultApiVersion = "2022-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/privateendpoints/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package routingintents
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoutingIntentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoutingIntentsClientWithBaseURI(endpoint string) RoutingIntentsClient {
+	return RoutingIntentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package routingintents
+
+type RoutingIntentProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	RoutingPolicies   *[]RoutingPolicy   `json:"routingPolicies,omitempty"`
+}
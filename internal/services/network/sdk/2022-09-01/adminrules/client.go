@@ -0,0 +1,15 @@
+package adminrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AdminRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAdminRulesClientWithBaseURI(endpoint string) AdminRulesClient {
+	return AdminRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
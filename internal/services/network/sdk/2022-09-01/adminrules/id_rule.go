@@ -0,0 +1,163 @@
+package adminrules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RuleId{}
+
+// RuleId is a struct representing the Resource ID for a Rule
+type RuleId struct {
+	SubscriptionId                 string
+	ResourceGroupName              string
+	NetworkManagerName             string
+	SecurityAdminConfigurationName string
+	RuleCollectionName             string
+	RuleName                       string
+}
+
+// NewRuleID returns a new RuleId struct
+func NewRuleID(subscriptionId string, resourceGroupName string, networkManagerName string, securityAdminConfigurationName string, ruleCollectionName string, ruleName string) RuleId {
+	return RuleId{
+		SubscriptionId:                 subscriptionId,
+		ResourceGroupName:              resourceGroupName,
+		NetworkManagerName:             networkManagerName,
+		SecurityAdminConfigurationName: securityAdminConfigurationName,
+		RuleCollectionName:             ruleCollectionName,
+		RuleName:                       ruleName,
+	}
+}
+
+// ParseRuleID parses 'input' into a RuleId
+func ParseRuleID(input string) (*RuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RuleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkManagerName, ok = parsed.Parsed["networkManagerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkManagerName' was not found in the resource id %q", input)
+	}
+
+	if id.SecurityAdminConfigurationName, ok = parsed.Parsed["securityAdminConfigurationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'securityAdminConfigurationName' was not found in the resource id %q", input)
+	}
+
+	if id.RuleCollectionName, ok = parsed.Parsed["ruleCollectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'ruleCollectionName' was not found in the resource id %q", input)
+	}
+
+	if id.RuleName, ok = parsed.Parsed["ruleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'ruleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRuleIDInsensitively parses 'input' case-insensitively into a RuleId
+// note: this method should only be used for API response data and not user input
+func ParseRuleIDInsensitively(input string) (*RuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RuleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkManagerName, ok = parsed.Parsed["networkManagerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkManagerName' was not found in the resource id %q", input)
+	}
+
+	if id.SecurityAdminConfigurationName, ok = parsed.Parsed["securityAdminConfigurationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'securityAdminConfigurationName' was not found in the resource id %q", input)
+	}
+
+	if id.RuleCollectionName, ok = parsed.Parsed["ruleCollectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'ruleCollectionName' was not found in the resource id %q", input)
+	}
+
+	if id.RuleName, ok = parsed.Parsed["ruleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'ruleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRuleID checks that 'input' can be parsed as a Rule ID
+func ValidateRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Rule ID
+func (id RuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/securityAdminConfigurations/%s/ruleCollections/%s/rules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName, id.RuleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Rule ID
+func (id RuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticNetworkManagers", "networkManagers", "networkManagers"),
+		resourceids.UserSpecifiedSegment("networkManagerName", "networkManagerValue"),
+		resourceids.StaticSegment("staticSecurityAdminConfigurations", "securityAdminConfigurations", "securityAdminConfigurations"),
+		resourceids.UserSpecifiedSegment("securityAdminConfigurationName", "securityAdminConfigurationValue"),
+		resourceids.StaticSegment("staticRuleCollections", "ruleCollections", "ruleCollections"),
+		resourceids.UserSpecifiedSegment("ruleCollectionName", "ruleCollectionValue"),
+		resourceids.StaticSegment("staticRules", "rules", "rules"),
+		resourceids.UserSpecifiedSegment("ruleName", "ruleValue"),
+	}
+}
+
+// String returns a human-readable description of this Rule ID
+func (id RuleId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Network Manager Name: %q", id.NetworkManagerName),
+		fmt.Sprintf("Security Admin Configuration Name: %q", id.SecurityAdminConfigurationName),
+		fmt.Sprintf("Rule Collection Name: %q", id.RuleCollectionName),
+		fmt.Sprintf("Rule Name: %q", id.RuleName),
+	}
+	return fmt.Sprintf("Rule (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,6 @@
+package connectivityconfigurations
+
+type Hub struct {
+	ResourceId   *string `json:"resourceId,omitempty"`
+	ResourceType *string `json:"resourceType,omitempty"`
+}
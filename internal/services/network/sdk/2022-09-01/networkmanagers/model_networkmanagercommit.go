@@ -0,0 +1,8 @@
+package networkmanagers
+
+type NetworkManagerCommit struct {
+	CommitId         *string           `json:"commitId,omitempty"`
+	CommitType       ConfigurationType `json:"commitType"`
+	ConfigurationIds *[]string         `json:"configurationIds,omitempty"`
+	TargetLocations  []string          `json:"targetLocations"`
+}
@@ -0,0 +1,7 @@
+package networkmanagers
+
+type NetworkManagerDeploymentStatusParameter struct {
+	DeploymentTypes *[]ConfigurationType `json:"deploymentTypes,omitempty"`
+	Regions         *[]string            `json:"regions,omitempty"`
+	SkipToken       *string              `json:"skipToken,omitempty"`
+}
@@ -0,0 +1,68 @@
+package securityadminconfigurations
+
+import "strings"
+
+type NetworkIntentPolicyBasedService string
+
+const (
+	NetworkIntentPolicyBasedServiceAll            NetworkIntentPolicyBasedService = "All"
+	NetworkIntentPolicyBasedServiceAllowRulesOnly NetworkIntentPolicyBasedService = "AllowRulesOnly"
+	NetworkIntentPolicyBasedServiceNone           NetworkIntentPolicyBasedService = "None"
+)
+
+func PossibleValuesForNetworkIntentPolicyBasedService() []string {
+	return []string{
+		string(NetworkIntentPolicyBasedServiceAll),
+		string(NetworkIntentPolicyBasedServiceAllowRulesOnly),
+		string(NetworkIntentPolicyBasedServiceNone),
+	}
+}
+
+func parseNetworkIntentPolicyBasedService(input string) (*NetworkIntentPolicyBasedService, error) {
+	vals := map[string]NetworkIntentPolicyBasedService{
+		"all":            NetworkIntentPolicyBasedServiceAll,
+		"allowrulesonly": NetworkIntentPolicyBasedServiceAllowRulesOnly,
+		"none":           NetworkIntentPolicyBasedServiceNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NetworkIntentPolicyBasedService(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
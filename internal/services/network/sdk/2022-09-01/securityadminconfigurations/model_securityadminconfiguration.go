@@ -0,0 +1,9 @@
+package securityadminconfigurations
+
+type SecurityAdminConfiguration struct {
+	Etag       *string                                     `json:"etag,omitempty"`
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *SecurityAdminConfigurationPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
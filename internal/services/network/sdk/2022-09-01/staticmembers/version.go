@@ -0,0 +1,9 @@
+package staticmembers
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/staticmembers/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package bastionhosts
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BastionHostsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBastionHostsClientWithBaseURI(endpoint string) BastionHostsClient {
+	return BastionHostsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package bastionhosts
+
+type BastionHostIPConfigurationPropertiesFormat struct {
+	PrivateIPAllocationMethod *IPAllocationMethod `json:"privateIPAllocationMethod,omitempty"`
+	ProvisioningState         *ProvisioningState  `json:"provisioningState,omitempty"`
+	PublicIPAddress           SubResource         `json:"publicIPAddress"`
+	Subnet                    SubResource         `json:"subnet"`
+}
@@ -0,0 +1,14 @@
+package bastionhosts
+
+type BastionHostPropertiesFormat struct {
+	DisableCopyPaste    *bool                         `json:"disableCopyPaste,omitempty"`
+	DnsName             *string                       `json:"dnsName,omitempty"`
+	EnableFileCopy      *bool                         `json:"enableFileCopy,omitempty"`
+	EnableIPConnect     *bool                         `json:"enableIpConnect,omitempty"`
+	EnableKerberos      *bool                         `json:"enableKerberos,omitempty"`
+	EnableShareableLink *bool                         `json:"enableShareableLink,omitempty"`
+	EnableTunneling     *bool                         `json:"enableTunneling,omitempty"`
+	IPConfigurations    *[]BastionHostIPConfiguration `json:"ipConfigurations,omitempty"`
+	ProvisioningState   *ProvisioningState            `json:"provisioningState,omitempty"`
+	ScaleUnits          *int64                        `json:"scaleUnits,omitempty"`
+}
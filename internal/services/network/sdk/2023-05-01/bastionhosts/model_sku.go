@@ -0,0 +1,5 @@
+package bastionhosts
+
+type Sku struct {
+	Name *BastionHostSkuName `json:"name,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webapplicationfirewallpolicies
+
+type ExclusionManagedRuleGroup struct {
+	RuleGroupName string                  `json:"ruleGroupName"`
+	Rules         *[]ExclusionManagedRule `json:"rules,omitempty"`
+}
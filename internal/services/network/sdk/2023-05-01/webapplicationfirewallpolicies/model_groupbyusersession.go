@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type GroupByUserSession struct {
+	GroupByVariables []GroupByVariable `json:"groupByVariables"`
+}
@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type GroupByVariable struct {
+	VariableName ApplicationGatewayFirewallUserSessionVariable `json:"variableName"`
+}
@@ -0,0 +1,6 @@
+package webapplicationfirewallpolicies
+
+type ManagedRulesDefinition struct {
+	Exclusions      *[]OwaspCrsExclusionEntry `json:"exclusions,omitempty"`
+	ManagedRuleSets []ManagedRuleSet          `json:"managedRuleSets"`
+}
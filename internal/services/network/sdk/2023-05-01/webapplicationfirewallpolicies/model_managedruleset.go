@@ -0,0 +1,7 @@
+package webapplicationfirewallpolicies
+
+type ManagedRuleSet struct {
+	RuleGroupOverrides *[]ManagedRuleGroupOverride `json:"ruleGroupOverrides,omitempty"`
+	RuleSetType        string                      `json:"ruleSetType"`
+	RuleSetVersion     string                      `json:"ruleSetVersion"`
+}
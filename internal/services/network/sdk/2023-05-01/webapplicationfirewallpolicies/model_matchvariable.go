@@ -0,0 +1,6 @@
+package webapplicationfirewallpolicies
+
+type MatchVariable struct {
+	Selector     *string                             `json:"selector,omitempty"`
+	VariableName WebApplicationFirewallMatchVariable `json:"variableName"`
+}
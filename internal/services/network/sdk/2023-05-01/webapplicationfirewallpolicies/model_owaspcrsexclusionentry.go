@@ -0,0 +1,8 @@
+package webapplicationfirewallpolicies
+
+type OwaspCrsExclusionEntry struct {
+	ExclusionManagedRuleSets *[]ExclusionManagedRuleSet                  `json:"exclusionManagedRuleSets,omitempty"`
+	MatchVariable            OwaspCrsExclusionEntryMatchVariable         `json:"matchVariable"`
+	Selector                 string                                      `json:"selector"`
+	SelectorMatchOperator    OwaspCrsExclusionEntrySelectorMatchOperator `json:"selectorMatchOperator"`
+}
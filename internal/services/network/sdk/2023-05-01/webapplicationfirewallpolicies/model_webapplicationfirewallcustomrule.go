@@ -0,0 +1,14 @@
+package webapplicationfirewallpolicies
+
+type WebApplicationFirewallCustomRule struct {
+	Action             WebApplicationFirewallAction                 `json:"action"`
+	Etag               *string                                      `json:"etag,omitempty"`
+	GroupByUserSession *[]GroupByUserSession                        `json:"groupByUserSession,omitempty"`
+	MatchConditions    []MatchCondition                             `json:"matchConditions"`
+	Name               *string                                      `json:"name,omitempty"`
+	Priority           int64                                        `json:"priority"`
+	RateLimitDuration  *ApplicationGatewayFirewallRateLimitDuration `json:"rateLimitDuration,omitempty"`
+	RateLimitThreshold *int64                                       `json:"rateLimitThreshold,omitempty"`
+	RuleType           WebApplicationFirewallRuleType               `json:"ruleType"`
+	State              *WebApplicationFirewallState                 `json:"state,omitempty"`
+}
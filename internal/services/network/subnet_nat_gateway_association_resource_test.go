@@ -92,6 +92,23 @@ func TestAccAzureRMSubnetNatGatewayAssociation_updateSubnet(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSubnetNatGatewayAssociation_multipleSubnets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet_nat_gateway_association", "test")
+	r := SubnetNatGatewayAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		// intentional since this is a virtual resource
+		{
+			Config: r.multipleSubnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_subnet_nat_gateway_association.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t SubnetNatGatewayAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SubnetID(state.ID)
 	if err != nil {
@@ -198,6 +215,48 @@ resource "azurerm_subnet_nat_gateway_association" "test" {
 `, r.template(data))
 }
 
+func (r SubnetNatGatewayAssociationResource) multipleSubnets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctestpipp-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  prefix_length       = 30
+}
+
+resource "azurerm_nat_gateway_public_ip_prefix_association" "test" {
+  nat_gateway_id      = azurerm_nat_gateway.test.id
+  public_ip_prefix_id = azurerm_public_ip_prefix.test.id
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.3.0/24"
+}
+
+resource "azurerm_subnet_nat_gateway_association" "test" {
+  subnet_id      = azurerm_subnet.test.id
+  nat_gateway_id = azurerm_nat_gateway.test.id
+}
+
+resource "azurerm_subnet_nat_gateway_association" "second" {
+  subnet_id      = azurerm_subnet.second.id
+  nat_gateway_id = azurerm_nat_gateway.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (SubnetNatGatewayAssociationResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -5,6 +5,10 @@ import (
 )
 
 var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice([]string{
+	"BadBots",
+	"GoodBots",
+	"KnownBadBots",
+	"UnknownBots",
 	"crs_20_protocol_violations",
 	"crs_21_protocol_anomalies",
 	"crs_23_request_limits",
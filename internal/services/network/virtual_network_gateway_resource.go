@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
@@ -85,6 +86,12 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"bgp_route_translation_for_nat_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"active_active": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -235,6 +242,37 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 							Set: hashVirtualNetworkGatewayRevokedCert,
 						},
 
+						"radius_server": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ConflictsWith: []string{
+								"vpn_client_configuration.0.radius_server_address",
+								"vpn_client_configuration.0.radius_server_secret",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"address": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsIPv4Address,
+									},
+
+									"secret": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"score": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 30),
+									},
+								},
+							},
+						},
+
 						"radius_server_address": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
@@ -473,6 +511,7 @@ func resourceVirtualNetworkGatewayRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("type", string(gw.GatewayType))
 		d.Set("enable_bgp", gw.EnableBgp)
 		d.Set("private_ip_address_enabled", gw.EnablePrivateIPAddress)
+		d.Set("bgp_route_translation_for_nat_enabled", gw.EnableBgpRouteTranslationForNat)
 		d.Set("active_active", gw.ActiveActive)
 		d.Set("generation", string(gw.VpnGatewayGeneration))
 
@@ -544,15 +583,16 @@ func getVirtualNetworkGatewayProperties(id parse.VirtualNetworkGatewayId, d *plu
 	customRoute := d.Get("custom_route").([]interface{})
 
 	props := &network.VirtualNetworkGatewayPropertiesFormat{
-		GatewayType:            gatewayType,
-		VpnType:                vpnType,
-		EnableBgp:              &enableBgp,
-		EnablePrivateIPAddress: &enablePrivateIpAddress,
-		ActiveActive:           &activeActive,
-		VpnGatewayGeneration:   generation,
-		Sku:                    expandVirtualNetworkGatewaySku(d),
-		IPConfigurations:       expandVirtualNetworkGatewayIPConfigurations(d),
-		CustomRoutes:           expandVirtualNetworkGatewayAddressSpace(customRoute),
+		GatewayType:                     gatewayType,
+		VpnType:                         vpnType,
+		EnableBgp:                       &enableBgp,
+		EnablePrivateIPAddress:          &enablePrivateIpAddress,
+		EnableBgpRouteTranslationForNat: utils.Bool(d.Get("bgp_route_translation_for_nat_enabled").(bool)),
+		ActiveActive:                    &activeActive,
+		VpnGatewayGeneration:            generation,
+		Sku:                             expandVirtualNetworkGatewaySku(d),
+		IPConfigurations:                expandVirtualNetworkGatewayIPConfigurations(d),
+		CustomRoutes:                    expandVirtualNetworkGatewayAddressSpace(customRoute),
 	}
 
 	if gatewayDefaultSiteID := d.Get("default_local_network_gateway_id").(string); gatewayDefaultSiteID != "" {
@@ -563,6 +603,10 @@ func getVirtualNetworkGatewayProperties(id parse.VirtualNetworkGatewayId, d *plu
 
 	if _, ok := d.GetOk("vpn_client_configuration"); ok {
 		props.VpnClientConfiguration = expandVirtualNetworkGatewayVpnClientConfig(d)
+
+		if err := validateVirtualNetworkGatewayVpnClientConfigAAD(props.VpnClientConfiguration); err != nil {
+			return nil, err
+		}
 	}
 
 	if _, ok := d.GetOk("bgp_settings"); ok {
@@ -784,10 +828,26 @@ func expandVirtualNetworkGatewayVpnClientConfig(d *pluginsdk.ResourceData) *netw
 		VpnClientProtocols:           &vpnClientProtocols,
 		RadiusServerAddress:          &confRadiusServerAddress,
 		RadiusServerSecret:           &confRadiusServerSecret,
+		RadiusServers:                expandVirtualNetworkGatewayRadiusServers(conf["radius_server"].([]interface{})),
 		VpnAuthenticationTypes:       &vpnAuthTypes,
 	}
 }
 
+func expandVirtualNetworkGatewayRadiusServers(input []interface{}) *[]network.RadiusServer {
+	results := make([]network.RadiusServer, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, network.RadiusServer{
+			RadiusServerAddress: utils.String(v["address"].(string)),
+			RadiusServerSecret:  utils.String(v["secret"].(string)),
+			RadiusServerScore:   utils.Int64(int64(v["score"].(int))),
+		})
+	}
+
+	return &results
+}
+
 func expandVirtualNetworkGatewaySku(d *pluginsdk.ResourceData) *network.VirtualNetworkGatewaySku {
 	sku := d.Get("sku").(string)
 
@@ -967,9 +1027,43 @@ func flattenVirtualNetworkGatewayVpnClientConfig(cfg *network.VpnClientConfigura
 		flat["radius_server_secret"] = *v
 	}
 
+	flat["radius_server"] = flattenVirtualNetworkGatewayRadiusServers(cfg.RadiusServers)
+
 	return []interface{}{flat}
 }
 
+func flattenVirtualNetworkGatewayRadiusServers(input *[]network.RadiusServer) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		address := ""
+		if item.RadiusServerAddress != nil {
+			address = *item.RadiusServerAddress
+		}
+
+		secret := ""
+		if item.RadiusServerSecret != nil {
+			secret = *item.RadiusServerSecret
+		}
+
+		score := 0
+		if item.RadiusServerScore != nil {
+			score = int(*item.RadiusServerScore)
+		}
+
+		results = append(results, map[string]interface{}{
+			"address": address,
+			"secret":  secret,
+			"score":   score,
+		})
+	}
+
+	return results
+}
+
 func hashVirtualNetworkGatewayRootCert(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -990,6 +1084,34 @@ func hashVirtualNetworkGatewayRevokedCert(v interface{}) int {
 	return pluginsdk.HashString(buf.String())
 }
 
+// Azure Active Directory authentication is only supported for the OpenVPN protocol, other protocols
+// can only be enabled alongside it when an additional authentication type is configured
+func validateVirtualNetworkGatewayVpnClientConfigAAD(config *network.VpnClientConfiguration) error {
+	if config == nil || config.AadTenant == nil || *config.AadTenant == "" {
+		return nil
+	}
+
+	if config.VpnAuthenticationTypes != nil {
+		for _, authType := range *config.VpnAuthenticationTypes {
+			if authType != network.VpnAuthenticationTypeAAD {
+				return nil
+			}
+		}
+	}
+
+	if config.VpnClientProtocols == nil || len(*config.VpnClientProtocols) == 0 {
+		return nil
+	}
+
+	for _, protocol := range *config.VpnClientProtocols {
+		if !strings.EqualFold(string(protocol), string(network.VpnClientProtocolOpenVPN)) {
+			return fmt.Errorf("`vpn_client_protocols` must only contain `OpenVPN` when `aad_tenant` is specified, got %q", string(protocol))
+		}
+	}
+
+	return nil
+}
+
 func validateVirtualNetworkGatewayPolicyBasedVpnSku() pluginsdk.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		string(network.VirtualNetworkGatewaySkuTierBasic),
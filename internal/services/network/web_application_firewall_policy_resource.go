@@ -5,11 +5,12 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -25,7 +26,7 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 		Update: resourceWebApplicationFirewallPolicyCreateUpdate,
 		Delete: resourceWebApplicationFirewallPolicyDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(id)
+			_, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID(id)
 			return err
 		}),
 
@@ -54,13 +55,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"action": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallActionAllow),
-								string(network.WebApplicationFirewallActionBlock),
-								string(network.WebApplicationFirewallActionLog),
-							}, false),
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForWebApplicationFirewallAction(), false),
 						},
 						"match_conditions": {
 							Type:     pluginsdk.TypeList,
@@ -80,18 +77,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Elem: &pluginsdk.Resource{
 											Schema: map[string]*pluginsdk.Schema{
 												"variable_name": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(network.WebApplicationFirewallMatchVariableRemoteAddr),
-														string(network.WebApplicationFirewallMatchVariableRequestMethod),
-														string(network.WebApplicationFirewallMatchVariableQueryString),
-														string(network.WebApplicationFirewallMatchVariablePostArgs),
-														string(network.WebApplicationFirewallMatchVariableRequestURI),
-														string(network.WebApplicationFirewallMatchVariableRequestHeaders),
-														string(network.WebApplicationFirewallMatchVariableRequestBody),
-														string(network.WebApplicationFirewallMatchVariableRequestCookies),
-													}, false),
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForWebApplicationFirewallMatchVariable(), false),
 												},
 												"selector": {
 													Type:     pluginsdk.TypeString,
@@ -101,21 +89,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										},
 									},
 									"operator": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.WebApplicationFirewallOperatorIPMatch),
-											string(network.WebApplicationFirewallOperatorGeoMatch),
-											string(network.WebApplicationFirewallOperatorEqual),
-											string(network.WebApplicationFirewallOperatorContains),
-											string(network.WebApplicationFirewallOperatorLessThan),
-											string(network.WebApplicationFirewallOperatorGreaterThan),
-											string(network.WebApplicationFirewallOperatorLessThanOrEqual),
-											string(network.WebApplicationFirewallOperatorGreaterThanOrEqual),
-											string(network.WebApplicationFirewallOperatorBeginsWith),
-											string(network.WebApplicationFirewallOperatorEndsWith),
-											string(network.WebApplicationFirewallOperatorRegex),
-										}, false),
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForWebApplicationFirewallOperator(), false),
 									},
 									"negation_condition": {
 										Type:     pluginsdk.TypeBool,
@@ -125,15 +101,8 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeSet,
 										Optional: true,
 										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.WebApplicationFirewallTransformHTMLEntityDecode),
-												string(network.WebApplicationFirewallTransformLowercase),
-												string(network.WebApplicationFirewallTransformRemoveNulls),
-												string(network.WebApplicationFirewallTransformTrim),
-												string(network.WebApplicationFirewallTransformURLDecode),
-												string(network.WebApplicationFirewallTransformURLEncode),
-											}, false),
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForWebApplicationFirewallTransform(), false),
 										},
 									},
 								},
@@ -144,12 +113,24 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Required: true,
 						},
 						"rule_type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallRuleTypeMatchRule),
-								string(network.WebApplicationFirewallRuleTypeInvalid),
-							}, false),
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForWebApplicationFirewallRuleType(), false),
+						},
+						"rate_limit_duration": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForApplicationGatewayFirewallRateLimitDuration(), false),
+						},
+						"rate_limit_threshold": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"group_rate_limit_by": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForApplicationGatewayFirewallUserSessionVariable(), false),
 						},
 						"name": {
 							Type:     pluginsdk.TypeString,
@@ -171,13 +152,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"match_variable": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestArgNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestCookieNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestHeaderNames),
-										}, false),
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForOwaspCrsExclusionEntryMatchVariable(), false),
 									},
 									"selector": {
 										Type:         pluginsdk.TypeString,
@@ -185,15 +162,51 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										ValidateFunc: validation.NoZeroValues,
 									},
 									"selector_match_operator": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
-										}, false),
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForOwaspCrsExclusionEntrySelectorMatchOperator(), false),
+									},
+									"excluded_rule_set": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"type": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													Default:      "OWASP",
+													ValidateFunc: validate.ValidateWebApplicationFirewallPolicyRuleSetType,
+												},
+												"version": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													Default:      "3.2",
+													ValidateFunc: validate.ValidateWebApplicationFirewallPolicyRuleSetVersion,
+												},
+												"rule_group": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"rule_group_name": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validate.ValidateWebApplicationFirewallPolicyRuleGroupName,
+															},
+															"excluded_rules": {
+																Type:     pluginsdk.TypeList,
+																Optional: true,
+																Elem: &pluginsdk.Schema{
+																	Type:         pluginsdk.TypeString,
+																	ValidateFunc: validation.StringIsNotEmpty,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -253,13 +266,10 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Default:  true,
 						},
 						"mode": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallModePrevention),
-								string(network.WebApplicationFirewallModeDetection),
-							}, false),
-							Default: string(network.WebApplicationFirewallModePrevention),
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForWebApplicationFirewallMode(), false),
+							Default:      string(webapplicationfirewallpolicies.WebApplicationFirewallModePrevention),
 						},
 						"request_body_check": {
 							Type:     pluginsdk.TypeBool,
@@ -305,17 +315,17 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData,
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewApplicationGatewayWebApplicationFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := webapplicationfirewallpolicies.NewApplicationGatewayWebApplicationFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for present of existing %s: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(resp.Response) {
-			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", *resp.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", id.ID())
 		}
 	}
 
@@ -323,19 +333,18 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData,
 	customRules := d.Get("custom_rules").([]interface{})
 	policySettings := d.Get("policy_settings").([]interface{})
 	managedRules := d.Get("managed_rules").([]interface{})
-	t := d.Get("tags").(map[string]interface{})
 
-	parameters := network.WebApplicationFirewallPolicy{
+	parameters := webapplicationfirewallpolicies.WebApplicationFirewallPolicy{
 		Location: utils.String(location),
-		WebApplicationFirewallPolicyPropertiesFormat: &network.WebApplicationFirewallPolicyPropertiesFormat{
+		Properties: &webapplicationfirewallpolicies.WebApplicationFirewallPolicyPropertiesFormat{
 			CustomRules:    expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(customRules),
 			PolicySettings: expandWebApplicationFirewallPolicyPolicySettings(policySettings),
 			ManagedRules:   expandWebApplicationFirewallPolicyManagedRulesDefinition(managedRules),
 		},
-		Tags: tags.Expand(t),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -349,14 +358,14 @@ func resourceWebApplicationFirewallPolicyRead(d *pluginsdk.ResourceData, meta in
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
+	id, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Web Application Firewall Policy %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -364,30 +373,36 @@ func resourceWebApplicationFirewallPolicyRead(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("reading %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-	if webApplicationFirewallPolicyPropertiesFormat := resp.WebApplicationFirewallPolicyPropertiesFormat; webApplicationFirewallPolicyPropertiesFormat != nil {
-		if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(webApplicationFirewallPolicyPropertiesFormat.CustomRules)); err != nil {
-			return fmt.Errorf("setting `custom_rules`: %+v", err)
-		}
-		if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicyPolicySettings(webApplicationFirewallPolicyPropertiesFormat.PolicySettings)); err != nil {
-			return fmt.Errorf("setting `policy_settings`: %+v", err)
-		}
-		if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRulesDefinition(webApplicationFirewallPolicyPropertiesFormat.ManagedRules)); err != nil {
-			return fmt.Errorf("setting `managed_rules`: %+v", err)
-		}
-		if err := d.Set("http_listener_ids", flattenSubResourcesToIDs(webApplicationFirewallPolicyPropertiesFormat.HTTPListeners)); err != nil {
-			return fmt.Errorf("setting `http_listeners`: %+v", err)
+	d.Set("name", id.PolicyName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
 		}
-		if err := d.Set("path_based_rule_ids", flattenSubResourcesToIDs(webApplicationFirewallPolicyPropertiesFormat.PathBasedRules)); err != nil {
-			return fmt.Errorf("setting `path_based_rules`: %+v", err)
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(props.CustomRules)); err != nil {
+				return fmt.Errorf("setting `custom_rules`: %+v", err)
+			}
+			if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicyPolicySettings(props.PolicySettings)); err != nil {
+				return fmt.Errorf("setting `policy_settings`: %+v", err)
+			}
+			if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRulesDefinition(props.ManagedRules)); err != nil {
+				return fmt.Errorf("setting `managed_rules`: %+v", err)
+			}
+			if err := d.Set("http_listener_ids", flattenWebApplicationFirewallPolicySubResourcesToIDs(props.HTTPListeners)); err != nil {
+				return fmt.Errorf("setting `http_listeners`: %+v", err)
+			}
+			if err := d.Set("path_based_rule_ids", flattenWebApplicationFirewallPolicySubResourcesToIDs(props.PathBasedRules)); err != nil {
+				return fmt.Errorf("setting `path_based_rules`: %+v", err)
+			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceWebApplicationFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -395,25 +410,20 @@ func resourceWebApplicationFirewallPolicyDelete(d *pluginsdk.ResourceData, meta
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
+	id, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []interface{}) *[]network.WebApplicationFirewallCustomRule {
-	results := make([]network.WebApplicationFirewallCustomRule, 0)
+func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []interface{}) *[]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule {
+	results := make([]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		name := v["name"].(string)
@@ -422,12 +432,33 @@ func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []
 		matchConditions := v["match_conditions"].([]interface{})
 		action := v["action"].(string)
 
-		result := network.WebApplicationFirewallCustomRule{
-			Action:          network.WebApplicationFirewallAction(action),
+		result := webapplicationfirewallpolicies.WebApplicationFirewallCustomRule{
+			Action:          webapplicationfirewallpolicies.WebApplicationFirewallAction(action),
 			MatchConditions: expandWebApplicationFirewallPolicyMatchCondition(matchConditions),
 			Name:            utils.String(name),
-			Priority:        utils.Int32(int32(priority)),
-			RuleType:        network.WebApplicationFirewallRuleType(ruleType),
+			Priority:        int64(priority),
+			RuleType:        webapplicationfirewallpolicies.WebApplicationFirewallRuleType(ruleType),
+		}
+
+		if rateLimitDuration := v["rate_limit_duration"].(string); rateLimitDuration != "" {
+			duration := webapplicationfirewallpolicies.ApplicationGatewayFirewallRateLimitDuration(rateLimitDuration)
+			result.RateLimitDuration = &duration
+		}
+
+		if rateLimitThreshold := v["rate_limit_threshold"].(int); rateLimitThreshold > 0 {
+			result.RateLimitThreshold = utils.Int64(int64(rateLimitThreshold))
+		}
+
+		if groupBy := v["group_rate_limit_by"].(string); groupBy != "" {
+			result.GroupByUserSession = &[]webapplicationfirewallpolicies.GroupByUserSession{
+				{
+					GroupByVariables: []webapplicationfirewallpolicies.GroupByVariable{
+						{
+							VariableName: webapplicationfirewallpolicies.ApplicationGatewayFirewallUserSessionVariable(groupBy),
+						},
+					},
+				},
+			}
 		}
 
 		results = append(results, result)
@@ -435,59 +466,61 @@ func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyPolicySettings(input []interface{}) *network.PolicySettings {
+func expandWebApplicationFirewallPolicyPolicySettings(input []interface{}) *webapplicationfirewallpolicies.PolicySettings {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
 
-	enabled := network.WebApplicationFirewallEnabledStateDisabled
+	enabled := webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateDisabled
 	if value, ok := v["enabled"].(bool); ok && value {
-		enabled = network.WebApplicationFirewallEnabledStateEnabled
+		enabled = webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateEnabled
 	}
-	mode := v["mode"].(string)
+	mode := webapplicationfirewallpolicies.WebApplicationFirewallMode(v["mode"].(string))
 	requestBodyCheck := v["request_body_check"].(bool)
 	maxRequestBodySizeInKb := v["max_request_body_size_in_kb"].(int)
 	fileUploadLimitInMb := v["file_upload_limit_in_mb"].(int)
 
-	result := network.PolicySettings{
-		State:                  enabled,
-		Mode:                   network.WebApplicationFirewallMode(mode),
+	result := webapplicationfirewallpolicies.PolicySettings{
+		State:                  &enabled,
+		Mode:                   &mode,
 		RequestBodyCheck:       utils.Bool(requestBodyCheck),
-		MaxRequestBodySizeInKb: utils.Int32(int32(maxRequestBodySizeInKb)),
-		FileUploadLimitInMb:    utils.Int32(int32(fileUploadLimitInMb)),
+		MaxRequestBodySizeInKb: utils.Int64(int64(maxRequestBodySizeInKb)),
+		FileUploadLimitInMb:    utils.Int64(int64(fileUploadLimitInMb)),
 	}
 	return &result
 }
 
-func expandWebApplicationFirewallPolicyManagedRulesDefinition(input []interface{}) *network.ManagedRulesDefinition {
+func expandWebApplicationFirewallPolicyManagedRulesDefinition(input []interface{}) webapplicationfirewallpolicies.ManagedRulesDefinition {
 	if len(input) == 0 {
-		return nil
+		return webapplicationfirewallpolicies.ManagedRulesDefinition{}
 	}
 	v := input[0].(map[string]interface{})
 
 	exclusions := v["exclusion"].([]interface{})
 	managedRuleSets := v["managed_rule_set"].([]interface{})
 
-	return &network.ManagedRulesDefinition{
+	return webapplicationfirewallpolicies.ManagedRulesDefinition{
 		Exclusions:      expandWebApplicationFirewallPolicyExclusions(exclusions),
 		ManagedRuleSets: expandWebApplicationFirewallPolicyManagedRuleSet(managedRuleSets),
 	}
 }
 
-func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]network.OwaspCrsExclusionEntry {
-	results := make([]network.OwaspCrsExclusionEntry, 0)
+func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]webapplicationfirewallpolicies.OwaspCrsExclusionEntry {
+	results := make([]webapplicationfirewallpolicies.OwaspCrsExclusionEntry, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
 		matchVariable := v["match_variable"].(string)
 		selectorMatchOperator := v["selector_match_operator"].(string)
 		selector := v["selector"].(string)
+		exclusionManagedRuleSets := v["excluded_rule_set"].([]interface{})
 
-		result := network.OwaspCrsExclusionEntry{
-			MatchVariable:         network.OwaspCrsExclusionEntryMatchVariable(matchVariable),
-			SelectorMatchOperator: network.OwaspCrsExclusionEntrySelectorMatchOperator(selectorMatchOperator),
-			Selector:              utils.String(selector),
+		result := webapplicationfirewallpolicies.OwaspCrsExclusionEntry{
+			MatchVariable:            webapplicationfirewallpolicies.OwaspCrsExclusionEntryMatchVariable(matchVariable),
+			SelectorMatchOperator:    webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperator(selectorMatchOperator),
+			Selector:                 selector,
+			ExclusionManagedRuleSets: expandWebApplicationFirewallPolicyExclusionManagedRuleSets(exclusionManagedRuleSets),
 		}
 
 		results = append(results, result)
@@ -495,8 +528,39 @@ func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]networ
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) *[]network.ManagedRuleSet {
-	results := make([]network.ManagedRuleSet, 0)
+func expandWebApplicationFirewallPolicyExclusionManagedRuleSets(input []interface{}) *[]webapplicationfirewallpolicies.ExclusionManagedRuleSet {
+	results := make([]webapplicationfirewallpolicies.ExclusionManagedRuleSet, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		ruleGroups := make([]webapplicationfirewallpolicies.ExclusionManagedRuleGroup, 0)
+		for _, group := range v["rule_group"].([]interface{}) {
+			g := group.(map[string]interface{})
+
+			rules := make([]webapplicationfirewallpolicies.ExclusionManagedRule, 0)
+			for _, rule := range g["excluded_rules"].([]interface{}) {
+				rules = append(rules, webapplicationfirewallpolicies.ExclusionManagedRule{
+					RuleId: rule.(string),
+				})
+			}
+
+			ruleGroups = append(ruleGroups, webapplicationfirewallpolicies.ExclusionManagedRuleGroup{
+				RuleGroupName: g["rule_group_name"].(string),
+				Rules:         &rules,
+			})
+		}
+
+		results = append(results, webapplicationfirewallpolicies.ExclusionManagedRuleSet{
+			RuleSetType:    v["type"].(string),
+			RuleSetVersion: v["version"].(string),
+			RuleGroups:     &ruleGroups,
+		})
+	}
+	return &results
+}
+
+func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) []webapplicationfirewallpolicies.ManagedRuleSet {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleSet, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
@@ -506,27 +570,27 @@ func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) *[]ne
 		if value, exists := v["rule_group_override"]; exists {
 			ruleGroupOverrides = value.([]interface{})
 		}
-		result := network.ManagedRuleSet{
-			RuleSetType:        utils.String(ruleSetType),
-			RuleSetVersion:     utils.String(ruleSetVersion),
+		result := webapplicationfirewallpolicies.ManagedRuleSet{
+			RuleSetType:        ruleSetType,
+			RuleSetVersion:     ruleSetVersion,
 			RuleGroupOverrides: expandWebApplicationFirewallPolicyRuleGroupOverrides(ruleGroupOverrides),
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *[]network.ManagedRuleGroupOverride {
-	results := make([]network.ManagedRuleGroupOverride, 0)
+func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleGroupOverride, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
 		ruleGroupName := v["rule_group_name"].(string)
 		disabledRules := v["disabled_rules"].([]interface{})
 
-		result := network.ManagedRuleGroupOverride{
-			RuleGroupName: utils.String(ruleGroupName),
+		result := webapplicationfirewallpolicies.ManagedRuleGroupOverride{
+			RuleGroupName: ruleGroupName,
 			Rules:         expandWebApplicationFirewallPolicyRules(disabledRules),
 		}
 
@@ -535,14 +599,15 @@ func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyRules(input []interface{}) *[]network.ManagedRuleOverride {
-	results := make([]network.ManagedRuleOverride, 0)
+func expandWebApplicationFirewallPolicyRules(input []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleOverride {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleOverride, 0)
 	for _, item := range input {
 		ruleID := item.(string)
 
-		result := network.ManagedRuleOverride{
-			RuleID: utils.String(ruleID),
-			State:  network.ManagedRuleEnabledStateDisabled,
+		state := webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled
+		result := webapplicationfirewallpolicies.ManagedRuleOverride{
+			RuleId: ruleID,
+			State:  &state,
 		}
 
 		results = append(results, result)
@@ -550,8 +615,8 @@ func expandWebApplicationFirewallPolicyRules(input []interface{}) *[]network.Man
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) *[]network.MatchCondition {
-	results := make([]network.MatchCondition, 0)
+func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) []webapplicationfirewallpolicies.MatchCondition {
+	results := make([]webapplicationfirewallpolicies.MatchCondition, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		matchVariables := v["match_variables"].([]interface{})
@@ -560,41 +625,41 @@ func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) *[]ne
 		matchValues := v["match_values"].([]interface{})
 		transformsRaw := v["transforms"].(*pluginsdk.Set).List()
 
-		var transforms []network.WebApplicationFirewallTransform
+		var transforms []webapplicationfirewallpolicies.WebApplicationFirewallTransform
 		for _, trans := range transformsRaw {
-			transforms = append(transforms, network.WebApplicationFirewallTransform(trans.(string)))
+			transforms = append(transforms, webapplicationfirewallpolicies.WebApplicationFirewallTransform(trans.(string)))
 		}
-		result := network.MatchCondition{
-			MatchValues:      utils.ExpandStringSlice(matchValues),
+		result := webapplicationfirewallpolicies.MatchCondition{
+			MatchValues:      *utils.ExpandStringSlice(matchValues),
 			MatchVariables:   expandWebApplicationFirewallPolicyMatchVariable(matchVariables),
 			NegationConditon: utils.Bool(negationCondition),
-			Operator:         network.WebApplicationFirewallOperator(operator),
+			Operator:         webapplicationfirewallpolicies.WebApplicationFirewallOperator(operator),
 			Transforms:       &transforms,
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func expandWebApplicationFirewallPolicyMatchVariable(input []interface{}) *[]network.MatchVariable {
-	results := make([]network.MatchVariable, 0)
+func expandWebApplicationFirewallPolicyMatchVariable(input []interface{}) []webapplicationfirewallpolicies.MatchVariable {
+	results := make([]webapplicationfirewallpolicies.MatchVariable, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		variableName := v["variable_name"].(string)
 		selector := v["selector"].(string)
 
-		result := network.MatchVariable{
+		result := webapplicationfirewallpolicies.MatchVariable{
 			Selector:     utils.String(selector),
-			VariableName: network.WebApplicationFirewallMatchVariable(variableName),
+			VariableName: webapplicationfirewallpolicies.WebApplicationFirewallMatchVariable(variableName),
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *[]network.WebApplicationFirewallCustomRule) []interface{} {
+func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *[]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -608,38 +673,67 @@ func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *
 		}
 		v["action"] = string(item.Action)
 		v["match_conditions"] = flattenWebApplicationFirewallPolicyMatchCondition(item.MatchConditions)
-		if priority := item.Priority; priority != nil {
-			v["priority"] = int(*priority)
-		}
+		v["priority"] = int(item.Priority)
 		v["rule_type"] = string(item.RuleType)
 
+		rateLimitDuration := ""
+		if item.RateLimitDuration != nil {
+			rateLimitDuration = string(*item.RateLimitDuration)
+		}
+		v["rate_limit_duration"] = rateLimitDuration
+
+		rateLimitThreshold := 0
+		if item.RateLimitThreshold != nil {
+			rateLimitThreshold = int(*item.RateLimitThreshold)
+		}
+		v["rate_limit_threshold"] = rateLimitThreshold
+
+		groupBy := ""
+		if item.GroupByUserSession != nil {
+			for _, session := range *item.GroupByUserSession {
+				for _, variable := range session.GroupByVariables {
+					groupBy = string(variable.VariableName)
+				}
+			}
+		}
+		v["group_rate_limit_by"] = groupBy
+
 		results = append(results, v)
 	}
 
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyPolicySettings(input *network.PolicySettings) []interface{} {
+func flattenWebApplicationFirewallPolicyPolicySettings(input *webapplicationfirewallpolicies.PolicySettings) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
 
 	result := make(map[string]interface{})
 
-	result["enabled"] = input.State == network.WebApplicationFirewallEnabledStateEnabled
-	result["mode"] = string(input.Mode)
-	result["request_body_check"] = input.RequestBodyCheck
-	result["max_request_body_size_in_kb"] = int(*input.MaxRequestBodySizeInKb)
-	result["file_upload_limit_in_mb"] = int(*input.FileUploadLimitInMb)
+	result["enabled"] = input.State != nil && *input.State == webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateEnabled
+	mode := ""
+	if input.Mode != nil {
+		mode = string(*input.Mode)
+	}
+	result["mode"] = mode
+	result["request_body_check"] = input.RequestBodyCheck != nil && *input.RequestBodyCheck
+	maxRequestBodySizeInKb := 0
+	if input.MaxRequestBodySizeInKb != nil {
+		maxRequestBodySizeInKb = int(*input.MaxRequestBodySizeInKb)
+	}
+	result["max_request_body_size_in_kb"] = maxRequestBodySizeInKb
+	fileUploadLimitInMb := 0
+	if input.FileUploadLimitInMb != nil {
+		fileUploadLimitInMb = int(*input.FileUploadLimitInMb)
+	}
+	result["file_upload_limit_in_mb"] = fileUploadLimitInMb
 
 	return []interface{}{result}
 }
 
-func flattenWebApplicationFirewallPolicyManagedRulesDefinition(input *network.ManagedRulesDefinition) []interface{} {
+func flattenWebApplicationFirewallPolicyManagedRulesDefinition(input webapplicationfirewallpolicies.ManagedRulesDefinition) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
 	v := make(map[string]interface{})
 
@@ -651,7 +745,7 @@ func flattenWebApplicationFirewallPolicyManagedRulesDefinition(input *network.Ma
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyExclusions(input *[]network.OwaspCrsExclusionEntry) []interface{} {
+func flattenWebApplicationFirewallPolicyExclusions(input *[]webapplicationfirewallpolicies.OwaspCrsExclusionEntry) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -660,26 +754,53 @@ func flattenWebApplicationFirewallPolicyExclusions(input *[]network.OwaspCrsExcl
 	for _, item := range *input {
 		v := make(map[string]interface{})
 
-		selector := item.Selector
-
 		v["match_variable"] = string(item.MatchVariable)
-		if selector != nil {
-			v["selector"] = *selector
-		}
+		v["selector"] = item.Selector
 		v["selector_match_operator"] = string(item.SelectorMatchOperator)
+		v["excluded_rule_set"] = flattenWebApplicationFirewallPolicyExclusionManagedRuleSets(item.ExclusionManagedRuleSets)
 
 		results = append(results, v)
 	}
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyManagedRuleSets(input *[]network.ManagedRuleSet) []interface{} {
+func flattenWebApplicationFirewallPolicyExclusionManagedRuleSets(input *[]webapplicationfirewallpolicies.ExclusionManagedRuleSet) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, item := range *input {
+		ruleGroups := make([]interface{}, 0)
+		if item.RuleGroups != nil {
+			for _, group := range *item.RuleGroups {
+				rules := make([]interface{}, 0)
+				if group.Rules != nil {
+					for _, rule := range *group.Rules {
+						rules = append(rules, rule.RuleId)
+					}
+				}
+
+				ruleGroups = append(ruleGroups, map[string]interface{}{
+					"rule_group_name": group.RuleGroupName,
+					"excluded_rules":  rules,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"type":       item.RuleSetType,
+			"version":    item.RuleSetVersion,
+			"rule_group": ruleGroups,
+		})
+	}
+	return results
+}
+
+func flattenWebApplicationFirewallPolicyManagedRuleSets(input []webapplicationfirewallpolicies.ManagedRuleSet) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		v["type"] = item.RuleSetType
@@ -691,7 +812,7 @@ func flattenWebApplicationFirewallPolicyManagedRuleSets(input *[]network.Managed
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]network.ManagedRuleGroupOverride) []interface{} {
+func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -708,30 +829,25 @@ func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]network.Mana
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyManagedRuleOverrides(input *[]network.ManagedRuleOverride) []string {
+func flattenWebApplicationFirewallPolicyManagedRuleOverrides(input *[]webapplicationfirewallpolicies.ManagedRuleOverride) []string {
 	results := make([]string, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, item := range *input {
-		if item.State == "" || item.State == network.ManagedRuleEnabledStateDisabled {
-			v := *item.RuleID
-
-			results = append(results, v)
+		if item.State == nil || *item.State == "" || *item.State == webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled {
+			results = append(results, item.RuleId)
 		}
 	}
 
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCondition) []interface{} {
+func flattenWebApplicationFirewallPolicyMatchCondition(input []webapplicationfirewallpolicies.MatchCondition) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
-	for _, item := range *input {
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		var transforms []interface{}
@@ -740,7 +856,7 @@ func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCon
 				transforms = append(transforms, string(trans))
 			}
 		}
-		v["match_values"] = utils.FlattenStringSlice(item.MatchValues)
+		v["match_values"] = utils.FlattenStringSlice(&item.MatchValues)
 		v["match_variables"] = flattenWebApplicationFirewallPolicyMatchVariable(item.MatchVariables)
 		if negationCondition := item.NegationConditon; negationCondition != nil {
 			v["negation_condition"] = *negationCondition
@@ -754,13 +870,10 @@ func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCon
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyMatchVariable(input *[]network.MatchVariable) []interface{} {
+func flattenWebApplicationFirewallPolicyMatchVariable(input []webapplicationfirewallpolicies.MatchVariable) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
-	for _, item := range *input {
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		if selector := item.Selector; selector != nil {
@@ -773,3 +886,20 @@ func flattenWebApplicationFirewallPolicyMatchVariable(input *[]network.MatchVari
 
 	return results
 }
+
+func flattenWebApplicationFirewallPolicySubResourcesToIDs(input *[]webapplicationfirewallpolicies.SubResource) []interface{} {
+	ids := make([]interface{}, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+
+		ids = append(ids, *v.Id)
+	}
+
+	return ids
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2021-06-01/postgresqlflexibleservers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2022-12-01/servers"
 )
 
 type Client struct {
@@ -14,6 +15,7 @@ type Client struct {
 	FlexibleServersConfigurationsClient *postgresqlflexibleservers.ConfigurationsClient
 	FlexibleServerFirewallRuleClient    *postgresqlflexibleservers.FirewallRulesClient
 	FlexibleServerDatabaseClient        *postgresqlflexibleservers.DatabasesClient
+	FlexibleServerUpgradeClient         *servers.ServersClient
 	ServersClient                       *postgresql.ServersClient
 	ServerKeysClient                    *postgresql.ServerKeysClient
 	ServerSecurityAlertPoliciesClient   *postgresql.ServerSecurityAlertPoliciesClient
@@ -62,6 +64,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerConfigurationsClient := postgresqlflexibleservers.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerUpgradeClient := servers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerUpgradeClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ConfigurationsClient:                &configurationsClient,
 		DatabasesClient:                     &databasesClient,
@@ -70,6 +75,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		FlexibleServersClient:               &flexibleServersClient,
 		FlexibleServerFirewallRuleClient:    &flexibleServerFirewallRuleClient,
 		FlexibleServerDatabaseClient:        &flexibleServerDatabaseClient,
+		FlexibleServerUpgradeClient:         &flexibleServerUpgradeClient,
 		ServersClient:                       &serversClient,
 		ServerKeysClient:                    &serverKeysClient,
 		ServerSecurityAlertPoliciesClient:   &serverSecurityAlertPoliciesClient,
@@ -1,8 +1,10 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2022-12-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -47,6 +50,15 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the major version can only be upgraded in-place, downgrading requires a new server
+			pluginsdk.ForceNewIfChange("version", func(ctx context.Context, old, new, meta interface{}) bool {
+				oldVersion, _ := strconv.Atoi(old.(string))
+				newVersion, _ := strconv.Atoi(new.(string))
+				return oldVersion != 0 && newVersion < oldVersion
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -89,15 +101,10 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 			},
 
 			"version": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(postgresqlflexibleservers.ServerVersionOneOne),
-					string(postgresqlflexibleservers.ServerVersionOneTwo),
-					string(postgresqlflexibleservers.ServerVersionOneThree),
-				}, false),
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(servers.PossibleValuesForServerVersion(), false),
 			},
 
 			"zone": {
@@ -444,9 +451,15 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		ServerPropertiesForUpdate: &postgresqlflexibleservers.ServerPropertiesForUpdate{},
 	}
 
+	// the standby zone can be chosen when high availability is enabled on an existing server
+	oldHa, _ := d.GetChange("high_availability")
+	enablingHa := len(oldHa.([]interface{})) == 0 && len(d.Get("high_availability").([]interface{})) > 0
+
 	var requireFailover bool
 	// failover is only supported when `zone` and `standby_availability_zone` is exchanged
 	switch {
+	case enablingHa && !d.HasChange("zone"):
+		requireFailover = false
 	case d.HasChange("zone") && d.HasChange("high_availability.0.standby_availability_zone"):
 		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -473,6 +486,24 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("`zone` and `standby_availability_zone` should only be either exchanged with each other or unchanged")
 	}
 
+	// the major version upgrade has to be performed on its own, before any other properties are updated
+	if d.HasChange("version") {
+		upgradeClient := meta.(*clients.Client).Postgres.FlexibleServerUpgradeClient
+		createMode := servers.CreateModeForUpdateUpdate
+		version := servers.ServerVersion(d.Get("version").(string))
+		upgradeParameters := servers.ServerForUpdate{
+			Properties: &servers.ServerPropertiesForUpdate{
+				CreateMode: &createMode,
+				Version:    &version,
+			},
+		}
+
+		serverId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.Name)
+		if err := upgradeClient.UpdateThenPoll(ctx, serverId, upgradeParameters); err != nil {
+			return fmt.Errorf("upgrading the major version of %s: %+v", *id, err)
+		}
+	}
+
 	if d.HasChange("administrator_password") {
 		parameters.ServerPropertiesForUpdate.AdministratorLoginPassword = utils.String(d.Get("administrator_password").(string))
 	}
@@ -502,7 +533,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	if d.HasChange("high_availability") {
-		parameters.HighAvailability = expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{}), false)
+		parameters.HighAvailability = expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{}), enablingHa)
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters)
@@ -677,7 +708,7 @@ func flattenArmServerMaintenanceWindow(input *postgresqlflexibleservers.Maintena
 	}
 }
 
-func expandFlexibleServerHighAvailability(inputs []interface{}, isCreate bool) *postgresqlflexibleservers.HighAvailability {
+func expandFlexibleServerHighAvailability(inputs []interface{}, includeStandbyZone bool) *postgresqlflexibleservers.HighAvailability {
 	if len(inputs) == 0 || inputs[0] == nil {
 		return &postgresqlflexibleservers.HighAvailability{
 			Mode: postgresqlflexibleservers.HighAvailabilityModeDisabled,
@@ -690,8 +721,8 @@ func expandFlexibleServerHighAvailability(inputs []interface{}, isCreate bool) *
 		Mode: postgresqlflexibleservers.HighAvailabilityMode(input["mode"].(string)),
 	}
 
-	// service team confirmed it doesn't support to update `standby_availability_zone` after the PostgreSQL Flexible Server resource is created
-	if isCreate {
+	// service team confirmed it doesn't support to update `standby_availability_zone` once high availability has been enabled
+	if includeStandbyZone {
 		if v, ok := input["standby_availability_zone"]; ok && v.(string) != "" {
 			result.StandbyAvailabilityZone = utils.String(v.(string))
 		}
@@ -0,0 +1,65 @@
+package servers
+
+import "strings"
+
+type CreateModeForUpdate string
+
+const (
+	CreateModeForUpdateDefault CreateModeForUpdate = "Default"
+	CreateModeForUpdateUpdate  CreateModeForUpdate = "Update"
+)
+
+func PossibleValuesForCreateModeForUpdate() []string {
+	return []string{
+		string(CreateModeForUpdateDefault),
+		string(CreateModeForUpdateUpdate),
+	}
+}
+
+func parseCreateModeForUpdate(input string) (*CreateModeForUpdate, error) {
+	vals := map[string]CreateModeForUpdate{
+		"default": CreateModeForUpdateDefault,
+		"update":  CreateModeForUpdateUpdate,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreateModeForUpdate(input)
+	return &out, nil
+}
+
+type ServerVersion string
+
+const (
+	ServerVersionOneOne   ServerVersion = "11"
+	ServerVersionOneTwo   ServerVersion = "12"
+	ServerVersionOneThree ServerVersion = "13"
+	ServerVersionOneFour  ServerVersion = "14"
+)
+
+func PossibleValuesForServerVersion() []string {
+	return []string{
+		string(ServerVersionOneOne),
+		string(ServerVersionOneTwo),
+		string(ServerVersionOneThree),
+		string(ServerVersionOneFour),
+	}
+}
+
+func parseServerVersion(input string) (*ServerVersion, error) {
+	vals := map[string]ServerVersion{
+		"11": ServerVersionOneOne,
+		"12": ServerVersionOneTwo,
+		"13": ServerVersionOneThree,
+		"14": ServerVersionOneFour,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ServerVersion(input)
+	return &out, nil
+}
@@ -0,0 +1,5 @@
+package servers
+
+type ServerForUpdate struct {
+	Properties *ServerPropertiesForUpdate `json:"properties,omitempty"`
+}
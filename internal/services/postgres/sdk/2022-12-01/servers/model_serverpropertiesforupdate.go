@@ -0,0 +1,6 @@
+package servers
+
+type ServerPropertiesForUpdate struct {
+	CreateMode *CreateModeForUpdate `json:"createMode,omitempty"`
+	Version    *ServerVersion       `json:"version,omitempty"`
+}
@@ -0,0 +1,230 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2022-07-01/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverDnsForwardingRulesetResource{}
+
+type PrivateDNSResolverDnsForwardingRulesetResource struct{}
+
+type DnsForwardingRulesetModel struct {
+	Name                                  string            `tfschema:"name"`
+	ResourceGroupName                     string            `tfschema:"resource_group_name"`
+	PrivateDNSResolverOutboundEndpointIds []string          `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
+	Location                              string            `tfschema:"location"`
+	Tags                                  map[string]string `tfschema:"tags"`
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"private_dns_resolver_outbound_endpoint_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: outboundendpoints.ValidateOutboundEndpointID,
+			},
+		},
+
+		"location": location.Schema(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) ModelObject() interface{} {
+	return &DnsForwardingRulesetModel{}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dnsforwardingrulesets.ValidateDnsForwardingRulesetID
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := dnsforwardingrulesets.NewDnsForwardingRulesetID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := dnsforwardingrulesets.DnsForwardingRuleset{
+				Location: location.Normalize(model.Location),
+				Properties: dnsforwardingrulesets.DnsForwardingRulesetProperties{
+					DnsResolverOutboundEndpoints: expandDnsForwardingRulesetOutboundEndpoints(model.PrivateDNSResolverOutboundEndpointIds),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DnsForwardingRulesetModel{
+				Name:              id.DnsForwardingRulesetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.PrivateDNSResolverOutboundEndpointIds = flattenDnsForwardingRulesetOutboundEndpoints(model.Properties.DnsResolverOutboundEndpoints)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := dnsforwardingrulesets.DnsForwardingRulesetPatch{}
+			if metadata.ResourceData.HasChange("private_dns_resolver_outbound_endpoint_ids") {
+				outboundEndpoints := expandDnsForwardingRulesetOutboundEndpoints(model.PrivateDNSResolverOutboundEndpointIds)
+				parameters.Properties = &dnsforwardingrulesets.DnsForwardingRulesetPatchProperties{
+					DnsResolverOutboundEndpoints: &outboundEndpoints,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDnsForwardingRulesetOutboundEndpoints(input []string) []dnsforwardingrulesets.SubResource {
+	results := make([]dnsforwardingrulesets.SubResource, 0)
+
+	for _, id := range input {
+		results = append(results, dnsforwardingrulesets.SubResource{
+			Id: id,
+		})
+	}
+
+	return results
+}
+
+func flattenDnsForwardingRulesetOutboundEndpoints(input []dnsforwardingrulesets.SubResource) []string {
+	results := make([]string, 0)
+
+	for _, item := range input {
+		results = append(results, item.Id)
+	}
+
+	return results
+}
@@ -0,0 +1,313 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverForwardingRuleResource{}
+
+type PrivateDNSResolverForwardingRuleResource struct{}
+
+type ForwardingRuleModel struct {
+	Name                   string                 `tfschema:"name"`
+	DnsForwardingRulesetId string                 `tfschema:"dns_forwarding_ruleset_id"`
+	DomainName             string                 `tfschema:"domain_name"`
+	Enabled                bool                   `tfschema:"enabled"`
+	Metadata               map[string]string      `tfschema:"metadata"`
+	TargetDnsServers       []TargetDnsServerModel `tfschema:"target_dns_servers"`
+}
+
+type TargetDnsServerModel struct {
+	IPAddress string `tfschema:"ip_address"`
+	Port      int64  `tfschema:"port"`
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsforwardingrulesets.ValidateDnsForwardingRulesetID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateForwardingRuleDomainName,
+		},
+
+		"target_dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPAddress,
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      53,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) ModelObject() interface{} {
+	return &ForwardingRuleModel{}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rule"
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return forwardingrules.ValidateForwardingRuleID
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			var model ForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			rulesetId, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := forwardingrules.NewForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			state := expandForwardingRuleState(model.Enabled)
+			parameters := forwardingrules.ForwardingRule{
+				Properties: forwardingrules.ForwardingRuleProperties{
+					DomainName:          model.DomainName,
+					ForwardingRuleState: &state,
+					Metadata:            &model.Metadata,
+					TargetDnsServers:    expandForwardingRuleTargetDnsServers(model.TargetDnsServers),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ForwardingRuleModel{
+				Name:                   id.ForwardingRuleName,
+				DnsForwardingRulesetId: dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DomainName = props.DomainName
+				state.Enabled = props.ForwardingRuleState == nil || *props.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled
+				state.TargetDnsServers = flattenForwardingRuleTargetDnsServers(props.TargetDnsServers)
+
+				if props.Metadata != nil {
+					state.Metadata = *props.Metadata
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := forwardingrules.ForwardingRulePatchProperties{}
+			if metadata.ResourceData.HasChange("enabled") {
+				state := expandForwardingRuleState(model.Enabled)
+				properties.ForwardingRuleState = &state
+			}
+
+			if metadata.ResourceData.HasChange("metadata") {
+				properties.Metadata = &model.Metadata
+			}
+
+			if metadata.ResourceData.HasChange("target_dns_servers") {
+				targetDnsServers := expandForwardingRuleTargetDnsServers(model.TargetDnsServers)
+				properties.TargetDnsServers = &targetDnsServers
+			}
+
+			parameters := forwardingrules.ForwardingRulePatch{
+				Properties: &properties,
+			}
+
+			if _, err := client.Update(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandForwardingRuleState(enabled bool) forwardingrules.ForwardingRuleState {
+	if enabled {
+		return forwardingrules.ForwardingRuleStateEnabled
+	}
+
+	return forwardingrules.ForwardingRuleStateDisabled
+}
+
+func expandForwardingRuleTargetDnsServers(input []TargetDnsServerModel) []forwardingrules.TargetDnsServer {
+	results := make([]forwardingrules.TargetDnsServer, 0)
+
+	for _, item := range input {
+		results = append(results, forwardingrules.TargetDnsServer{
+			IPAddress: item.IPAddress,
+			Port:      utils.Int64(item.Port),
+		})
+	}
+
+	return results
+}
+
+func flattenForwardingRuleTargetDnsServers(input []forwardingrules.TargetDnsServer) []TargetDnsServerModel {
+	results := make([]TargetDnsServerModel, 0)
+
+	for _, item := range input {
+		result := TargetDnsServerModel{
+			IPAddress: item.IPAddress,
+		}
+
+		if item.Port != nil {
+			result.Port = *item.Port
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func validateForwardingRuleDomainName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(strings.Split(v, ".")) < 2 || !strings.HasSuffix(v, ".") {
+		errors = append(errors, fmt.Errorf("%q must contain at least two labels and end with a dot, got %q", k, v))
+	}
+
+	return
+}
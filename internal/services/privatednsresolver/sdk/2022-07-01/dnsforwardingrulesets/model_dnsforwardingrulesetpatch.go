@@ -0,0 +1,6 @@
+package dnsforwardingrulesets
+
+type DnsForwardingRulesetPatch struct {
+	Properties *DnsForwardingRulesetPatchProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                   `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package dnsforwardingrulesets
+
+type DnsForwardingRulesetPatchProperties struct {
+	DnsResolverOutboundEndpoints *[]SubResource `json:"dnsResolverOutboundEndpoints,omitempty"`
+}
@@ -0,0 +1,5 @@
+package dnsresolvers
+
+type DnsResolverPatch struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,8 @@
+package dnsresolvers
+
+type DnsResolverProperties struct {
+	DnsResolverState  *DnsResolverState  `json:"dnsResolverState,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+	VirtualNetwork    SubResource        `json:"virtualNetwork"`
+}
@@ -0,0 +1,9 @@
+package forwardingrules
+
+type ForwardingRule struct {
+	Etag       *string                  `json:"etag,omitempty"`
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties ForwardingRuleProperties `json:"properties"`
+	Type       *string                  `json:"type,omitempty"`
+}
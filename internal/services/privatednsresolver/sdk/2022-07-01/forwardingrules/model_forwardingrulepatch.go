@@ -0,0 +1,5 @@
+package forwardingrules
+
+type ForwardingRulePatch struct {
+	Properties *ForwardingRulePatchProperties `json:"properties,omitempty"`
+}
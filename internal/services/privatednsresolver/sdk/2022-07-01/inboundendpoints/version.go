@@ -0,0 +1,9 @@
+package inboundendpoints
+
+import "fmt"
+
+const defaultApiVersion = "2022-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/inboundendpoints/%s", defaultApiVersion)
+}
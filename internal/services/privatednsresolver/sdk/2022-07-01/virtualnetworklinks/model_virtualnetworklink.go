@@ -0,0 +1,9 @@
+package virtualnetworklinks
+
+type VirtualNetworkLink struct {
+	Etag       *string                      `json:"etag,omitempty"`
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties VirtualNetworkLinkProperties `json:"properties"`
+	Type       *string                      `json:"type,omitempty"`
+}
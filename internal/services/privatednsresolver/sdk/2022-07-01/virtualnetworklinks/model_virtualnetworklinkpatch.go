@@ -0,0 +1,5 @@
+package virtualnetworklinks
+
+type VirtualNetworkLinkPatch struct {
+	Properties *VirtualNetworkLinkPatchProperties `json:"properties,omitempty"`
+}
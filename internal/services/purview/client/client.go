@@ -1,16 +1,16 @@
 package client
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/purview/mgmt/2021-07-01/purview"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/account"
 )
 
 type Client struct {
-	AccountsClient *purview.AccountsClient
+	AccountsClient *account.AccountClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
-	accountsClient := purview.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	accountsClient := account.NewAccountClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
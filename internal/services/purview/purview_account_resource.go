@@ -1,16 +1,20 @@
 package purview
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/purview/mgmt/2021-07-01/purview"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/account"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -26,7 +30,7 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 		Delete: resourcePurviewAccountDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.AccountID(id)
+			_, err := account.ParseAccountID(id)
 			return err
 		}),
 
@@ -65,6 +69,12 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"managed_event_hub_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"managed_resource_group_name": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -73,6 +83,15 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceGroupName,
 			},
 
+			"root_collection_admin_object_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
 			"identity": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -94,6 +113,27 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"managed_resources": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"event_hub_namespace_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"resource_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"storage_account_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"catalog_endpoint": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -123,6 +163,13 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// Root Collection Admins can be added, but there's no API to remove them again
+			pluginsdk.ForceNewIfChange("root_collection_admin_object_ids", func(ctx context.Context, old, new, _ interface{}) bool {
+				return len(old.(*pluginsdk.Set).Difference(new.(*pluginsdk.Set)).List()) > 0
+			}),
+		),
 	}
 }
 
@@ -132,52 +179,65 @@ func resourcePurviewAccountCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	t := d.Get("tags").(map[string]interface{})
-
-	id := parse.NewAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := account.NewAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_purview_account", id.ID())
 		}
 	}
 
-	account := purview.Account{
-		AccountProperties: &purview.AccountProperties{},
-		Identity: &purview.Identity{
-			Type: purview.TypeSystemAssigned,
-		},
-		Location: &location,
-		Tags:     tags.Expand(t),
+	publicNetworkAccess := account.PublicNetworkAccessDisabled
+	if d.Get("public_network_enabled").(bool) {
+		publicNetworkAccess = account.PublicNetworkAccessEnabled
 	}
 
-	if d.Get("public_network_enabled").(bool) {
-		account.AccountProperties.PublicNetworkAccess = purview.PublicNetworkAccessEnabled
-	} else {
-		account.AccountProperties.PublicNetworkAccess = purview.PublicNetworkAccessDisabled
+	managedEventHubState := account.ManagedEventHubStateDisabled
+	if d.Get("managed_event_hub_enabled").(bool) {
+		managedEventHubState = account.ManagedEventHubStateEnabled
+	}
+
+	payload := account.Account{
+		Identity: &identity.SystemAssignedIdentity{
+			Type: identity.Type("SystemAssigned"),
+		},
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Properties: &account.AccountProperties{
+			ManagedEventHubState: &managedEventHubState,
+			PublicNetworkAccess:  &publicNetworkAccess,
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("managed_resource_group_name"); ok {
-		account.AccountProperties.ManagedResourceGroupName = utils.String(v.(string))
+		payload.Properties.ManagedResourceGroupName = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, account)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
+	d.SetId(id.ID())
+
+	if d.HasChange("root_collection_admin_object_ids") {
+		// removals force a new resource, so only the newly specified admins need adding
+		oldRaw, newRaw := d.GetChange("root_collection_admin_object_ids")
+		for _, objectId := range newRaw.(*pluginsdk.Set).Difference(oldRaw.(*pluginsdk.Set)).List() {
+			input := account.CollectionAdminUpdate{
+				ObjectId: utils.String(objectId.(string)),
+			}
+			if _, err := client.AddRootCollectionAdmin(ctx, id, input); err != nil {
+				return fmt.Errorf("adding %q as a Root Collection Admin for %s: %+v", objectId.(string), id, err)
+			}
+		}
 	}
 
-	d.SetId(id.ID())
 	return resourcePurviewAccountRead(d, meta)
 }
 
@@ -186,14 +246,15 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.AccountID(d.Id())
+	id, err := account.ParseAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
@@ -201,38 +262,59 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("name", id.AccountName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if err := d.Set("identity", flattenPurviewAccountIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("flattening `identity`: %+v", err)
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if err := d.Set("identity", flattenPurviewAccountIdentity(model.Identity)); err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("public_network_enabled", props.PublicNetworkAccess != nil && *props.PublicNetworkAccess == account.PublicNetworkAccessEnabled)
 
-	if props := resp.AccountProperties; props != nil {
-		d.Set("public_network_enabled", props.PublicNetworkAccess == purview.PublicNetworkAccessEnabled)
+			// accounts created prior to the introduction of this setting will have a managed Event Hub
+			managedEventHubEnabled := true
+			if props.ManagedEventHubState != nil {
+				managedEventHubEnabled = *props.ManagedEventHubState != account.ManagedEventHubStateDisabled
+			}
+			d.Set("managed_event_hub_enabled", managedEventHubEnabled)
+
+			managedResourceGroupName := ""
+			if props.ManagedResourceGroupName != nil {
+				managedResourceGroupName = *props.ManagedResourceGroupName
+			}
+			d.Set("managed_resource_group_name", managedResourceGroupName)
 
-		managedResourceGroupName := ""
-		if props.ManagedResourceGroupName != nil {
-			managedResourceGroupName = *props.ManagedResourceGroupName
+			if err := d.Set("managed_resources", flattenPurviewAccountManagedResources(props.ManagedResources)); err != nil {
+				return fmt.Errorf("flattening `managed_resources`: %+v", err)
+			}
+
+			if endpoints := props.Endpoints; endpoints != nil {
+				d.Set("catalog_endpoint", endpoints.Catalog)
+				d.Set("guardian_endpoint", endpoints.Guardian)
+				d.Set("scan_endpoint", endpoints.Scan)
+			}
 		}
-		d.Set("managed_resource_group_name", managedResourceGroupName)
 
-		if endpoints := resp.Endpoints; endpoints != nil {
-			d.Set("catalog_endpoint", endpoints.Catalog)
-			d.Set("guardian_endpoint", endpoints.Guardian)
-			d.Set("scan_endpoint", endpoints.Scan)
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
 		}
 	}
 
-	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.Name)
+	keys, err := client.ListKeys(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving Keys for %s: %+v", *id, err)
 	}
-	d.Set("atlas_kafka_endpoint_primary_connection_string", keys.AtlasKafkaPrimaryEndpoint)
-	d.Set("atlas_kafka_endpoint_secondary_connection_string", keys.AtlasKafkaSecondaryEndpoint)
+	if model := keys.Model; model != nil {
+		d.Set("atlas_kafka_endpoint_primary_connection_string", model.AtlasKafkaPrimaryEndpoint)
+		d.Set("atlas_kafka_endpoint_secondary_connection_string", model.AtlasKafkaSecondaryEndpoint)
+	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	// the API doesn't expose the Root Collection Admins, so `root_collection_admin_object_ids` is kept as-is from the config
+	return nil
 }
 
 func resourcePurviewAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -240,41 +322,62 @@ func resourcePurviewAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.AccountID(d.Id())
+	id, err := account.ParseAccountID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func flattenPurviewAccountIdentity(identity *purview.Identity) interface{} {
-	if identity == nil || identity.Type == "None" {
+func flattenPurviewAccountIdentity(input *identity.SystemAssignedIdentity) interface{} {
+	if input == nil || input.Type == identity.Type("None") {
 		return make([]interface{}, 0)
 	}
 
 	principalId := ""
-	if identity.PrincipalID != nil {
-		principalId = *identity.PrincipalID
+	if input.PrincipalId != nil {
+		principalId = *input.PrincipalId
 	}
 	tenantId := ""
-	if identity.TenantID != nil {
-		tenantId = *identity.TenantID
+	if input.TenantId != nil {
+		tenantId = *input.TenantId
 	}
 	return []interface{}{
 		map[string]interface{}{
-			"type":         string(identity.Type),
+			"type":         string(input.Type),
 			"principal_id": principalId,
 			"tenant_id":    tenantId,
 		},
 	}
 }
+
+func flattenPurviewAccountManagedResources(input *account.ManagedResources) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	eventHubNamespaceId := ""
+	if input.EventHubNamespace != nil {
+		eventHubNamespaceId = *input.EventHubNamespace
+	}
+	resourceGroupId := ""
+	if input.ResourceGroup != nil {
+		resourceGroupId = *input.ResourceGroup
+	}
+	storageAccountId := ""
+	if input.StorageAccount != nil {
+		storageAccountId = *input.StorageAccount
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"event_hub_namespace_id": eventHubNamespaceId,
+			"resource_group_id":      resourceGroupId,
+			"storage_account_id":     storageAccountId,
+		},
+	}
+}
@@ -0,0 +1,148 @@
+package account
+
+import "strings"
+
+type ManagedEventHubState string
+
+const (
+	ManagedEventHubStateDisabled     ManagedEventHubState = "Disabled"
+	ManagedEventHubStateEnabled      ManagedEventHubState = "Enabled"
+	ManagedEventHubStateNotSpecified ManagedEventHubState = "NotSpecified"
+)
+
+func PossibleValuesForManagedEventHubState() []string {
+	return []string{
+		string(ManagedEventHubStateDisabled),
+		string(ManagedEventHubStateEnabled),
+		string(ManagedEventHubStateNotSpecified),
+	}
+}
+
+func parseManagedEventHubState(input string) (*ManagedEventHubState, error) {
+	vals := map[string]ManagedEventHubState{
+		"disabled":     ManagedEventHubStateDisabled,
+		"enabled":      ManagedEventHubStateEnabled,
+		"notspecified": ManagedEventHubStateNotSpecified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedEventHubState(input)
+	return &out, nil
+}
+
+type ManagedResourcesPublicNetworkAccess string
+
+const (
+	ManagedResourcesPublicNetworkAccessDisabled     ManagedResourcesPublicNetworkAccess = "Disabled"
+	ManagedResourcesPublicNetworkAccessEnabled      ManagedResourcesPublicNetworkAccess = "Enabled"
+	ManagedResourcesPublicNetworkAccessNotSpecified ManagedResourcesPublicNetworkAccess = "NotSpecified"
+)
+
+func PossibleValuesForManagedResourcesPublicNetworkAccess() []string {
+	return []string{
+		string(ManagedResourcesPublicNetworkAccessDisabled),
+		string(ManagedResourcesPublicNetworkAccessEnabled),
+		string(ManagedResourcesPublicNetworkAccessNotSpecified),
+	}
+}
+
+func parseManagedResourcesPublicNetworkAccess(input string) (*ManagedResourcesPublicNetworkAccess, error) {
+	vals := map[string]ManagedResourcesPublicNetworkAccess{
+		"disabled":     ManagedResourcesPublicNetworkAccessDisabled,
+		"enabled":      ManagedResourcesPublicNetworkAccessEnabled,
+		"notspecified": ManagedResourcesPublicNetworkAccessNotSpecified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedResourcesPublicNetworkAccess(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateCreating     ProvisioningState = "Creating"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateMoving       ProvisioningState = "Moving"
+	ProvisioningStateSoftDeleted  ProvisioningState = "SoftDeleted"
+	ProvisioningStateSoftDeleting ProvisioningState = "SoftDeleting"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUnknown      ProvisioningState = "Unknown"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMoving),
+		string(ProvisioningStateSoftDeleted),
+		string(ProvisioningStateSoftDeleting),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUnknown),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":     ProvisioningStateCanceled,
+		"creating":     ProvisioningStateCreating,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"moving":       ProvisioningStateMoving,
+		"softdeleted":  ProvisioningStateSoftDeleted,
+		"softdeleting": ProvisioningStateSoftDeleting,
+		"succeeded":    ProvisioningStateSucceeded,
+		"unknown":      ProvisioningStateUnknown,
+		"updating":     ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled     PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled      PublicNetworkAccess = "Enabled"
+	PublicNetworkAccessNotSpecified PublicNetworkAccess = "NotSpecified"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+		string(PublicNetworkAccessNotSpecified),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled":     PublicNetworkAccessDisabled,
+		"enabled":      PublicNetworkAccessEnabled,
+		"notspecified": PublicNetworkAccessNotSpecified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
@@ -0,0 +1,12 @@
+package account
+
+type AccountProperties struct {
+	Endpoints                           *AccountEndpoints                    `json:"endpoints,omitempty"`
+	FriendlyName                        *string                              `json:"friendlyName,omitempty"`
+	ManagedEventHubState                *ManagedEventHubState                `json:"managedEventHubState,omitempty"`
+	ManagedResourceGroupName            *string                              `json:"managedResourceGroupName,omitempty"`
+	ManagedResources                    *ManagedResources                    `json:"managedResources,omitempty"`
+	ManagedResourcesPublicNetworkAccess *ManagedResourcesPublicNetworkAccess `json:"managedResourcesPublicNetworkAccess,omitempty"`
+	ProvisioningState                   *ProvisioningState                   `json:"provisioningState,omitempty"`
+	PublicNetworkAccess                 *PublicNetworkAccess                 `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,5 @@
+package account
+
+type CollectionAdminUpdate struct {
+	ObjectId *string `json:"objectId,omitempty"`
+}
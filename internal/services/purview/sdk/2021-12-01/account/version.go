@@ -0,0 +1,9 @@
+package account
+
+import "fmt"
+
+const defaultApiVersion = "2021-12-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/account/%s", defaultApiVersion)
+}
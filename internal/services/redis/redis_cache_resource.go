@@ -768,6 +768,18 @@ func expandRedisConfiguration(d *pluginsdk.ResourceData) (map[string]*string, er
 		output["maxmemory-policy"] = utils.String(v)
 	}
 
+	// Persistence (RDB/AOF) is only available on the Premium SKU and the two modes are mutually exclusive
+	rdbBackupEnabled := raw["rdb_backup_enabled"].(bool)
+	aofBackupEnabled := raw["aof_backup_enabled"].(bool)
+	if rdbBackupEnabled || aofBackupEnabled {
+		if d.Get("sku_name").(string) != string(redis.SkuNamePremium) {
+			return nil, fmt.Errorf("`rdb_backup_enabled` and `aof_backup_enabled` can only be set to `true` when `sku_name` is `Premium`")
+		}
+		if rdbBackupEnabled && aofBackupEnabled {
+			return nil, fmt.Errorf("only one of `rdb_backup_enabled` and `aof_backup_enabled` can be set to `true`")
+		}
+	}
+
 	// RDB Backup
 	if v := raw["rdb_backup_enabled"].(bool); v {
 		if connStr := raw["rdb_storage_connection_string"].(string); connStr == "" {
@@ -794,6 +806,9 @@ func expandRedisConfiguration(d *pluginsdk.ResourceData) (map[string]*string, er
 
 	// AOF Backup
 	if v := raw["aof_backup_enabled"].(bool); v {
+		if connStr := raw["aof_storage_connection_string_0"].(string); connStr == "" {
+			return nil, fmt.Errorf("The aof_storage_connection_string_0 property must be set when aof_backup_enabled is true")
+		}
 		output["aof-backup-enabled"] = utils.String(strconv.FormatBool(v))
 	}
 
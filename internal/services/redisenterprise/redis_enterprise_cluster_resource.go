@@ -8,12 +8,17 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/redisenterprise/mgmt/2021-03-01/redisenterprise"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2023-07-01/redisenterprise"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -22,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+type redisEnterpriseClusterIdentity = identity.SystemAssignedUserAssigned
+
 func resourceRedisEnterpriseCluster() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRedisEnterpriseClusterCreate,
@@ -29,7 +36,7 @@ func resourceRedisEnterpriseCluster() *pluginsdk.Resource {
 		Update: resourceRedisEnterpriseClusterUpdate,
 		Delete: resourceRedisEnterpriseClusterDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.RedisEnterpriseClusterID(id)
+			_, err := redisenterprise.ParseRedisEnterpriseID(id)
 			return err
 		}),
 
@@ -71,14 +78,38 @@ func resourceRedisEnterpriseCluster() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(redisenterprise.OneFullStopTwo),
+				Default:  string(redisenterprise.TlsVersionOnePointTwo),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redisenterprise.OneFullStopZero),
-					string(redisenterprise.OneFullStopOne),
-					string(redisenterprise.OneFullStopTwo),
+					string(redisenterprise.TlsVersionOnePointZero),
+					string(redisenterprise.TlsVersionOnePointOne),
+					string(redisenterprise.TlsVersionOnePointTwo),
 				}, false),
 			},
 
+			"identity": redisEnterpriseClusterIdentity{}.Schema(),
+
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+
+						"user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: msiValidate.UserAssignedIdentityID,
+						},
+					},
+				},
+				RequiredWith: []string{"identity"},
+			},
+
 			"hostname": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -104,17 +135,17 @@ func resourceRedisEnterpriseClusterCreate(d *pluginsdk.ResourceData, meta interf
 	log.Printf("[INFO] preparing arguments for Redis Enterprise Cluster creation.")
 
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	resourceId := parse.NewRedisEnterpriseClusterID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := redisenterprise.NewRedisEnterpriseID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.RedisEnterpriseName)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", resourceId.RedisEnterpriseName, resourceId.ResourceGroup, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return tf.ImportAsExistsError("azurerm_redis_enterprise_cluster", resourceId.ID())
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_redis_enterprise_cluster", id.ID())
 		}
 	}
 
@@ -124,51 +155,53 @@ func resourceRedisEnterpriseClusterCreate(d *pluginsdk.ResourceData, meta interf
 	// If the sku type is flash check to make sure that the sku is supported in that region
 	if strings.Contains(string(sku.Name), "Flash") {
 		if err := validate.RedisEnterpriseClusterLocationFlashSkuSupport(location); err != nil {
-			return fmt.Errorf("%s: %s", resourceId, err)
+			return fmt.Errorf("%s: %s", id, err)
 		}
 	}
 
+	clusterIdentity, err := expandRedisEnterpriseClusterIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	minimumTlsVersion := redisenterprise.TlsVersion(d.Get("minimum_tls_version").(string))
 	parameters := redisenterprise.Cluster{
-		Name:     utils.String(d.Get("name").(string)),
-		Location: utils.String(location),
-		Sku:      sku,
-		ClusterProperties: &redisenterprise.ClusterProperties{
-			MinimumTLSVersion: redisenterprise.TLSVersion(d.Get("minimum_tls_version").(string)),
+		Location: location,
+		Sku:      *sku,
+		Identity: clusterIdentity,
+		Properties: &redisenterprise.ClusterProperties{
+			MinimumTlsVersion: &minimumTlsVersion,
+			Encryption:        expandRedisEnterpriseClusterEncryption(d.Get("customer_managed_key").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("zones"); ok {
 		// Zones are currently not supported in these regions
 		if err := validate.RedisEnterpriseClusterLocationZoneSupport(location); err != nil {
-			return fmt.Errorf("%s: %s", resourceId, err)
+			return fmt.Errorf("%s: %s", id, err)
 		}
 		parameters.Zones = azure.ExpandZones(v.([]interface{}))
 	}
 
-	future, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.RedisEnterpriseName, parameters)
-	if err != nil {
-		return fmt.Errorf("waiting for creation of Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", resourceId.RedisEnterpriseName, resourceId.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the creation of Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", resourceId.RedisEnterpriseName, resourceId.ResourceGroup, err)
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	log.Printf("[DEBUG] Waiting for Redis Enterprise Cluster (Name %q / Resource Group %q) to become available", resourceId.RedisEnterpriseName, resourceId.ResourceGroup)
+	log.Printf("[DEBUG] Waiting for %s to become available", id)
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{"Creating", "Updating", "Enabling", "Deleting", "Disabling"},
 		Target:     []string{"Running"},
-		Refresh:    redisEnterpriseClusterStateRefreshFunc(ctx, client, resourceId),
+		Refresh:    redisEnterpriseClusterStateRefreshFunc(ctx, client, id),
 		MinTimeout: 15 * time.Second,
 		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Redis Enterprise Cluster (Name %q / Resource Group %q) to become available: %+v", resourceId.RedisEnterpriseName, resourceId.ResourceGroup, err)
+		return fmt.Errorf("waiting for %s to become available: %+v", id, err)
 	}
 
-	d.SetId(resourceId.ID())
+	d.SetId(id.ID())
 
 	return resourceRedisEnterpriseClusterRead(d, meta)
 }
@@ -178,76 +211,118 @@ func resourceRedisEnterpriseClusterRead(d *pluginsdk.ResourceData, meta interfac
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.RedisEnterpriseClusterID(d.Id())
+	id, err := redisenterprise.ParseRedisEnterpriseID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[DEBUG] Redis Enterprise Cluster (Name %q / Resource Group %q) was not found - removing from state!", id.RedisEnterpriseName, id.ResourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("retrieving Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", id.RedisEnterpriseName, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.RedisEnterpriseName)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("name", id.ClusterName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if err := d.Set("sku_name", flattenRedisEnterpriseClusterSku(resp.Sku)); err != nil {
-		return fmt.Errorf("setting `sku_name`: %+v", err)
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 
-	if zones := resp.Zones; zones != nil {
-		d.Set("zones", zones)
-	}
+		if err := d.Set("sku_name", flattenRedisEnterpriseClusterSku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku_name`: %+v", err)
+		}
 
-	if props := resp.ClusterProperties; props != nil {
-		d.Set("hostname", props.HostName)
-		d.Set("version", props.RedisVersion)
-		d.Set("minimum_tls_version", string(props.MinimumTLSVersion))
+		if zones := model.Zones; zones != nil {
+			d.Set("zones", zones)
+		}
+
+		clusterIdentity, err := flattenRedisEnterpriseClusterIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", clusterIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("hostname", props.HostName)
+			d.Set("version", props.RedisVersion)
+
+			minimumTlsVersion := ""
+			if props.MinimumTlsVersion != nil {
+				minimumTlsVersion = string(*props.MinimumTlsVersion)
+			}
+			d.Set("minimum_tls_version", minimumTlsVersion)
+
+			if err := d.Set("customer_managed_key", flattenRedisEnterpriseClusterEncryption(props.Encryption)); err != nil {
+				return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceRedisEnterpriseClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).RedisEnterprise.Client
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache update.")
+	log.Printf("[INFO] preparing arguments for Redis Enterprise Cluster update.")
 
-	id, err := parse.RedisEnterpriseClusterID(d.Id())
+	id, err := redisenterprise.ParseRedisEnterpriseID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	parameters := redisenterprise.ClusterUpdate{}
+
+	if d.HasChange("identity") {
+		clusterIdentity, err := expandRedisEnterpriseClusterIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		parameters.Identity = clusterIdentity
+	}
+
+	if d.HasChange("customer_managed_key") {
+		encryption := expandRedisEnterpriseClusterEncryption(d.Get("customer_managed_key").([]interface{}))
+		if encryption == nil {
+			// an empty encryption block reverts the cluster to Microsoft managed keys
+			encryption = &redisenterprise.ClusterPropertiesEncryption{}
+		}
+		parameters.Properties = &redisenterprise.ClusterProperties{
+			Encryption: encryption,
+		}
+	}
 
-	parameters := redisenterprise.ClusterUpdate{
-		Tags: expandedTags,
+	if d.HasChange("tags") {
+		parameters.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	if _, err := client.Update(ctx, id.ResourceGroup, id.RedisEnterpriseName, parameters); err != nil {
-		return fmt.Errorf("updating Redis Cache %q (Resource Group %q): %+v", id.RedisEnterpriseName, id.ResourceGroup, err)
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
-	log.Printf("[DEBUG] Waiting for Redis Cache %q (Resource Group %q) to become available", id.RedisEnterpriseName, id.ResourceGroup)
+	log.Printf("[DEBUG] Waiting for %s to become available", *id)
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{"Creating", "Updating", "Enabling", "Deleting", "Disabling"},
 		Target:     []string{"Running"},
 		Refresh:    redisEnterpriseClusterStateRefreshFunc(ctx, client, *id),
 		MinTimeout: 15 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Redis Cache %q (Resource Group %q) to become available: %+v", id.RedisEnterpriseName, id.ResourceGroup, err)
+		return fmt.Errorf("waiting for %s to become available: %+v", *id, err)
 	}
 
 	return resourceRedisEnterpriseClusterRead(d, meta)
@@ -258,18 +333,13 @@ func resourceRedisEnterpriseClusterDelete(d *pluginsdk.ResourceData, meta interf
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.RedisEnterpriseClusterID(d.Id())
+	id, err := redisenterprise.ParseRedisEnterpriseID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.RedisEnterpriseName)
-	if err != nil {
-		return fmt.Errorf("deleting Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", id.RedisEnterpriseName, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", id.RedisEnterpriseName, id.ResourceGroup, err)
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
@@ -281,40 +351,92 @@ func expandRedisEnterpriseClusterSku(v string) *redisenterprise.Sku {
 
 	sku := &redisenterprise.Sku{
 		Name:     redisenterprise.SkuName(redisSku.Name),
-		Capacity: utils.Int32(int32(capacity)),
+		Capacity: utils.Int64(capacity),
 	}
 
 	return sku
 }
 
-func flattenRedisEnterpriseClusterSku(input *redisenterprise.Sku) *string {
+func flattenRedisEnterpriseClusterSku(input redisenterprise.Sku) *string {
+	var capacity int64
+	if input.Capacity != nil {
+		capacity = *input.Capacity
+	}
+
+	skuName := fmt.Sprintf("%s-%d", input.Name, capacity)
+
+	return &skuName
+}
+
+func expandRedisEnterpriseClusterIdentity(input []interface{}) (*identity.SystemUserAssignedIdentityMap, error) {
+	expanded, err := redisEnterpriseClusterIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := identity.SystemUserAssignedIdentityMap{}
+	result.FromExpandedConfig(*expanded)
+	return &result, nil
+}
+
+func flattenRedisEnterpriseClusterIdentity(input *identity.SystemUserAssignedIdentityMap) ([]interface{}, error) {
 	if input == nil {
-		return nil
+		return []interface{}{}, nil
 	}
 
-	var name redisenterprise.SkuName
-	var capacity int32
+	config := input.ToExpandedConfig()
+	return redisEnterpriseClusterIdentity{}.Flatten(&config), nil
+}
 
-	if input.Name != "" {
-		name = input.Name
+func expandRedisEnterpriseClusterEncryption(input []interface{}) *redisenterprise.ClusterPropertiesEncryption {
+	if len(input) == 0 || input[0] == nil {
+		return nil
 	}
 
-	if input.Capacity != nil {
-		capacity = *input.Capacity
+	v := input[0].(map[string]interface{})
+	identityType := redisenterprise.CmkIdentityTypeUserAssignedIdentity
+	return &redisenterprise.ClusterPropertiesEncryption{
+		CustomerManagedKeyEncryption: &redisenterprise.ClusterPropertiesEncryptionCustomerManagedKeyEncryption{
+			KeyEncryptionKeyUrl: utils.String(v["key_vault_key_id"].(string)),
+			KeyEncryptionKeyIdentity: &redisenterprise.ClusterPropertiesEncryptionCustomerManagedKeyEncryptionKeyIdentity{
+				IdentityType:                   &identityType,
+				UserAssignedIdentityResourceId: utils.String(v["user_assigned_identity_id"].(string)),
+			},
+		},
 	}
+}
 
-	skuName := fmt.Sprintf("%s-%d", name, capacity)
+func flattenRedisEnterpriseClusterEncryption(input *redisenterprise.ClusterPropertiesEncryption) []interface{} {
+	if input == nil || input.CustomerManagedKeyEncryption == nil || input.CustomerManagedKeyEncryption.KeyEncryptionKeyUrl == nil {
+		return []interface{}{}
+	}
 
-	return &skuName
+	cmk := input.CustomerManagedKeyEncryption
+	userAssignedIdentityId := ""
+	if keyIdentity := cmk.KeyEncryptionKeyIdentity; keyIdentity != nil && keyIdentity.UserAssignedIdentityResourceId != nil {
+		userAssignedIdentityId = *keyIdentity.UserAssignedIdentityResourceId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":          *cmk.KeyEncryptionKeyUrl,
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
 }
 
-func redisEnterpriseClusterStateRefreshFunc(ctx context.Context, client *redisenterprise.Client, id parse.RedisEnterpriseClusterId) pluginsdk.StateRefreshFunc {
+func redisEnterpriseClusterStateRefreshFunc(ctx context.Context, client *redisenterprise.RedisEnterpriseClient, id redisenterprise.RedisEnterpriseId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName)
+		res, err := client.Get(ctx, id)
 		if err != nil {
-			return nil, "", fmt.Errorf("retrieving status of Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", id.RedisEnterpriseName, id.ResourceGroup, err)
+			return nil, "", fmt.Errorf("retrieving status of %s: %+v", id, err)
+		}
+
+		state := ""
+		if model := res.Model; model != nil && model.Properties != nil && model.Properties.ResourceState != nil {
+			state = string(*model.Properties.ResourceState)
 		}
 
-		return res, string(res.ClusterProperties.ResourceState), nil
+		return res, state, nil
 	}
 }
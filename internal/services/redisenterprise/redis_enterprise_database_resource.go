@@ -1,16 +1,19 @@
 package redisenterprise
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/redisenterprise/mgmt/2021-03-01/redisenterprise"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2023-07-01/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2023-07-01/redisenterprise"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -22,22 +25,36 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRedisEnterpriseDatabaseCreate,
 		Read:   resourceRedisEnterpriseDatabaseRead,
-		// Update currently is not implemented, will be for GA
+		Update: resourceRedisEnterpriseDatabaseUpdate,
 		Delete: resourceRedisEnterpriseDatabaseDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.RedisEnterpriseDatabaseID(id)
+			_, err := databases.ParseDatabaseID(id)
 			return err
 		}),
 
-		// Since update is not currently supported all attribute have to be marked as FORCE NEW
-		// until support for Update comes online in the near future
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("linked_database_id", func(ctx context.Context, old, new, _ interface{}) bool {
+				// linked databases can be unlinked in-place, but linking a new database requires the database to be recreated
+				oldIds := old.(*pluginsdk.Set)
+				for _, v := range new.(*pluginsdk.Set).List() {
+					if !oldIds.Contains(v) {
+						return true
+					}
+				}
+				return false
+			}),
+		),
+
+		// Apart from the linked databases, updates to a Redis Enterprise Database are not supported by the API,
+		// so all other attributes have to be marked as ForceNew
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -60,10 +77,10 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(redisenterprise.Encrypted),
+				Default:  string(databases.ProtocolEncrypted),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redisenterprise.Encrypted),
-					string(redisenterprise.Plaintext),
+					string(databases.ProtocolEncrypted),
+					string(databases.ProtocolPlaintext),
 				}, false),
 			},
 
@@ -71,10 +88,10 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(redisenterprise.OSSCluster),
+				Default:  string(databases.ClusteringPolicyOSSCluster),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redisenterprise.EnterpriseCluster),
-					string(redisenterprise.OSSCluster),
+					string(databases.ClusteringPolicyEnterpriseCluster),
+					string(databases.ClusteringPolicyOSSCluster),
 				}, false),
 			},
 
@@ -82,16 +99,16 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(redisenterprise.VolatileLRU),
+				Default:  string(databases.EvictionPolicyVolatileLRU),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redisenterprise.AllKeysLFU),
-					string(redisenterprise.AllKeysLRU),
-					string(redisenterprise.AllKeysRandom),
-					string(redisenterprise.VolatileLRU),
-					string(redisenterprise.VolatileLFU),
-					string(redisenterprise.VolatileTTL),
-					string(redisenterprise.VolatileRandom),
-					string(redisenterprise.NoEviction),
+					string(databases.EvictionPolicyAllKeysLFU),
+					string(databases.EvictionPolicyAllKeysLRU),
+					string(databases.EvictionPolicyAllKeysRandom),
+					string(databases.EvictionPolicyVolatileLRU),
+					string(databases.EvictionPolicyVolatileLFU),
+					string(databases.EvictionPolicyVolatileTTL),
+					string(databases.EvictionPolicyVolatileRandom),
+					string(databases.EvictionPolicyNoEviction),
 				}, false),
 			},
 
@@ -99,7 +116,7 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				ForceNew: true,
-				MaxItems: 3,
+				MaxItems: 4,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -110,6 +127,7 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 								"RedisBloom",
 								"RedisTimeSeries",
 								"RediSearch",
+								"RedisJSON",
 							}, false),
 						},
 
@@ -175,6 +193,25 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 65353),
 			},
 
+			"linked_database_id": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.RedisEnterpriseDatabaseID,
+				},
+				RequiredWith: []string{"linked_database_group_nickname"},
+			},
+
+			"linked_database_group_nickname": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"linked_database_id"},
+			},
+
 			"primary_access_key": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -195,54 +232,67 @@ func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta inter
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
+	clusterId, err := parse.RedisEnterpriseClusterID(d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
 
-	clusterID, _ := parse.RedisEnterpriseClusterID(d.Get("cluster_id").(string))
-	id := parse.NewRedisEnterpriseDatabaseID(subscriptionId, resourceGroup, clusterID.RedisEnterpriseName, name)
+	id := databases.NewDatabaseID(subscriptionId, d.Get("resource_group_name").(string), clusterId.RedisEnterpriseName, d.Get("name").(string))
 
-	existing, err := client.Get(ctx, resourceGroup, id.RedisEnterpriseName, name)
+	existing, err := client.Get(ctx, id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for present of existing Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", name, resourceGroup, id.RedisEnterpriseName, err)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
 
-	if !utils.ResponseWasNotFound(existing.Response) {
+	if !response.WasNotFound(existing.HttpResponse) {
 		return tf.ImportAsExistsError("azurerm_redis_enterprise_database", id.ID())
 	}
 
-	parameters := redisenterprise.Database{
-		DatabaseProperties: &redisenterprise.DatabaseProperties{
-			ClientProtocol:   redisenterprise.Protocol(d.Get("client_protocol").(string)),
-			ClusteringPolicy: redisenterprise.ClusteringPolicy(d.Get("clustering_policy").(string)),
-			EvictionPolicy:   redisenterprise.EvictionPolicy(d.Get("eviction_policy").(string)),
-			Modules:          expandArmDatabaseModuleArray(d.Get("module").([]interface{})),
+	clientProtocol := databases.Protocol(d.Get("client_protocol").(string))
+	clusteringPolicy := databases.ClusteringPolicy(d.Get("clustering_policy").(string))
+	evictionPolicy := databases.EvictionPolicy(d.Get("eviction_policy").(string))
+	modules := expandArmDatabaseModuleArray(d.Get("module").([]interface{}))
+
+	parameters := databases.Database{
+		Properties: &databases.DatabaseProperties{
+			ClientProtocol:   &clientProtocol,
+			ClusteringPolicy: &clusteringPolicy,
+			EvictionPolicy:   &evictionPolicy,
+			Modules:          modules,
 			//Persistence:      expandArmDatabasePersistence(d.Get("persistence").([]interface{})),
-			Port: utils.Int32(int32(d.Get("port").(int))),
+			Port: utils.Int64(int64(d.Get("port").(int))),
 		},
 	}
 
-	future, err := client.Create(ctx, resourceGroup, id.RedisEnterpriseName, name, parameters)
-	if err != nil {
+	if v, ok := d.GetOk("linked_database_id"); ok {
+		linkedDatabaseIds := v.(*pluginsdk.Set).List()
+		if err := validateRedisEnterpriseDatabaseGeoReplication(id, linkedDatabaseIds, modules); err != nil {
+			return err
+		}
+
+		parameters.Properties.GeoReplication = &databases.DatabasePropertiesGeoReplication{
+			GroupNickname:   utils.String(d.Get("linked_database_group_nickname").(string)),
+			LinkedDatabases: expandArmDatabaseLinkedDatabases(linkedDatabaseIds),
+		}
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
 		// Need to check if this was due to the cluster having the wrong sku
 		if strings.Contains(err.Error(), "The value of the parameter 'properties.modules' is invalid") {
 			clusterClient := meta.(*clients.Client).RedisEnterprise.Client
-			resp, err := clusterClient.Get(ctx, clusterID.ResourceGroup, clusterID.RedisEnterpriseName)
+			resp, err := clusterClient.Get(ctx, redisenterprise.NewRedisEnterpriseID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.RedisEnterpriseName))
 			if err != nil {
-				return fmt.Errorf("retrieving Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", clusterID.RedisEnterpriseName, clusterID.ResourceGroup, err)
+				return fmt.Errorf("retrieving Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", clusterId.RedisEnterpriseName, clusterId.ResourceGroup, err)
 			}
 
-			if strings.Contains(strings.ToLower(string(resp.Sku.Name)), "flash") {
-				return fmt.Errorf("creating a Redis Enterprise Database with modules in a Redis Enterprise Cluster that has an incompatible Flash SKU type %q - please remove the Redis Enterprise Database modules or change the Redis Enterprise Cluster SKU type (Resource Group %q / Cluster Name %q / Database %q)", string(resp.Sku.Name), resourceGroup, id.RedisEnterpriseName, name)
+			if model := resp.Model; model != nil && strings.Contains(strings.ToLower(string(model.Sku.Name)), "flash") {
+				return fmt.Errorf("creating a Redis Enterprise Database with modules in a Redis Enterprise Cluster that has an incompatible Flash SKU type %q - please remove the Redis Enterprise Database modules or change the Redis Enterprise Cluster SKU type (%s)", string(model.Sku.Name), id)
 			}
 		}
 
-		return fmt.Errorf("creating Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", name, resourceGroup, id.RedisEnterpriseName, err)
-	}
-
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creating future for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", name, resourceGroup, id.RedisEnterpriseName, err)
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
@@ -255,83 +305,181 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.RedisEnterpriseDatabaseID(d.Id())
+	id, err := databases.ParseDatabaseID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[INFO] Redis Enterprise Database %q does not exist - removing from state", d.Id())
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	keysResp, err := client.ListKeys(ctx, *id)
 	if err != nil {
-		return fmt.Errorf("listing keys for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+		return fmt.Errorf("listing keys for %s: %+v", *id, err)
 	}
 
 	d.Set("name", id.DatabaseName)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("cluster_id", parse.NewRedisEnterpriseClusterID(id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName).ID())
-
-	if props := resp.DatabaseProperties; props != nil {
-		d.Set("client_protocol", props.ClientProtocol)
-		d.Set("clustering_policy", props.ClusteringPolicy)
-		d.Set("eviction_policy", props.EvictionPolicy)
-		if err := d.Set("module", flattenArmDatabaseModuleArray(props.Modules)); err != nil {
-			return fmt.Errorf("setting `module`: %+v", err)
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("cluster_id", parse.NewRedisEnterpriseClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			clientProtocol := ""
+			if props.ClientProtocol != nil {
+				clientProtocol = string(*props.ClientProtocol)
+			}
+			d.Set("client_protocol", clientProtocol)
+
+			clusteringPolicy := ""
+			if props.ClusteringPolicy != nil {
+				clusteringPolicy = string(*props.ClusteringPolicy)
+			}
+			d.Set("clustering_policy", clusteringPolicy)
+
+			evictionPolicy := ""
+			if props.EvictionPolicy != nil {
+				evictionPolicy = string(*props.EvictionPolicy)
+			}
+			d.Set("eviction_policy", evictionPolicy)
+
+			if err := d.Set("module", flattenArmDatabaseModuleArray(props.Modules)); err != nil {
+				return fmt.Errorf("setting `module`: %+v", err)
+			}
+			// if err := d.Set("persistence", flattenArmDatabasePersistence(props.Persistence)); err != nil {
+			// 	return fmt.Errorf("setting `persistence`: %+v", err)
+			// }
+			d.Set("port", props.Port)
+
+			groupNickname := ""
+			var linkedDatabaseIds []interface{}
+			if geoReplication := props.GeoReplication; geoReplication != nil {
+				if geoReplication.GroupNickname != nil {
+					groupNickname = *geoReplication.GroupNickname
+				}
+				linkedDatabaseIds, err = flattenArmDatabaseLinkedDatabases(geoReplication.LinkedDatabases)
+				if err != nil {
+					return fmt.Errorf("flattening `linked_database_id`: %+v", err)
+				}
+			}
+			d.Set("linked_database_group_nickname", groupNickname)
+			if err := d.Set("linked_database_id", linkedDatabaseIds); err != nil {
+				return fmt.Errorf("setting `linked_database_id`: %+v", err)
+			}
 		}
-		// if err := d.Set("persistence", flattenArmDatabasePersistence(props.Persistence)); err != nil {
-		// 	return fmt.Errorf("setting `persistence`: %+v", err)
-		// }
-		d.Set("port", props.Port)
 	}
 
-	d.Set("primary_access_key", keysResp.PrimaryKey)
-	d.Set("secondary_access_key", keysResp.SecondaryKey)
+	if model := keysResp.Model; model != nil {
+		d.Set("primary_access_key", model.PrimaryKey)
+		d.Set("secondary_access_key", model.SecondaryKey)
+	}
 
 	return nil
 }
 
+func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := databases.ParseDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("linked_database_id") {
+		oldRaw, newRaw := d.GetChange("linked_database_id")
+		unlinkIds := make([]string, 0)
+		for _, v := range oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set)).List() {
+			unlinkIds = append(unlinkIds, v.(string))
+		}
+
+		if len(unlinkIds) > 0 {
+			log.Printf("[DEBUG] Force unlinking %d database(s) from the geo-replication group of %s", len(unlinkIds), *id)
+			parameters := databases.ForceUnlinkParameters{
+				Ids: unlinkIds,
+			}
+			if err := client.ForceUnlinkThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("force unlinking databases from %s: %+v", *id, err)
+			}
+		}
+	}
+
+	return resourceRedisEnterpriseDatabaseRead(d, meta)
+}
+
 func resourceRedisEnterpriseDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.RedisEnterpriseDatabaseID(d.Id())
+	id, err := databases.ParseDatabaseID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
-	if err != nil {
-		return fmt.Errorf("deleting Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// validateRedisEnterpriseDatabaseGeoReplication ensures the linked databases contain the database itself
+// and that only the modules supported by active geo-replication are enabled
+func validateRedisEnterpriseDatabaseGeoReplication(id databases.DatabaseId, linkedDatabaseIds []interface{}, modules *[]databases.Module) error {
+	selfLinked := false
+	for _, v := range linkedDatabaseIds {
+		if strings.EqualFold(v.(string), id.ID()) {
+			selfLinked = true
+			break
+		}
+	}
+	if !selfLinked {
+		return fmt.Errorf("`linked_database_id` must include the ID of the database itself (%q)", id.ID())
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deleting future for Redis Enterprise Database %q (Resource Group %q / Cluster Name %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+	if modules != nil {
+		for _, module := range *modules {
+			if module.Name != "RediSearch" && module.Name != "RedisJSON" {
+				return fmt.Errorf("only the `RediSearch` and `RedisJSON` modules are supported when geo-replication is enabled, got %q", module.Name)
+			}
+		}
 	}
+
 	return nil
 }
 
-func expandArmDatabaseModuleArray(input []interface{}) *[]redisenterprise.Module {
-	results := make([]redisenterprise.Module, 0)
+func expandArmDatabaseModuleArray(input []interface{}) *[]databases.Module {
+	results := make([]databases.Module, 0)
 
 	for _, item := range input {
 		v := item.(map[string]interface{})
-		results = append(results, redisenterprise.Module{
-			Name: utils.String(v["name"].(string)),
+		results = append(results, databases.Module{
+			Name: v["name"].(string),
 			Args: utils.String(v["args"].(string)),
 		})
 	}
 	return &results
 }
 
+func expandArmDatabaseLinkedDatabases(input []interface{}) *[]databases.LinkedDatabase {
+	results := make([]databases.LinkedDatabase, 0)
+
+	for _, item := range input {
+		results = append(results, databases.LinkedDatabase{
+			Id: utils.String(item.(string)),
+		})
+	}
+	return &results
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
 // func expandArmDatabasePersistence(input []interface{}) *redisenterprise.Persistence {
 // 	if len(input) == 0 {
@@ -346,17 +494,14 @@ func expandArmDatabaseModuleArray(input []interface{}) *[]redisenterprise.Module
 // 	}
 // }
 
-func flattenArmDatabaseModuleArray(input *[]redisenterprise.Module) []interface{} {
+func flattenArmDatabaseModuleArray(input *[]databases.Module) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, item := range *input {
-		var name string
-		if item.Name != nil {
-			name = *item.Name
-		}
+		name := item.Name
 
 		args := ""
 		if item.Args != nil {
@@ -386,6 +531,27 @@ func flattenArmDatabaseModuleArray(input *[]redisenterprise.Module) []interface{
 	return results
 }
 
+func flattenArmDatabaseLinkedDatabases(input *[]databases.LinkedDatabase) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, item := range *input {
+		if item.Id == nil {
+			continue
+		}
+
+		id, err := databases.ParseDatabaseIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, id.ID())
+	}
+
+	return results, nil
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
 // func flattenArmDatabasePersistence(input *redisenterprise.Persistence) []interface{} {
 // 	if input == nil {
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2023-07-01/databases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -57,18 +58,41 @@ func TestRedisEnterpriseDatabase_complete(t *testing.T) {
 	})
 }
 
+func TestRedisEnterpriseDatabase_geoReplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisenterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoReplication(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.geoReplication(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r RedisenterpriseDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := parse.RedisEnterpriseDatabaseID(state.ID)
+	id, err := databases.ParseDatabaseID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := client.RedisEnterprise.DatabaseClient.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	resp, err := client.RedisEnterprise.DatabaseClient.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
-		return nil, fmt.Errorf("retrieving Redis Entrprise Database %q (Resource Group %q / clusterName %q): %+v", id.DatabaseName, id.ResourceGroup, id.RedisEnterpriseName, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	return utils.Bool(true), nil
@@ -154,3 +178,68 @@ resource "azurerm_redis_enterprise_database" "test" {
 }
 `, template)
 }
+
+func (r RedisenterpriseDatabaseResource) geoReplication(data acceptance.TestData, linkThirdDatabase bool) string {
+	linkedDatabaseIds := `"${azurerm_redis_enterprise_cluster.test.id}/databases/default", "${azurerm_redis_enterprise_cluster.test1.id}/databases/default"`
+	if linkThirdDatabase {
+		linkedDatabaseIds += `, "${azurerm_redis_enterprise_cluster.test2.id}/databases/default"`
+	}
+
+	// I have to hardcode the locations because some features are not currently available in all regions
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-redisEnterprise-%[1]d"
+  location = "eastus"
+}
+
+resource "azurerm_redis_enterprise_cluster" "test" {
+  name                = "acctest-rec-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "eastus"
+
+  sku_name = "Enterprise_E20-4"
+}
+
+resource "azurerm_redis_enterprise_cluster" "test1" {
+  name                = "acctest-rec1-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "westus2"
+
+  sku_name = "Enterprise_E20-4"
+}
+
+resource "azurerm_redis_enterprise_cluster" "test2" {
+  name                = "acctest-rec2-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "centralus"
+
+  sku_name = "Enterprise_E20-4"
+}
+
+resource "azurerm_redis_enterprise_database" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_id          = azurerm_redis_enterprise_cluster.test.id
+
+  client_protocol   = "Encrypted"
+  clustering_policy = "EnterpriseCluster"
+  eviction_policy   = "NoEviction"
+
+  module {
+    name = "RediSearch"
+    args = ""
+  }
+
+  module {
+    name = "RedisJSON"
+    args = ""
+  }
+
+  linked_database_id             = [%[2]s]
+  linked_database_group_nickname = "tftestGeoGroup"
+}
+`, data.RandomInteger, linkedDatabaseIds)
+}
@@ -0,0 +1,8 @@
+package databases
+
+type Database struct {
+	Id         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *DatabaseProperties `json:"properties,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
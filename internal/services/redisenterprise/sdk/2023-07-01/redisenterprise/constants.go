@@ -0,0 +1,203 @@
+package redisenterprise
+
+import "strings"
+
+type CmkIdentityType string
+
+const (
+	CmkIdentityTypeSystemAssignedIdentity CmkIdentityType = "systemAssignedIdentity"
+	CmkIdentityTypeUserAssignedIdentity   CmkIdentityType = "userAssignedIdentity"
+)
+
+func PossibleValuesForCmkIdentityType() []string {
+	return []string{
+		string(CmkIdentityTypeSystemAssignedIdentity),
+		string(CmkIdentityTypeUserAssignedIdentity),
+	}
+}
+
+func parseCmkIdentityType(input string) (*CmkIdentityType, error) {
+	vals := map[string]CmkIdentityType{
+		"systemassignedidentity": CmkIdentityTypeSystemAssignedIdentity,
+		"userassignedidentity":   CmkIdentityTypeUserAssignedIdentity,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CmkIdentityType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type ResourceState string
+
+const (
+	ResourceStateCreateFailed  ResourceState = "CreateFailed"
+	ResourceStateCreating      ResourceState = "Creating"
+	ResourceStateDeleteFailed  ResourceState = "DeleteFailed"
+	ResourceStateDeleting      ResourceState = "Deleting"
+	ResourceStateDisableFailed ResourceState = "DisableFailed"
+	ResourceStateDisabled      ResourceState = "Disabled"
+	ResourceStateDisabling     ResourceState = "Disabling"
+	ResourceStateEnableFailed  ResourceState = "EnableFailed"
+	ResourceStateEnabling      ResourceState = "Enabling"
+	ResourceStateRunning       ResourceState = "Running"
+	ResourceStateUpdateFailed  ResourceState = "UpdateFailed"
+	ResourceStateUpdating      ResourceState = "Updating"
+)
+
+func PossibleValuesForResourceState() []string {
+	return []string{
+		string(ResourceStateCreateFailed),
+		string(ResourceStateCreating),
+		string(ResourceStateDeleteFailed),
+		string(ResourceStateDeleting),
+		string(ResourceStateDisableFailed),
+		string(ResourceStateDisabled),
+		string(ResourceStateDisabling),
+		string(ResourceStateEnableFailed),
+		string(ResourceStateEnabling),
+		string(ResourceStateRunning),
+		string(ResourceStateUpdateFailed),
+		string(ResourceStateUpdating),
+	}
+}
+
+func parseResourceState(input string) (*ResourceState, error) {
+	vals := map[string]ResourceState{
+		"createfailed":  ResourceStateCreateFailed,
+		"creating":      ResourceStateCreating,
+		"deletefailed":  ResourceStateDeleteFailed,
+		"deleting":      ResourceStateDeleting,
+		"disablefailed": ResourceStateDisableFailed,
+		"disabled":      ResourceStateDisabled,
+		"disabling":     ResourceStateDisabling,
+		"enablefailed":  ResourceStateEnableFailed,
+		"enabling":      ResourceStateEnabling,
+		"running":       ResourceStateRunning,
+		"updatefailed":  ResourceStateUpdateFailed,
+		"updating":      ResourceStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceState(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameEnterpriseFlashFOneFiveZeroZero SkuName = "EnterpriseFlash_F1500"
+	SkuNameEnterpriseFlashFSevenZeroZero   SkuName = "EnterpriseFlash_F700"
+	SkuNameEnterpriseFlashFThreeZeroZero   SkuName = "EnterpriseFlash_F300"
+	SkuNameEnterpriseEFifty                SkuName = "Enterprise_E50"
+	SkuNameEnterpriseEOneHundred           SkuName = "Enterprise_E100"
+	SkuNameEnterpriseETen                  SkuName = "Enterprise_E10"
+	SkuNameEnterpriseETwenty               SkuName = "Enterprise_E20"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameEnterpriseFlashFOneFiveZeroZero),
+		string(SkuNameEnterpriseFlashFSevenZeroZero),
+		string(SkuNameEnterpriseFlashFThreeZeroZero),
+		string(SkuNameEnterpriseEFifty),
+		string(SkuNameEnterpriseEOneHundred),
+		string(SkuNameEnterpriseETen),
+		string(SkuNameEnterpriseETwenty),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"enterpriseflash_f1500": SkuNameEnterpriseFlashFOneFiveZeroZero,
+		"enterpriseflash_f700":  SkuNameEnterpriseFlashFSevenZeroZero,
+		"enterpriseflash_f300":  SkuNameEnterpriseFlashFThreeZeroZero,
+		"enterprise_e50":        SkuNameEnterpriseEFifty,
+		"enterprise_e100":       SkuNameEnterpriseEOneHundred,
+		"enterprise_e10":        SkuNameEnterpriseETen,
+		"enterprise_e20":        SkuNameEnterpriseETwenty,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
+type TlsVersion string
+
+const (
+	TlsVersionOnePointOne  TlsVersion = "1.1"
+	TlsVersionOnePointTwo  TlsVersion = "1.2"
+	TlsVersionOnePointZero TlsVersion = "1.0"
+)
+
+func PossibleValuesForTlsVersion() []string {
+	return []string{
+		string(TlsVersionOnePointOne),
+		string(TlsVersionOnePointTwo),
+		string(TlsVersionOnePointZero),
+	}
+}
+
+func parseTlsVersion(input string) (*TlsVersion, error) {
+	vals := map[string]TlsVersion{
+		"1.1": TlsVersionOnePointOne,
+		"1.2": TlsVersionOnePointTwo,
+		"1.0": TlsVersionOnePointZero,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TlsVersion(input)
+	return &out, nil
+}
@@ -0,0 +1,17 @@
+package redisenterprise
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type Cluster struct {
+	Id         *string                                 `json:"id,omitempty"`
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Location   string                                  `json:"location"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties *ClusterProperties                      `json:"properties,omitempty"`
+	Sku        Sku                                     `json:"sku"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+	Type       *string                                 `json:"type,omitempty"`
+	Zones      *[]string                               `json:"zones,omitempty"`
+}
@@ -0,0 +1,10 @@
+package redisenterprise
+
+type ClusterProperties struct {
+	Encryption        *ClusterPropertiesEncryption `json:"encryption,omitempty"`
+	HostName          *string                      `json:"hostName,omitempty"`
+	MinimumTlsVersion *TlsVersion                  `json:"minimumTlsVersion,omitempty"`
+	ProvisioningState *ProvisioningState           `json:"provisioningState,omitempty"`
+	RedisVersion      *string                      `json:"redisVersion,omitempty"`
+	ResourceState     *ResourceState               `json:"resourceState,omitempty"`
+}
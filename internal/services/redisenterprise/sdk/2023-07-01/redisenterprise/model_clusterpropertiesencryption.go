@@ -0,0 +1,5 @@
+package redisenterprise
+
+type ClusterPropertiesEncryption struct {
+	CustomerManagedKeyEncryption *ClusterPropertiesEncryptionCustomerManagedKeyEncryption `json:"customerManagedKeyEncryption,omitempty"`
+}
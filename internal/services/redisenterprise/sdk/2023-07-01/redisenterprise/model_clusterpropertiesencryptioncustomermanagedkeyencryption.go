@@ -0,0 +1,6 @@
+package redisenterprise
+
+type ClusterPropertiesEncryptionCustomerManagedKeyEncryption struct {
+	KeyEncryptionKeyIdentity *ClusterPropertiesEncryptionCustomerManagedKeyEncryptionKeyIdentity `json:"keyEncryptionKeyIdentity,omitempty"`
+	KeyEncryptionKeyUrl      *string                                                             `json:"keyEncryptionKeyUrl,omitempty"`
+}
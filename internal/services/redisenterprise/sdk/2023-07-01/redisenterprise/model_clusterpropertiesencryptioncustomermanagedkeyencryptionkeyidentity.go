@@ -0,0 +1,7 @@
+package redisenterprise
+
+type ClusterPropertiesEncryptionCustomerManagedKeyEncryptionKeyIdentity struct {
+	DelegatedResourceId            *string          `json:"delegatedResourceId,omitempty"`
+	IdentityType                   *CmkIdentityType `json:"identityType,omitempty"`
+	UserAssignedIdentityResourceId *string          `json:"userAssignedIdentityResourceId,omitempty"`
+}
@@ -0,0 +1,12 @@
+package redisenterprise
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type ClusterUpdate struct {
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Properties *ClusterProperties                      `json:"properties,omitempty"`
+	Sku        *Sku                                    `json:"sku,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+}
@@ -0,0 +1,6 @@
+package redisenterprise
+
+type Sku struct {
+	Capacity *int64  `json:"capacity,omitempty"`
+	Name     SkuName `json:"name"`
+}
@@ -5,8 +5,8 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/redisenterprise/mgmt/2021-03-01/redisenterprise"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/sdk/2023-07-01/redisenterprise"
 )
 
 // RedisEnterpriseClusterSkuName - validates if passed input string contains a valid Redis Enterprise Cluster Sku
@@ -68,12 +68,12 @@ func RedisEnterpriseClusterSkuName(v interface{}, k string) (warnings []string,
 
 func getValidRedisEnterpriseClusterSkus() []string {
 	return []string{
-		string(redisenterprise.EnterpriseE10),
-		string(redisenterprise.EnterpriseE20),
-		string(redisenterprise.EnterpriseE50),
-		string(redisenterprise.EnterpriseE100),
-		string(redisenterprise.EnterpriseFlashF300),
-		string(redisenterprise.EnterpriseFlashF700),
-		string(redisenterprise.EnterpriseFlashF1500),
+		string(redisenterprise.SkuNameEnterpriseETen),
+		string(redisenterprise.SkuNameEnterpriseETwenty),
+		string(redisenterprise.SkuNameEnterpriseEFifty),
+		string(redisenterprise.SkuNameEnterpriseEOneHundred),
+		string(redisenterprise.SkuNameEnterpriseFlashFThreeZeroZero),
+		string(redisenterprise.SkuNameEnterpriseFlashFSevenZeroZero),
+		string(redisenterprise.SkuNameEnterpriseFlashFOneFiveZeroZero),
 	}
 }
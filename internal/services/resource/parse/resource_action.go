@@ -0,0 +1,49 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ResourceActionId struct {
+	ResourceId string
+	Action     string
+}
+
+func NewResourceActionID(resourceId, action string) ResourceActionId {
+	return ResourceActionId{
+		ResourceId: resourceId,
+		Action:     action,
+	}
+}
+
+func (id ResourceActionId) String() string {
+	return fmt.Sprintf("Resource Action (Resource %q / Action %q)", id.ResourceId, id.Action)
+}
+
+func (id ResourceActionId) ID() string {
+	return fmt.Sprintf("%s|%s", id.ResourceId, id.Action)
+}
+
+// ResourceActionID parses a Resource Action ID into a ResourceActionId struct
+func ResourceActionID(input string) (*ResourceActionId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format {resourceID}|{action} but got %q", input)
+	}
+
+	if _, err := azure.ParseAzureResourceID(segments[0]); err != nil {
+		return nil, fmt.Errorf("parsing Resource ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("ID was missing the 'action' element")
+	}
+
+	return &ResourceActionId{
+		ResourceId: segments[0],
+		Action:     segments[1],
+	}, nil
+}
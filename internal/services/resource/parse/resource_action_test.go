@@ -0,0 +1,62 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestResourceActionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *ResourceActionId
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// missing action
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+		},
+		{
+			// empty action
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1|",
+		},
+		{
+			// invalid resource id
+			Input: "vm1|start",
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1|start",
+			Expected: &ResourceActionId{
+				ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+				Action:     "start",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ResourceActionID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ResourceId != v.Expected.ResourceId {
+			t.Fatalf("Expected %q but got %q for ResourceId", v.Expected.ResourceId, actual.ResourceId)
+		}
+
+		if actual.Action != v.Expected.Action {
+			t.Fatalf("Expected %q but got %q for Action", v.Expected.Action, actual.Action)
+		}
+	}
+}
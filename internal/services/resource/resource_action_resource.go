@@ -0,0 +1,289 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	azureHelpers "github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithCustomImporter = ResourceActionResource{}
+
+type ResourceActionResource struct{}
+
+type ResourceActionModel struct {
+	ResourceId string            `tfschema:"resource_id"`
+	Action     string            `tfschema:"action"`
+	ApiVersion string            `tfschema:"api_version"`
+	Body       string            `tfschema:"body"`
+	When       string            `tfschema:"when"`
+	Triggers   map[string]string `tfschema:"triggers"`
+	Output     string            `tfschema:"output"`
+}
+
+const (
+	ResourceActionWhenApply   = "apply"
+	ResourceActionWhenDestroy = "destroy"
+)
+
+func (r ResourceActionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azureHelpers.ValidateResourceID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"api_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"body": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"when": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  ResourceActionWhenApply,
+			ValidateFunc: validation.StringInSlice([]string{
+				ResourceActionWhenApply,
+				ResourceActionWhenDestroy,
+			}, false),
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ResourceActionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ResourceActionResource) ModelObject() interface{} {
+	return &ResourceActionModel{}
+}
+
+func (r ResourceActionResource) ResourceType() string {
+	return "azurerm_resource_action"
+}
+
+func (r ResourceActionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourcesClient
+
+			var model ResourceActionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewResourceActionID(model.ResourceId, model.Action)
+
+			if model.When == ResourceActionWhenApply {
+				output, err := r.invokeAction(ctx, client, model)
+				if err != nil {
+					return fmt.Errorf("invoking %s: %+v", id, err)
+				}
+				model.Output = output
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ResourceActionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourcesClient
+
+			id, err := parse.ResourceActionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceActionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the action itself can't be retrieved, so the best we can do is check the target resource still exists
+			resp, err := client.GetByID(ctx, id.ResourceId, model.ApiVersion)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving Resource %q: %+v", id.ResourceId, err)
+			}
+
+			model.ResourceId = id.ResourceId
+			model.Action = id.Action
+			return metadata.Encode(&model)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ResourceActionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourcesClient
+
+			id, err := parse.ResourceActionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceActionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.When != ResourceActionWhenDestroy {
+				return nil
+			}
+
+			if _, err := r.invokeAction(ctx, client, model); err != nil {
+				return fmt.Errorf("invoking %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ResourceActionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ResourceActionID
+}
+
+// CustomImporter rejects any import, since a Resource Action doesn't represent an object within Azure and the
+// `api_version` required to look up the target Resource isn't part of the ID
+func (r ResourceActionResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("%s cannot be imported since it doesn't represent an object within Azure", r.ResourceType())
+	}
+}
+
+// invokeAction POSTs the action against the Resource, waiting for it to complete when it's a long-running
+// operation, and returns the (JSON) response body
+func (r ResourceActionResource) invokeAction(ctx context.Context, client *resources.Client, model ResourceActionModel) (string, error) {
+	pathParameters := map[string]interface{}{
+		"action":     autorest.Encode("path", model.Action),
+		"resourceId": model.ResourceId,
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": model.ApiVersion,
+	}
+
+	decorators := []autorest.PrepareDecorator{
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}/{action}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}
+	if model.Body != "" {
+		decorators = append(decorators,
+			autorest.AsContentType("application/json; charset=utf-8"),
+			autorest.WithString(model.Body))
+	}
+
+	req, err := autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return "", fmt.Errorf("sending request: %+v", err)
+	}
+
+	if resp.StatusCode == http.StatusAccepted {
+		future, err := azure.NewFutureFromResponse(resp)
+		if err != nil {
+			return "", fmt.Errorf("building long-running operation: %+v", err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return "", fmt.Errorf("waiting for the action to complete: %+v", err)
+		}
+
+		// not all actions return a payload once they've completed, so this is best-effort
+		result, err := future.GetResult(client)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve the result of action %q for %q: %+v", model.Action, model.ResourceId, err)
+			return "", nil
+		}
+		resp = result
+	}
+
+	var body bytes.Buffer
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		func(r autorest.Responder) autorest.Responder {
+			return autorest.ResponderFunc(func(resp *http.Response) error {
+				if err := r.Respond(resp); err != nil {
+					return err
+				}
+				if resp.Body == nil {
+					return nil
+				}
+				_, err := io.Copy(&body, resp.Body)
+				return err
+			})
+		},
+		autorest.ByClosing())
+	if err != nil {
+		return "", err
+	}
+
+	return body.String(), nil
+}
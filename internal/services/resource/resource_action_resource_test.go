@@ -0,0 +1,151 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ResourceActionResource struct{}
+
+func TestAccResourceAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccResourceAction_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.triggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.triggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccResourceAction_destroy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.destroy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (ResourceActionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ResourceActionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Resource.ResourcesClient.GetByID(ctx, id.ResourceId, state.Attributes["api_version"])
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving Resource %q: %+v", id.ResourceId, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (ResourceActionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r ResourceActionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "regenerateKey"
+  api_version = "2021-04-01"
+  body = jsonencode({
+    keyName = "key2"
+  })
+}
+`, r.template(data))
+}
+
+func (r ResourceActionResource) triggers(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "regenerateKey"
+  api_version = "2021-04-01"
+  body = jsonencode({
+    keyName = "key2"
+  })
+
+  triggers = {
+    rotation = %q
+  }
+}
+`, r.template(data), trigger)
+}
+
+func (r ResourceActionResource) destroy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "regenerateKey"
+  api_version = "2021-04-01"
+  when        = "destroy"
+  body = jsonencode({
+    keyName = "key1"
+  })
+}
+`, r.template(data))
+}
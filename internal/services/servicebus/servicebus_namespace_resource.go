@@ -11,7 +11,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
@@ -30,6 +35,8 @@ var (
 	serviceBusNamespaceResourceName             = "azurerm_servicebus_namespace"
 )
 
+type serviceBusNamespaceIdentity = identity.SystemAssignedUserAssigned
+
 func resourceServiceBusNamespace() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceServiceBusNamespaceCreateUpdate,
@@ -85,6 +92,35 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				ValidateFunc: validation.IntInSlice([]int{0, 1, 2, 4, 8, 16}),
 			},
 
+			"identity": serviceBusNamespaceIdentity{}.Schema(),
+
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
+
+						"identity_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: msivalidate.UserAssignedIdentityID,
+						},
+
+						"infrastructure_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"default_primary_connection_string": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -146,13 +182,36 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	namespaceIdentity, err := expandServiceBusNamespaceIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	// the `identity` block has been removed, so it needs to be explicitly disabled
+	if namespaceIdentity == nil && !d.IsNewResource() && d.HasChange("identity") {
+		namespaceIdentity = &servicebus.Identity{
+			Type: servicebus.ManagedServiceIdentityTypeNone,
+		}
+	}
+
+	encryption, err := expandServiceBusNamespaceEncryption(d.Get("customer_managed_key").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `customer_managed_key`: %+v", err)
+	}
+
+	if encryption != nil && !strings.EqualFold(sku, string(servicebus.SkuNamePremium)) {
+		return fmt.Errorf("`customer_managed_key` can only be specified when `sku` is `Premium`")
+	}
+
 	parameters := servicebus.SBNamespace{
 		Location: &location,
+		Identity: namespaceIdentity,
 		Sku: &servicebus.SBSku{
 			Name: servicebus.SkuName(sku),
 			Tier: servicebus.SkuTier(sku),
 		},
 		SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+			Encryption:    encryption,
 			ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 		},
 		Tags: tags.Expand(t),
@@ -210,8 +269,24 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("capacity", sku.Capacity)
 	}
 
+	namespaceIdentity, err := flattenServiceBusNamespaceIdentity(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", namespaceIdentity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
 	if properties := resp.SBNamespaceProperties; properties != nil {
 		d.Set("zone_redundant", properties.ZoneRedundant)
+
+		customerManagedKey, err := flattenServiceBusNamespaceEncryption(properties.Encryption)
+		if err != nil {
+			return fmt.Errorf("flattening `customer_managed_key`: %+v", err)
+		}
+		if err := d.Set("customer_managed_key", customerManagedKey); err != nil {
+			return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+		}
 	}
 
 	keys, err := clientStable.ListKeys(ctx, id.ResourceGroup, id.Name, serviceBusNamespaceDefaultAuthorizationRule)
@@ -250,3 +325,133 @@ func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+func expandServiceBusNamespaceIdentity(input []interface{}) (*servicebus.Identity, error) {
+	config, err := serviceBusNamespaceIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Type == identity.Type(servicebus.ManagedServiceIdentityTypeNone) {
+		return nil, nil
+	}
+
+	var identityMaps map[string]*servicebus.UserAssignedIdentity
+	if len(config.UserAssignedIdentityIds) != 0 {
+		identityMaps = make(map[string]*servicebus.UserAssignedIdentity, len(config.UserAssignedIdentityIds))
+		for _, id := range config.UserAssignedIdentityIds {
+			identityMaps[id] = &servicebus.UserAssignedIdentity{}
+		}
+	}
+
+	return &servicebus.Identity{
+		Type:                   servicebus.ManagedServiceIdentityType(config.Type),
+		UserAssignedIdentities: identityMaps,
+	}, nil
+}
+
+func flattenServiceBusNamespaceIdentity(input *servicebus.Identity) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	var identityIds []string
+	for id := range input.UserAssignedIdentities {
+		parsedId, err := msiparse.UserAssignedIdentityIDInsensitively(id)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = append(identityIds, parsedId.ID())
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return serviceBusNamespaceIdentity{}.Flatten(&identity.ExpandedConfig{
+		Type:                    identity.Type(string(input.Type)),
+		PrincipalId:             principalId,
+		TenantId:                tenantId,
+		UserAssignedIdentityIds: identityIds,
+	}), nil
+}
+
+func expandServiceBusNamespaceEncryption(input []interface{}) (*servicebus.Encryption, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v["key_vault_key_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &servicebus.Encryption{
+		KeySource: servicebus.KeySourceMicrosoftKeyVault,
+		KeyVaultProperties: &[]servicebus.KeyVaultProperties{
+			{
+				KeyName:     utils.String(keyId.Name),
+				KeyVaultURI: utils.String(keyId.KeyVaultBaseUrl),
+				KeyVersion:  utils.String(keyId.Version),
+				Identity: &servicebus.UserAssignedIdentityProperties{
+					UserAssignedIdentity: utils.String(v["identity_id"].(string)),
+				},
+			},
+		},
+		RequireInfrastructureEncryption: utils.Bool(v["infrastructure_encryption_enabled"].(bool)),
+	}, nil
+}
+
+func flattenServiceBusNamespaceEncryption(input *servicebus.Encryption) ([]interface{}, error) {
+	if input == nil || input.KeyVaultProperties == nil || len(*input.KeyVaultProperties) == 0 {
+		return []interface{}{}, nil
+	}
+
+	keyVaultProperties := (*input.KeyVaultProperties)[0]
+
+	var keyName, keyVaultUri, keyVersion string
+	if keyVaultProperties.KeyName != nil {
+		keyName = *keyVaultProperties.KeyName
+	}
+	if keyVaultProperties.KeyVaultURI != nil {
+		keyVaultUri = *keyVaultProperties.KeyVaultURI
+	}
+	if keyVaultProperties.KeyVersion != nil {
+		keyVersion = *keyVaultProperties.KeyVersion
+	}
+
+	keyVaultKeyId, err := keyVaultParse.NewNestedItemID(keyVaultUri, "keys", keyName, keyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	identityId := ""
+	if keyVaultProperties.Identity != nil && keyVaultProperties.Identity.UserAssignedIdentity != nil {
+		parsedId, err := msiparse.UserAssignedIdentityIDInsensitively(*keyVaultProperties.Identity.UserAssignedIdentity)
+		if err != nil {
+			return nil, err
+		}
+		identityId = parsedId.ID()
+	}
+
+	infrastructureEncryptionEnabled := false
+	if input.RequireInfrastructureEncryption != nil {
+		infrastructureEncryptionEnabled = *input.RequireInfrastructureEncryption
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":                  keyVaultKeyId.ID(),
+			"identity_id":                       identityId,
+			"infrastructure_encryption_enabled": infrastructureEncryptionEnabled,
+		},
+	}, nil
+}
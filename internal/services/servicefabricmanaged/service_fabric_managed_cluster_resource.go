@@ -155,8 +155,11 @@ func (k ClusterResource) Arguments() map[string]*pluginsdk.Schema {
 				validation.StringMatch(regexp.MustCompile("^[^\\\\/\"\\[\\]:|<>+=;,?*$]{1,14}$"), "User names cannot contain special characters \\/\"\"[]:|<>+=;,$?*@")),
 		},
 		"password": {
-			Type:     pluginsdk.TypeString,
-			Optional: true,
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			// the password is write-only, so only a salted hash of it is stored in the state
+			DiffSuppressFunc: pluginsdk.DiffSuppressWriteOnlyValue,
 			ValidateFunc: validation.All(
 				validation.StringLenBetween(8, 123),
 				validation.StringIsNotWhiteSpace),
@@ -273,8 +276,14 @@ func (k ClusterResource) Read() sdk.ResourceFunc {
 			}
 
 			model := flattenClusterProperties(cluster.Model)
-			// Password is read-only
+			// Password is write-only, so we keep the hash which is stored in the state - hashing the value when it's
+			// been set in this apply (or was stored in the state prior to it being hashed)
 			model.Password = metadata.ResourceData.Get("password").(string)
+			if !pluginsdk.IsWriteOnlyValueHash(model.Password) {
+				if model.Password, err = pluginsdk.HashWriteOnlyValue(model.Password); err != nil {
+					return fmt.Errorf("hashing `password`: %+v", err)
+				}
+			}
 			model.ResourceGroup = resourceId.ResourceGroupName
 			model.NodeTypes = make([]NodeType, 0)
 			for _, nt := range nts.Items {
@@ -403,6 +412,11 @@ func createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		Sku:        &managedcluster.Sku{Name: model.Sku},
 	}
 
+	// only the hash of the password is available when it's unchanged, so we can only send it when it's been changed
+	if !metadata.ResourceData.HasChange("password") {
+		cluster.Properties.AdminPassword = nil
+	}
+
 	tagsMap := make(map[string]string)
 	for k, v := range model.Tags {
 		tagsMap[k] = v.(string)
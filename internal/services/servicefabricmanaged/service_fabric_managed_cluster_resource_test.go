@@ -3,6 +3,7 @@ package servicefabricmanaged_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -58,6 +59,39 @@ func TestAccServiceFabricManagedCluster_full(t *testing.T) {
 	})
 }
 
+func TestAccServiceFabricManagedCluster_passwordUnchanged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster", "test")
+	r := ClusterResource{}
+	nodeTypeData := r.nodeType("test1", true, 130, 5)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withPassword(data, nodeTypeData, "NotV3ryS3cur3P@$$w0rd", "value"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").MatchesRegex(regexp.MustCompile("^[0-9a-f]{32}:[0-9a-f]{64}$")),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			// the password is omitted from the request when it's unchanged, which the API must accept without resetting it
+			Config: r.withPassword(data, nodeTypeData, "NotV3ryS3cur3P@$$w0rd", "updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.Test").HasValue("updated"),
+				check.That(data.ResourceName).Key("password").MatchesRegex(regexp.MustCompile("^[0-9a-f]{32}:[0-9a-f]{64}$")),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.withPassword(data, nodeTypeData, "An0th3rNotS3cur3P@$$w0rd", "updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
 func (r ClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	resourceID, err := managedcluster.ParseManagedClusterID(state.ID)
 	if err != nil {
@@ -76,6 +110,10 @@ func (r ClusterResource) Exists(ctx context.Context, clients *clients.Client, st
 }
 
 func (r ClusterResource) basic(data acceptance.TestData, nodeTypeData string) string {
+	return r.withPassword(data, nodeTypeData, "NotV3ryS3cur3P@$$w0rd", "value")
+}
+
+func (r ClusterResource) withPassword(data acceptance.TestData, nodeTypeData string, password string, tagValue string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -92,7 +130,7 @@ resource "azurerm_service_fabric_managed_cluster" "test" {
   location            = azurerm_resource_group.test.location
   sku                 = "Standard"
   username            = "testUser"
-  password            = "NotV3ryS3cur3P@$$w0rd"
+  password            = "%[5]s"
   dns_service_enabled = true
 
   client_connection_port = 12345
@@ -109,10 +147,10 @@ resource "azurerm_service_fabric_managed_cluster" "test" {
   %[4]s
 
   tags = {
-    Test = "value"
+    Test = "%[6]s"
   }
 }
-`, data.RandomInteger, data.Locations.Primary, data.RandomString, nodeTypeData)
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, nodeTypeData, password, tagValue)
 }
 
 func (r ClusterResource) nodeType(name string, primary bool, diskSize int, instanceCount int) string {
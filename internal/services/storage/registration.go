@@ -69,5 +69,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DisksPoolResource{},
+		StorageAccountFailoverResource{},
+		StorageAccountLocalUserResource{},
+		StorageContainerImmutabilityPolicyResource{},
+		StorageContainerLegalHoldResource{},
 	}
 }
@@ -0,0 +1,36 @@
+package storageaccounts
+
+import "strings"
+
+type AccountImmutabilityPolicyState string
+
+const (
+	AccountImmutabilityPolicyStateDisabled AccountImmutabilityPolicyState = "Disabled"
+	AccountImmutabilityPolicyStateLocked   AccountImmutabilityPolicyState = "Locked"
+	AccountImmutabilityPolicyStateUnlocked AccountImmutabilityPolicyState = "Unlocked"
+)
+
+func PossibleValuesForAccountImmutabilityPolicyState() []string {
+	return []string{
+		"Disabled",
+		"Locked",
+		"Unlocked",
+	}
+}
+
+func parseAccountImmutabilityPolicyState(input string) (*AccountImmutabilityPolicyState, error) {
+	vals := map[string]AccountImmutabilityPolicyState{
+		"disabled": "Disabled",
+		"locked":   "Locked",
+		"unlocked": "Unlocked",
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// it could be a new value - best effort convert this
+	v := input
+
+	out := AccountImmutabilityPolicyState(v)
+	return &out, nil
+}
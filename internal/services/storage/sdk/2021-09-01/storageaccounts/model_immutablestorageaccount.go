@@ -0,0 +1,6 @@
+package storageaccounts
+
+type ImmutableStorageAccount struct {
+	Enabled            *bool                                `json:"enabled,omitempty"`
+	ImmutabilityPolicy *AccountImmutabilityPolicyProperties `json:"immutabilityPolicy,omitempty"`
+}
@@ -0,0 +1,11 @@
+package storageaccounts
+
+type StorageAccount struct {
+	Id         *string                   `json:"id,omitempty"`
+	Kind       *string                   `json:"kind,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *StorageAccountProperties `json:"properties,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
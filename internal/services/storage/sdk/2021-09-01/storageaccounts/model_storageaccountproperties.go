@@ -0,0 +1,9 @@
+package storageaccounts
+
+type StorageAccountProperties struct {
+	ImmutableStorageWithVersioning *ImmutableStorageAccount `json:"immutableStorageWithVersioning,omitempty"`
+	IsHnsEnabled                   *bool                    `json:"isHnsEnabled,omitempty"`
+	IsLocalUserEnabled             *bool                    `json:"isLocalUserEnabled,omitempty"`
+	IsNfsV3Enabled                 *bool                    `json:"isNfsV3Enabled,omitempty"`
+	IsSftpEnabled                  *bool                    `json:"isSftpEnabled,omitempty"`
+}
@@ -0,0 +1,6 @@
+package storageaccounts
+
+type StorageAccountUpdateParameters struct {
+	Properties *StorageAccountPropertiesUpdateParameters `json:"properties,omitempty"`
+	Tags       *map[string]string                        `json:"tags,omitempty"`
+}
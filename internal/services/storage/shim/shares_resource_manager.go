@@ -0,0 +1,210 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/shares"
+)
+
+// the Data Plane API uses this format for the Start and Expiry fields of an Access Policy
+const shareAccessPolicyTimeFormat = "2006-01-02T15:04:05.0000000Z"
+
+type ResourceManagerStorageShareWrapper struct {
+	client *storage.FileSharesClient
+}
+
+// NewResourceManagerStorageShareWrapper returns a StorageShareWrapper which manages File Shares using
+// the Resource Manager API, which (unlike the Data Plane API) can be authenticated using AzureAD.
+func NewResourceManagerStorageShareWrapper(client *storage.FileSharesClient) StorageShareWrapper {
+	return ResourceManagerStorageShareWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageShareWrapper) Create(ctx context.Context, resourceGroup, accountName, shareName string, input shares.CreateInput) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			EnabledProtocols: storage.EnabledProtocols(input.EnabledProtocol),
+			Metadata:         w.expandMetaData(input.MetaData),
+			ShareQuota:       utils.Int32(int32(input.QuotaInGB)),
+		},
+	}
+	_, err := w.client.Create(ctx, resourceGroup, accountName, shareName, share, "")
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) Delete(ctx context.Context, resourceGroup, accountName, shareName string) error {
+	resp, err := w.client.Delete(ctx, resourceGroup, accountName, shareName, "", "snapshots")
+	if utils.ResponseWasNotFound(resp) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) Exists(ctx context.Context, resourceGroup, accountName, shareName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, err
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (w ResourceManagerStorageShareWrapper) Get(ctx context.Context, resourceGroup, accountName, shareName string) (*StorageShareProperties, error) {
+	share, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(share.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if share.FileShareProperties == nil {
+		return nil, fmt.Errorf("`properties` was nil for Share %q (Storage Account %q / Resource Group %q)", shareName, accountName, resourceGroup)
+	}
+
+	props := *share.FileShareProperties
+	output := StorageShareProperties{
+		ACLs:            w.flattenSignedIdentifiers(props.SignedIdentifiers),
+		EnabledProtocol: shares.ShareProtocol(props.EnabledProtocols),
+		MetaData:        w.flattenMetaData(props.Metadata),
+	}
+	if props.ShareQuota != nil {
+		output.QuotaGB = int(*props.ShareQuota)
+	}
+
+	return &output, nil
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateACLs(ctx context.Context, resourceGroup, accountName, shareName string, acls []shares.SignedIdentifier) error {
+	identifiers, err := w.expandSignedIdentifiers(acls)
+	if err != nil {
+		return err
+	}
+
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			SignedIdentifiers: identifiers,
+		},
+	}
+	_, err = w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, shareName string, metaData map[string]string) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			Metadata: w.expandMetaData(metaData),
+		},
+	}
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateQuota(ctx context.Context, resourceGroup, accountName, shareName string, quotaGB int) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			ShareQuota: utils.Int32(int32(quotaGB)),
+		},
+	}
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) expandMetaData(input map[string]string) map[string]*string {
+	output := make(map[string]*string)
+
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+
+	return output
+}
+
+func (w ResourceManagerStorageShareWrapper) flattenMetaData(input map[string]*string) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+
+		output[k] = *v
+	}
+
+	return output
+}
+
+func (w ResourceManagerStorageShareWrapper) expandSignedIdentifiers(input []shares.SignedIdentifier) (*[]storage.SignedIdentifier, error) {
+	output := make([]storage.SignedIdentifier, 0)
+
+	for _, v := range input {
+		policy := storage.AccessPolicy{
+			Permission: utils.String(v.AccessPolicy.Permission),
+		}
+
+		if v.AccessPolicy.Start != "" {
+			start, err := time.Parse(time.RFC3339, v.AccessPolicy.Start)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `start` %q for Access Policy %q: %+v", v.AccessPolicy.Start, v.Id, err)
+			}
+			policy.Start = &date.Time{Time: start}
+		}
+
+		if v.AccessPolicy.Expiry != "" {
+			expiry, err := time.Parse(time.RFC3339, v.AccessPolicy.Expiry)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `expiry` %q for Access Policy %q: %+v", v.AccessPolicy.Expiry, v.Id, err)
+			}
+			policy.Expiry = &date.Time{Time: expiry}
+		}
+
+		output = append(output, storage.SignedIdentifier{
+			ID:           utils.String(v.Id),
+			AccessPolicy: &policy,
+		})
+	}
+
+	return &output, nil
+}
+
+func (w ResourceManagerStorageShareWrapper) flattenSignedIdentifiers(input *[]storage.SignedIdentifier) []shares.SignedIdentifier {
+	output := make([]shares.SignedIdentifier, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		identifier := shares.SignedIdentifier{}
+		if v.ID != nil {
+			identifier.Id = *v.ID
+		}
+
+		if policy := v.AccessPolicy; policy != nil {
+			if policy.Start != nil {
+				identifier.AccessPolicy.Start = policy.Start.Format(shareAccessPolicyTimeFormat)
+			}
+			if policy.Expiry != nil {
+				identifier.AccessPolicy.Expiry = policy.Expiry.Format(shareAccessPolicyTimeFormat)
+			}
+			if policy.Permission != nil {
+				identifier.AccessPolicy.Permission = *policy.Permission
+			}
+		}
+
+		output = append(output, identifier)
+	}
+
+	return output
+}
@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = StorageAccountFailoverResource{}
+
+type StorageAccountFailoverResource struct{}
+
+type StorageAccountFailoverModel struct {
+	StorageAccountId string            `tfschema:"storage_account_id"`
+	Triggers         map[string]string `tfschema:"triggers"`
+	LastSyncTime     string            `tfschema:"last_sync_time"`
+	PrimaryLocation  string            `tfschema:"primary_location"`
+}
+
+func (r StorageAccountFailoverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_sync_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) ModelObject() interface{} {
+	return &StorageAccountFailoverModel{}
+}
+
+func (r StorageAccountFailoverResource) ResourceType() string {
+	return "azurerm_storage_account_failover"
+}
+
+func (r StorageAccountFailoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageAccountID
+}
+
+func (r StorageAccountFailoverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// a failover typically completes within an hour, but can take longer for large accounts
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.AccountsClient
+
+			var model StorageAccountFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.StorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.Name, storageAccountResourceName)
+			defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+			existing, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Sku == nil || !storageAccountSkuIsGeoReplicated(existing.Sku.Name) {
+				return fmt.Errorf("failing over %s: a failover can only be performed for a geo-redundant Storage Account (GRS, RAGRS, GZRS or RAGZRS)", *id)
+			}
+
+			stats, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, storage.AccountExpandGeoReplicationStats)
+			if err != nil {
+				return fmt.Errorf("retrieving the Geo Replication Stats for %s: %+v", *id, err)
+			}
+			if props := stats.AccountProperties; props != nil && props.GeoReplicationStats != nil {
+				replication := props.GeoReplicationStats
+				if replication.CanFailover != nil && !*replication.CanFailover {
+					return fmt.Errorf("failing over %s: the Storage Account can't currently be failed over (the secondary location has status %q)", *id, string(replication.Status))
+				}
+
+				// any writes made after this point in time may be lost as a result of the failover
+				if replication.LastSyncTime != nil {
+					model.LastSyncTime = replication.LastSyncTime.Format(time.RFC3339)
+				}
+			}
+
+			log.Printf("[DEBUG] Failing over %s..", *id)
+			future, err := client.Failover(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("failing over %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the failover of %s: %+v", *id, err)
+			}
+
+			resp, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if props := resp.AccountProperties; props != nil && props.PrimaryLocation != nil {
+				model.PrimaryLocation = *props.PrimaryLocation
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.AccountsClient
+
+			id, err := parse.StorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageAccountFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the failover itself can't be retrieved, so the best we can do is check the Storage Account still exists
+			resp, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model.StorageAccountId = id.ID()
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageAccountFailoverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a failover can't be undone, so there's nothing to do here
+			return nil
+		},
+	}
+}
+
+func storageAccountSkuIsGeoReplicated(input storage.SkuName) bool {
+	replicationType := strings.ToUpper(string(input))
+	for _, v := range []string{"_GRS", "_RAGRS", "_GZRS", "_RAGZRS"} {
+		if strings.HasSuffix(replicationType, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,460 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-09-01/localusers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-09-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StorageAccountLocalUserResource{}
+
+type StorageAccountLocalUserResource struct{}
+
+type StorageAccountLocalUserModel struct {
+	Name               string                                   `tfschema:"name"`
+	StorageAccountId   string                                   `tfschema:"storage_account_id"`
+	HomeDirectory      string                                   `tfschema:"home_directory"`
+	PermissionScope    []StorageAccountLocalUserPermissionScope `tfschema:"permission_scope"`
+	SshAuthorizedKey   []StorageAccountLocalUserSshKey          `tfschema:"ssh_authorized_key"`
+	SshKeyEnabled      bool                                     `tfschema:"ssh_key_enabled"`
+	SshPasswordEnabled bool                                     `tfschema:"ssh_password_enabled"`
+	Password           string                                   `tfschema:"password"`
+	Sid                string                                   `tfschema:"sid"`
+}
+
+type StorageAccountLocalUserPermissionScope struct {
+	Permissions  []StorageAccountLocalUserPermissions `tfschema:"permissions"`
+	ResourceName string                               `tfschema:"resource_name"`
+	Service      string                               `tfschema:"service"`
+}
+
+type StorageAccountLocalUserPermissions struct {
+	Create bool `tfschema:"create"`
+	Delete bool `tfschema:"delete"`
+	List   bool `tfschema:"list"`
+	Read   bool `tfschema:"read"`
+	Write  bool `tfschema:"write"`
+}
+
+type StorageAccountLocalUserSshKey struct {
+	Description string `tfschema:"description"`
+	Key         string `tfschema:"key"`
+}
+
+func (r StorageAccountLocalUserResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{3,64}$`), "`name` must be between 3 and 64 characters and may only contain lowercase letters and numbers"),
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageaccounts.ValidateStorageAccountID,
+		},
+
+		"home_directory": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"permission_scope": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"permissions": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"create": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"delete": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"list": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"read": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"write": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+							},
+						},
+					},
+
+					"resource_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"service": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"blob",
+							"file",
+						}, false),
+					},
+				},
+			},
+		},
+
+		"ssh_authorized_key": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"ssh_key_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"ssh_password_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageAccountLocalUserResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"password": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"sid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageAccountLocalUserResource) ModelObject() interface{} {
+	return &StorageAccountLocalUserModel{}
+}
+
+func (r StorageAccountLocalUserResource) ResourceType() string {
+	return "azurerm_storage_account_local_user"
+}
+
+func (r StorageAccountLocalUserResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return localusers.ValidateLocalUserID
+}
+
+func (r StorageAccountLocalUserResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.LocalUsersClient
+
+			var model StorageAccountLocalUserModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := storageaccounts.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := localusers.NewLocalUserID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := localusers.LocalUser{
+				Properties: &localusers.LocalUserProperties{
+					HasSshKey:         utils.Bool(model.SshKeyEnabled),
+					HasSshPassword:    utils.Bool(model.SshPasswordEnabled),
+					PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(model.PermissionScope),
+					SshAuthorizedKeys: expandStorageAccountLocalUserSshKeys(model.SshAuthorizedKey),
+				},
+			}
+			if model.HomeDirectory != "" {
+				parameters.Properties.HomeDirectory = utils.String(model.HomeDirectory)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			// the password is only returned when it's (re)generated, so is generated once and stored in the state
+			if model.SshPasswordEnabled {
+				password, err := regenerateStorageAccountLocalUserPassword(ctx, client, id)
+				if err != nil {
+					return err
+				}
+				if err := metadata.ResourceData.Set("password", password); err != nil {
+					return fmt.Errorf("setting `password`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountLocalUserResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.LocalUsersClient
+
+			id, err := localusers.ParseLocalUserID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API doesn't return the password or the SSH keys, so these are retrieved from the existing state
+			var config StorageAccountLocalUserModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := StorageAccountLocalUserModel{
+				Name:             id.LocalUserName,
+				StorageAccountId: storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID(),
+				Password:         config.Password,
+				SshAuthorizedKey: config.SshAuthorizedKey,
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.HomeDirectory = utils.NormalizeNilableString(props.HomeDirectory)
+				state.Sid = utils.NormalizeNilableString(props.Sid)
+				state.PermissionScope = flattenStorageAccountLocalUserPermissionScopes(props.PermissionScopes)
+				if props.HasSshKey != nil {
+					state.SshKeyEnabled = *props.HasSshKey
+				}
+				if props.HasSshPassword != nil {
+					state.SshPasswordEnabled = *props.HasSshPassword
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountLocalUserResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.LocalUsersClient
+
+			id, err := localusers.ParseLocalUserID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageAccountLocalUserModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the SSH keys are never returned from the API, so the full payload is sent from the configuration
+			parameters := localusers.LocalUser{
+				Properties: &localusers.LocalUserProperties{
+					HasSshKey:         utils.Bool(model.SshKeyEnabled),
+					HasSshPassword:    utils.Bool(model.SshPasswordEnabled),
+					PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(model.PermissionScope),
+					SshAuthorizedKeys: expandStorageAccountLocalUserSshKeys(model.SshAuthorizedKey),
+				},
+			}
+			if model.HomeDirectory != "" {
+				parameters.Properties.HomeDirectory = utils.String(model.HomeDirectory)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if metadata.ResourceData.HasChange("ssh_password_enabled") {
+				password := ""
+				if model.SshPasswordEnabled {
+					password, err = regenerateStorageAccountLocalUserPassword(ctx, client, *id)
+					if err != nil {
+						return err
+					}
+				}
+				if err := metadata.ResourceData.Set("password", password); err != nil {
+					return fmt.Errorf("setting `password`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountLocalUserResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.LocalUsersClient
+
+			id, err := localusers.ParseLocalUserID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func regenerateStorageAccountLocalUserPassword(ctx context.Context, client *localusers.LocalUsersClient, id localusers.LocalUserId) (string, error) {
+	resp, err := client.RegeneratePassword(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("regenerating the password for %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.SshPassword == nil {
+		return "", fmt.Errorf("regenerating the password for %s: `sshPassword` was nil", id)
+	}
+
+	return *resp.Model.SshPassword, nil
+}
+
+func expandStorageAccountLocalUserPermissionScopes(input []StorageAccountLocalUserPermissionScope) *[]localusers.PermissionScope {
+	output := make([]localusers.PermissionScope, 0)
+	for _, scope := range input {
+		permissions := ""
+		if len(scope.Permissions) > 0 {
+			p := scope.Permissions[0]
+			if p.Read {
+				permissions += "r"
+			}
+			if p.Write {
+				permissions += "w"
+			}
+			if p.Delete {
+				permissions += "d"
+			}
+			if p.List {
+				permissions += "l"
+			}
+			if p.Create {
+				permissions += "c"
+			}
+		}
+
+		output = append(output, localusers.PermissionScope{
+			Permissions:  permissions,
+			ResourceName: scope.ResourceName,
+			Service:      scope.Service,
+		})
+	}
+	return &output
+}
+
+func flattenStorageAccountLocalUserPermissionScopes(input *[]localusers.PermissionScope) []StorageAccountLocalUserPermissionScope {
+	output := make([]StorageAccountLocalUserPermissionScope, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, scope := range *input {
+		output = append(output, StorageAccountLocalUserPermissionScope{
+			Permissions: []StorageAccountLocalUserPermissions{
+				{
+					Create: strings.Contains(scope.Permissions, "c"),
+					Delete: strings.Contains(scope.Permissions, "d"),
+					List:   strings.Contains(scope.Permissions, "l"),
+					Read:   strings.Contains(scope.Permissions, "r"),
+					Write:  strings.Contains(scope.Permissions, "w"),
+				},
+			},
+			ResourceName: scope.ResourceName,
+			Service:      scope.Service,
+		})
+	}
+	return output
+}
+
+func expandStorageAccountLocalUserSshKeys(input []StorageAccountLocalUserSshKey) *[]localusers.SshPublicKey {
+	output := make([]localusers.SshPublicKey, 0)
+	for _, key := range input {
+		item := localusers.SshPublicKey{
+			Key: utils.String(key.Key),
+		}
+		if key.Description != "" {
+			item.Description = utils.String(key.Description)
+		}
+		output = append(output, item)
+	}
+	return &output
+}
@@ -0,0 +1,206 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-09-01/localusers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageAccountLocalUserResource struct{}
+
+func TestAccStorageAccountLocalUser_password(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.password(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").Exists(),
+				check.That(data.ResourceName).Key("sid").Exists(),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccStorageAccountLocalUser_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.password(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageAccountLocalUser_sshKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sshKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("ssh_authorized_key"),
+	})
+}
+
+func TestAccStorageAccountLocalUser_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.password(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.sshKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").IsEmpty(),
+			),
+		},
+		data.ImportStep("ssh_authorized_key"),
+		{
+			Config: r.password(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").Exists(),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func (StorageAccountLocalUserResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := localusers.ParseLocalUserID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Storage.LocalUsersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (StorageAccountLocalUserResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+  sftp_enabled             = true
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageAccountLocalUserResource) password(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "test" {
+  name                 = "user%s"
+  storage_account_id   = azurerm_storage_account.test.id
+  ssh_password_enabled = true
+  home_directory       = azurerm_storage_container.test.name
+
+  permission_scope {
+    permissions {
+      read   = true
+      create = true
+    }
+    service       = "blob"
+    resource_name = azurerm_storage_container.test.name
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r StorageAccountLocalUserResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "import" {
+  name                 = azurerm_storage_account_local_user.test.name
+  storage_account_id   = azurerm_storage_account_local_user.test.storage_account_id
+  ssh_password_enabled = azurerm_storage_account_local_user.test.ssh_password_enabled
+}
+`, r.password(data))
+}
+
+func (r StorageAccountLocalUserResource) sshKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "test" {
+  name               = "user%s"
+  storage_account_id = azurerm_storage_account.test.id
+  ssh_key_enabled    = true
+  home_directory     = azurerm_storage_container.test.name
+
+  ssh_authorized_key {
+    description = "key1"
+    key         = "AAAAB3NzaC1yc2EAAAADAQABAAABAQDIVCq/lPTnlqMV9S3nR07XRY/65Y+hsaHO2J5GO1wjC5FEMWpVpGIrfv+AoyYvb1bR+pLNmgpgrPwTM1ro7eE1kgs3lHCMQbHwGbCPZ5LNWCtGmXf7QTlEOlZJRH9YnZaovLmsdiidvHJPoF3TJ+9DwfO7g7YF3a4tZZQuVcaQbd4rjIZVNGZJTDnK/HNvNqaEzz9OJEM6OxNa5Mv6pq7sPvyT5DORbFWVdlATO94GH+E9Obdf9Mmq2DJEOnk3TFq5cqTADKqcL6pRt2Iu6wgvy+tBW3/vzL+hl51BN4gh/e+hEeO6whmQk7O9ZpIhVrHPXi1kBf2XjC3Zt+qiHBR"
+  }
+
+  permission_scope {
+    permissions {
+      read   = true
+      write  = true
+      delete = true
+      list   = true
+      create = true
+    }
+    service       = "blob"
+    resource_name = azurerm_storage_container.test.name
+  }
+}
+`, r.template(data), data.RandomString)
+}
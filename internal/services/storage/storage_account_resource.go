@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-getter/helper/url"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-09-01/storageaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -259,6 +261,38 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_protected_append_writes": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"period_since_creation_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"state": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(storageaccounts.PossibleValuesForAccountImmutabilityPolicyState(), false),
+						},
+					},
+				},
+			},
+
 			// TODO: document this new field in 3.0
 			allowPublicNestedItemsName: {
 				Type:     pluginsdk.TypeBool,
@@ -848,6 +882,206 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"primary_blob_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_queue_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_queue_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_table_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_table_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_blob_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_dfs_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_file_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_queue_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_queue_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_table_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_table_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_microsoft_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_microsoft_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_blob_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_dfs_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_file_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_internet_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_internet_host": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:      pluginsdk.TypeString,
 				Sensitive: true,
@@ -906,6 +1140,24 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
+				if v := d.Get("immutability_policy").([]interface{}); len(v) > 0 && !d.Get("blob_properties.0.versioning_enabled").(bool) {
+					return fmt.Errorf("`blob_properties.0.versioning_enabled` must be `true` when `immutability_policy` is specified")
+				}
+
+				if d.HasChange("immutability_policy") {
+					oldPolicy, newPolicy := d.GetChange("immutability_policy")
+					// version-level immutability can only be enabled when the account is created and can't be disabled
+					// once it's been enabled, as such adding or removing the block requires a new account
+					if (len(oldPolicy.([]interface{})) == 0) != (len(newPolicy.([]interface{})) == 0) {
+						d.ForceNew("immutability_policy")
+					}
+
+					oldState, newState := d.GetChange("immutability_policy.0.state")
+					if oldState.(string) == string(storageaccounts.AccountImmutabilityPolicyStateLocked) && newState.(string) != string(storageaccounts.AccountImmutabilityPolicyStateLocked) && len(newPolicy.([]interface{})) > 0 {
+						return fmt.Errorf("the `immutability_policy` cannot be unlocked once it's been `Locked`")
+					}
+				}
+
 				if d.HasChange("large_file_share_enabled") {
 					lfsEnabled, changedEnabled := d.GetChange("large_file_share_enabled")
 					if lfsEnabled.(bool) && !changedEnabled.(bool) {
@@ -1051,6 +1303,11 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
 	}
 
+	sftpEnabled := d.Get("sftp_enabled").(bool)
+	if sftpEnabled && !isHnsEnabled {
+		return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+	}
+
 	// AccountTier must be Premium for FileStorage
 	if accountKind == string(storage.KindFileStorage) {
 		if string(parameters.Sku.Tier) == string(storage.SkuNameStandardLRS) {
@@ -1097,7 +1354,16 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	future, err := client.Create(ctx, id.ResourceGroup, id.Name, parameters)
+	var future storage.AccountsCreateFuture
+	if v, ok := d.GetOk("immutability_policy"); ok {
+		// version-level immutability can only be enabled when the account is created and isn't available in the SDK
+		extended := azuresdkhacks.StorageAccountExtendedProperties{
+			ImmutableStorageWithVersioning: expandStorageAccountImmutabilityPolicyForCreate(v.([]interface{})),
+		}
+		future, err = azuresdkhacks.CreateStorageAccountWithExtendedProperties(ctx, client, id.ResourceGroup, id.Name, parameters, extended)
+	} else {
+		future, err = client.Create(ctx, id.ResourceGroup, id.Name, parameters)
+	}
 	if err != nil {
 		return fmt.Errorf("creating Azure Storage Account %q: %+v", id.Name, err)
 	}
@@ -1109,6 +1375,13 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", id.Name, id.ID())
 	d.SetId(id.ID())
 
+	// SFTP is disabled by default, so this only needs to be applied when it's been enabled
+	if sftpEnabled {
+		if err := applyStorageAccountSftp(ctx, meta, id, true); err != nil {
+			return err
+		}
+	}
+
 	if val, ok := d.GetOk("blob_properties"); ok {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.KindFileStorage) {
@@ -1432,6 +1705,17 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("sftp_enabled") {
+		sftpEnabled := d.Get("sftp_enabled").(bool)
+		if sftpEnabled && !d.Get("is_hns_enabled").(bool) {
+			return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+		}
+
+		if err := applyStorageAccountSftp(ctx, meta, *id, sftpEnabled); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("routing") {
 		opts := storage.AccountUpdateParameters{
 			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
@@ -1507,6 +1791,12 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("immutability_policy") {
+		if err := applyStorageAccountImmutabilityPolicy(ctx, meta, *id, d.Get("immutability_policy").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("queue_properties") {
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, id.Name)
@@ -1576,6 +1866,42 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	return resourceStorageAccountRead(d, meta)
 }
 
+// applyStorageAccountSftp toggles SFTP using a newer API version, since this isn't
+// available in the API version used for the rest of the Storage Account
+func applyStorageAccountSftp(ctx context.Context, meta interface{}, id parse.StorageAccountId, enabled bool) error {
+	client := meta.(*clients.Client).Storage.AccountsV2Client
+
+	accountId := storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	parameters := storageaccounts.StorageAccountUpdateParameters{
+		Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+			IsSftpEnabled: utils.Bool(enabled),
+		},
+	}
+	if _, err := client.Update(ctx, accountId, parameters); err != nil {
+		return fmt.Errorf("updating `sftp_enabled` for %s: %+v", accountId, err)
+	}
+
+	return nil
+}
+
+// applyStorageAccountImmutabilityPolicy configures the account-level version immutability using a newer API
+// version, since this isn't available in the API version used for the rest of the Storage Account
+func applyStorageAccountImmutabilityPolicy(ctx context.Context, meta interface{}, id parse.StorageAccountId, input []interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsV2Client
+
+	accountId := storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	parameters := storageaccounts.StorageAccountUpdateParameters{
+		Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+			ImmutableStorageWithVersioning: expandStorageAccountImmutabilityPolicy(input),
+		},
+	}
+	if _, err := client.Update(ctx, accountId, parameters); err != nil {
+		return fmt.Errorf("updating `immutability_policy` for %s: %+v", accountId, err)
+	}
+
+	return nil
+}
+
 func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Storage.AccountsClient
 	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
@@ -1612,7 +1938,9 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if e, ok := err.(azautorest.DetailedError); ok {
 			if status, ok := e.StatusCode.(int); ok {
 				hasWriteLock = status == http.StatusConflict
-				doesntHavePermissions = status == http.StatusUnauthorized
+				// listing the keys can be disallowed either through RBAC or an Azure Policy (which is common when
+				// `storage_use_azuread` is enabled) - in which case the keys are unavailable but this isn't an error
+				doesntHavePermissions = status == http.StatusUnauthorized || status == http.StatusForbidden
 			}
 		}
 
@@ -1645,6 +1973,24 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 		d.Set("nfsv3_enabled", props.EnableNfsV3)
 
+		// SFTP and the account-level immutability are only available in a newer API version, so need to be retrieved separately
+		sftpEnabled := false
+		var immutabilityPolicy *storageaccounts.ImmutableStorageAccount
+		account, err := meta.(*clients.Client).Storage.AccountsV2Client.GetProperties(ctx, storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name))
+		if err != nil {
+			return fmt.Errorf("retrieving `sftp_enabled` and `immutability_policy` for %s: %+v", *id, err)
+		}
+		if model := account.Model; model != nil && model.Properties != nil {
+			if model.Properties.IsSftpEnabled != nil {
+				sftpEnabled = *model.Properties.IsSftpEnabled
+			}
+			immutabilityPolicy = model.Properties.ImmutableStorageWithVersioning
+		}
+		d.Set("sftp_enabled", sftpEnabled)
+		if err := d.Set("immutability_policy", flattenStorageAccountImmutabilityPolicy(immutabilityPolicy)); err != nil {
+			return fmt.Errorf("setting `immutability_policy`: %+v", err)
+		}
+
 		if features.ThreePointOh() {
 			// There is a certain edge case that could result in the Azure API returning a null value for AllowBLobPublicAccess.
 			// Since the field is a pointer, this gets marshalled to a nil value instead of a boolean. Here we set this
@@ -2968,7 +3314,7 @@ func flattenAndSetAzureRmStorageAccountPrimaryEndpoints(d *pluginsdk.ResourceDat
 		return err
 	}
 
-	return nil
+	return flattenAndSetAzureRmStorageAccountRoutingEndpoints(d, "primary", primary)
 }
 
 func flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d *pluginsdk.ResourceData, secondary *storage.Endpoints) error {
@@ -2994,6 +3340,54 @@ func flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d *pluginsdk.ResourceD
 	if err := setEndpointAndHost(d, "secondary", secondary.Web, "web"); err != nil {
 		return err
 	}
+
+	return flattenAndSetAzureRmStorageAccountRoutingEndpoints(d, "secondary", secondary)
+}
+
+// flattenAndSetAzureRmStorageAccountRoutingEndpoints sets the route-specific endpoints, which are only
+// returned when they've been published using the `routing` block
+func flattenAndSetAzureRmStorageAccountRoutingEndpoints(d *pluginsdk.ResourceData, ordinalString string, input *storage.Endpoints) error {
+	microsoftEndpoints := &storage.AccountMicrosoftEndpoints{}
+	if input.MicrosoftEndpoints != nil {
+		microsoftEndpoints = input.MicrosoftEndpoints
+	}
+	internetEndpoints := &storage.AccountInternetEndpoints{}
+	if input.InternetEndpoints != nil {
+		internetEndpoints = input.InternetEndpoints
+	}
+
+	if err := setEndpointAndHost(d, ordinalString, microsoftEndpoints.Blob, "blob_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoftEndpoints.Dfs, "dfs_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoftEndpoints.File, "file_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoftEndpoints.Queue, "queue_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoftEndpoints.Table, "table_microsoft"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, microsoftEndpoints.Web, "web_microsoft"); err != nil {
+		return err
+	}
+
+	if err := setEndpointAndHost(d, ordinalString, internetEndpoints.Blob, "blob_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internetEndpoints.Dfs, "dfs_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internetEndpoints.File, "file_internet"); err != nil {
+		return err
+	}
+	if err := setEndpointAndHost(d, ordinalString, internetEndpoints.Web, "web_internet"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -3028,3 +3422,70 @@ func getDefaultAllowBlobPublicAccessName() string {
 	}
 	return "allow_blob_public_access"
 }
+
+func expandStorageAccountImmutabilityPolicy(input []interface{}) *storageaccounts.ImmutableStorageAccount {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	state := storageaccounts.AccountImmutabilityPolicyState(v["state"].(string))
+	return &storageaccounts.ImmutableStorageAccount{
+		Enabled: utils.Bool(true),
+		ImmutabilityPolicy: &storageaccounts.AccountImmutabilityPolicyProperties{
+			AllowProtectedAppendWrites:            utils.Bool(v["allow_protected_append_writes"].(bool)),
+			ImmutabilityPeriodSinceCreationInDays: utils.Int64(int64(v["period_since_creation_in_days"].(int))),
+			State:                                 &state,
+		},
+	}
+}
+
+func expandStorageAccountImmutabilityPolicyForCreate(input []interface{}) *azuresdkhacks.StorageAccountImmutableStorageWithVersioning {
+	policy := expandStorageAccountImmutabilityPolicy(input)
+	if policy == nil || policy.ImmutabilityPolicy == nil {
+		return nil
+	}
+
+	var state *string
+	if v := policy.ImmutabilityPolicy.State; v != nil {
+		state = utils.String(string(*v))
+	}
+	return &azuresdkhacks.StorageAccountImmutableStorageWithVersioning{
+		Enabled: policy.Enabled,
+		ImmutabilityPolicy: &azuresdkhacks.StorageAccountImmutabilityPolicy{
+			AllowProtectedAppendWrites:            policy.ImmutabilityPolicy.AllowProtectedAppendWrites,
+			ImmutabilityPeriodSinceCreationInDays: policy.ImmutabilityPolicy.ImmutabilityPeriodSinceCreationInDays,
+			State:                                 state,
+		},
+	}
+}
+
+func flattenStorageAccountImmutabilityPolicy(input *storageaccounts.ImmutableStorageAccount) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled || input.ImmutabilityPolicy == nil {
+		return []interface{}{}
+	}
+
+	policy := input.ImmutabilityPolicy
+	allowProtectedAppendWrites := false
+	if policy.AllowProtectedAppendWrites != nil {
+		allowProtectedAppendWrites = *policy.AllowProtectedAppendWrites
+	}
+
+	periodSinceCreationInDays := 0
+	if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+		periodSinceCreationInDays = int(*policy.ImmutabilityPeriodSinceCreationInDays)
+	}
+
+	state := ""
+	if policy.State != nil {
+		state = string(*policy.State)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allow_protected_append_writes": allowProtectedAppendWrites,
+			"period_since_creation_in_days": periodSinceCreationInDays,
+			"state":                         state,
+		},
+	}
+}
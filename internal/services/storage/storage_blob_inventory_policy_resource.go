@@ -3,9 +3,10 @@ package storage
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
@@ -16,6 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// blobInventoryPolicyDefaultSchemaFields are the fields exported by a rule when `schema_fields` isn't specified
+var blobInventoryPolicyDefaultSchemaFields = []string{"Name"}
+
 func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStorageBlobInventoryPolicyCreateUpdate,
@@ -45,9 +49,9 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 
 			"storage_container_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
+				Optional:     true,
 				ValidateFunc: validate.StorageContainerName,
+				Deprecated:   "`storage_container_name` has been deprecated in favour of `storage_container_name` within the `rules` block and will be removed in version 3.0 of the AzureRM Provider",
 			},
 
 			"rules": {
@@ -61,15 +65,64 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
+						// TODO: make this Required in 3.0 when the top-level `storage_container_name` is removed
+						"storage_container_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.StorageContainerName,
+						},
+
+						"format": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(storage.FormatCsv),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.FormatCsv),
+								string(storage.FormatParquet),
+							}, false),
+						},
+
+						"schedule": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(storage.ScheduleDaily),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.ScheduleDaily),
+								string(storage.ScheduleWeekly),
+							}, false),
+						},
+
+						"scope": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(storage.ObjectTypeBlob),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.ObjectTypeBlob),
+								string(storage.ObjectTypeContainer),
+							}, false),
+						},
+
+						// when omitted the fields in `blobInventoryPolicyDefaultSchemaFields` are exported, which matches the
+						// behaviour of earlier versions of the API which didn't support specifying the fields
+						"schema_fields": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
 						"filter": {
 							Type:     pluginsdk.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"blob_types": {
 										Type:     pluginsdk.TypeSet,
-										Required: true,
+										Optional: true,
 										Elem: &pluginsdk.Schema{
 											Type: pluginsdk.TypeString,
 											ValidateFunc: validation.StringInSlice([]string{
@@ -134,13 +187,17 @@ func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, m
 		}
 	}
 
+	rules, err := expandBlobInventoryPolicyRules(d.Get("rules").(*pluginsdk.Set).List(), d.Get("storage_container_name").(string))
+	if err != nil {
+		return err
+	}
+
 	props := storage.BlobInventoryPolicy{
 		BlobInventoryPolicyProperties: &storage.BlobInventoryPolicyProperties{
 			Policy: &storage.BlobInventoryPolicySchema{
-				Enabled:     utils.Bool(true),
-				Destination: utils.String(d.Get("storage_container_name").(string)),
-				Type:        utils.String("Inventory"),
-				Rules:       expandBlobInventoryPolicyRules(d.Get("rules").(*pluginsdk.Set).List()),
+				Enabled: utils.Bool(true),
+				Type:    utils.String("Inventory"),
+				Rules:   rules,
 			},
 		},
 	}
@@ -180,8 +237,20 @@ func resourceStorageBlobInventoryPolicyRead(d *pluginsdk.ResourceData, meta inte
 				d.SetId("")
 				return nil
 			}
-			d.Set("storage_container_name", policy.Destination)
-			d.Set("rules", flattenBlobInventoryPolicyRules(policy.Rules))
+			// when the deprecated top-level `storage_container_name` is used it's the destination of every rule, as such
+			// it's omitted from the `rules` block - when the rules no longer share a destination it's cleared, meaning the
+			// destination of each rule will show up in the plan
+			// TODO: remove in 3.0
+			storageContainerName := ""
+			if d.Get("storage_container_name").(string) != "" {
+				storageContainerName = blobInventoryPolicySharedDestination(policy.Rules)
+			}
+			d.Set("storage_container_name", storageContainerName)
+
+			rules := flattenBlobInventoryPolicyRules(policy.Rules, storageContainerName, d.Get("rules").(*pluginsdk.Set).List())
+			if err := d.Set("rules", rules); err != nil {
+				return fmt.Errorf("setting `rules`: %+v", err)
+			}
 		}
 	}
 	return nil
@@ -203,23 +272,60 @@ func resourceStorageBlobInventoryPolicyDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
-func expandBlobInventoryPolicyRules(input []interface{}) *[]storage.BlobInventoryPolicyRule {
+func expandBlobInventoryPolicyRules(input []interface{}, storageContainerName string) (*[]storage.BlobInventoryPolicyRule, error) {
 	results := make([]storage.BlobInventoryPolicyRule, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
+		name := v["name"].(string)
+
+		destination := v["storage_container_name"].(string)
+		if destination != "" && storageContainerName != "" {
+			return nil, fmt.Errorf("`storage_container_name` cannot be specified for the rule %q when the top-level `storage_container_name` is specified", name)
+		}
+		if destination == "" {
+			destination = storageContainerName
+		}
+		if destination == "" {
+			return nil, fmt.Errorf("`storage_container_name` must be specified for the rule %q", name)
+		}
+
+		schemaFields := v["schema_fields"].([]interface{})
+		if len(schemaFields) == 0 {
+			schemaFields = utils.FlattenStringSlice(&blobInventoryPolicyDefaultSchemaFields)
+		}
+
+		scope := v["scope"].(string)
+		filter := expandBlobInventoryPolicyFilter(v["filter"].([]interface{}))
+		if scope == string(storage.ObjectTypeBlob) && (filter == nil || filter.BlobTypes == nil || len(*filter.BlobTypes) == 0) {
+			return nil, fmt.Errorf("`filter.0.blob_types` must be specified for the rule %q when `scope` is `Blob`", name)
+		}
+		if scope == string(storage.ObjectTypeContainer) && filter != nil {
+			if (filter.BlobTypes != nil && len(*filter.BlobTypes) > 0) || *filter.IncludeBlobVersions || *filter.IncludeSnapshots {
+				return nil, fmt.Errorf("only `filter.0.prefix_match` can be specified for the rule %q when `scope` is `Container`", name)
+			}
+			filter.BlobTypes = nil
+			filter.IncludeBlobVersions = nil
+			filter.IncludeSnapshots = nil
+		}
+
 		results = append(results, storage.BlobInventoryPolicyRule{
-			Enabled: utils.Bool(true),
-			Name:    utils.String(v["name"].(string)),
+			Enabled:     utils.Bool(true),
+			Name:        utils.String(name),
+			Destination: utils.String(destination),
 			Definition: &storage.BlobInventoryPolicyDefinition{
-				Filters: expandBlobInventoryPolicyFilter(v["filter"].([]interface{})),
+				Filters:      filter,
+				Format:       storage.Format(v["format"].(string)),
+				Schedule:     storage.Schedule(v["schedule"].(string)),
+				ObjectType:   storage.ObjectType(scope),
+				SchemaFields: utils.ExpandStringSlice(schemaFields),
 			},
 		})
 	}
-	return &results
+	return &results, nil
 }
 
 func expandBlobInventoryPolicyFilter(input []interface{}) *storage.BlobInventoryPolicyFilter {
-	if len(input) == 0 {
+	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
@@ -231,12 +337,44 @@ func expandBlobInventoryPolicyFilter(input []interface{}) *storage.BlobInventory
 	}
 }
 
-func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule) []interface{} {
+// blobInventoryPolicySharedDestination returns the destination of the enabled rules when they all share the same one
+func blobInventoryPolicySharedDestination(input *[]storage.BlobInventoryPolicyRule) string {
+	if input == nil {
+		return ""
+	}
+
+	destination := ""
+	for _, item := range *input {
+		if item.Enabled == nil || !*item.Enabled || item.Definition == nil {
+			continue
+		}
+
+		if item.Destination == nil || *item.Destination == "" {
+			return ""
+		}
+
+		if destination != "" && destination != *item.Destination {
+			return ""
+		}
+		destination = *item.Destination
+	}
+
+	return destination
+}
+
+func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule, storageContainerName string, existing []interface{}) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
+	// the default `schema_fields` are only set when they've been explicitly specified for the rule
+	configuredSchemaFields := make(map[string]bool)
+	for _, item := range existing {
+		v := item.(map[string]interface{})
+		configuredSchemaFields[v["name"].(string)] = len(v["schema_fields"].([]interface{})) > 0
+	}
+
 	for _, item := range *input {
 		var name string
 		if item.Name != nil {
@@ -245,9 +383,22 @@ func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule) [
 		if item.Enabled == nil || !*item.Enabled || item.Definition == nil {
 			continue
 		}
+		var containerName string
+		if item.Destination != nil && *item.Destination != storageContainerName {
+			containerName = *item.Destination
+		}
+		schemaFields := utils.FlattenStringSlice(item.Definition.SchemaFields)
+		if !configuredSchemaFields[name] && item.Definition.SchemaFields != nil && reflect.DeepEqual(*item.Definition.SchemaFields, blobInventoryPolicyDefaultSchemaFields) {
+			schemaFields = make([]interface{}, 0)
+		}
 		results = append(results, map[string]interface{}{
-			"name":   name,
-			"filter": flattenBlobInventoryPolicyFilter(item.Definition.Filters),
+			"name":                   name,
+			"storage_container_name": containerName,
+			"format":                 string(item.Definition.Format),
+			"schedule":               string(item.Definition.Schedule),
+			"scope":                  string(item.Definition.ObjectType),
+			"schema_fields":          schemaFields,
+			"filter":                 flattenBlobInventoryPolicyFilter(item.Definition.Filters),
 		})
 	}
 	return results
@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StorageContainerImmutabilityPolicyResource{}
+
+type StorageContainerImmutabilityPolicyResource struct{}
+
+type StorageContainerImmutabilityPolicyModel struct {
+	StorageContainerResourceManagerId string `tfschema:"storage_container_resource_manager_id"`
+	ImmutabilityPeriodInDays          int    `tfschema:"immutability_period_in_days"`
+	Locked                            bool   `tfschema:"locked"`
+	ProtectedAppendWritesEnabled      bool   `tfschema:"protected_append_writes_enabled"`
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_resource_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerResourceManagerID,
+		},
+
+		"immutability_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 146000),
+		},
+
+		"locked": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"protected_append_writes_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) ModelObject() interface{} {
+	return &StorageContainerImmutabilityPolicyModel{}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) ResourceType() string {
+	return "azurerm_storage_container_immutability_policy"
+}
+
+func (r StorageContainerImmutabilityPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageContainerImmutabilityPolicyID
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			var model StorageContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := parse.StorageContainerResourceManagerID(model.StorageContainerResourceManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageContainerImmutabilityPolicyID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName, containerId.BlobServiceName, containerId.ContainerName, "default")
+
+			// the API always returns a policy, so the policy only exists once it's got an immutability period
+			existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if props := existing.ImmutabilityPolicyProperty; props != nil && props.ImmutabilityPeriodSinceCreationInDays != nil && *props.ImmutabilityPeriodSinceCreationInDays != 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := storage.ImmutabilityPolicy{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(model.ImmutabilityPeriodInDays)),
+					AllowProtectedAppendWrites:            utils.Bool(model.ProtectedAppendWritesEnabled),
+				},
+			}
+			resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &parameters, "")
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if model.Locked {
+				if resp.Etag == nil {
+					return fmt.Errorf("locking %s: `etag` was nil", id)
+				}
+				if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+					return fmt.Errorf("locking %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			props := resp.ImmutabilityPolicyProperty
+			if props == nil || props.ImmutabilityPeriodSinceCreationInDays == nil || *props.ImmutabilityPeriodSinceCreationInDays == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageContainerImmutabilityPolicyModel{
+				StorageContainerResourceManagerId: parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName).ID(),
+				ImmutabilityPeriodInDays:          int(*props.ImmutabilityPeriodSinceCreationInDays),
+				Locked:                            props.State == storage.ImmutabilityPolicyStateLocked,
+			}
+			if props.AllowProtectedAppendWrites != nil {
+				state.ProtectedAppendWritesEnabled = *props.AllowProtectedAppendWrites
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Etag == nil {
+				return fmt.Errorf("retrieving %s: `etag` was nil", *id)
+			}
+			etag := *existing.Etag
+
+			parameters := storage.ImmutabilityPolicy{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(model.ImmutabilityPeriodInDays)),
+					AllowProtectedAppendWrites:            utils.Bool(model.ProtectedAppendWritesEnabled),
+				},
+			}
+
+			// once a policy has been locked it can't be unlocked, and the immutability period can only be extended
+			if existing.ImmutabilityPolicyProperty != nil && existing.ImmutabilityPolicyProperty.State == storage.ImmutabilityPolicyStateLocked {
+				if !model.Locked {
+					return fmt.Errorf("updating %s: a locked immutability policy cannot be unlocked", *id)
+				}
+				if metadata.ResourceData.HasChange("protected_append_writes_enabled") {
+					return fmt.Errorf("updating %s: `protected_append_writes_enabled` cannot be changed once the immutability policy has been locked", *id)
+				}
+				if metadata.ResourceData.HasChange("immutability_period_in_days") {
+					parameters.ImmutabilityPolicyProperty.AllowProtectedAppendWrites = nil
+					if _, err := client.ExtendImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, etag, &parameters); err != nil {
+						return fmt.Errorf("extending %s: %+v", *id, err)
+					}
+				}
+
+				return nil
+			}
+
+			resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &parameters, etag)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if model.Locked {
+				if resp.Etag == nil {
+					return fmt.Errorf("locking %s: `etag` was nil", *id)
+				}
+				if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *resp.Etag); err != nil {
+					return fmt.Errorf("locking %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.ImmutabilityPolicyProperty != nil && existing.ImmutabilityPolicyProperty.State == storage.ImmutabilityPolicyStateLocked {
+				return fmt.Errorf("deleting %s: a locked immutability policy cannot be deleted", *id)
+			}
+			if existing.Etag == nil {
+				return fmt.Errorf("retrieving %s: `etag` was nil", *id)
+			}
+
+			if _, err := client.DeleteImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *existing.Etag); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StorageContainerLegalHoldResource{}
+
+type StorageContainerLegalHoldResource struct{}
+
+type StorageContainerLegalHoldModel struct {
+	StorageContainerResourceManagerId string   `tfschema:"storage_container_resource_manager_id"`
+	Tags                              []string `tfschema:"tags"`
+}
+
+func (r StorageContainerLegalHoldResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_resource_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerResourceManagerID,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				// the tags are normalized to lower case by the API
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{3,23}$`), "each tag must be between 3 and 23 characters and may only contain lowercase letters and numbers"),
+			},
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageContainerLegalHoldResource) ModelObject() interface{} {
+	return &StorageContainerLegalHoldModel{}
+}
+
+func (r StorageContainerLegalHoldResource) ResourceType() string {
+	return "azurerm_storage_container_legal_hold"
+}
+
+func (r StorageContainerLegalHoldResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageContainerResourceManagerID
+}
+
+func (r StorageContainerLegalHoldResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			var model StorageContainerLegalHoldModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a Legal Hold is a property of the Container, rather than a resource in its own right
+			id, err := parse.StorageContainerResourceManagerID(model.StorageContainerResourceManagerId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if len(flattenStorageContainerLegalHoldTags(existing.ContainerProperties)) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			legalHold := storage.LegalHold{
+				Tags: &model.Tags,
+			}
+			if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("setting the Legal Hold for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			id, err := parse.StorageContainerResourceManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			tags := flattenStorageContainerLegalHoldTags(resp.ContainerProperties)
+			if len(tags) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageContainerLegalHoldModel{
+				StorageContainerResourceManagerId: id.ID(),
+				Tags:                              tags,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			id, err := parse.StorageContainerResourceManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				oldRaw, newRaw := metadata.ResourceData.GetChange("tags")
+				oldTags := oldRaw.(*pluginsdk.Set)
+				newTags := newRaw.(*pluginsdk.Set)
+
+				// the new tags are added before the old ones are cleared, so the Container is never without a Legal Hold
+				if toAdd := newTags.Difference(oldTags); toAdd.Len() > 0 {
+					legalHold := storage.LegalHold{
+						Tags: utils.ExpandStringSlice(toAdd.List()),
+					}
+					if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+						return fmt.Errorf("setting the Legal Hold for %s: %+v", *id, err)
+					}
+				}
+
+				if toRemove := oldTags.Difference(newTags); toRemove.Len() > 0 {
+					legalHold := storage.LegalHold{
+						Tags: utils.ExpandStringSlice(toRemove.List()),
+					}
+					if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+						return fmt.Errorf("clearing the Legal Hold for %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.BlobContainersClient
+
+			id, err := parse.StorageContainerResourceManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageContainerLegalHoldModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			legalHold := storage.LegalHold{
+				Tags: &model.Tags,
+			}
+			if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("clearing the Legal Hold for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenStorageContainerLegalHoldTags(input *storage.ContainerProperties) []string {
+	output := make([]string, 0)
+	if input == nil || input.LegalHold == nil || input.LegalHold.Tags == nil {
+		return output
+	}
+
+	for _, tag := range *input.LegalHold.Tags {
+		if tag.Tag != nil {
+			output = append(output, strings.ToLower(*tag.Tag))
+		}
+	}
+
+	return output
+}
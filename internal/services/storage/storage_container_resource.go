@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
 )
 
@@ -66,6 +68,29 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
+			"immutable_storage_with_versioning_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"default_encryption_scope": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageEncryptionScopeName,
+			},
+
+			"encryption_scope_override_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      true,
+				RequiredWith: []string{"default_encryption_scope"},
+			},
+
 			// TODO: support for ACL's, Legal Holds and Immutability Policies
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
@@ -126,8 +151,32 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		MetaData:    metaData,
 	}
 
-	if err := client.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
-		return fmt.Errorf("failed creating container: %+v", err)
+	// the version-level immutability and the encryption scope can only be configured when the container is created,
+	// which is only supported by the Resource Manager API
+	defaultEncryptionScope := d.Get("default_encryption_scope").(string)
+	encryptionScopeOverrideEnabled := d.Get("encryption_scope_override_enabled").(bool)
+	immutableStorageWithVersioningEnabled := d.Get("immutable_storage_with_versioning_enabled").(bool)
+	if immutableStorageWithVersioningEnabled || defaultEncryptionScope != "" || !encryptionScopeOverrideEnabled {
+		parameters := storage.BlobContainer{
+			ContainerProperties: &storage.ContainerProperties{
+				PublicAccess: expandStorageContainerPublicAccess(accessLevel),
+				Metadata:     utils.ExpandMapStringPtrString(metaDataRaw),
+				ImmutableStorageWithVersioning: &storage.ImmutableStorageWithVersioning{
+					Enabled: utils.Bool(immutableStorageWithVersioningEnabled),
+				},
+			},
+		}
+		if defaultEncryptionScope != "" {
+			parameters.ContainerProperties.DefaultEncryptionScope = utils.String(defaultEncryptionScope)
+			parameters.ContainerProperties.DenyEncryptionScopeOverride = utils.Bool(!encryptionScopeOverrideEnabled)
+		}
+		if _, err := storageClient.BlobContainersClient.Create(ctx, account.ResourceGroup, accountName, containerName, parameters); err != nil {
+			return fmt.Errorf("failed creating container: %+v", err)
+		}
+	} else {
+		if err := client.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
+			return fmt.Errorf("failed creating container: %+v", err)
+		}
 	}
 
 	d.SetId(id)
@@ -233,6 +282,29 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 	resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
+	// the version-level immutability and the encryption scope aren't exposed by the Data Plane API
+	container, err := storageClient.BlobContainersClient.Get(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", resourceManagerId, err)
+	}
+	defaultEncryptionScope := ""
+	encryptionScopeOverrideEnabled := true
+	immutableStorageWithVersioningEnabled := false
+	if props := container.ContainerProperties; props != nil {
+		if props.DefaultEncryptionScope != nil {
+			defaultEncryptionScope = *props.DefaultEncryptionScope
+		}
+		if props.DenyEncryptionScopeOverride != nil {
+			encryptionScopeOverrideEnabled = !*props.DenyEncryptionScopeOverride
+		}
+		if props.ImmutableStorageWithVersioning != nil && props.ImmutableStorageWithVersioning.Enabled != nil {
+			immutableStorageWithVersioningEnabled = *props.ImmutableStorageWithVersioning.Enabled
+		}
+	}
+	d.Set("default_encryption_scope", defaultEncryptionScope)
+	d.Set("encryption_scope_override_enabled", encryptionScopeOverrideEnabled)
+	d.Set("immutable_storage_with_versioning_enabled", immutableStorageWithVersioningEnabled)
+
 	return nil
 }
 
@@ -276,6 +348,17 @@ func expandStorageContainerAccessLevel(input string) containers.AccessLevel {
 	return containers.AccessLevel(input)
 }
 
+func expandStorageContainerPublicAccess(input containers.AccessLevel) storage.PublicAccess {
+	switch input {
+	case containers.Blob:
+		return storage.PublicAccessBlob
+	case containers.Container:
+		return storage.PublicAccessContainer
+	default:
+		return storage.PublicAccessNone
+	}
+}
+
 func flattenStorageContainerAccessLevel(input containers.AccessLevel) string {
 	// for historical reasons, "private" above is an empty string in the API
 	if input == containers.Private {
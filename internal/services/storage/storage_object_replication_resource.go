@@ -127,9 +127,11 @@ func resourceStorageObjectReplicationCreate(d *pluginsdk.ResourceData, meta inte
 			return fmt.Errorf("checking for present of existing Storage Object Replication for destination %q): %+v", dstAccount, err)
 		}
 	}
-	for _, existing := range *existingList.Value {
-		if existing.ID != nil && *existing.ID != "" && existing.SourceAccount != nil && *existing.SourceAccount == srcAccount.Name && existing.DestinationAccount != nil && *existing.DestinationAccount == dstAccount.Name {
-			return tf.ImportAsExistsError("azurerm_storage_object_replication", *existing.ID)
+	if existingList.Value != nil {
+		for _, existing := range *existingList.Value {
+			if existing.ID != nil && *existing.ID != "" && existing.SourceAccount != nil && *existing.SourceAccount == srcAccount.Name && existing.DestinationAccount != nil && *existing.DestinationAccount == dstAccount.Name {
+				return tf.ImportAsExistsError("azurerm_storage_object_replication", *existing.ID)
+			}
 		}
 	}
 
@@ -257,12 +259,17 @@ func resourceStorageObjectReplicationDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	if _, err := client.Delete(ctx, id.DstResourceGroup, id.DstStorageAccountName, id.DstName); err != nil {
-		return fmt.Errorf("deleting %q: %+v", id, err)
+	// the policy has to be removed from both sides, either of which may already be gone
+	if resp, err := client.Delete(ctx, id.DstResourceGroup, id.DstStorageAccountName, id.DstName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %q for destination storage account name %q: %+v", id, id.DstStorageAccountName, err)
+		}
 	}
 
-	if _, err := client.Delete(ctx, id.SrcResourceGroup, id.SrcStorageAccountName, id.SrcName); err != nil {
-		return fmt.Errorf("deleting %q : %+v", id, err)
+	if resp, err := client.Delete(ctx, id.SrcResourceGroup, id.SrcStorageAccountName, id.SrcName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %q for source storage account name %q: %+v", id, id.SrcStorageAccountName, err)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,38 @@
+package storagemover
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		StorageMoverResource{},
+		StorageMoverAgentResource{},
+		StorageMoverFileShareTargetEndpointResource{},
+		StorageMoverJobDefinitionResource{},
+		StorageMoverProjectResource{},
+		StorageMoverSmbSourceEndpointResource{},
+		StorageMoverSourceEndpointResource{},
+		StorageMoverTargetEndpointResource{},
+	}
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Storage Mover"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Storage Mover",
+	}
+}
@@ -0,0 +1,8 @@
+package agents
+
+type Agent struct {
+	Id         *string         `json:"id,omitempty"`
+	Name       *string         `json:"name,omitempty"`
+	Properties AgentProperties `json:"properties"`
+	Type       *string         `json:"type,omitempty"`
+}
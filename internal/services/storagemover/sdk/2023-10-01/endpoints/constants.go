@@ -0,0 +1,127 @@
+package endpoints
+
+import "strings"
+
+type CredentialType string
+
+const (
+	CredentialTypeAzureKeyVaultSmb CredentialType = "AzureKeyVaultSmb"
+)
+
+func PossibleValuesForCredentialType() []string {
+	return []string{
+		string(CredentialTypeAzureKeyVaultSmb),
+	}
+}
+
+func parseCredentialType(input string) (*CredentialType, error) {
+	vals := map[string]CredentialType{
+		"azurekeyvaultsmb": CredentialTypeAzureKeyVaultSmb,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CredentialType(input)
+	return &out, nil
+}
+
+type EndpointType string
+
+const (
+	EndpointTypeAzureStorageBlobContainer EndpointType = "AzureStorageBlobContainer"
+	EndpointTypeAzureStorageSmbFileShare  EndpointType = "AzureStorageSmbFileShare"
+	EndpointTypeNfsMount                  EndpointType = "NfsMount"
+	EndpointTypeSmbMount                  EndpointType = "SmbMount"
+)
+
+func PossibleValuesForEndpointType() []string {
+	return []string{
+		string(EndpointTypeAzureStorageBlobContainer),
+		string(EndpointTypeAzureStorageSmbFileShare),
+		string(EndpointTypeNfsMount),
+		string(EndpointTypeSmbMount),
+	}
+}
+
+func parseEndpointType(input string) (*EndpointType, error) {
+	vals := map[string]EndpointType{
+		"azurestorageblobcontainer": EndpointTypeAzureStorageBlobContainer,
+		"azurestoragesmbfileshare":  EndpointTypeAzureStorageSmbFileShare,
+		"nfsmount":                  EndpointTypeNfsMount,
+		"smbmount":                  EndpointTypeSmbMount,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EndpointType(input)
+	return &out, nil
+}
+
+type NfsVersion string
+
+const (
+	NfsVersionNFSauto   NfsVersion = "NFSauto"
+	NfsVersionNFSvFour  NfsVersion = "NFSv4"
+	NfsVersionNFSvThree NfsVersion = "NFSv3"
+)
+
+func PossibleValuesForNfsVersion() []string {
+	return []string{
+		string(NfsVersionNFSauto),
+		string(NfsVersionNFSvFour),
+		string(NfsVersionNFSvThree),
+	}
+}
+
+func parseNfsVersion(input string) (*NfsVersion, error) {
+	vals := map[string]NfsVersion{
+		"nfsauto": NfsVersionNFSauto,
+		"nfsv4":   NfsVersionNFSvFour,
+		"nfsv3":   NfsVersionNFSvThree,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NfsVersion(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
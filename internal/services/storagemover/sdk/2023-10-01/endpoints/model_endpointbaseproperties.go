@@ -0,0 +1,69 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type EndpointBaseProperties interface {
+}
+
+func unmarshalEndpointBasePropertiesImplementation(input []byte) (EndpointBaseProperties, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling EndpointBaseProperties into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["endpointType"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "AzureStorageBlobContainer") {
+		var out AzureStorageBlobContainerEndpointProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into AzureStorageBlobContainerEndpointProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "AzureStorageSmbFileShare") {
+		var out AzureStorageSmbFileShareEndpointProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into AzureStorageSmbFileShareEndpointProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "NfsMount") {
+		var out NfsMountEndpointProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into NfsMountEndpointProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "SmbMount") {
+		var out SmbMountEndpointProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into SmbMountEndpointProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawEndpointBasePropertiesImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawEndpointBasePropertiesImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
@@ -0,0 +1,15 @@
+package jobdefinitions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type JobDefinitionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewJobDefinitionsClientWithBaseURI(endpoint string) JobDefinitionsClient {
+	return JobDefinitionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
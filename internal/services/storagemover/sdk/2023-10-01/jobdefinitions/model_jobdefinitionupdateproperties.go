@@ -0,0 +1,7 @@
+package jobdefinitions
+
+type JobDefinitionUpdateProperties struct {
+	AgentName   *string   `json:"agentName,omitempty"`
+	CopyMode    *CopyMode `json:"copyMode,omitempty"`
+	Description *string   `json:"description,omitempty"`
+}
@@ -0,0 +1,9 @@
+package jobdefinitions
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/jobdefinitions/%s", defaultApiVersion)
+}
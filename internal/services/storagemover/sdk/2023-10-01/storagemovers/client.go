@@ -0,0 +1,15 @@
+package storagemovers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StorageMoversClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStorageMoversClientWithBaseURI(endpoint string) StorageMoversClient {
+	return StorageMoversClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,5 @@
+package storagemovers
+
+type StorageMoverUpdateProperties struct {
+	Description *string `json:"description,omitempty"`
+}
@@ -0,0 +1,188 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/agents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverAgentResource struct{}
+
+func TestAccStorageMoverAgent_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_MACHINE_ID") == "" || os.Getenv("ARM_TEST_ARC_MACHINE_UUID") == "" {
+		t.Skip("Skipping as ARM_TEST_ARC_MACHINE_ID and/or ARM_TEST_ARC_MACHINE_UUID are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+	r := StorageMoverAgentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverAgent_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_MACHINE_ID") == "" || os.Getenv("ARM_TEST_ARC_MACHINE_UUID") == "" {
+		t.Skip("Skipping as ARM_TEST_ARC_MACHINE_ID and/or ARM_TEST_ARC_MACHINE_UUID are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+	r := StorageMoverAgentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverAgent_complete(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_MACHINE_ID") == "" || os.Getenv("ARM_TEST_ARC_MACHINE_UUID") == "" {
+		t.Skip("Skipping as ARM_TEST_ARC_MACHINE_ID and/or ARM_TEST_ARC_MACHINE_UUID are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+	r := StorageMoverAgentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverAgent_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_MACHINE_ID") == "" || os.Getenv("ARM_TEST_ARC_MACHINE_UUID") == "" {
+		t.Skip("Skipping as ARM_TEST_ARC_MACHINE_ID and/or ARM_TEST_ARC_MACHINE_UUID are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+	r := StorageMoverAgentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverAgentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := agents.ParseAgentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StorageMover.AgentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverAgentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storagemover-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_mover" "test" {
+  name                = "acctest-ssm-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r StorageMoverAgentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sa-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = "%s"
+  arc_virtual_machine_uuid = "%s"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_ARC_MACHINE_ID"), os.Getenv("ARM_TEST_ARC_MACHINE_UUID"))
+}
+
+func (r StorageMoverAgentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "import" {
+  name                     = azurerm_storage_mover_agent.test.name
+  storage_mover_id         = azurerm_storage_mover_agent.test.storage_mover_id
+  arc_virtual_machine_id   = azurerm_storage_mover_agent.test.arc_virtual_machine_id
+  arc_virtual_machine_uuid = azurerm_storage_mover_agent.test.arc_virtual_machine_uuid
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverAgentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sa-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = "%s"
+  arc_virtual_machine_uuid = "%s"
+  description              = "Example Agent Description"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_ARC_MACHINE_ID"), os.Getenv("ARM_TEST_ARC_MACHINE_UUID"))
+}
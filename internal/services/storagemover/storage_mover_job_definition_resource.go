@@ -0,0 +1,279 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StorageMoverJobDefinitionResource{}
+
+type StorageMoverJobDefinitionResource struct{}
+
+type StorageMoverJobDefinitionModel struct {
+	Name                  string `tfschema:"name"`
+	StorageMoverProjectId string `tfschema:"storage_mover_project_id"`
+	AgentName             string `tfschema:"agent_name"`
+	CopyMode              string `tfschema:"copy_mode"`
+	Description           string `tfschema:"description"`
+	SourceName            string `tfschema:"source_name"`
+	SourceSubPath         string `tfschema:"source_sub_path"`
+	TargetName            string `tfschema:"target_name"`
+	TargetSubPath         string `tfschema:"target_sub_path"`
+}
+
+func (r StorageMoverJobDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"storage_mover_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: projects.ValidateProjectID,
+		},
+
+		"copy_mode": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(jobdefinitions.CopyModeAdditive),
+				string(jobdefinitions.CopyModeMirror),
+			}, false),
+		},
+
+		"source_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"target_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"agent_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"source_sub_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_sub_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageMoverJobDefinitionResource) ModelObject() interface{} {
+	return &StorageMoverJobDefinitionModel{}
+}
+
+func (r StorageMoverJobDefinitionResource) ResourceType() string {
+	return "azurerm_storage_mover_job_definition"
+}
+
+func (r StorageMoverJobDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobdefinitions.ValidateJobDefinitionID
+}
+
+func (r StorageMoverJobDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			var model StorageMoverJobDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			projectId, err := projects.ParseProjectID(model.StorageMoverProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := jobdefinitions.NewJobDefinitionID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.StorageMoverName, projectId.ProjectName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := jobdefinitions.JobDefinition{
+				Properties: jobdefinitions.JobDefinitionProperties{
+					CopyMode:   jobdefinitions.CopyMode(model.CopyMode),
+					SourceName: model.SourceName,
+					TargetName: model.TargetName,
+				},
+			}
+			if model.AgentName != "" {
+				parameters.Properties.AgentName = utils.String(model.AgentName)
+			}
+			if model.Description != "" {
+				parameters.Properties.Description = utils.String(model.Description)
+			}
+			if model.SourceSubPath != "" {
+				parameters.Properties.SourceSubpath = utils.String(model.SourceSubPath)
+			}
+			if model.TargetSubPath != "" {
+				parameters.Properties.TargetSubpath = utils.String(model.TargetSubPath)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverJobDefinitionModel{
+				Name:                  id.JobDefinitionName,
+				StorageMoverProjectId: projects.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.CopyMode = string(props.CopyMode)
+				state.SourceName = props.SourceName
+				state.TargetName = props.TargetName
+
+				if props.AgentName != nil {
+					state.AgentName = *props.AgentName
+				}
+				if props.Description != nil {
+					state.Description = *props.Description
+				}
+				if props.SourceSubpath != nil {
+					state.SourceSubPath = *props.SourceSubpath
+				}
+				if props.TargetSubpath != nil {
+					state.TargetSubPath = *props.TargetSubpath
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverJobDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := jobdefinitions.JobDefinitionUpdateParameters{
+				Properties: &jobdefinitions.JobDefinitionUpdateProperties{},
+			}
+			if metadata.ResourceData.HasChange("agent_name") {
+				parameters.Properties.AgentName = utils.String(model.AgentName)
+			}
+			if metadata.ResourceData.HasChange("copy_mode") {
+				copyMode := jobdefinitions.CopyMode(model.CopyMode)
+				parameters.Properties.CopyMode = &copyMode
+			}
+			if metadata.ResourceData.HasChange("description") {
+				parameters.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.Update(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// StorageMoverName validates the name of a Storage Mover, or of one of its child resources
+// (Agents, Endpoints, Projects and Job Definitions), which all share the same naming rules.
+func StorageMoverName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[0-9a-zA-Z][-_0-9a-zA-Z]{0,63}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters, start with a letter or number and may only contain letters, numbers, hyphens and underscores", k))
+	}
+
+	return warnings, errors
+}
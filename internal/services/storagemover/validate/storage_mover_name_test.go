@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageMoverName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"a", false},
+		{"a-b_c", false},
+		{"Mover1", false},
+		{"-mover", true},
+		{"_mover", true},
+		{"mover.1", true},
+		{"mover 1", true},
+		{strings.Repeat("a", 64), false},
+		{strings.Repeat("a", 65), true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageMoverName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q to succeed", test.input)
+		}
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/artifacts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopooldatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopooldataconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopools"
 )
 
 type Client struct {
@@ -16,6 +19,9 @@ type Client struct {
 	IntegrationRuntimeAuthKeysClient                  *synapse.IntegrationRuntimeAuthKeysClient
 	IntegrationRuntimesClient                         *synapse.IntegrationRuntimesClient
 	KeysClient                                        *synapse.KeysClient
+	KustoPoolDatabasesClient                          *kustopooldatabases.KustoPoolDatabasesClient
+	KustoPoolDataConnectionsClient                    *kustopooldataconnections.KustoPoolDataConnectionsClient
+	KustoPoolsClient                                  *kustopools.KustoPoolsClient
 	PrivateLinkHubsClient                             *synapse.PrivateLinkHubsClient
 	SparkPoolClient                                   *synapse.BigDataPoolsClient
 	SqlPoolClient                                     *synapse.SQLPoolsClient
@@ -48,6 +54,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	keysClient := synapse.NewKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&keysClient.Client, o.ResourceManagerAuthorizer)
 
+	kustoPoolDatabasesClient := kustopooldatabases.NewKustoPoolDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kustoPoolDatabasesClient.Client, o.ResourceManagerAuthorizer)
+
+	kustoPoolDataConnectionsClient := kustopooldataconnections.NewKustoPoolDataConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kustoPoolDataConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	kustoPoolsClient := kustopools.NewKustoPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kustoPoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	privateLinkHubsClient := synapse.NewPrivateLinkHubsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&privateLinkHubsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -99,6 +114,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		IntegrationRuntimeAuthKeysClient:                  &integrationRuntimeAuthKeysClient,
 		IntegrationRuntimesClient:                         &integrationRuntimesClient,
 		KeysClient:                                        &keysClient,
+		KustoPoolDatabasesClient:                          &kustoPoolDatabasesClient,
+		KustoPoolDataConnectionsClient:                    &kustoPoolDataConnectionsClient,
+		KustoPoolsClient:                                  &kustoPoolsClient,
 		PrivateLinkHubsClient:                             &privateLinkHubsClient,
 		SparkPoolClient:                                   &sparkPoolClient,
 		SqlPoolClient:                                     &sqlPoolClient,
@@ -149,6 +167,16 @@ func (client Client) ManagedPrivateEndpointsClient(workspaceName, synapseEndpoin
 	return &managedPrivateEndpointsClient, nil
 }
 
+func (client Client) LinkConnectionClient(workspaceName, synapseEndpointSuffix string) (*artifacts.LinkConnectionClient, error) {
+	if client.synapseAuthorizer == nil {
+		return nil, fmt.Errorf("Synapse is not supported in this Azure Environment")
+	}
+	endpoint := buildEndpoint(workspaceName, synapseEndpointSuffix)
+	linkConnectionClient := artifacts.NewLinkConnectionClient(endpoint)
+	linkConnectionClient.Client.Authorizer = client.synapseAuthorizer
+	return &linkConnectionClient, nil
+}
+
 func (client Client) LinkedServiceClient(workspaceName, synapseEndpointSuffix string) (*artifacts.LinkedServiceClient, error) {
 	if client.synapseAuthorizer == nil {
 		return nil, fmt.Errorf("Synapse is not supported in this Azure Environment")
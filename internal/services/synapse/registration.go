@@ -29,6 +29,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_synapse_firewall_rule":                              resourceSynapseFirewallRule(),
 		"azurerm_synapse_integration_runtime_azure":                  resourceSynapseIntegrationRuntimeAzure(),
 		"azurerm_synapse_integration_runtime_self_hosted":            resourceSynapseIntegrationRuntimeSelfHosted(),
+		"azurerm_synapse_kusto_pool":                                 resourceSynapseKustoPool(),
+		"azurerm_synapse_kusto_pool_database":                        resourceSynapseKustoPoolDatabase(),
+		"azurerm_synapse_kusto_pool_eventhub_data_connection":        resourceSynapseKustoPoolEventHubDataConnection(),
+		"azurerm_synapse_link_connection":                            resourceSynapseLinkConnection(),
 		"azurerm_synapse_linked_service":                             resourceSynapseLinkedService(),
 		"azurerm_synapse_managed_private_endpoint":                   resourceSynapseManagedPrivateEndpoint(),
 		"azurerm_synapse_private_link_hub":                           resourceSynapsePrivateLinkHub(),
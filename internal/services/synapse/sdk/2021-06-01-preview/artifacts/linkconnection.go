@@ -0,0 +1,644 @@
+package artifacts
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/tracing"
+)
+
+// LinkConnectionClient is the client for the LinkConnection methods of the Artifacts service.
+type LinkConnectionClient struct {
+	BaseClient
+}
+
+// NewLinkConnectionClient creates an instance of the LinkConnectionClient client.
+func NewLinkConnectionClient(endpoint string) LinkConnectionClient {
+	return LinkConnectionClient{New(endpoint)}
+}
+
+// CreateOrUpdate creates or updates a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+// linkConnection - the link connection resource definition
+func (client LinkConnectionClient) CreateOrUpdate(ctx context.Context, linkConnectionName string, linkConnection LinkConnectionResource) (result LinkConnectionResource, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.CreateOrUpdate")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.CreateOrUpdatePreparer(ctx, linkConnectionName, linkConnection)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "CreateOrUpdate", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdatePreparer prepares the CreateOrUpdate request.
+func (client LinkConnectionClient) CreateOrUpdatePreparer(ctx context.Context, linkConnectionName string, linkConnection LinkConnectionResource) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithJSON(linkConnection))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// CreateOrUpdateSender sends the CreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) CreateOrUpdateSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// CreateOrUpdateResponder handles the response to the CreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) CreateOrUpdateResponder(resp *http.Response) (result LinkConnectionResource, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Get get a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+func (client LinkConnectionClient) Get(ctx context.Context, linkConnectionName string) (result LinkConnectionResource, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.Get")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetPreparer(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetPreparer prepares the Get request.
+func (client LinkConnectionClient) GetPreparer(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetSender sends the Get request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) GetSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetResponder handles the response to the Get request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) GetResponder(resp *http.Response) (result LinkConnectionResource, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete delete a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+func (client LinkConnectionClient) Delete(ctx context.Context, linkConnectionName string) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.Delete")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.DeletePreparer(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.DeleteSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Delete", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.DeleteResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Delete", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// DeletePreparer prepares the Delete request.
+func (client LinkConnectionClient) DeletePreparer(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// DeleteSender sends the Delete request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) DeleteSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// DeleteResponder handles the response to the Delete request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) DeleteResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// EditTables edit tables for a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+// editTablesRequest - edit tables request
+func (client LinkConnectionClient) EditTables(ctx context.Context, linkConnectionName string, editTablesRequest EditTablesRequest) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.EditTables")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.EditTablesPreparer(ctx, linkConnectionName, editTablesRequest)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "EditTables", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.EditTablesSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "EditTables", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.EditTablesResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "EditTables", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// EditTablesPreparer prepares the EditTables request.
+func (client LinkConnectionClient) EditTablesPreparer(ctx context.Context, linkConnectionName string, editTablesRequest EditTablesRequest) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/edittables", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithJSON(editTablesRequest))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// EditTablesSender sends the EditTables request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) EditTablesSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// EditTablesResponder handles the response to the EditTables request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) EditTablesResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// ListLinkTables list the link tables of a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+func (client LinkConnectionClient) ListLinkTables(ctx context.Context, linkConnectionName string) (result LinkTableListResponse, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.ListLinkTables")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.ListLinkTablesPreparer(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "ListLinkTables", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.ListLinkTablesSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "ListLinkTables", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.ListLinkTablesResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "ListLinkTables", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// ListLinkTablesPreparer prepares the ListLinkTables request.
+func (client LinkConnectionClient) ListLinkTablesPreparer(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/linktables", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// ListLinkTablesSender sends the ListLinkTables request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) ListLinkTablesSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// ListLinkTablesResponder handles the response to the ListLinkTables request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) ListLinkTablesResponder(resp *http.Response) (result LinkTableListResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Start start a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+func (client LinkConnectionClient) Start(ctx context.Context, linkConnectionName string) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.Start")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.StartPreparer(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Start", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.StartSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Start", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.StartResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Start", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// StartPreparer prepares the Start request.
+func (client LinkConnectionClient) StartPreparer(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/start", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// StartSender sends the Start request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) StartSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// StartResponder handles the response to the Start request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) StartResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// Stop stop a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+func (client LinkConnectionClient) Stop(ctx context.Context, linkConnectionName string) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.Stop")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.StopPreparer(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Stop", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.StopSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Stop", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.StopResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "Stop", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// StopPreparer prepares the Stop request.
+func (client LinkConnectionClient) StopPreparer(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/stop", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// StopSender sends the Stop request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) StopSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// StopResponder handles the response to the Stop request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) StopResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// GetDetailedStatus get the detailed status of a link connection
+// Parameters:
+// linkConnectionName - the link connection name
+func (client LinkConnectionClient) GetDetailedStatus(ctx context.Context, linkConnectionName string) (result LinkConnectionDetailedStatus, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/LinkConnectionClient.GetDetailedStatus")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetDetailedStatusPreparer(ctx, linkConnectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "GetDetailedStatus", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetDetailedStatusSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "GetDetailedStatus", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetDetailedStatusResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "artifacts.LinkConnectionClient", "GetDetailedStatus", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetDetailedStatusPreparer prepares the GetDetailedStatus request.
+func (client LinkConnectionClient) GetDetailedStatusPreparer(ctx context.Context, linkConnectionName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"linkConnectionName": autorest.Encode("path", linkConnectionName),
+	}
+
+	const APIVersion = "2022-12-01-preview"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/linkconnections/{linkConnectionName}/getDetailedStatus", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetDetailedStatusSender sends the GetDetailedStatus request. The method will close the
+// http.Response Body if it receives an error.
+func (client LinkConnectionClient) GetDetailedStatusSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetDetailedStatusResponder handles the response to the GetDetailedStatus request. The method always
+// closes the http.Response Body.
+func (client LinkConnectionClient) GetDetailedStatusResponder(resp *http.Response) (result LinkConnectionDetailedStatus, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
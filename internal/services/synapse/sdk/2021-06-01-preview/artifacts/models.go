@@ -89114,6 +89114,12 @@ func (ds *DynamicsSource) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// EditTablesRequest ...
+type EditTablesRequest struct {
+	// LinkTables - Edit link tables request
+	LinkTables *[]LinkTableRequest `json:"linkTables,omitempty"`
+}
+
 // EloquaLinkedService eloqua server linked service.
 type EloquaLinkedService struct {
 	// EloquaLinkedServiceTypeProperties - Eloqua server linked service properties.
@@ -131819,6 +131825,165 @@ func (lcstp *LicensedComponentSetupTypeProperties) UnmarshalJSON(body []byte) er
 	return nil
 }
 
+// LinkConnection ...
+type LinkConnection struct {
+	// SourceDatabase - Properties of link connection's source database
+	SourceDatabase *LinkConnectionSourceDatabase `json:"sourceDatabase,omitempty"`
+	// TargetDatabase - Properties of link connection's target database
+	TargetDatabase *LinkConnectionTargetDatabase `json:"targetDatabase,omitempty"`
+	// LandingZone - Properties of link connection's landing zone
+	LandingZone *LinkConnectionLandingZone `json:"landingZone,omitempty"`
+	// Compute - Properties of link connection's compute
+	Compute *LinkConnectionCompute `json:"compute,omitempty"`
+}
+
+// LinkConnectionCompute ...
+type LinkConnectionCompute struct {
+	// CoreCount - Link connection's compute core count
+	CoreCount *int32 `json:"coreCount,omitempty"`
+	// ComputeType - Link connection's compute type
+	ComputeType *string `json:"computeType,omitempty"`
+	// DataProcessIntervalInMinutes - Link connection's data process interval in minutes
+	DataProcessIntervalInMinutes *int32 `json:"dataProcessIntervalInMinutes,omitempty"`
+}
+
+// LinkConnectionDetailedStatus ...
+type LinkConnectionDetailedStatus struct {
+	autorest.Response `json:"-"`
+	// ID - Link connection id
+	ID *string `json:"id,omitempty"`
+	// Name - Link connection name
+	Name *string `json:"name,omitempty"`
+	// IsApplyingChanges - Is link connection applying changes
+	IsApplyingChanges *bool `json:"isApplyingChanges,omitempty"`
+	// IsPartiallyFailed - Is link connection partially failed
+	IsPartiallyFailed *bool `json:"isPartiallyFailed,omitempty"`
+	// StartTime - Link connection start time
+	StartTime interface{} `json:"startTime,omitempty"`
+	// StopTime - Link connection stop time
+	StopTime interface{} `json:"stopTime,omitempty"`
+	// Status - Link connection status
+	Status *string `json:"status,omitempty"`
+	// ContinuousRunID - Link connection's corresponding continuous run id
+	ContinuousRunID *string `json:"continuousRunId,omitempty"`
+	// Error - Link connection error
+	Error interface{} `json:"error,omitempty"`
+}
+
+// LinkConnectionLandingZone ...
+type LinkConnectionLandingZone struct {
+	// LinkedService - Linked service reference
+	LinkedService *LinkedServiceReference `json:"linkedService,omitempty"`
+	// FileSystem - Landing zone's file system name
+	FileSystem *string `json:"fileSystem,omitempty"`
+	// FolderPath - Landing zone's folder path name
+	FolderPath *string `json:"folderPath,omitempty"`
+	// SasToken - Landing zone's sas token
+	SasToken *SecureString `json:"sasToken,omitempty"`
+}
+
+// LinkConnectionResource ...
+type LinkConnectionResource struct {
+	autorest.Response `json:"-"`
+	// ID - Link connection id
+	ID *string `json:"id,omitempty"`
+	// Name - Link connection name
+	Name *string `json:"name,omitempty"`
+	// Type - Link connection type
+	Type *string `json:"type,omitempty"`
+	// Properties - Properties of link connection
+	Properties *LinkConnection `json:"properties,omitempty"`
+	// Description - Link connection description
+	Description *string `json:"description,omitempty"`
+}
+
+// LinkConnectionSourceDatabase ...
+type LinkConnectionSourceDatabase struct {
+	// LinkedService - Linked service reference
+	LinkedService *LinkedServiceReference `json:"linkedService,omitempty"`
+	// TypeProperties - Source database type properties
+	TypeProperties *LinkConnectionSourceDatabaseTypeProperties `json:"typeProperties,omitempty"`
+}
+
+// LinkConnectionSourceDatabaseTypeProperties ...
+type LinkConnectionSourceDatabaseTypeProperties struct {
+	// ResourceID - Link connection source database server's resource id
+	ResourceID *string `json:"resourceId,omitempty"`
+	// PrincipalID - Link connection source database server's principal id
+	PrincipalID *string `json:"principalId,omitempty"`
+}
+
+// LinkConnectionTargetDatabase ...
+type LinkConnectionTargetDatabase struct {
+	// LinkedService - Linked service reference
+	LinkedService *LinkedServiceReference `json:"linkedService,omitempty"`
+	// TypeProperties - Target database type properties
+	TypeProperties *LinkConnectionTargetDatabaseTypeProperties `json:"typeProperties,omitempty"`
+}
+
+// LinkConnectionTargetDatabaseTypeProperties ...
+type LinkConnectionTargetDatabaseTypeProperties struct {
+	// CrossTableTransaction - Enable cross table transaction consistency on target database
+	CrossTableTransaction *bool `json:"crossTableTransaction,omitempty"`
+	// DropExistingTargetTableOnStart - Drop and recreate same existing target table on link connection target database
+	DropExistingTargetTableOnStart *bool `json:"dropExistingTargetTableOnStart,omitempty"`
+}
+
+// LinkTableListResponse ...
+type LinkTableListResponse struct {
+	autorest.Response `json:"-"`
+	// Value - List link table value
+	Value *[]LinkTableResource `json:"value,omitempty"`
+}
+
+// LinkTableRequest ...
+type LinkTableRequest struct {
+	// ID - Link table id
+	ID *string `json:"id,omitempty"`
+	// Source - Source table properties for link table request
+	Source *LinkTableRequestSource `json:"source,omitempty"`
+	// Target - Target table properties for link table request
+	Target *LinkTableRequestTarget `json:"target,omitempty"`
+	// OperationType - Link table operation type
+	OperationType *string `json:"operationType,omitempty"`
+}
+
+// LinkTableRequestSource ...
+type LinkTableRequestSource struct {
+	// TableName - Source table table name
+	TableName *string `json:"tableName,omitempty"`
+	// SchemaName - Source table schema name
+	SchemaName *string `json:"schemaName,omitempty"`
+}
+
+// LinkTableRequestTarget ...
+type LinkTableRequestTarget struct {
+	// TableName - Target table table name
+	TableName *string `json:"tableName,omitempty"`
+	// SchemaName - Target table schema name
+	SchemaName *string `json:"schemaName,omitempty"`
+	// DistributionOptions - Target table distribution options for link table request
+	DistributionOptions *LinkTableRequestTargetDistributionOptions `json:"distributionOptions,omitempty"`
+}
+
+// LinkTableRequestTargetDistributionOptions ...
+type LinkTableRequestTargetDistributionOptions struct {
+	// Type - Target table distribution type
+	Type *string `json:"type,omitempty"`
+	// DistributionColumn - Target table distribution column
+	DistributionColumn *string `json:"distributionColumn,omitempty"`
+}
+
+// LinkTableResource ...
+type LinkTableResource struct {
+	// ID - Link table id
+	ID *string `json:"id,omitempty"`
+	// Source - Source table properties for link table request
+	Source *LinkTableRequestSource `json:"source,omitempty"`
+	// Target - Target table properties for link table request
+	Target *LinkTableRequestTarget `json:"target,omitempty"`
+}
+
 // LinkedIntegrationRuntimeKeyAuthorization the key authorization type integration runtime.
 type LinkedIntegrationRuntimeKeyAuthorization struct {
 	// Key - The key used for authorization.
@@ -0,0 +1,15 @@
+package kustopooldatabases
+
+import "github.com/Azure/go-autorest/autorest"
+
+type KustoPoolDatabasesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewKustoPoolDatabasesClientWithBaseURI(endpoint string) KustoPoolDatabasesClient {
+	return KustoPoolDatabasesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
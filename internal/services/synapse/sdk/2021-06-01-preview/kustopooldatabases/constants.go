@@ -0,0 +1,74 @@
+package kustopooldatabases
+
+import "strings"
+
+type Kind string
+
+const (
+	KindReadOnlyFollowing Kind = "ReadOnlyFollowing"
+	KindReadWrite         Kind = "ReadWrite"
+)
+
+func PossibleValuesForKind() []string {
+	return []string{
+		string(KindReadOnlyFollowing),
+		string(KindReadWrite),
+	}
+}
+
+func parseKind(input string) (*Kind, error) {
+	vals := map[string]Kind{
+		"readonlyfollowing": KindReadOnlyFollowing,
+		"readwrite":         KindReadWrite,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Kind(input)
+	return &out, nil
+}
+
+type ResourceProvisioningState string
+
+const (
+	ResourceProvisioningStateCanceled  ResourceProvisioningState = "Canceled"
+	ResourceProvisioningStateCreating  ResourceProvisioningState = "Creating"
+	ResourceProvisioningStateDeleting  ResourceProvisioningState = "Deleting"
+	ResourceProvisioningStateFailed    ResourceProvisioningState = "Failed"
+	ResourceProvisioningStateMoving    ResourceProvisioningState = "Moving"
+	ResourceProvisioningStateRunning   ResourceProvisioningState = "Running"
+	ResourceProvisioningStateSucceeded ResourceProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForResourceProvisioningState() []string {
+	return []string{
+		string(ResourceProvisioningStateCanceled),
+		string(ResourceProvisioningStateCreating),
+		string(ResourceProvisioningStateDeleting),
+		string(ResourceProvisioningStateFailed),
+		string(ResourceProvisioningStateMoving),
+		string(ResourceProvisioningStateRunning),
+		string(ResourceProvisioningStateSucceeded),
+	}
+}
+
+func parseResourceProvisioningState(input string) (*ResourceProvisioningState, error) {
+	vals := map[string]ResourceProvisioningState{
+		"canceled":  ResourceProvisioningStateCanceled,
+		"creating":  ResourceProvisioningStateCreating,
+		"deleting":  ResourceProvisioningStateDeleting,
+		"failed":    ResourceProvisioningStateFailed,
+		"moving":    ResourceProvisioningStateMoving,
+		"running":   ResourceProvisioningStateRunning,
+		"succeeded": ResourceProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceProvisioningState(input)
+	return &out, nil
+}
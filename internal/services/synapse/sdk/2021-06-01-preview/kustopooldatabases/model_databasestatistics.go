@@ -0,0 +1,5 @@
+package kustopooldatabases
+
+type DatabaseStatistics struct {
+	Size *float64 `json:"size,omitempty"`
+}
@@ -0,0 +1,10 @@
+package kustopooldatabases
+
+type ReadWriteDatabase struct {
+	Id         *string                      `json:"id,omitempty"`
+	Kind       Kind                         `json:"kind"`
+	Location   *string                      `json:"location,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *ReadWriteDatabaseProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package kustopooldatabases
+
+type ReadWriteDatabaseProperties struct {
+	HotCachePeriod    *string                    `json:"hotCachePeriod,omitempty"`
+	IsFollowed        *bool                      `json:"isFollowed,omitempty"`
+	ProvisioningState *ResourceProvisioningState `json:"provisioningState,omitempty"`
+	SoftDeletePeriod  *string                    `json:"softDeletePeriod,omitempty"`
+	Statistics        *DatabaseStatistics        `json:"statistics,omitempty"`
+}
@@ -0,0 +1,9 @@
+package kustopooldatabases
+
+import "fmt"
+
+const defaultApiVersion = "2021-06-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/kustopooldatabases/%s", defaultApiVersion)
+}
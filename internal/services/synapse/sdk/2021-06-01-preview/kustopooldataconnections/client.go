@@ -0,0 +1,15 @@
+package kustopooldataconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type KustoPoolDataConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewKustoPoolDataConnectionsClientWithBaseURI(endpoint string) KustoPoolDataConnectionsClient {
+	return KustoPoolDataConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,175 @@
+package kustopooldataconnections
+
+import "strings"
+
+type Compression string
+
+const (
+	CompressionGZip Compression = "GZip"
+	CompressionNone Compression = "None"
+)
+
+func PossibleValuesForCompression() []string {
+	return []string{
+		string(CompressionGZip),
+		string(CompressionNone),
+	}
+}
+
+func parseCompression(input string) (*Compression, error) {
+	vals := map[string]Compression{
+		"gzip": CompressionGZip,
+		"none": CompressionNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Compression(input)
+	return &out, nil
+}
+
+type DataConnectionKind string
+
+const (
+	DataConnectionKindEventGrid DataConnectionKind = "EventGrid"
+	DataConnectionKindEventHub  DataConnectionKind = "EventHub"
+	DataConnectionKindIotHub    DataConnectionKind = "IotHub"
+)
+
+func PossibleValuesForDataConnectionKind() []string {
+	return []string{
+		string(DataConnectionKindEventGrid),
+		string(DataConnectionKindEventHub),
+		string(DataConnectionKindIotHub),
+	}
+}
+
+func parseDataConnectionKind(input string) (*DataConnectionKind, error) {
+	vals := map[string]DataConnectionKind{
+		"eventgrid": DataConnectionKindEventGrid,
+		"eventhub":  DataConnectionKindEventHub,
+		"iothub":    DataConnectionKindIotHub,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataConnectionKind(input)
+	return &out, nil
+}
+
+type EventHubDataFormat string
+
+const (
+	EventHubDataFormatAPACHEAVRO EventHubDataFormat = "APACHEAVRO"
+	EventHubDataFormatAVRO       EventHubDataFormat = "AVRO"
+	EventHubDataFormatCSV        EventHubDataFormat = "CSV"
+	EventHubDataFormatJSON       EventHubDataFormat = "JSON"
+	EventHubDataFormatMULTIJSON  EventHubDataFormat = "MULTIJSON"
+	EventHubDataFormatORC        EventHubDataFormat = "ORC"
+	EventHubDataFormatPARQUET    EventHubDataFormat = "PARQUET"
+	EventHubDataFormatPSV        EventHubDataFormat = "PSV"
+	EventHubDataFormatRAW        EventHubDataFormat = "RAW"
+	EventHubDataFormatSCSV       EventHubDataFormat = "SCSV"
+	EventHubDataFormatSINGLEJSON EventHubDataFormat = "SINGLEJSON"
+	EventHubDataFormatSOHSV      EventHubDataFormat = "SOHSV"
+	EventHubDataFormatTSV        EventHubDataFormat = "TSV"
+	EventHubDataFormatTSVE       EventHubDataFormat = "TSVE"
+	EventHubDataFormatTXT        EventHubDataFormat = "TXT"
+	EventHubDataFormatW3CLOGFILE EventHubDataFormat = "W3CLOGFILE"
+)
+
+func PossibleValuesForEventHubDataFormat() []string {
+	return []string{
+		string(EventHubDataFormatAPACHEAVRO),
+		string(EventHubDataFormatAVRO),
+		string(EventHubDataFormatCSV),
+		string(EventHubDataFormatJSON),
+		string(EventHubDataFormatMULTIJSON),
+		string(EventHubDataFormatORC),
+		string(EventHubDataFormatPARQUET),
+		string(EventHubDataFormatPSV),
+		string(EventHubDataFormatRAW),
+		string(EventHubDataFormatSCSV),
+		string(EventHubDataFormatSINGLEJSON),
+		string(EventHubDataFormatSOHSV),
+		string(EventHubDataFormatTSV),
+		string(EventHubDataFormatTSVE),
+		string(EventHubDataFormatTXT),
+		string(EventHubDataFormatW3CLOGFILE),
+	}
+}
+
+func parseEventHubDataFormat(input string) (*EventHubDataFormat, error) {
+	vals := map[string]EventHubDataFormat{
+		"apacheavro": EventHubDataFormatAPACHEAVRO,
+		"avro":       EventHubDataFormatAVRO,
+		"csv":        EventHubDataFormatCSV,
+		"json":       EventHubDataFormatJSON,
+		"multijson":  EventHubDataFormatMULTIJSON,
+		"orc":        EventHubDataFormatORC,
+		"parquet":    EventHubDataFormatPARQUET,
+		"psv":        EventHubDataFormatPSV,
+		"raw":        EventHubDataFormatRAW,
+		"scsv":       EventHubDataFormatSCSV,
+		"singlejson": EventHubDataFormatSINGLEJSON,
+		"sohsv":      EventHubDataFormatSOHSV,
+		"tsv":        EventHubDataFormatTSV,
+		"tsve":       EventHubDataFormatTSVE,
+		"txt":        EventHubDataFormatTXT,
+		"w3clogfile": EventHubDataFormatW3CLOGFILE,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EventHubDataFormat(input)
+	return &out, nil
+}
+
+type ResourceProvisioningState string
+
+const (
+	ResourceProvisioningStateCanceled  ResourceProvisioningState = "Canceled"
+	ResourceProvisioningStateCreating  ResourceProvisioningState = "Creating"
+	ResourceProvisioningStateDeleting  ResourceProvisioningState = "Deleting"
+	ResourceProvisioningStateFailed    ResourceProvisioningState = "Failed"
+	ResourceProvisioningStateMoving    ResourceProvisioningState = "Moving"
+	ResourceProvisioningStateRunning   ResourceProvisioningState = "Running"
+	ResourceProvisioningStateSucceeded ResourceProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForResourceProvisioningState() []string {
+	return []string{
+		string(ResourceProvisioningStateCanceled),
+		string(ResourceProvisioningStateCreating),
+		string(ResourceProvisioningStateDeleting),
+		string(ResourceProvisioningStateFailed),
+		string(ResourceProvisioningStateMoving),
+		string(ResourceProvisioningStateRunning),
+		string(ResourceProvisioningStateSucceeded),
+	}
+}
+
+func parseResourceProvisioningState(input string) (*ResourceProvisioningState, error) {
+	vals := map[string]ResourceProvisioningState{
+		"canceled":  ResourceProvisioningStateCanceled,
+		"creating":  ResourceProvisioningStateCreating,
+		"deleting":  ResourceProvisioningStateDeleting,
+		"failed":    ResourceProvisioningStateFailed,
+		"moving":    ResourceProvisioningStateMoving,
+		"running":   ResourceProvisioningStateRunning,
+		"succeeded": ResourceProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceProvisioningState(input)
+	return &out, nil
+}
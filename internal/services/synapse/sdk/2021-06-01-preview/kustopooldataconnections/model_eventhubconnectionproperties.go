@@ -0,0 +1,13 @@
+package kustopooldataconnections
+
+type EventHubConnectionProperties struct {
+	Compression               *Compression               `json:"compression,omitempty"`
+	ConsumerGroup             string                     `json:"consumerGroup"`
+	DataFormat                *EventHubDataFormat        `json:"dataFormat,omitempty"`
+	EventHubResourceId        string                     `json:"eventHubResourceId"`
+	EventSystemProperties     *[]string                  `json:"eventSystemProperties,omitempty"`
+	ManagedIdentityResourceId *string                    `json:"managedIdentityResourceId,omitempty"`
+	MappingRuleName           *string                    `json:"mappingRuleName,omitempty"`
+	ProvisioningState         *ResourceProvisioningState `json:"provisioningState,omitempty"`
+	TableName                 *string                    `json:"tableName,omitempty"`
+}
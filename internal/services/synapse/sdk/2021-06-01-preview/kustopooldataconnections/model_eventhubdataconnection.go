@@ -0,0 +1,10 @@
+package kustopooldataconnections
+
+type EventHubDataConnection struct {
+	Id         *string                       `json:"id,omitempty"`
+	Kind       DataConnectionKind            `json:"kind"`
+	Location   *string                       `json:"location,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *EventHubConnectionProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package kustopools
+
+import "github.com/Azure/go-autorest/autorest"
+
+type KustoPoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewKustoPoolsClientWithBaseURI(endpoint string) KustoPoolsClient {
+	return KustoPoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,185 @@
+package kustopools
+
+import "strings"
+
+type LanguageExtensionName string
+
+const (
+	LanguageExtensionNamePYTHON LanguageExtensionName = "PYTHON"
+	LanguageExtensionNameR      LanguageExtensionName = "R"
+)
+
+func PossibleValuesForLanguageExtensionName() []string {
+	return []string{
+		string(LanguageExtensionNamePYTHON),
+		string(LanguageExtensionNameR),
+	}
+}
+
+func parseLanguageExtensionName(input string) (*LanguageExtensionName, error) {
+	vals := map[string]LanguageExtensionName{
+		"python": LanguageExtensionNamePYTHON,
+		"r":      LanguageExtensionNameR,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LanguageExtensionName(input)
+	return &out, nil
+}
+
+type ResourceProvisioningState string
+
+const (
+	ResourceProvisioningStateCanceled  ResourceProvisioningState = "Canceled"
+	ResourceProvisioningStateCreating  ResourceProvisioningState = "Creating"
+	ResourceProvisioningStateDeleting  ResourceProvisioningState = "Deleting"
+	ResourceProvisioningStateFailed    ResourceProvisioningState = "Failed"
+	ResourceProvisioningStateMoving    ResourceProvisioningState = "Moving"
+	ResourceProvisioningStateRunning   ResourceProvisioningState = "Running"
+	ResourceProvisioningStateSucceeded ResourceProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForResourceProvisioningState() []string {
+	return []string{
+		string(ResourceProvisioningStateCanceled),
+		string(ResourceProvisioningStateCreating),
+		string(ResourceProvisioningStateDeleting),
+		string(ResourceProvisioningStateFailed),
+		string(ResourceProvisioningStateMoving),
+		string(ResourceProvisioningStateRunning),
+		string(ResourceProvisioningStateSucceeded),
+	}
+}
+
+func parseResourceProvisioningState(input string) (*ResourceProvisioningState, error) {
+	vals := map[string]ResourceProvisioningState{
+		"canceled":  ResourceProvisioningStateCanceled,
+		"creating":  ResourceProvisioningStateCreating,
+		"deleting":  ResourceProvisioningStateDeleting,
+		"failed":    ResourceProvisioningStateFailed,
+		"moving":    ResourceProvisioningStateMoving,
+		"running":   ResourceProvisioningStateRunning,
+		"succeeded": ResourceProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceProvisioningState(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameComputeOptimized SkuName = "Compute optimized"
+	SkuNameStorageOptimized SkuName = "Storage optimized"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameComputeOptimized),
+		string(SkuNameStorageOptimized),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"compute optimized": SkuNameComputeOptimized,
+		"storage optimized": SkuNameStorageOptimized,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
+type SkuSize string
+
+const (
+	SkuSizeExtraSmall SkuSize = "Extra small"
+	SkuSizeLarge      SkuSize = "Large"
+	SkuSizeMedium     SkuSize = "Medium"
+	SkuSizeSmall      SkuSize = "Small"
+)
+
+func PossibleValuesForSkuSize() []string {
+	return []string{
+		string(SkuSizeExtraSmall),
+		string(SkuSizeLarge),
+		string(SkuSizeMedium),
+		string(SkuSizeSmall),
+	}
+}
+
+func parseSkuSize(input string) (*SkuSize, error) {
+	vals := map[string]SkuSize{
+		"extra small": SkuSizeExtraSmall,
+		"large":       SkuSizeLarge,
+		"medium":      SkuSizeMedium,
+		"small":       SkuSizeSmall,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuSize(input)
+	return &out, nil
+}
+
+type State string
+
+const (
+	StateCreating    State = "Creating"
+	StateDeleted     State = "Deleted"
+	StateDeleting    State = "Deleting"
+	StateRunning     State = "Running"
+	StateStarting    State = "Starting"
+	StateStopped     State = "Stopped"
+	StateStopping    State = "Stopping"
+	StateUnavailable State = "Unavailable"
+	StateUpdating    State = "Updating"
+)
+
+func PossibleValuesForState() []string {
+	return []string{
+		string(StateCreating),
+		string(StateDeleted),
+		string(StateDeleting),
+		string(StateRunning),
+		string(StateStarting),
+		string(StateStopped),
+		string(StateStopping),
+		string(StateUnavailable),
+		string(StateUpdating),
+	}
+}
+
+func parseState(input string) (*State, error) {
+	vals := map[string]State{
+		"creating":    StateCreating,
+		"deleted":     StateDeleted,
+		"deleting":    StateDeleting,
+		"running":     StateRunning,
+		"starting":    StateStarting,
+		"stopped":     StateStopped,
+		"stopping":    StateStopping,
+		"unavailable": StateUnavailable,
+		"updating":    StateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := State(input)
+	return &out, nil
+}
@@ -0,0 +1,137 @@
+package kustopools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = KustoPoolId{}
+
+// KustoPoolId is a struct representing the Resource ID for a Kusto Pool
+type KustoPoolId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkspaceName     string
+	KustoPoolName     string
+}
+
+// NewKustoPoolID returns a new KustoPoolId struct
+func NewKustoPoolID(subscriptionId string, resourceGroupName string, workspaceName string, kustoPoolName string) KustoPoolId {
+	return KustoPoolId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkspaceName:     workspaceName,
+		KustoPoolName:     kustoPoolName,
+	}
+}
+
+// ParseKustoPoolID parses 'input' into a KustoPoolId
+func ParseKustoPoolID(input string) (*KustoPoolId, error) {
+	parser := resourceids.NewParserFromResourceIdType(KustoPoolId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := KustoPoolId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.KustoPoolName, ok = parsed.Parsed["kustoPoolName"]; !ok {
+		return nil, fmt.Errorf("the segment 'kustoPoolName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseKustoPoolIDInsensitively parses 'input' case-insensitively into a KustoPoolId
+// note: this method should only be used for API response data and not user input
+func ParseKustoPoolIDInsensitively(input string) (*KustoPoolId, error) {
+	parser := resourceids.NewParserFromResourceIdType(KustoPoolId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := KustoPoolId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.KustoPoolName, ok = parsed.Parsed["kustoPoolName"]; !ok {
+		return nil, fmt.Errorf("the segment 'kustoPoolName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateKustoPoolID checks that 'input' can be parsed as a Kusto Pool ID
+func ValidateKustoPoolID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseKustoPoolID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Kusto Pool ID
+func (id KustoPoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/kustoPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.KustoPoolName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Kusto Pool ID
+func (id KustoPoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspaceValue"),
+		resourceids.StaticSegment("staticKustoPools", "kustoPools", "kustoPools"),
+		resourceids.UserSpecifiedSegment("kustoPoolName", "kustoPoolValue"),
+	}
+}
+
+// String returns a human-readable description of this Kusto Pool ID
+func (id KustoPoolId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workspace Name: %q", id.WorkspaceName),
+		fmt.Sprintf("Kusto Pool Name: %q", id.KustoPoolName),
+	}
+	return fmt.Sprintf("Kusto Pool (%s)", strings.Join(components, "\n"))
+}
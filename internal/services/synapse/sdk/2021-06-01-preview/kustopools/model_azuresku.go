@@ -0,0 +1,7 @@
+package kustopools
+
+type AzureSku struct {
+	Capacity *int64  `json:"capacity,omitempty"`
+	Name     SkuName `json:"name"`
+	Size     SkuSize `json:"size"`
+}
@@ -0,0 +1,12 @@
+package kustopools
+
+type KustoPool struct {
+	Etag       *string              `json:"etag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Location   string               `json:"location"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *KustoPoolProperties `json:"properties,omitempty"`
+	Sku        AzureSku             `json:"sku"`
+	Tags       *map[string]string   `json:"tags,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
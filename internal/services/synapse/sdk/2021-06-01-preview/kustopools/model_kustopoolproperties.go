@@ -0,0 +1,14 @@
+package kustopools
+
+type KustoPoolProperties struct {
+	DataIngestionUri      *string                    `json:"dataIngestionUri,omitempty"`
+	EnablePurge           *bool                      `json:"enablePurge,omitempty"`
+	EnableStreamingIngest *bool                      `json:"enableStreamingIngest,omitempty"`
+	LanguageExtensions    *LanguageExtensionsList    `json:"languageExtensions,omitempty"`
+	OptimizedAutoscale    *OptimizedAutoscale        `json:"optimizedAutoscale,omitempty"`
+	ProvisioningState     *ResourceProvisioningState `json:"provisioningState,omitempty"`
+	State                 *State                     `json:"state,omitempty"`
+	StateReason           *string                    `json:"stateReason,omitempty"`
+	Uri                   *string                    `json:"uri,omitempty"`
+	WorkspaceUID          *string                    `json:"workspaceUID,omitempty"`
+}
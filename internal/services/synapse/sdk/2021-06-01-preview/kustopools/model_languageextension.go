@@ -0,0 +1,5 @@
+package kustopools
+
+type LanguageExtension struct {
+	LanguageExtensionName *LanguageExtensionName `json:"languageExtensionName,omitempty"`
+}
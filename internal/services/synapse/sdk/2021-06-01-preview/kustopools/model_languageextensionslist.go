@@ -0,0 +1,5 @@
+package kustopools
+
+type LanguageExtensionsList struct {
+	Value *[]LanguageExtension `json:"value,omitempty"`
+}
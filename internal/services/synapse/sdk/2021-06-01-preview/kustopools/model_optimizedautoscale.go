@@ -0,0 +1,8 @@
+package kustopools
+
+type OptimizedAutoscale struct {
+	IsEnabled bool  `json:"isEnabled"`
+	Maximum   int64 `json:"maximum"`
+	Minimum   int64 `json:"minimum"`
+	Version   int64 `json:"version"`
+}
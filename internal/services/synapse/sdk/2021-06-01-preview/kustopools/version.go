@@ -0,0 +1,9 @@
+package kustopools
+
+import "fmt"
+
+const defaultApiVersion = "2021-06-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/kustopools/%s", defaultApiVersion)
+}
@@ -0,0 +1,189 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	kustoValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopooldatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSynapseKustoPoolDatabase() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseKustoPoolDatabaseCreateUpdate,
+		Read:   resourceSynapseKustoPoolDatabaseRead,
+		Update: resourceSynapseKustoPoolDatabaseCreateUpdate,
+		Delete: resourceSynapseKustoPoolDatabaseDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := kustopooldatabases.ParseDatabaseID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kustoValidate.DatabaseName,
+			},
+
+			"kusto_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kustopools.ValidateKustoPoolID,
+			},
+
+			"soft_delete_period": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"hot_cache_period": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"size": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSynapseKustoPoolDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDatabasesClient
+	poolsClient := meta.(*clients.Client).Synapse.KustoPoolsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	kustoPoolId, err := kustopools.ParseKustoPoolID(d.Get("kusto_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := kustopooldatabases.NewDatabaseID(kustoPoolId.SubscriptionId, kustoPoolId.ResourceGroupName, kustoPoolId.WorkspaceName, kustoPoolId.KustoPoolName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_synapse_kusto_pool_database", id.ID())
+		}
+	}
+
+	// a database must be in the same location as the Kusto Pool
+	pool, err := poolsClient.Get(ctx, *kustoPoolId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *kustoPoolId, err)
+	}
+	if pool.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *kustoPoolId)
+	}
+
+	payload := kustopooldatabases.ReadWriteDatabase{
+		Kind:       kustopooldatabases.KindReadWrite,
+		Location:   utils.String(pool.Model.Location),
+		Properties: &kustopooldatabases.ReadWriteDatabaseProperties{},
+	}
+
+	if v, ok := d.GetOk("soft_delete_period"); ok {
+		payload.Properties.SoftDeletePeriod = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hot_cache_period"); ok {
+		payload.Properties.HotCachePeriod = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseKustoPoolDatabaseRead(d, meta)
+}
+
+func resourceSynapseKustoPoolDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDatabasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kustopooldatabases.ParseDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DatabaseName)
+	d.Set("kusto_pool_id", kustopools.NewKustoPoolID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.KustoPoolName).ID())
+
+	if model := resp.Model; model != nil {
+		if model.Kind != kustopooldatabases.KindReadWrite {
+			return fmt.Errorf("retrieving %s: expected a `kind` of %q but got %q", *id, string(kustopooldatabases.KindReadWrite), string(model.Kind))
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("soft_delete_period", props.SoftDeletePeriod)
+			d.Set("hot_cache_period", props.HotCachePeriod)
+
+			size := 0.0
+			if props.Statistics != nil && props.Statistics.Size != nil {
+				size = *props.Statistics.Size
+			}
+			d.Set("size", size)
+		}
+	}
+
+	return nil
+}
+
+func resourceSynapseKustoPoolDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDatabasesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kustopooldatabases.ParseDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
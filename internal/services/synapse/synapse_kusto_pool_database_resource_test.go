@@ -0,0 +1,130 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopooldatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KustoPoolDatabaseResource struct{}
+
+func TestAccSynapseKustoPoolDatabase_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_database", "test")
+	r := KustoPoolDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseKustoPoolDatabase_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_database", "test")
+	r := KustoPoolDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseKustoPoolDatabase_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_database", "test")
+	r := KustoPoolDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_period").HasValue("P7D"),
+				check.That(data.ResourceName).Key("hot_cache_period").HasValue("P1D"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KustoPoolDatabaseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := kustopooldatabases.ParseDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Synapse.KustoPoolDatabasesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (KustoPoolDatabaseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_database" "test" {
+  name          = "acctestkpd-%d"
+  kusto_pool_id = azurerm_synapse_kusto_pool.test.id
+}
+`, KustoPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r KustoPoolDatabaseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_database" "import" {
+  name          = azurerm_synapse_kusto_pool_database.test.name
+  kusto_pool_id = azurerm_synapse_kusto_pool_database.test.kusto_pool_id
+}
+`, r.basic(data))
+}
+
+func (KustoPoolDatabaseResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_database" "test" {
+  name               = "acctestkpd-%d"
+  kusto_pool_id      = azurerm_synapse_kusto_pool.test.id
+  soft_delete_period = "P7D"
+  hot_cache_period   = "P1D"
+}
+`, KustoPoolResource{}.basic(data), data.RandomInteger)
+}
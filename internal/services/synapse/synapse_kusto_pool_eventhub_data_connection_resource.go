@@ -0,0 +1,271 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	kustoValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopooldatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopooldataconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSynapseKustoPoolEventHubDataConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseKustoPoolEventHubDataConnectionCreateUpdate,
+		Read:   resourceSynapseKustoPoolEventHubDataConnectionRead,
+		Update: resourceSynapseKustoPoolEventHubDataConnectionCreateUpdate,
+		Delete: resourceSynapseKustoPoolEventHubDataConnectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := kustopooldataconnections.ParseDataConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kustoValidate.DataConnectionName,
+			},
+
+			"kusto_pool_database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kustopooldatabases.ValidateDatabaseID,
+			},
+
+			"eventhub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: eventhubs.ValidateEventhubID,
+			},
+
+			"consumer_group": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					eventhubValidate.ValidateEventHubConsumerName(),
+					validation.StringInSlice([]string{"$Default"}, false)),
+			},
+
+			"compression": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(kustopooldataconnections.CompressionNone),
+				ValidateFunc: validation.StringInSlice(kustopooldataconnections.PossibleValuesForCompression(), false),
+			},
+
+			"data_format": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kustopooldataconnections.PossibleValuesForEventHubDataFormat(), false),
+			},
+
+			"event_system_properties": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"identity_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validate.WorkspaceID,
+					msiValidate.UserAssignedIdentityID,
+				),
+			},
+
+			"mapping_rule_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: kustoValidate.EntityName,
+			},
+
+			"table_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: kustoValidate.EntityName,
+			},
+		},
+	}
+}
+
+func resourceSynapseKustoPoolEventHubDataConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDataConnectionsClient
+	databasesClient := meta.(*clients.Client).Synapse.KustoPoolDatabasesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := kustopooldatabases.ParseDatabaseID(d.Get("kusto_pool_database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := kustopooldataconnections.NewDataConnectionID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.WorkspaceName, databaseId.KustoPoolName, databaseId.DatabaseName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_synapse_kusto_pool_eventhub_data_connection", id.ID())
+		}
+	}
+
+	// a data connection must be in the same location as the database
+	database, err := databasesClient.Get(ctx, *databaseId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *databaseId, err)
+	}
+	if database.Model == nil || database.Model.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *databaseId)
+	}
+
+	payload := kustopooldataconnections.EventHubDataConnection{
+		Kind:       kustopooldataconnections.DataConnectionKindEventHub,
+		Location:   database.Model.Location,
+		Properties: expandSynapseKustoPoolEventHubDataConnectionProperties(d),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseKustoPoolEventHubDataConnectionRead(d, meta)
+}
+
+func resourceSynapseKustoPoolEventHubDataConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDataConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kustopooldataconnections.ParseDataConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataConnectionName)
+	d.Set("kusto_pool_database_id", kustopooldatabases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.KustoPoolName, id.DatabaseName).ID())
+
+	if model := resp.Model; model != nil {
+		if model.Kind != kustopooldataconnections.DataConnectionKindEventHub {
+			return fmt.Errorf("retrieving %s: expected a `kind` of %q but got %q", *id, string(kustopooldataconnections.DataConnectionKindEventHub), string(model.Kind))
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("eventhub_id", props.EventHubResourceId)
+			d.Set("consumer_group", props.ConsumerGroup)
+			d.Set("table_name", props.TableName)
+			d.Set("mapping_rule_name", props.MappingRuleName)
+			d.Set("identity_id", props.ManagedIdentityResourceId)
+			d.Set("event_system_properties", utils.FlattenStringSlice(props.EventSystemProperties))
+
+			compression := string(kustopooldataconnections.CompressionNone)
+			if props.Compression != nil {
+				compression = string(*props.Compression)
+			}
+			d.Set("compression", compression)
+
+			dataFormat := ""
+			if props.DataFormat != nil {
+				dataFormat = string(*props.DataFormat)
+			}
+			d.Set("data_format", dataFormat)
+		}
+	}
+
+	return nil
+}
+
+func resourceSynapseKustoPoolEventHubDataConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDataConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kustopooldataconnections.ParseDataConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandSynapseKustoPoolEventHubDataConnectionProperties(d *pluginsdk.ResourceData) *kustopooldataconnections.EventHubConnectionProperties {
+	compression := kustopooldataconnections.Compression(d.Get("compression").(string))
+	props := &kustopooldataconnections.EventHubConnectionProperties{
+		EventHubResourceId: d.Get("eventhub_id").(string),
+		ConsumerGroup:      d.Get("consumer_group").(string),
+		Compression:        &compression,
+	}
+
+	if v, ok := d.GetOk("table_name"); ok {
+		props.TableName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mapping_rule_name"); ok {
+		props.MappingRuleName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_format"); ok {
+		dataFormat := kustopooldataconnections.EventHubDataFormat(v.(string))
+		props.DataFormat = &dataFormat
+	}
+
+	if v, ok := d.GetOk("event_system_properties"); ok {
+		props.EventSystemProperties = utils.ExpandStringSlice(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("identity_id"); ok {
+		props.ManagedIdentityResourceId = utils.String(v.(string))
+	}
+
+	return props
+}
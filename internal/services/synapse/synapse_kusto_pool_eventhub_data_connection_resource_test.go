@@ -0,0 +1,162 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopooldataconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KustoPoolEventHubDataConnectionResource struct{}
+
+func TestAccSynapseKustoPoolEventHubDataConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_eventhub_data_connection", "test")
+	r := KustoPoolEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseKustoPoolEventHubDataConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_eventhub_data_connection", "test")
+	r := KustoPoolEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseKustoPoolEventHubDataConnection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_eventhub_data_connection", "test")
+	r := KustoPoolEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KustoPoolEventHubDataConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := kustopooldataconnections.ParseDataConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Synapse.KustoPoolDataConnectionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KustoPoolEventHubDataConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_eventhub_data_connection" "test" {
+  name                   = "acctestkpdc-%d"
+  kusto_pool_database_id = azurerm_synapse_kusto_pool_database.test.id
+  eventhub_id            = azurerm_eventhub.test.id
+  consumer_group         = azurerm_eventhub_consumer_group.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KustoPoolEventHubDataConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_eventhub_data_connection" "import" {
+  name                   = azurerm_synapse_kusto_pool_eventhub_data_connection.test.name
+  kusto_pool_database_id = azurerm_synapse_kusto_pool_eventhub_data_connection.test.kusto_pool_database_id
+  eventhub_id            = azurerm_synapse_kusto_pool_eventhub_data_connection.test.eventhub_id
+  consumer_group         = azurerm_synapse_kusto_pool_eventhub_data_connection.test.consumer_group
+}
+`, r.basic(data))
+}
+
+func (r KustoPoolEventHubDataConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_eventhub_data_connection" "test" {
+  name                    = "acctestkpdc-%d"
+  kusto_pool_database_id  = azurerm_synapse_kusto_pool_database.test.id
+  eventhub_id             = azurerm_eventhub.test.id
+  consumer_group          = azurerm_eventhub_consumer_group.test.name
+  identity_id             = azurerm_synapse_workspace.test.id
+  compression             = "GZip"
+  data_format             = "MULTIJSON"
+  event_system_properties = ["x-opt-enqueued-time"]
+  table_name              = "TestTable"
+  mapping_rule_name       = "TestMapping"
+
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (KustoPoolEventHubDataConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[2]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 1
+  message_retention   = 1
+}
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%[2]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub.test.id
+  role_definition_name = "Azure Event Hubs Data Receiver"
+  principal_id         = azurerm_synapse_workspace.test.identity.0.principal_id
+}
+`, KustoPoolDatabaseResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,386 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/kustopools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSynapseKustoPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseKustoPoolCreateUpdate,
+		Read:   resourceSynapseKustoPoolRead,
+		Update: resourceSynapseKustoPoolCreateUpdate,
+		Delete: resourceSynapseKustoPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := kustopools.ParseKustoPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KustoPoolName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(kustopools.PossibleValuesForSkuName(), false),
+						},
+
+						"size": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(kustopools.PossibleValuesForSkuSize(), false),
+						},
+
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 1000),
+						},
+					},
+				},
+			},
+
+			"optimized_auto_scale": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"minimum_instances": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(2, 1000),
+						},
+
+						"maximum_instances": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(2, 1000),
+						},
+					},
+				},
+			},
+
+			"language_extensions": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(kustopools.PossibleValuesForLanguageExtensionName(), false),
+				},
+			},
+
+			"streaming_ingestion_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"purge_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"data_ingestion_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceSynapseKustoPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolsClient
+	workspaceClient := meta.(*clients.Client).Synapse.WorkspaceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := kustopools.NewKustoPoolID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_synapse_kusto_pool", id.ID())
+		}
+	}
+
+	// a Kusto Pool must be in the same location as the Workspace
+	workspace, err := workspaceClient.Get(ctx, workspaceId.ResourceGroup, workspaceId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+	if workspace.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", workspaceId)
+	}
+
+	sku, err := expandSynapseKustoPoolSku(d.Get("sku").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	optimizedAutoScale := expandSynapseKustoPoolOptimizedAutoScale(d.Get("optimized_auto_scale").([]interface{}))
+	if optimizedAutoScale != nil {
+		if optimizedAutoScale.Minimum > optimizedAutoScale.Maximum {
+			return fmt.Errorf("`optimized_auto_scale.maximum_instances` must be >= `optimized_auto_scale.minimum_instances`")
+		}
+
+		// the capacity of the pool must sit within the bounds of the auto scale
+		if sku.Capacity != nil {
+			if *sku.Capacity < optimizedAutoScale.Minimum || *sku.Capacity > optimizedAutoScale.Maximum {
+				return fmt.Errorf("`sku.capacity` must be between `optimized_auto_scale.minimum_instances` and `optimized_auto_scale.maximum_instances`")
+			}
+		} else {
+			sku.Capacity = utils.Int64(optimizedAutoScale.Minimum)
+		}
+	}
+
+	payload := kustopools.KustoPool{
+		Location: location.Normalize(*workspace.Location),
+		Sku:      *sku,
+		Properties: &kustopools.KustoPoolProperties{
+			EnablePurge:           utils.Bool(d.Get("purge_enabled").(bool)),
+			EnableStreamingIngest: utils.Bool(d.Get("streaming_ingestion_enabled").(bool)),
+			LanguageExtensions:    expandSynapseKustoPoolLanguageExtensions(d.Get("language_extensions").(*pluginsdk.Set).List()),
+			OptimizedAutoscale:    optimizedAutoScale,
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseKustoPoolRead(d, meta)
+}
+
+func resourceSynapseKustoPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kustopools.ParseKustoPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.KustoPoolName)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		if err := d.Set("sku", flattenSynapseKustoPoolSku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("optimized_auto_scale", flattenSynapseKustoPoolOptimizedAutoScale(props.OptimizedAutoscale)); err != nil {
+				return fmt.Errorf("setting `optimized_auto_scale`: %+v", err)
+			}
+
+			if err := d.Set("language_extensions", flattenSynapseKustoPoolLanguageExtensions(props.LanguageExtensions)); err != nil {
+				return fmt.Errorf("setting `language_extensions`: %+v", err)
+			}
+
+			purgeEnabled := false
+			if props.EnablePurge != nil {
+				purgeEnabled = *props.EnablePurge
+			}
+			d.Set("purge_enabled", purgeEnabled)
+
+			streamingIngestionEnabled := false
+			if props.EnableStreamingIngest != nil {
+				streamingIngestionEnabled = *props.EnableStreamingIngest
+			}
+			d.Set("streaming_ingestion_enabled", streamingIngestionEnabled)
+
+			d.Set("uri", props.Uri)
+			d.Set("data_ingestion_uri", props.DataIngestionUri)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceSynapseKustoPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kustopools.ParseKustoPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandSynapseKustoPoolSku(input []interface{}) (*kustopools.AzureSku, error) {
+	v := input[0].(map[string]interface{})
+
+	name := kustopools.SkuName(v["name"].(string))
+	size := kustopools.SkuSize(v["size"].(string))
+
+	// Storage optimized pools are only available in the Medium and Large sizes
+	if name == kustopools.SkuNameStorageOptimized && (size == kustopools.SkuSizeExtraSmall || size == kustopools.SkuSizeSmall) {
+		return nil, fmt.Errorf("a `sku.size` of %q is not supported with a `sku.name` of %q", size, name)
+	}
+
+	sku := &kustopools.AzureSku{
+		Name: name,
+		Size: size,
+	}
+
+	if capacity := v["capacity"].(int); capacity > 0 {
+		sku.Capacity = utils.Int64(int64(capacity))
+	}
+
+	return sku, nil
+}
+
+func expandSynapseKustoPoolOptimizedAutoScale(input []interface{}) *kustopools.OptimizedAutoscale {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &kustopools.OptimizedAutoscale{
+		IsEnabled: true,
+		Minimum:   int64(v["minimum_instances"].(int)),
+		Maximum:   int64(v["maximum_instances"].(int)),
+		Version:   1,
+	}
+}
+
+func expandSynapseKustoPoolLanguageExtensions(input []interface{}) *kustopools.LanguageExtensionsList {
+	extensions := make([]kustopools.LanguageExtension, 0)
+	for _, v := range input {
+		name := kustopools.LanguageExtensionName(v.(string))
+		extensions = append(extensions, kustopools.LanguageExtension{
+			LanguageExtensionName: &name,
+		})
+	}
+
+	return &kustopools.LanguageExtensionsList{
+		Value: &extensions,
+	}
+}
+
+func flattenSynapseKustoPoolSku(input kustopools.AzureSku) []interface{} {
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     string(input.Name),
+			"size":     string(input.Size),
+			"capacity": capacity,
+		},
+	}
+}
+
+func flattenSynapseKustoPoolOptimizedAutoScale(input *kustopools.OptimizedAutoscale) []interface{} {
+	if input == nil || !input.IsEnabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"minimum_instances": int(input.Minimum),
+			"maximum_instances": int(input.Maximum),
+		},
+	}
+}
+
+func flattenSynapseKustoPoolLanguageExtensions(input *kustopools.LanguageExtensionsList) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Value == nil {
+		return output
+	}
+
+	for _, v := range *input.Value {
+		if v.LanguageExtensionName != nil {
+			output = append(output, string(*v.LanguageExtensionName))
+		}
+	}
+
+	return output
+}
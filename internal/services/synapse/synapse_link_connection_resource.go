@@ -0,0 +1,720 @@
+package synapse
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/artifacts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	synapseLinkConnectionStatusRunning  = "Running"
+	synapseLinkConnectionStatusStarting = "Starting"
+	synapseLinkConnectionStatusStopped  = "Stopped"
+	synapseLinkConnectionStatusStopping = "Stopping"
+)
+
+func resourceSynapseLinkConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseLinkConnectionCreate,
+		Read:   resourceSynapseLinkConnectionRead,
+		Update: resourceSynapseLinkConnectionUpdate,
+		Delete: resourceSynapseLinkConnectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LinkConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"source_linked_service_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_linked_service_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"table": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_table_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_table_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"source_schema_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "dbo",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_schema_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "dbo",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"distribution_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "Round_Robin",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Hash",
+								"Replicate",
+								"Round_Robin",
+							}, false),
+						},
+
+						"distribution_column_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"compute_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "General",
+				ValidateFunc: validation.StringInSlice([]string{
+					"General",
+					"MemoryOptimized",
+				}, false),
+			},
+
+			"compute_core_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntInSlice([]int{4, 8, 16, 32, 48, 80, 144, 272}),
+			},
+
+			"landing_zone": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"file_system_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"sas_token": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"folder_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"drop_existing_target_table_on_start_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"started": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceSynapseLinkConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(workspaceId.Name, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLinkConnectionID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_synapse_link_connection", id.ID())
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.Name, expandSynapseLinkConnection(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	linkTables, err := expandSynapseLinkConnectionTables(d.Get("table").(*pluginsdk.Set).List(), nil)
+	if err != nil {
+		return err
+	}
+	if _, err := client.EditTables(ctx, id.Name, artifacts.EditTablesRequest{LinkTables: &linkTables}); err != nil {
+		return fmt.Errorf("adding tables to %s: %+v", id, err)
+	}
+
+	if d.Get("started").(bool) {
+		if err := synapseLinkConnectionStart(ctx, client, id, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSynapseLinkConnectionRead(d, meta)
+}
+
+func resourceSynapseLinkConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+
+	id, err := parse.LinkConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+	d.Set("description", resp.Description)
+
+	if props := resp.Properties; props != nil {
+		sourceLinkedServiceName := ""
+		if props.SourceDatabase != nil && props.SourceDatabase.LinkedService != nil && props.SourceDatabase.LinkedService.ReferenceName != nil {
+			sourceLinkedServiceName = *props.SourceDatabase.LinkedService.ReferenceName
+		}
+		d.Set("source_linked_service_name", sourceLinkedServiceName)
+
+		targetLinkedServiceName := ""
+		dropExistingTargetTableOnStart := false
+		if target := props.TargetDatabase; target != nil {
+			if target.LinkedService != nil && target.LinkedService.ReferenceName != nil {
+				targetLinkedServiceName = *target.LinkedService.ReferenceName
+			}
+			if target.TypeProperties != nil && target.TypeProperties.DropExistingTargetTableOnStart != nil {
+				dropExistingTargetTableOnStart = *target.TypeProperties.DropExistingTargetTableOnStart
+			}
+		}
+		d.Set("target_linked_service_name", targetLinkedServiceName)
+		d.Set("drop_existing_target_table_on_start_enabled", dropExistingTargetTableOnStart)
+
+		computeType := ""
+		coreCount := 0
+		if compute := props.Compute; compute != nil {
+			if compute.ComputeType != nil {
+				computeType = *compute.ComputeType
+			}
+			if compute.CoreCount != nil {
+				coreCount = int(*compute.CoreCount)
+			}
+		}
+		d.Set("compute_type", computeType)
+		d.Set("compute_core_count", coreCount)
+
+		if err := d.Set("landing_zone", flattenSynapseLinkConnectionLandingZone(props.LandingZone, d.Get("landing_zone").([]interface{}))); err != nil {
+			return fmt.Errorf("setting `landing_zone`: %+v", err)
+		}
+	}
+
+	tables, err := client.ListLinkTables(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing tables for %s: %+v", id, err)
+	}
+	if err := d.Set("table", flattenSynapseLinkConnectionTables(tables.Value)); err != nil {
+		return fmt.Errorf("setting `table`: %+v", err)
+	}
+
+	status, err := client.GetDetailedStatus(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving status for %s: %+v", id, err)
+	}
+	started := false
+	if status.Status != nil {
+		started = strings.EqualFold(*status.Status, synapseLinkConnectionStatusRunning) || strings.EqualFold(*status.Status, synapseLinkConnectionStatusStarting)
+	}
+	d.Set("started", started)
+
+	return nil
+}
+
+func resourceSynapseLinkConnectionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+
+	id, err := parse.LinkConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return err
+	}
+
+	oldStarted, _ := d.GetChange("started")
+	running := oldStarted.(bool)
+
+	// the compute and target database settings can only be changed whilst the link connection is stopped
+	if d.HasChanges("compute_type", "compute_core_count", "drop_existing_target_table_on_start_enabled", "description") {
+		if running {
+			if err := synapseLinkConnectionStop(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+				return err
+			}
+			running = false
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, id.Name, expandSynapseLinkConnection(d)); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	}
+
+	if d.HasChange("table") {
+		existing, err := client.ListLinkTables(ctx, id.Name)
+		if err != nil {
+			return fmt.Errorf("listing tables for %s: %+v", id, err)
+		}
+
+		linkTables, err := expandSynapseLinkConnectionTables(d.Get("table").(*pluginsdk.Set).List(), existing.Value)
+		if err != nil {
+			return err
+		}
+		if _, err := client.EditTables(ctx, id.Name, artifacts.EditTablesRequest{LinkTables: &linkTables}); err != nil {
+			return fmt.Errorf("updating tables for %s: %+v", id, err)
+		}
+	}
+
+	if started := d.Get("started").(bool); started != running {
+		if started {
+			if err := synapseLinkConnectionStart(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			if err := synapseLinkConnectionStop(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceSynapseLinkConnectionRead(d, meta)
+}
+
+func resourceSynapseLinkConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+
+	id, err := parse.LinkConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LinkConnectionClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return err
+	}
+
+	// a running link connection has to be stopped before it can be deleted
+	status, err := client.GetDetailedStatus(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving status for %s: %+v", id, err)
+	}
+	if status.Status != nil && !strings.EqualFold(*status.Status, synapseLinkConnectionStatusStopped) {
+		if strings.EqualFold(*status.Status, synapseLinkConnectionStatusRunning) || strings.EqualFold(*status.Status, synapseLinkConnectionStatusStarting) {
+			if err := synapseLinkConnectionStop(ctx, client, *id, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := client.Delete(ctx, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func synapseLinkConnectionStart(ctx context.Context, client *artifacts.LinkConnectionClient, id parse.LinkConnectionId, timeout time.Duration) error {
+	if _, err := client.Start(ctx, id.Name); err != nil {
+		return fmt.Errorf("starting %s: %+v", id, err)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{synapseLinkConnectionStatusStarting, synapseLinkConnectionStatusStopped},
+		Target:     []string{synapseLinkConnectionStatusRunning},
+		Refresh:    synapseLinkConnectionStatusRefreshFunc(ctx, client, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to start: %+v", id, err)
+	}
+
+	return nil
+}
+
+func synapseLinkConnectionStop(ctx context.Context, client *artifacts.LinkConnectionClient, id parse.LinkConnectionId, timeout time.Duration) error {
+	if _, err := client.Stop(ctx, id.Name); err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{synapseLinkConnectionStatusStopping, synapseLinkConnectionStatusRunning},
+		Target:     []string{synapseLinkConnectionStatusStopped},
+		Refresh:    synapseLinkConnectionStatusRefreshFunc(ctx, client, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to stop: %+v", id, err)
+	}
+
+	return nil
+}
+
+func synapseLinkConnectionStatusRefreshFunc(ctx context.Context, client *artifacts.LinkConnectionClient, id parse.LinkConnectionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetDetailedStatus(ctx, id.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving status for %s: %+v", id, err)
+		}
+
+		if resp.Status == nil {
+			return nil, "", fmt.Errorf("retrieving status for %s: `status` was nil", id)
+		}
+
+		return resp, *resp.Status, nil
+	}
+}
+
+func expandSynapseLinkConnection(d *pluginsdk.ResourceData) artifacts.LinkConnectionResource {
+	linkConnection := artifacts.LinkConnectionResource{
+		Properties: &artifacts.LinkConnection{
+			SourceDatabase: &artifacts.LinkConnectionSourceDatabase{
+				LinkedService: &artifacts.LinkedServiceReference{
+					Type:          utils.String("LinkedServiceReference"),
+					ReferenceName: utils.String(d.Get("source_linked_service_name").(string)),
+				},
+			},
+			TargetDatabase: &artifacts.LinkConnectionTargetDatabase{
+				LinkedService: &artifacts.LinkedServiceReference{
+					Type:          utils.String("LinkedServiceReference"),
+					ReferenceName: utils.String(d.Get("target_linked_service_name").(string)),
+				},
+				TypeProperties: &artifacts.LinkConnectionTargetDatabaseTypeProperties{
+					DropExistingTargetTableOnStart: utils.Bool(d.Get("drop_existing_target_table_on_start_enabled").(bool)),
+				},
+			},
+			LandingZone: expandSynapseLinkConnectionLandingZone(d.Get("landing_zone").([]interface{})),
+			Compute: &artifacts.LinkConnectionCompute{
+				ComputeType: utils.String(d.Get("compute_type").(string)),
+				CoreCount:   utils.Int32(int32(d.Get("compute_core_count").(int))),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		linkConnection.Description = utils.String(v.(string))
+	}
+
+	return linkConnection
+}
+
+func expandSynapseLinkConnectionLandingZone(input []interface{}) *artifacts.LinkConnectionLandingZone {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	landingZone := &artifacts.LinkConnectionLandingZone{
+		LinkedService: &artifacts.LinkedServiceReference{
+			Type:          utils.String("LinkedServiceReference"),
+			ReferenceName: utils.String(v["linked_service_name"].(string)),
+		},
+		FileSystem: utils.String(v["file_system_name"].(string)),
+		SasToken: &artifacts.SecureString{
+			Type:  artifacts.TypeSecureString,
+			Value: utils.String(v["sas_token"].(string)),
+		},
+	}
+
+	if folderPath := v["folder_path"].(string); folderPath != "" {
+		landingZone.FolderPath = utils.String(folderPath)
+	}
+
+	return landingZone
+}
+
+// expandSynapseLinkConnectionTables builds the set of table edits needed to move from the `existing` tables on the
+// link connection to those defined in `input` - tables are matched on their source schema and table name.
+func expandSynapseLinkConnectionTables(input []interface{}, existing *[]artifacts.LinkTableResource) ([]artifacts.LinkTableRequest, error) {
+	existingIds := make(map[string]string)
+	if existing != nil {
+		for _, table := range *existing {
+			if table.ID == nil || table.Source == nil {
+				continue
+			}
+			existingIds[synapseLinkConnectionTableKey(table.Source.SchemaName, table.Source.TableName)] = *table.ID
+		}
+	}
+
+	output := make([]artifacts.LinkTableRequest, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		sourceSchemaName := v["source_schema_name"].(string)
+		sourceTableName := v["source_table_name"].(string)
+		key := synapseLinkConnectionTableKey(&sourceSchemaName, &sourceTableName)
+
+		operationType := "update"
+		tableId, ok := existingIds[key]
+		if ok {
+			delete(existingIds, key)
+		} else {
+			operationType = "add"
+			newId, err := uuid.GenerateUUID()
+			if err != nil {
+				return nil, fmt.Errorf("generating ID for table %q: %+v", key, err)
+			}
+			tableId = newId
+		}
+
+		distributionOptions := &artifacts.LinkTableRequestTargetDistributionOptions{
+			Type: utils.String(v["distribution_type"].(string)),
+		}
+		if column := v["distribution_column_name"].(string); column != "" {
+			distributionOptions.DistributionColumn = utils.String(column)
+		}
+
+		output = append(output, artifacts.LinkTableRequest{
+			ID: utils.String(tableId),
+			Source: &artifacts.LinkTableRequestSource{
+				SchemaName: utils.String(sourceSchemaName),
+				TableName:  utils.String(sourceTableName),
+			},
+			Target: &artifacts.LinkTableRequestTarget{
+				SchemaName:          utils.String(v["target_schema_name"].(string)),
+				TableName:           utils.String(v["target_table_name"].(string)),
+				DistributionOptions: distributionOptions,
+			},
+			OperationType: utils.String(operationType),
+		})
+	}
+
+	for _, tableId := range existingIds {
+		output = append(output, artifacts.LinkTableRequest{
+			ID:            utils.String(tableId),
+			OperationType: utils.String("remove"),
+		})
+	}
+
+	return output, nil
+}
+
+func synapseLinkConnectionTableKey(schemaName, tableName *string) string {
+	schema := ""
+	if schemaName != nil {
+		schema = *schemaName
+	}
+	table := ""
+	if tableName != nil {
+		table = *tableName
+	}
+	return strings.ToLower(fmt.Sprintf("%s.%s", schema, table))
+}
+
+func flattenSynapseLinkConnectionLandingZone(input *artifacts.LinkConnectionLandingZone, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	linkedServiceName := ""
+	if input.LinkedService != nil && input.LinkedService.ReferenceName != nil {
+		linkedServiceName = *input.LinkedService.ReferenceName
+	}
+
+	fileSystemName := ""
+	if input.FileSystem != nil {
+		fileSystemName = *input.FileSystem
+	}
+
+	folderPath := ""
+	if input.FolderPath != nil {
+		folderPath = *input.FolderPath
+	}
+
+	// the SAS Token isn't returned by the API so we pull it from the existing config
+	sasToken := ""
+	if len(existing) > 0 && existing[0] != nil {
+		sasToken = existing[0].(map[string]interface{})["sas_token"].(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"linked_service_name": linkedServiceName,
+			"file_system_name":    fileSystemName,
+			"folder_path":         folderPath,
+			"sas_token":           sasToken,
+		},
+	}
+}
+
+func flattenSynapseLinkConnectionTables(input *[]artifacts.LinkTableResource) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, table := range *input {
+		sourceSchemaName := ""
+		sourceTableName := ""
+		if source := table.Source; source != nil {
+			if source.SchemaName != nil {
+				sourceSchemaName = *source.SchemaName
+			}
+			if source.TableName != nil {
+				sourceTableName = *source.TableName
+			}
+		}
+
+		targetSchemaName := ""
+		targetTableName := ""
+		distributionType := ""
+		distributionColumnName := ""
+		if target := table.Target; target != nil {
+			if target.SchemaName != nil {
+				targetSchemaName = *target.SchemaName
+			}
+			if target.TableName != nil {
+				targetTableName = *target.TableName
+			}
+			if options := target.DistributionOptions; options != nil {
+				if options.Type != nil {
+					distributionType = *options.Type
+				}
+				if options.DistributionColumn != nil {
+					distributionColumnName = *options.DistributionColumn
+				}
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"source_schema_name":       sourceSchemaName,
+			"source_table_name":        sourceTableName,
+			"target_schema_name":       targetSchemaName,
+			"target_table_name":        targetTableName,
+			"distribution_type":        distributionType,
+			"distribution_column_name": distributionColumnName,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,275 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LinkConnectionResource struct{}
+
+func TestAccSynapseLinkConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_link_connection", "test")
+	r := LinkConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseLinkConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_link_connection", "test")
+	r := LinkConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseLinkConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_link_connection", "test")
+	r := LinkConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LinkConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LinkConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	environment := clients.Account.Environment
+	client, err := clients.Synapse.LinkConnectionClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r LinkConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_link_connection" "test" {
+  name                       = "acctestlc%d"
+  synapse_workspace_id       = azurerm_synapse_workspace.test.id
+  source_linked_service_name = azurerm_synapse_linked_service.source.name
+  target_linked_service_name = azurerm_synapse_linked_service.target.name
+
+  table {
+    source_table_name = "orders"
+    target_table_name = "orders"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LinkConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_link_connection" "import" {
+  name                       = azurerm_synapse_link_connection.test.name
+  synapse_workspace_id       = azurerm_synapse_link_connection.test.synapse_workspace_id
+  source_linked_service_name = azurerm_synapse_link_connection.test.source_linked_service_name
+  target_linked_service_name = azurerm_synapse_link_connection.test.target_linked_service_name
+
+  table {
+    source_table_name = "orders"
+    target_table_name = "orders"
+  }
+}
+`, r.basic(data))
+}
+
+func (r LinkConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_link_connection" "test" {
+  name                                        = "acctestlc%d"
+  synapse_workspace_id                        = azurerm_synapse_workspace.test.id
+  source_linked_service_name                  = azurerm_synapse_linked_service.source.name
+  target_linked_service_name                  = azurerm_synapse_linked_service.target.name
+  compute_type                                = "MemoryOptimized"
+  compute_core_count                          = 16
+  drop_existing_target_table_on_start_enabled = true
+  description                                 = "acctest link connection"
+
+  table {
+    source_table_name        = "orders"
+    target_schema_name       = "sales"
+    target_table_name        = "orders_replica"
+    distribution_type        = "Hash"
+    distribution_column_name = "id"
+  }
+
+  table {
+    source_schema_name = "sales"
+    source_table_name  = "customers"
+    target_table_name  = "customers"
+    distribution_type  = "Replicate"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (LinkConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-synapse-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%[1]d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%[1]d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+}
+
+resource "azurerm_synapse_firewall_rule" "test" {
+  name                 = "allowAll"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  start_ip_address     = "0.0.0.0"
+  end_ip_address       = "255.255.255.255"
+}
+
+resource "azurerm_synapse_sql_pool" "test" {
+  name                 = "acctestSP%[3]s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  sku_name             = "DW100c"
+  create_mode          = "Default"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_mssql_firewall_rule" "test" {
+  name             = "AllowAzureServices"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "0.0.0.0"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[1]d"
+  server_id = azurerm_mssql_server.test.id
+  sku_name  = "S1"
+}
+
+resource "azurerm_synapse_linked_service" "source" {
+  name                 = "acctestlssource%[1]d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "AzureSqlDatabase"
+  type_properties_json = <<JSON
+{
+  "connectionString": "Integrated Security=False;Data Source=${azurerm_mssql_server.test.fully_qualified_domain_name};Initial Catalog=${azurerm_mssql_database.test.name};User ID=${azurerm_mssql_server.test.administrator_login};Password=${azurerm_mssql_server.test.administrator_login_password}"
+}
+JSON
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+
+resource "azurerm_synapse_linked_service" "target" {
+  name                 = "acctestlstarget%[1]d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "AzureSqlDW"
+  type_properties_json = <<JSON
+{
+  "connectionString": "Integrated Security=False;Data Source=${azurerm_synapse_workspace.test.connectivity_endpoints.sql};Initial Catalog=${azurerm_synapse_sql_pool.test.name};User ID=${azurerm_synapse_workspace.test.sql_administrator_login};Password=${azurerm_synapse_workspace.test.sql_administrator_login_password}"
+}
+JSON
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func KustoPoolName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// The name attribute rules are :
+	// 1. The value must be between 4 and 15 characters long
+	// 2. must begin with a lowercase letter
+	// 3. must contain only lowercase letters or numbers.
+
+	if !regexp.MustCompile(`^[a-z][a-z0-9]{3,14}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be between 4 and 15 characters long, begin with a lowercase letter and can contain only lowercase letters or numbers", k))
+		return
+	}
+	return warnings, errors
+}
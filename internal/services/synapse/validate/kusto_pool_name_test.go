@@ -0,0 +1,63 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestKustoPoolName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// 3 chars
+			input:    "abc",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "abc123",
+			expected: true,
+		},
+		{
+			// can't start with a number
+			input:    "1abc",
+			expected: false,
+		},
+		{
+			// can't contain upper case
+			input:    "aBc123",
+			expected: false,
+		},
+		{
+			// can't contain hyphen
+			input:    "abc-123",
+			expected: false,
+		},
+		{
+			// 15 chars
+			input:    "abcdefghijklmno",
+			expected: true,
+		},
+		{
+			// 16 chars
+			input:    "abcdefghijklmnop",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := KustoPoolName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -452,6 +452,17 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
+			if metadata.ResourceData.HasChange("allow_new_private_endpoint_connections") {
+				aseNetworkConfig := web.AseV3NetworkingConfiguration{
+					AseV3NetworkingConfigurationProperties: &web.AseV3NetworkingConfigurationProperties{
+						AllowNewPrivateEndpointConnections: utils.Bool(state.AllowNewPrivateEndpointConnections),
+					},
+				}
+				if _, err := client.UpdateAseNetworkingConfiguration(ctx, id.ResourceGroup, id.HostingEnvironmentName, aseNetworkConfig); err != nil {
+					return fmt.Errorf("updating Allow New Private Endpoint Connections on %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
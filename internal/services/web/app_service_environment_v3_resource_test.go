@@ -82,6 +82,15 @@ func TestAccAppServiceEnvironmentV3_completeUpdate(t *testing.T) {
 			Config: r.completeUpdate(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_new_private_endpoint_connections").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_new_private_endpoint_connections").HasValue("true"),
 			),
 		},
 		data.ImportStep(),
@@ -216,6 +225,8 @@ resource "azurerm_app_service_environment_v3" "test" {
   subnet_id                    = azurerm_subnet.test.id
   internal_load_balancing_mode = "Web, Publishing"
 
+  allow_new_private_endpoint_connections = false
+
   cluster_setting {
     name  = "InternalEncryption"
     value = "true"
@@ -0,0 +1,330 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAppServiceManagedCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAppServiceManagedCustomDomainCreate,
+		Read:   resourceAppServiceManagedCustomDomainRead,
+		Delete: resourceAppServiceManagedCustomDomainDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HostnameBindingID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"app_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AppServiceID,
+			},
+
+			"hostname": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ssl_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(web.SslStateSniEnabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.SslStateIPBasedEnabled),
+					string(web.SslStateSniEnabled),
+				}, false),
+			},
+
+			"certificate_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_ip": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAppServiceManagedCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	appServiceId, err := parse.AppServiceID(d.Get("app_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHostnameBindingID(appServiceId.SubscriptionId, appServiceId.ResourceGroup, appServiceId.SiteName, d.Get("hostname").(string))
+
+	locks.ByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+	defer locks.UnlockByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+
+	existing, err := client.GetHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_app_service_managed_custom_domain", id.ID())
+	}
+
+	appService, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", appServiceId, err)
+	}
+	if appService.SiteProperties == nil || appService.SiteProperties.ServerFarmID == nil {
+		return fmt.Errorf("retrieving %s: `serverFarmId` was nil", appServiceId)
+	}
+	appServicePlanId, err := parse.AppServicePlanID(*appService.SiteProperties.ServerFarmID)
+	if err != nil {
+		return err
+	}
+
+	// the domain ownership is verified when the binding is created, since the `asuid` TXT record is typically created
+	// alongside this resource we retry whilst the record propagates - any other error is returned immediately
+	binding := web.HostNameBinding{
+		HostNameBindingProperties: &web.HostNameBindingProperties{
+			SiteName: utils.String(id.SiteName),
+		},
+	}
+	log.Printf("[DEBUG] Creating Hostname Binding for %s..", id)
+	err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+		resp, err := client.CreateOrUpdateHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name, binding)
+		if err != nil {
+			if utils.ResponseWasStatusCode(resp.Response, http.StatusBadRequest) && isHostnameVerificationPending(err) {
+				return pluginsdk.RetryableError(fmt.Errorf("creating Hostname Binding for %s: %+v", id, err))
+			}
+			return pluginsdk.NonRetryableError(fmt.Errorf("creating Hostname Binding for %s: %+v", id, err))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the ID is set once the Hostname Binding exists so that it's cleaned up if the remaining steps fail
+	d.SetId(id.ID())
+
+	certificateId := parse.NewManagedCertificateID(id.SubscriptionId, appServicePlanId.ResourceGroup, id.Name)
+	certificate := web.Certificate{
+		CertificateProperties: &web.CertificateProperties{
+			CanonicalName: utils.String(id.Name),
+			ServerFarmID:  utils.String(appServicePlanId.ID()),
+			Password:      new(string),
+		},
+		Location: utils.String(location.NormalizeNilable(appService.Location)),
+	}
+
+	log.Printf("[DEBUG] Creating Managed Certificate for %s..", id)
+	if resp, err := certClient.CreateOrUpdate(ctx, certificateId.ResourceGroup, certificateId.CertificateName, certificate); err != nil {
+		// API returns 202 where 200 is expected - https://github.com/Azure/azure-sdk-for-go/issues/13665
+		if !utils.ResponseWasStatusCode(resp.Response, http.StatusAccepted) {
+			return fmt.Errorf("creating Managed Certificate for %s: %+v", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for the Managed Certificate for %s to be issued..", id)
+	certificateWait := &pluginsdk.StateChangeConf{
+		Pending:    []string{"NotFound", "Pending"},
+		Target:     []string{"Issued"},
+		MinTimeout: 1 * time.Minute,
+		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := certClient.Get(ctx, certificateId.ResourceGroup, certificateId.CertificateName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+				return resp, "", err
+			}
+			if resp.CertificateProperties == nil || resp.CertificateProperties.Thumbprint == nil || *resp.CertificateProperties.Thumbprint == "" {
+				return resp, "Pending", nil
+			}
+			return resp, "Issued", nil
+		},
+	}
+	raw, err := certificateWait.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for the Managed Certificate for %s to be issued: %+v", id, err)
+	}
+	issued := raw.(web.Certificate)
+
+	binding.HostNameBindingProperties.SslState = web.SslState(d.Get("ssl_state").(string))
+	binding.HostNameBindingProperties.Thumbprint = issued.CertificateProperties.Thumbprint
+
+	log.Printf("[DEBUG] Binding the Managed Certificate to %s..", id)
+	if _, err := client.CreateOrUpdateHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name, binding); err != nil {
+		return fmt.Errorf("binding the Managed Certificate to %s: %+v", id, err)
+	}
+
+	return resourceAppServiceManagedCustomDomainRead(d, meta)
+}
+
+func resourceAppServiceManagedCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HostnameBindingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("app_service_id", parse.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID())
+	d.Set("hostname", id.Name)
+
+	if props := resp.HostNameBindingProperties; props != nil {
+		d.Set("ssl_state", string(props.SslState))
+		d.Set("thumbprint", props.Thumbprint)
+		d.Set("virtual_ip", props.VirtualIP)
+	}
+
+	certificateId, err := appServiceManagedCustomDomainCertificateID(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	d.Set("certificate_id", certificateId.ID())
+
+	expirationDate := ""
+	certificate, err := certClient.Get(ctx, certificateId.ResourceGroup, certificateId.CertificateName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(certificate.Response) {
+			return fmt.Errorf("retrieving Managed Certificate for %s: %+v", *id, err)
+		}
+	}
+	if props := certificate.CertificateProperties; props != nil && props.ExpirationDate != nil {
+		expirationDate = props.ExpirationDate.Format(time.RFC3339)
+	}
+	d.Set("expiration_date", expirationDate)
+
+	return nil
+}
+
+func resourceAppServiceManagedCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HostnameBindingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+	defer locks.UnlockByName(id.SiteName, appServiceCustomHostnameBindingResourceName)
+
+	certificateId, err := appServiceManagedCustomDomainCertificateID(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	// the Managed Certificate can't be deleted whilst it's bound, so the Hostname Binding has to be removed first
+	log.Printf("[DEBUG] Deleting Hostname Binding for %s..", *id)
+	resp, err := client.DeleteHostNameBinding(ctx, id.ResourceGroup, id.SiteName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Hostname Binding for %s: %+v", *id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Managed Certificate for %s..", *id)
+	certResp, err := certClient.Delete(ctx, certificateId.ResourceGroup, certificateId.CertificateName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(certResp) {
+			return fmt.Errorf("deleting Managed Certificate for %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+// appServiceManagedCustomDomainCertificateID returns the ID of the Managed Certificate for the Hostname Binding, which
+// is created within the Resource Group of the App Service Plan used by the App Service
+func appServiceManagedCustomDomainCertificateID(ctx context.Context, client *web.AppsClient, id parse.HostnameBindingId) (*parse.ManagedCertificateId, error) {
+	appService, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving App Service for %s: %+v", id, err)
+	}
+	if appService.SiteProperties == nil || appService.SiteProperties.ServerFarmID == nil {
+		return nil, fmt.Errorf("retrieving App Service for %s: `serverFarmId` was nil", id)
+	}
+
+	appServicePlanId, err := parse.AppServicePlanID(*appService.SiteProperties.ServerFarmID)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateId := parse.NewManagedCertificateID(id.SubscriptionId, appServicePlanId.ResourceGroup, id.Name)
+	return &certificateId, nil
+}
+
+// isHostnameVerificationPending returns whether the error returned when creating a Hostname Binding is due to the
+// DNS records used to verify the ownership of the domain not (yet) being resolvable
+func isHostnameVerificationPending(err error) bool {
+	msg := err.Error()
+	for _, fragment := range []string{
+		"A TXT record pointing from asuid.",
+		"A CNAME record pointing from ",
+	} {
+		if strings.Contains(msg, fragment) && strings.Contains(msg, "was not found") {
+			return true
+		}
+	}
+	return false
+}
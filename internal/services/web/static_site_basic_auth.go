@@ -0,0 +1,95 @@
+package web
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+)
+
+// staticSiteBasicAuthApiVersion is the API Version used to manage Basic Auth for a Static Site, since this
+// isn't available in the version of the Web SDK in use
+const staticSiteBasicAuthApiVersion = "2022-03-01"
+
+type staticSiteBasicAuth struct {
+	Properties *staticSiteBasicAuthProperties `json:"properties,omitempty"`
+}
+
+type staticSiteBasicAuthProperties struct {
+	ApplicableEnvironmentsMode *string   `json:"applicableEnvironmentsMode,omitempty"`
+	Environments               *[]string `json:"environments,omitempty"`
+	Password                   *string   `json:"password,omitempty"`
+}
+
+func createOrUpdateStaticSiteBasicAuth(ctx context.Context, client *web.StaticSitesClient, id parse.StaticSiteId, input staticSiteBasicAuth) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/staticSites/{name}/basicAuth/default", staticSiteBasicAuthPathParameters(client, id)),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": staticSiteBasicAuthApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.StaticSitesClient", "CreateOrUpdateBasicAuth", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.StaticSitesClient", "CreateOrUpdateBasicAuth", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.StaticSitesClient", "CreateOrUpdateBasicAuth", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func getStaticSiteBasicAuth(ctx context.Context, client *web.StaticSitesClient, id parse.StaticSiteId) (*staticSiteBasicAuth, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/staticSites/{name}/basicAuth/default", staticSiteBasicAuthPathParameters(client, id)),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": staticSiteBasicAuthApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetBasicAuth", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetBasicAuth", resp, "Failure sending request")
+	}
+
+	var result staticSiteBasicAuth
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "web.StaticSitesClient", "GetBasicAuth", resp, "Failure responding to request")
+	}
+
+	return &result, nil
+}
+
+func staticSiteBasicAuthPathParameters(client *web.StaticSitesClient, id parse.StaticSiteId) map[string]interface{} {
+	return map[string]interface{}{
+		"name":              autorest.Encode("path", id.Name),
+		"resourceGroupName": autorest.Encode("path", id.ResourceGroup),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+}
@@ -0,0 +1,214 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStaticSiteCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticSiteCustomDomainCreate,
+		Read:   resourceStaticSiteCustomDomainRead,
+		Delete: resourceStaticSiteCustomDomainDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteCustomDomainID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_site_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"domain_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"validation_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"cname-delegation",
+					"dns-txt-token",
+				}, false),
+			},
+
+			"validation_token": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceStaticSiteCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStaticSiteCustomDomainID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, d.Get("domain_name").(string))
+
+	existing, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_static_site_custom_domain", id.ID())
+	}
+
+	validationMethod := d.Get("validation_type").(string)
+	envelope := web.StaticSiteCustomDomainRequestPropertiesARMResource{
+		StaticSiteCustomDomainRequestPropertiesARMResourceProperties: &web.StaticSiteCustomDomainRequestPropertiesARMResourceProperties{},
+	}
+	if validationMethod != "" {
+		envelope.StaticSiteCustomDomainRequestPropertiesARMResourceProperties.ValidationMethod = utils.String(validationMethod)
+	}
+
+	future, err := client.CreateOrUpdateStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName, envelope)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if validationMethod == "dns-txt-token" {
+		// the operation doesn't complete until the TXT record exists, which can't be created until we've got the
+		// validation token - so instead we wait for the token to be generated and leave validation to the user
+		log.Printf("[DEBUG] Waiting for the Validation Token for %s to be generated..", id)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending: []string{string(web.CustomDomainStatusRetrievingValidationToken)},
+			Target: []string{
+				string(web.CustomDomainStatusValidating),
+				string(web.CustomDomainStatusAdding),
+				string(web.CustomDomainStatusReady),
+			},
+			Refresh:    staticSiteCustomDomainRefreshFunc(ctx, client, id),
+			MinTimeout: 15 * time.Second,
+			Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for the Validation Token for %s: %+v", id, err)
+		}
+	} else {
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticSiteCustomDomainRead(d, meta)
+}
+
+func resourceStaticSiteCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+	d.Set("domain_name", id.CustomDomainName)
+
+	// the validation token is only returned whilst the domain is being validated, so retain the existing value
+	if props := resp.StaticSiteCustomDomainOverviewARMResourceProperties; props != nil && props.ValidationToken != nil && *props.ValidationToken != "" {
+		d.Set("validation_token", props.ValidationToken)
+	}
+
+	return nil
+}
+
+func resourceStaticSiteCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func staticSiteCustomDomainRefreshFunc(ctx context.Context, client *web.StaticSitesClient, id parse.StaticSiteCustomDomainId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+		if err != nil {
+			return resp, "", fmt.Errorf("polling for %s: %+v", id, err)
+		}
+
+		props := resp.StaticSiteCustomDomainOverviewARMResourceProperties
+		if props == nil {
+			return resp, string(web.CustomDomainStatusRetrievingValidationToken), nil
+		}
+
+		if props.Status == web.CustomDomainStatusFailed {
+			errorMessage := ""
+			if props.ErrorMessage != nil {
+				errorMessage = *props.ErrorMessage
+			}
+			return resp, string(props.Status), fmt.Errorf("%s failed: %s", id, errorMessage)
+		}
+
+		// the status can move to `Validating` before the token has been populated
+		if props.Status == web.CustomDomainStatusValidating && (props.ValidationToken == nil || *props.ValidationToken == "") {
+			return resp, string(web.CustomDomainStatusRetrievingValidationToken), nil
+		}
+
+		return resp, string(props.Status), nil
+	}
+}
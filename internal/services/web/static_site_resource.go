@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -70,6 +71,39 @@ func resourceStaticSite() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"app_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"basic_auth": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.StaticSiteBasicAuthPassword,
+						},
+
+						"environments": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AllEnvironments",
+								"StagingEnvironments",
+							}, false),
+						},
+					},
+				},
+			},
+
 			"default_host_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -82,6 +116,13 @@ func resourceStaticSite() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if len(diff.Get("basic_auth").([]interface{})) > 0 && diff.Get("sku_tier").(string) != "Standard" {
+				return fmt.Errorf("`basic_auth` can only be specified when `sku_tier` is set to `Standard`")
+			}
+			return nil
+		}),
 	}
 }
 
@@ -124,6 +165,21 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("failed creating %s: %+v", id, err)
 	}
 
+	if d.IsNewResource() || d.HasChange("app_settings") {
+		appSettings := web.StringDictionary{
+			Properties: utils.ExpandMapStringPtrString(d.Get("app_settings").(map[string]interface{})),
+		}
+		if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name, appSettings); err != nil {
+			return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+		}
+	}
+
+	if d.HasChange("basic_auth") {
+		if err := createOrUpdateStaticSiteBasicAuth(ctx, client, id, expandStaticSiteBasicAuth(d.Get("basic_auth").([]interface{}))); err != nil {
+			return fmt.Errorf("updating Basic Auth for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceStaticSiteRead(d, meta)
@@ -186,6 +242,27 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("api_key", apiKey)
 
+	appSettingsResp, err := client.ListStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing App Settings for %s: %+v", id, err)
+	}
+	if err := d.Set("app_settings", utils.FlattenMapStringPtrString(appSettingsResp.Properties)); err != nil {
+		return fmt.Errorf("setting `app_settings`: %+v", err)
+	}
+
+	// Basic Auth is only available for Static Sites using the Standard SKU
+	basicAuth := make([]interface{}, 0)
+	if skuTier == "Standard" {
+		basicAuthResp, err := getStaticSiteBasicAuth(ctx, client, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving Basic Auth for %s: %+v", id, err)
+		}
+		basicAuth = flattenStaticSiteBasicAuth(basicAuthResp, d.Get("basic_auth").([]interface{}))
+	}
+	if err := d.Set("basic_auth", basicAuth); err != nil {
+		return fmt.Errorf("setting `basic_auth`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -210,3 +287,47 @@ func resourceStaticSiteDelete(d *pluginsdk.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func expandStaticSiteBasicAuth(input []interface{}) staticSiteBasicAuth {
+	if len(input) == 0 || input[0] == nil {
+		// there's no way to delete the Basic Auth settings, so instead we remove all of the environments it applies to
+		return staticSiteBasicAuth{
+			Properties: &staticSiteBasicAuthProperties{
+				ApplicableEnvironmentsMode: utils.String("SpecifiedEnvironments"),
+				Environments:               &[]string{},
+			},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return staticSiteBasicAuth{
+		Properties: &staticSiteBasicAuthProperties{
+			ApplicableEnvironmentsMode: utils.String(v["environments"].(string)),
+			Password:                   utils.String(v["password"].(string)),
+		},
+	}
+}
+
+func flattenStaticSiteBasicAuth(input *staticSiteBasicAuth, existing []interface{}) []interface{} {
+	if input == nil || input.Properties == nil || input.Properties.ApplicableEnvironmentsMode == nil {
+		return []interface{}{}
+	}
+
+	environments := *input.Properties.ApplicableEnvironmentsMode
+	if environments != "AllEnvironments" && environments != "StagingEnvironments" {
+		return []interface{}{}
+	}
+
+	// the password isn't returned by the API so we retain the value from the config
+	password := ""
+	if len(existing) > 0 && existing[0] != nil {
+		password = existing[0].(map[string]interface{})["password"].(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"environments": environments,
+			"password":     password,
+		},
+	}
+}
@@ -14,3 +14,22 @@ func StaticSiteName(v interface{}, k string) (warnings []string, errors []error)
 
 	return warnings, errors
 }
+
+func StaticSiteBasicAuthPassword(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(value) < 8 {
+		errors = append(errors, fmt.Errorf("%q must be at least 8 characters in length", k))
+		return
+	}
+
+	if !regexp.MustCompile(`[a-z]`).MatchString(value) || !regexp.MustCompile(`[A-Z]`).MatchString(value) || !regexp.MustCompile(`[0-9]`).MatchString(value) || !regexp.MustCompile(`[^a-zA-Z0-9]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain at least one uppercase letter, one lowercase letter, one number and one symbol", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,53 @@
+package validate
+
+import "testing"
+
+func TestStaticSiteBasicAuthPassword(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "P@ss1",
+			Valid: false,
+		},
+		{
+			Input: "password",
+			Valid: false,
+		},
+		{
+			Input: "Password1",
+			Valid: false,
+		},
+		{
+			Input: "password1!",
+			Valid: false,
+		},
+		{
+			Input: "PASSWORD1!",
+			Valid: false,
+		},
+		{
+			Input: "Password!",
+			Valid: false,
+		},
+		{
+			Input: "Password1!",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := StaticSiteBasicAuthPassword(tc.Input, "password")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
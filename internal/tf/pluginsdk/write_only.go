@@ -0,0 +1,82 @@
+package pluginsdk
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// writeOnlySaltLength is the number of random bytes used to salt the hash of a write-only value
+const writeOnlySaltLength = 16
+
+// HashWriteOnlyValue returns a salted hash of the value in the format `{salt}:{hash}`, which can be stored in the
+// State for sensitive arguments which are write-only in the API (for example passwords, connection strings or
+// client secrets) rather than the value itself.
+//
+// A new random salt is generated each time this is called, as such the result is only intended to be compared
+// against the configured value using WriteOnlyValueMatchesHash (e.g. via DiffSuppressWriteOnlyValue).
+func HashWriteOnlyValue(input string) (string, error) {
+	if input == "" {
+		return "", nil
+	}
+
+	salt := make([]byte, writeOnlySaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generating salt: %+v", err)
+	}
+
+	return fmt.Sprintf("%s:%s", hex.EncodeToString(salt), hex.EncodeToString(hashWriteOnlyValue(salt, input))), nil
+}
+
+// IsWriteOnlyValueHash returns whether the input is a hash generated by HashWriteOnlyValue
+func IsWriteOnlyValueHash(input string) bool {
+	_, _, ok := parseWriteOnlyValueHash(input)
+	return ok
+}
+
+// WriteOnlyValueMatchesHash returns whether the value matches the hash generated by HashWriteOnlyValue
+func WriteOnlyValueMatchesHash(value string, hash string) bool {
+	salt, expected, ok := parseWriteOnlyValueHash(hash)
+	if !ok {
+		return false
+	}
+
+	return hmac.Equal(hashWriteOnlyValue(salt, value), expected)
+}
+
+// DiffSuppressWriteOnlyValue is a DiffSuppressFunc for write-only arguments where the State contains the hash of the
+// value, suppressing the diff when the configured value matches this hash.
+//
+// NOTE: when the diff is suppressed `d.Get` returns the hash from the State - as such the value must only be sent to
+// the API when `d.HasChange` is true.
+func DiffSuppressWriteOnlyValue(_, old, new string, _ *ResourceData) bool {
+	return old != "" && WriteOnlyValueMatchesHash(new, old)
+}
+
+func hashWriteOnlyValue(salt []byte, value string) []byte {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+func parseWriteOnlyValueHash(input string) ([]byte, []byte, bool) {
+	segments := strings.Split(input, ":")
+	if len(segments) != 2 {
+		return nil, nil, false
+	}
+
+	salt, err := hex.DecodeString(segments[0])
+	if err != nil || len(salt) != writeOnlySaltLength {
+		return nil, nil, false
+	}
+
+	hash, err := hex.DecodeString(segments[1])
+	if err != nil || len(hash) != sha256.Size {
+		return nil, nil, false
+	}
+
+	return salt, hash, true
+}
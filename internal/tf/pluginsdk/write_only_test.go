@@ -0,0 +1,153 @@
+package pluginsdk
+
+import "testing"
+
+func TestHashWriteOnlyValue(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input string
+	}{
+		{
+			Name:  "simple value",
+			Input: "hello",
+		},
+		{
+			Name:  "password",
+			Input: "NotV3ryS3cur3P@$$w0rd",
+		},
+		{
+			Name:  "value containing a colon",
+			Input: "abc:def",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			first, err := HashWriteOnlyValue(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			second, err := HashWriteOnlyValue(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if first == second {
+				t.Fatalf("Expected the hashes to use different salts but both were %q", first)
+			}
+
+			for _, hash := range []string{first, second} {
+				if !IsWriteOnlyValueHash(hash) {
+					t.Fatalf("Expected %q to be a write-only value hash", hash)
+				}
+				if !WriteOnlyValueMatchesHash(tc.Input, hash) {
+					t.Fatalf("Expected %q to match the hash %q", tc.Input, hash)
+				}
+				if WriteOnlyValueMatchesHash(tc.Input+"!", hash) {
+					t.Fatalf("Expected %q not to match the hash %q", tc.Input+"!", hash)
+				}
+			}
+		})
+	}
+}
+
+func TestHashWriteOnlyValueEmpty(t *testing.T) {
+	actual, err := HashWriteOnlyValue("")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if actual != "" {
+		t.Fatalf("Expected an empty value but got %q", actual)
+	}
+}
+
+func TestIsWriteOnlyValueHash(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "NotV3ryS3cur3P@$$w0rd",
+			Expected: false,
+		},
+		{
+			// an unsalted hash
+			Input:    "e50085ac8c1ab48705e541f23cf3782d8f0cb5cde6fafdac19f0bc9298526ac6",
+			Expected: false,
+		},
+		{
+			// salt too short
+			Input:    "0011:e50085ac8c1ab48705e541f23cf3782d8f0cb5cde6fafdac19f0bc9298526ac6",
+			Expected: false,
+		},
+		{
+			// hash isn't hex
+			Input:    "00112233445566778899aabbccddeeff:hello",
+			Expected: false,
+		},
+		{
+			Input:    "00112233445566778899aabbccddeeff:e50085ac8c1ab48705e541f23cf3782d8f0cb5cde6fafdac19f0bc9298526ac6",
+			Expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			if actual := IsWriteOnlyValueHash(tc.Input); actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestDiffSuppressWriteOnlyValue(t *testing.T) {
+	hash, err := HashWriteOnlyValue("NotV3ryS3cur3P@$$w0rd")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Name:     "new value",
+			Old:      "",
+			New:      "NotV3ryS3cur3P@$$w0rd",
+			Expected: false,
+		},
+		{
+			Name:     "unchanged value",
+			Old:      hash,
+			New:      "NotV3ryS3cur3P@$$w0rd",
+			Expected: true,
+		},
+		{
+			Name:     "changed value",
+			Old:      hash,
+			New:      "An0th3rNotS3cur3P@$$w0rd",
+			Expected: false,
+		},
+		{
+			Name:     "removed value",
+			Old:      hash,
+			New:      "",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if actual := DiffSuppressWriteOnlyValue("password", tc.Old, tc.New, nil); actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}